@@ -12,7 +12,7 @@ require (
 	github.com/getkin/kin-openapi v0.118.0
 	github.com/googollee/go-socket.io v1.6.2
 	github.com/grpc-ecosystem/go-grpc-middleware v1.3.0
-	github.com/horahoradev/PrometheusTube/backend/video_service v0.0.0-20231121020509-1ab4cceb72a4
+	github.com/horahoradev/PrometheusTube/backend/video_service v0.0.0
 	github.com/horahoradev/horahora/scheduler v0.0.0-20221218222730-094304eae0b2
 	github.com/labstack/echo-contrib v0.13.0
 	github.com/labstack/echo/v4 v4.10.2
@@ -120,3 +120,9 @@ require (
 	google.golang.org/protobuf v1.30.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace (
+	github.com/KIRAKIRA-DOUGA/KIRAKIRA-golang-backend/user_service => ../user_service
+	github.com/horahoradev/PrometheusTube/backend/video_service => ../video_service
+	github.com/horahoradev/horahora/scheduler => ../scheduler
+)
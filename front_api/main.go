@@ -1,6 +1,8 @@
 package main
 
 import (
+	"context"
+
 	"github.com/horahoradev/horahora/front_api/config"
 	custommiddleware "github.com/horahoradev/horahora/front_api/middleware"
 	routes "github.com/horahoradev/horahora/front_api/routes"
@@ -27,11 +29,12 @@ func main() {
 	grpcAuth := custommiddleware.NewGRPCAuth(cfg)
 	e.Use(grpcAuth.GRPCAuth)
 
-	srv := sockets.New()
+	srv := sockets.New(grpcAuth, cfg.SchedulerClient)
 
 	routes.SetupRoutes(e, cfg, srv)
 
 	go sockets.Run(srv)
+	go sockets.RelayDownloadEvents(context.Background(), srv, cfg.SchedulerClient)
 
 	e.Logger.Fatal(e.Start(":8083"))
 }
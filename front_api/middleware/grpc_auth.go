@@ -56,13 +56,7 @@ func (j *JWTGRPCAuthenticator) GRPCAuth(next echo.HandlerFunc) echo.HandlerFunc
 			return next(c)
 		}
 
-		jwtDecoded, err := base64.StdEncoding.DecodeString(jwt)
-		if err != nil {
-			log.Errorf("Failed to decode jwt. Err: %s", err)
-			return next(c)
-		}
-
-		uid, err := j.authenticate(string(jwtDecoded))
+		uid, err := j.AuthenticateCookie(jwt)
 		if err != nil {
 			log.Errorf("Error while authenticating: %s", err)
 			return next(c)
@@ -83,6 +77,16 @@ func (j *JWTGRPCAuthenticator) GRPCAuth(next echo.HandlerFunc) echo.HandlerFunc
 	}
 }
 
+// AuthenticateCookie returns the user ID for the (base64 encoded) value of a jwt cookie
+func (j *JWTGRPCAuthenticator) AuthenticateCookie(cookie string) (int64, error) {
+	jwtDecoded, err := base64.StdEncoding.DecodeString(cookie)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode jwt. Err: %s", err)
+	}
+
+	return j.authenticate(string(jwtDecoded))
+}
+
 func (j *JWTGRPCAuthenticator) authenticate(jwt string) (int64, error) {
 	jwtValidationRequest := &userproto.ValidateJWTRequest{
 		Jwt: jwt,
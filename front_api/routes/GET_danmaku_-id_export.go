@@ -0,0 +1,55 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/horahoradev/PrometheusTube/backend/video_service/danmaku"
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// Route: GET /api/danmaku/:id/export
+// Query params: format is bilibili (the default), niconico or ass. imported=false leaves out danmaku archived from the
+// original site or imported from files.
+// Response: the video's danmaku as a file, for offline players like mpv
+func (v RouteHandler) handleExportDanmaku(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid video id")
+	}
+
+	format := danmaku.Bilibili
+	if f := c.QueryParam("format"); f != "" {
+		format, err = danmaku.ParseFormat(f)
+		if err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+	}
+
+	includeImported := c.QueryParam("imported") != "false"
+
+	resp, err := v.v.GetDanmaku(context.TODO(), &videoproto.DanmakuQueryReq{
+		VideoId: id,
+	})
+	if err != nil {
+		return err
+	}
+
+	comments := make([]danmaku.Comment, 0, len(resp.Comments))
+	for _, dn := range resp.Comments {
+		if dn.Imported && !includeImported {
+			continue
+		}
+		comments = append(comments, danmaku.FromProto(dn))
+	}
+
+	h := c.Response().Header()
+	h.Set(echo.HeaderContentType, format.ContentType())
+	h.Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=\"%d.%s\"", id, format.Extension()))
+	c.Response().WriteHeader(http.StatusOK)
+
+	return danmaku.Write(c.Response(), format, comments)
+}
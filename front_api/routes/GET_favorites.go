@@ -0,0 +1,72 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	custommiddleware "github.com/horahoradev/horahora/front_api/middleware"
+	"github.com/labstack/echo/v4"
+)
+
+// Route: GET /api/favorites
+// Requires authentication
+// Lists the videos the user has favorited, most recently favorited first
+// Query: page (from 1), and showMature=true to include mature videos
+// Response: a page of videos, with pagination data
+func (v RouteHandler) handleGetFavorites(c echo.Context) error {
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return c.String(http.StatusUnauthorized, err.Error())
+	}
+
+	pageNumber := getPageNumber(c)
+
+	resp, err := v.v.GetFavorites(context.TODO(), &videoproto.FavoritesQuery{
+		UserId:     profile.UserID,
+		PageNumber: pageNumber,
+		ShowMature: c.QueryParam("showMature") == "true",
+	})
+	if err != nil {
+		return err
+	}
+
+	data := FavoritesData{
+		PaginationData: PaginationData{
+			NumberOfItems: int(resp.NumberOfVideos),
+			CurrentPage:   int(pageNumber),
+		},
+		Videos: []Video{},
+	}
+
+	for _, video := range resp.Videos {
+		data.Videos = append(data.Videos, videoData(video))
+	}
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func videoData(video *videoproto.Video) Video {
+	return Video{
+		Title:         video.VideoTitle,
+		VideoID:       video.VideoID,
+		Views:         video.Views,
+		AuthorID:      video.AuthorID,
+		AuthorName:    video.AuthorName,
+		ThumbnailLoc:  video.ThumbnailLoc,
+		Rating:        video.Rating,
+		VideoDuration: video.VideoDuration,
+		IsMature:      video.IsMature,
+	}
+}
+
+// viewerID returns the ID of the logged in user, or 0 if nobody's logged in
+func viewerID(c echo.Context) int64 {
+	loggedIn, _ := c.Get(custommiddleware.UserLoggedIn).(bool)
+	if !loggedIn {
+		return 0
+	}
+
+	id, _ := c.Get(custommiddleware.UserIDKey).(int64)
+	return id
+}
@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// Route: GET /api/favorites/:id
+// Says how many users have favorited the video, and whether the logged in user has, if anyone's logged in
+// Response: the video's favorite status
+func (v RouteHandler) handleGetFavoriteStatus(c echo.Context) error {
+	videoID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid video id")
+	}
+
+	resp, err := v.v.GetFavoriteStatus(context.TODO(), &videoproto.FavoriteReq{
+		UserId:  viewerID(c),
+		VideoId: videoID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, favoriteStatus(resp))
+}
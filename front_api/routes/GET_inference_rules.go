@@ -0,0 +1,47 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+
+	schedulerproto "github.com/horahoradev/horahora/scheduler/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// Route: GET /api/inference-rules
+// Requires authentication
+// Lists the rules archived videos are classified with. Admins only.
+// Response: the rules
+func (v RouteHandler) handleGetInferenceRules(c echo.Context) error {
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return err
+	}
+
+	if profile.Rank != 2 {
+		return c.String(http.StatusForbidden, "Insufficient user status")
+	}
+
+	resp, err := v.s.GetInferenceCategories(context.TODO(), &schedulerproto.Empty{})
+	if err != nil {
+		return err
+	}
+
+	rules := make([]InferenceRule, 0, len(resp.Entries))
+	for _, entry := range resp.Entries {
+		rules = append(rules, inferenceRule(entry))
+	}
+
+	return c.JSON(http.StatusOK, rules)
+}
+
+func inferenceRule(entry *schedulerproto.InferenceEntry) InferenceRule {
+	return InferenceRule{
+		ID:       entry.ID,
+		Category: entry.Category,
+		Field:    entry.Field,
+		Pattern:  entry.Pattern,
+		Weight:   entry.Weight,
+		Priority: entry.Priority,
+	}
+}
@@ -0,0 +1,45 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// Route: GET /api/playlists
+// Lists a user's playlists, most recently changed first, without their entries. Only the user sees their unlisted and
+// private playlists.
+// Query: user is the user's ID, defaulting to the logged in user
+// Response: the playlists
+func (v RouteHandler) handleListPlaylists(c echo.Context) error {
+	ownerID := viewerID(c)
+	if user := c.QueryParam("user"); user != "" {
+		var err error
+		ownerID, err = strconv.ParseInt(user, 10, 64)
+		if err != nil {
+			return c.String(http.StatusBadRequest, "invalid user id")
+		}
+	}
+
+	if ownerID == 0 {
+		return c.String(http.StatusBadRequest, "a user is required")
+	}
+
+	resp, err := v.v.ListPlaylists(context.TODO(), &videoproto.PlaylistListReq{
+		OwnerId: ownerID,
+		UserId:  viewerID(c),
+	})
+	if err != nil {
+		return err
+	}
+
+	playlists := make([]Playlist, 0, len(resp.Playlists))
+	for _, p := range resp.Playlists {
+		playlists = append(playlists, playlistData(p))
+	}
+
+	return c.JSON(http.StatusOK, playlists)
+}
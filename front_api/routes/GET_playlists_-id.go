@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// Route: GET /api/playlists/:id
+// Private playlists are only shown to their owner
+// Response: the playlist, with its entries in order
+func (v RouteHandler) handleGetPlaylist(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid playlist id")
+	}
+
+	resp, err := v.v.GetPlaylist(context.TODO(), &videoproto.PlaylistReq{
+		Id:     id,
+		UserId: viewerID(c),
+	})
+	if err != nil {
+		return playlistErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, playlistData(resp))
+}
@@ -0,0 +1,42 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// Route: GET /api/playlists/:id/neighbours/:videoid
+// Finds the entries either side of a video in a playlist, so that the player can go on to the next one when it ends.
+// Videos which can't be played yet are skipped.
+// Query: loop=true goes from the last entry back to the first, and the other way
+// Response: the previous and next entries, which are null at either end of the playlist unless it loops
+func (v RouteHandler) handleGetPlaylistNeighbours(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid playlist id")
+	}
+
+	videoID, err := strconv.ParseInt(c.Param("videoid"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid video id")
+	}
+
+	resp, err := v.v.GetPlaylistNeighbours(context.TODO(), &videoproto.PlaylistPositionReq{
+		PlaylistId: id,
+		UserId:     viewerID(c),
+		VideoId:    videoID,
+		Loop:       c.QueryParam("loop") == "true",
+	})
+	if err != nil {
+		return playlistErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, PlaylistNeighbours{
+		Previous: playlistEntryData(resp.Previous),
+		Next:     playlistEntryData(resp.Next),
+	})
+}
@@ -0,0 +1,147 @@
+package routes
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errInvalidRange = errors.New("invalid range")
+
+// parseByteRange parses a Range header into an offset and length as understood by the video service.
+// partial is false if the whole file should be sent, which includes headers we don't support (e.g. multiple ranges).
+func parseByteRange(header string) (offset, length int64, partial bool, err error) {
+	if !strings.HasPrefix(header, "bytes=") || strings.Contains(header, ",") {
+		return 0, 0, false, nil
+	}
+
+	spl := strings.SplitN(strings.TrimPrefix(header, "bytes="), "-", 2)
+	if len(spl) != 2 {
+		return 0, 0, false, nil
+	}
+
+	// bytes=-500 is the last 500 bytes
+	if spl[0] == "" {
+		suffix, err := strconv.ParseInt(spl[1], 10, 64)
+		if err != nil || suffix <= 0 {
+			return 0, 0, false, errInvalidRange
+		}
+		return -suffix, 0, true, nil
+	}
+
+	start, err := strconv.ParseInt(spl[0], 10, 64)
+	if err != nil || start < 0 {
+		return 0, 0, false, errInvalidRange
+	}
+
+	// bytes=500- is everything from 500
+	if spl[1] == "" {
+		return start, 0, true, nil
+	}
+
+	end, err := strconv.ParseInt(spl[1], 10, 64)
+	if err != nil || end < start {
+		return 0, 0, false, errInvalidRange
+	}
+
+	return start, end - start + 1, true, nil
+}
+
+// handleDownloadVideo sends the original upload of a video. Only the author and trusted users can download originals.
+// Range requests are supported, so interrupted downloads can be resumed.
+func (v RouteHandler) handleDownloadVideo(c echo.Context) error {
+	id := c.Param("id")
+	if _, err := strconv.ParseInt(id, 10, 64); err != nil {
+		return c.String(http.StatusBadRequest, "invalid video id")
+	}
+
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return err
+	}
+
+	video, err := v.v.GetVideo(context.Background(), &videoproto.VideoRequest{VideoID: id})
+	if err != nil {
+		return err
+	}
+
+	if video.AuthorID != profile.UserID && profile.Rank < 1 {
+		return c.String(http.StatusForbidden, "Insufficient user status")
+	}
+
+	offset, length, partial, err := parseByteRange(c.Request().Header.Get("Range"))
+	if err != nil {
+		return c.String(http.StatusRequestedRangeNotSatisfiable, err.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := v.v.DownloadVideo(ctx, &videoproto.VideoRequest{
+		VideoID: id,
+		Offset:  offset,
+		Length:  length,
+	})
+	if err != nil {
+		return err
+	}
+
+	first, err := stream.Recv()
+	if status.Code(err) == codes.OutOfRange {
+		return c.String(http.StatusRequestedRangeNotSatisfiable, status.Convert(err).Message())
+	} else if err != nil {
+		return err
+	}
+
+	meta := first.GetMeta()
+	if meta == nil {
+		return fmt.Errorf("expected metadata as the first message of the download for video %s", id)
+	}
+
+	h := c.Response().Header()
+	h.Set(echo.HeaderContentType, "application/octet-stream")
+	h.Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=\"%s\"", id))
+	h.Set(echo.HeaderContentLength, strconv.FormatInt(meta.Length, 10))
+	h.Set("Accept-Ranges", "bytes")
+	// Older videos might not have a checksum yet
+	if digest, err := hex.DecodeString(meta.Sha256); err == nil && len(digest) > 0 {
+		h.Set("X-Checksum-Sha256", meta.Sha256)
+		// RFC 3230 instance digest, for clients which verify downloads automatically
+		h.Set("Digest", "sha-256="+base64.StdEncoding.EncodeToString(digest))
+	}
+
+	code := http.StatusOK
+	if partial {
+		code = http.StatusPartialContent
+		h.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", meta.Offset, meta.Offset+meta.Length-1, meta.Size))
+	}
+	c.Response().WriteHeader(code)
+
+	for {
+		chunk, err := stream.Recv()
+		switch {
+		case err == io.EOF:
+			return nil
+		case err != nil:
+			// Too late to change the status, so the client will see a short read
+			c.Logger().Errorf("Download of video %s failed. Err: %s", id, err)
+			return nil
+		}
+
+		_, err = c.Response().Write(chunk.GetContent().GetData())
+		if err != nil {
+			return nil // client went away
+		}
+	}
+}
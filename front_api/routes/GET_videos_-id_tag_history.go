@@ -0,0 +1,55 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// Route: GET /api/videos/:id/tag-history
+// Lists the changes to the video's tags, newest first, with who made them
+// Query: page (from 1)
+// Response: a page of edits, with pagination data
+func (v RouteHandler) handleGetTagHistory(c echo.Context) error {
+	videoID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid video id")
+	}
+
+	pageNumber := getPageNumber(c)
+
+	resp, err := v.v.GetTagHistory(context.TODO(), &videoproto.TagHistoryReq{
+		VideoId:    videoID,
+		PageNumber: pageNumber,
+	})
+	if err != nil {
+		return err
+	}
+
+	data := TagHistoryData{
+		PaginationData: PaginationData{
+			NumberOfItems: int(resp.Total),
+			CurrentPage:   int(pageNumber),
+		},
+		Edits: []TagEdit{},
+	}
+
+	for _, e := range resp.Edits {
+		data.Edits = append(data.Edits, TagEdit{
+			ID:             e.Id,
+			UserID:         e.UserId,
+			Username:       e.Username,
+			Action:         e.Action,
+			Tag:            e.Tag,
+			RevertedEditID: e.RevertedEditId,
+			TagsBefore:     e.TagsBefore,
+			TagsAfter:      e.TagsAfter,
+			CreatedAt:      e.CreatedAt,
+		})
+	}
+
+	return c.JSON(http.StatusOK, data)
+}
@@ -0,0 +1,26 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// Route: GET /api/videos/:id/tags
+// Response: the video's tags, in the order they were added, and whether each is locked
+func (v RouteHandler) handleGetTags(c echo.Context) error {
+	videoID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid video id")
+	}
+
+	resp, err := v.v.GetTags(context.TODO(), &videoproto.VideoTagsReq{VideoId: videoID})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, videoTags(resp))
+}
@@ -0,0 +1,33 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// handleGetUploadOffset returns how much of the upload has been received in Upload-Offset, so that the client knows
+// where to resume from
+func (v RouteHandler) handleGetUploadOffset(c echo.Context) error {
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return err
+	}
+
+	if profile.Rank != 2 {
+		return c.String(http.StatusForbidden, "Insufficient user status")
+	}
+
+	session, err := v.v.GetUploadSession(context.Background(), &videoproto.UploadSessionReq{
+		SessionID: c.Param("id"),
+		UserID:    profile.UserID,
+	})
+	if err != nil {
+		return uploadErr(c, err)
+	}
+
+	setUploadSessionHeaders(c, session)
+	return c.NoContent(http.StatusOK)
+}
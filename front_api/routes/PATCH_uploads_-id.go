@@ -0,0 +1,87 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// handleAppendUpload appends the request body to an upload session at Upload-Offset.
+// If Upload-Checksum is set, nothing is appended unless the body matches it.
+func (v RouteHandler) handleAppendUpload(c echo.Context) error {
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return err
+	}
+
+	if profile.Rank != 2 {
+		return c.String(http.StatusForbidden, "Insufficient user status")
+	}
+
+	if c.Request().Header.Get(echo.HeaderContentType) != uploadContentType {
+		return c.String(http.StatusUnsupportedMediaType, fmt.Sprintf("Content-Type must be %s", uploadContentType))
+	}
+
+	offset, err := strconv.ParseInt(c.Request().Header.Get(uploadOffsetHeader), 10, 64)
+	if err != nil || offset < 0 {
+		return c.String(http.StatusBadRequest, fmt.Sprintf("%s must be a non-negative integer", uploadOffsetHeader))
+	}
+
+	checksum, err := parseUploadChecksum(c.Request().Header.Get(uploadChecksumHeader))
+	if err != nil {
+		return c.String(http.StatusBadRequest, err.Error())
+	}
+
+	// Cancelling the stream, rather than closing it, tells the video service to discard what it's received
+	ctx, cancel := context.WithCancel(c.Request().Context())
+	defer cancel()
+
+	stream, err := v.v.AppendUploadChunk(ctx)
+	if err != nil {
+		return err
+	}
+
+	chunk := &videoproto.UploadChunk{
+		SessionID: c.Param("id"),
+		UserID:    profile.UserID,
+		Offset:    offset,
+		Sha256:    checksum,
+	}
+
+	// The first chunk is sent even if the body is empty, so that the session is still checked
+	chunkBuffer := make([]byte, fileUploadChunkSize)
+	body := c.Request().Body
+	for {
+		bytesRead, readErr := io.ReadFull(body, chunkBuffer)
+		if readErr != nil && readErr != io.EOF && readErr != io.ErrUnexpectedEOF {
+			// Whatever was sent in this request is discarded, the client can resume from the last offset
+			cancel()
+			return readErr
+		}
+
+		chunk.Data = chunkBuffer[:bytesRead]
+		err = stream.Send(chunk)
+		if err != nil {
+			break // the real error is returned by CloseAndRecv
+		}
+
+		if readErr != nil {
+			break
+		}
+
+		chunk = &videoproto.UploadChunk{}
+	}
+
+	session, err := stream.CloseAndRecv()
+	if err != nil {
+		return uploadErr(c, err)
+	}
+
+	setUploadSessionHeaders(c, session)
+	return c.NoContent(http.StatusNoContent)
+}
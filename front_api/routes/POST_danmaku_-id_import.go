@@ -0,0 +1,109 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/horahoradev/PrometheusTube/backend/video_service/danmaku"
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	danmakuFileKey = "file"
+	// Even a busy video's danmaku are a few MB
+	maxDanmakuFileSize = 16 * 1024 * 1024
+)
+
+// Route: POST /api/danmaku/:id/import
+// Requires authentication
+// Form: file is a danmaku file in bilibili XML, niconico XML or JSON, or ASS (e.g. from DanmakuFactory). The format is
+// detected from its contents. The number of danmaku which can be imported at once and per day is limited.
+// Response: {"imported": n}
+func (v RouteHandler) handleImportDanmaku(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid video id")
+	}
+
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return c.String(http.StatusUnauthorized, err.Error())
+	}
+
+	if profile.Banned {
+		return c.String(http.StatusForbidden, "Insufficient user status")
+	}
+
+	fileHeader, err := c.FormFile(danmakuFileKey)
+	if err != nil {
+		return c.String(http.StatusBadRequest, fmt.Sprintf("missing %s", danmakuFileKey))
+	}
+
+	if fileHeader.Size > maxDanmakuFileSize {
+		return c.String(http.StatusRequestEntityTooLarge, fmt.Sprintf("danmaku files can be at most %d bytes", maxDanmakuFileSize))
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxDanmakuFileSize))
+	if err != nil {
+		return err
+	}
+
+	comments, err := danmaku.Parse(data)
+	switch {
+	case errors.Is(err, danmaku.ErrUnknownFormat):
+		return c.String(http.StatusUnsupportedMediaType, err.Error())
+	case err != nil:
+		return c.String(http.StatusBadRequest, err.Error())
+	case len(comments) == 0:
+		return c.String(http.StatusBadRequest, "no danmaku in file")
+	}
+
+	req := videoproto.DanmakuImportReq{
+		VideoId:  id,
+		UserId:   profile.UserID,
+		Source:   string(danmaku.DetectFormat(data)),
+		Comments: make([]*videoproto.Danmaku, 0, len(comments)),
+	}
+	for _, comment := range comments {
+		req.Comments = append(req.Comments, comment.ToProto())
+	}
+
+	resp, err := v.v.ImportDanmaku(context.TODO(), &req)
+	if err != nil {
+		return danmakuErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]int64{"imported": resp.Imported})
+}
+
+// danmakuErr reports errors from posting, importing or deleting danmaku with the matching status
+func danmakuErr(c echo.Context, err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	switch st.Code() {
+	case codes.InvalidArgument:
+		return c.String(http.StatusBadRequest, st.Message())
+	case codes.NotFound:
+		return c.String(http.StatusNotFound, st.Message())
+	case codes.ResourceExhausted:
+		return c.String(http.StatusTooManyRequests, st.Message())
+	}
+
+	return err
+}
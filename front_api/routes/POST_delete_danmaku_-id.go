@@ -0,0 +1,56 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	userproto "github.com/KIRAKIRA-DOUGA/KIRAKIRA-golang-backend/user_service/protocol"
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/horahoradev/horahora/front_api/sockets"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Route: POST /delete-danmaku/:id
+// Requires authentication
+// Allows the user, if sufficiently high rank, to delete a danmaku. Everyone watching the video is told to remove it.
+// Response: 200 if okay
+func (v RouteHandler) handleDeleteDanmaku(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid danmaku id")
+	}
+
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return err
+	}
+
+	// Make an audit event even if they don't pass the permission check
+	_, err = v.u.AddAuditEvent(context.TODO(), &userproto.NewAuditEventRequest{
+		Message: fmt.Sprintf("User attempted to delete danmaku id %d", id),
+		User_ID: profile.UserID,
+	})
+	if err != nil {
+		return err // If the audit event can't be created, fail the operation
+	}
+
+	if profile.Rank != 2 {
+		return c.String(http.StatusForbidden, "Insufficient user status")
+	}
+
+	deleted, err := v.v.DeleteDanmaku(context.Background(), &videoproto.DanmakuDeleteReq{Ids: []int64{id}})
+	if err != nil {
+		return danmakuErr(c, err)
+	}
+
+	if len(deleted.Comments) == 0 {
+		return c.String(http.StatusNotFound, "danmaku not found")
+	}
+
+	sockets.BroadcastDanmakuDeleted(v.srv, deleted.Comments)
+
+	return c.JSON(http.StatusOK, nil)
+}
@@ -0,0 +1,36 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// Route: POST /api/delete-favorite/:id
+// Requires authentication
+// Unfavorites the video
+// Response: whether the user has favorited the video, and how many users have
+func (v RouteHandler) handleRemoveFavorite(c echo.Context) error {
+	videoID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid video id")
+	}
+
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return c.String(http.StatusUnauthorized, err.Error())
+	}
+
+	resp, err := v.v.RemoveFavorite(context.TODO(), &videoproto.FavoriteReq{
+		UserId:  profile.UserID,
+		VideoId: videoID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, favoriteStatus(resp))
+}
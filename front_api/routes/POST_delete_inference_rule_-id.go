@@ -0,0 +1,47 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	userproto "github.com/KIRAKIRA-DOUGA/KIRAKIRA-golang-backend/user_service/protocol"
+	schedulerproto "github.com/horahoradev/horahora/scheduler/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// Route: POST /api/delete-inference-rule/:id
+// Requires authentication
+// Deletes a rule for classifying archived videos. Admins only.
+// Response: 200 if okay
+func (v RouteHandler) handleDeleteInferenceRule(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid rule id")
+	}
+
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = v.u.AddAuditEvent(context.TODO(), &userproto.NewAuditEventRequest{
+		Message: fmt.Sprintf("User attempted to delete inference rule id %d", id),
+		User_ID: profile.UserID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if profile.Rank != 2 {
+		return c.String(http.StatusForbidden, "Insufficient user status")
+	}
+
+	_, err = v.s.DeleteInferenceCategory(context.TODO(), &schedulerproto.InferenceDeletionReq{ID: id})
+	if err != nil {
+		return inferenceErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
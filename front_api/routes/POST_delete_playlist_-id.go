@@ -0,0 +1,36 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// Route: POST /api/delete-playlist/:id
+// Requires authentication
+// Deletes the user's playlist
+// Response: 200 if okay
+func (v RouteHandler) handleDeletePlaylist(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid playlist id")
+	}
+
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return c.String(http.StatusUnauthorized, err.Error())
+	}
+
+	_, err = v.v.DeletePlaylist(context.TODO(), &videoproto.PlaylistReq{
+		Id:     id,
+		UserId: profile.UserID,
+	})
+	if err != nil {
+		return playlistErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
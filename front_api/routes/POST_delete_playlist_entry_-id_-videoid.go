@@ -0,0 +1,42 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// Route: POST /api/delete-playlist-entry/:id/:videoid
+// Requires authentication
+// Removes a video from the user's playlist. The entries after it move up.
+// Response: 200 if okay
+func (v RouteHandler) handleRemovePlaylistEntry(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid playlist id")
+	}
+
+	videoID, err := strconv.ParseInt(c.Param("videoid"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid video id")
+	}
+
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return c.String(http.StatusUnauthorized, err.Error())
+	}
+
+	_, err = v.v.RemovePlaylistEntry(context.TODO(), &videoproto.PlaylistEntryReq{
+		PlaylistId: id,
+		UserId:     profile.UserID,
+		VideoId:    videoID,
+	})
+	if err != nil {
+		return playlistErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
@@ -0,0 +1,55 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	userproto "github.com/KIRAKIRA-DOUGA/KIRAKIRA-golang-backend/user_service/protocol"
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Route: POST /delete-subtitles/:id
+// Requires authentication
+// Allows the video's author and trusted users to delete a subtitle track. The track is removed from the video's manifest.
+// Response: 200 if okay
+func (v RouteHandler) handleDeleteSubtitles(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid subtitle track id")
+	}
+
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return err
+	}
+
+	// Make an audit event even if they don't pass the permission check
+	_, err = v.u.AddAuditEvent(context.TODO(), &userproto.NewAuditEventRequest{
+		Message: fmt.Sprintf("User attempted to delete subtitle track id %d", id),
+		User_ID: profile.UserID,
+	})
+	if err != nil {
+		return err // If the audit event can't be created, fail the operation
+	}
+
+	if profile.Banned {
+		return c.String(http.StatusForbidden, "Insufficient user status")
+	}
+
+	// Anyone else can only delete tracks of their own videos, which video service checks, since only it knows the track's video
+	req := videoproto.SubtitleDeleteReq{Id: id}
+	if profile.Rank < 1 {
+		req.VideoAuthorId = profile.UserID
+	}
+
+	_, err = v.v.DeleteSubtitle(context.Background(), &req)
+	if err != nil {
+		return subtitleErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
@@ -0,0 +1,37 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Route: POST /api/delete-tag/:id
+// Requires authentication
+// Removes a tag from the video. Locked tags can only be removed by the uploader or a moderator.
+// Form: tag
+// Response: the video's tags
+func (v RouteHandler) handleRemoveTag(c echo.Context) error {
+	videoID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid video id")
+	}
+
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return c.String(http.StatusUnauthorized, err.Error())
+	}
+
+	if profile.Banned {
+		return c.String(http.StatusForbidden, "Insufficient user status")
+	}
+
+	resp, err := v.v.RemoveTag(context.TODO(), tagEditReq(c, videoID, profile))
+	if err != nil {
+		return tagErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, videoTags(resp))
+}
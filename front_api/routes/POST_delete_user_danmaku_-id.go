@@ -0,0 +1,69 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	userproto "github.com/KIRAKIRA-DOUGA/KIRAKIRA-golang-backend/user_service/protocol"
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/horahoradev/horahora/front_api/sockets"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Route: POST /delete-user-danmaku/:id
+// Requires authentication
+// Query params: videoID, optional, limits the deletion to one video
+// Allows the user, if sufficiently high rank, to delete all of a user's danmaku, e.g. after they've spammed.
+// Response: {"deleted": n}
+func (v RouteHandler) handleDeleteUserDanmaku(c echo.Context) error {
+	authorID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid user id")
+	}
+
+	var videoID int64
+	if param := c.QueryParam("videoID"); param != "" {
+		videoID, err = strconv.ParseInt(param, 10, 64)
+		if err != nil {
+			return c.String(http.StatusBadRequest, "invalid video id")
+		}
+	}
+
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return err
+	}
+
+	msg := fmt.Sprintf("User attempted to delete all danmaku by user id %d", authorID)
+	if videoID != 0 {
+		msg += fmt.Sprintf(" on video id %d", videoID)
+	}
+
+	// Make an audit event even if they don't pass the permission check
+	_, err = v.u.AddAuditEvent(context.TODO(), &userproto.NewAuditEventRequest{
+		Message: msg,
+		User_ID: profile.UserID,
+	})
+	if err != nil {
+		return err // If the audit event can't be created, fail the operation
+	}
+
+	if profile.Rank != 2 {
+		return c.String(http.StatusForbidden, "Insufficient user status")
+	}
+
+	deleted, err := v.v.DeleteDanmaku(context.Background(), &videoproto.DanmakuDeleteReq{
+		AuthorId: authorID,
+		VideoId:  videoID,
+	})
+	if err != nil {
+		return danmakuErr(c, err)
+	}
+
+	sockets.BroadcastDanmakuDeleted(v.srv, deleted.Comments)
+
+	return c.JSON(http.StatusOK, map[string]int{"deleted": len(deleted.Comments)})
+}
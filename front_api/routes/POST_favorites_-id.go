@@ -0,0 +1,47 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Route: POST /api/favorites/:id
+// Requires authentication
+// Favorites the video. Favoriting a video twice does nothing.
+// Response: whether the user has favorited the video, and how many users have
+func (v RouteHandler) handleAddFavorite(c echo.Context) error {
+	videoID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid video id")
+	}
+
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return c.String(http.StatusUnauthorized, err.Error())
+	}
+
+	resp, err := v.v.AddFavorite(context.TODO(), &videoproto.FavoriteReq{
+		UserId:  profile.UserID,
+		VideoId: videoID,
+	})
+	if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+		return c.String(http.StatusNotFound, st.Message())
+	} else if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, favoriteStatus(resp))
+}
+
+func favoriteStatus(resp *videoproto.FavoriteStatus) FavoriteStatus {
+	return FavoriteStatus{
+		Favorite: resp.Favorite,
+		Count:    resp.Count,
+	}
+}
@@ -0,0 +1,95 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	userproto "github.com/KIRAKIRA-DOUGA/KIRAKIRA-golang-backend/user_service/protocol"
+	schedulerproto "github.com/horahoradev/horahora/scheduler/protocol"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Route: POST /api/inference-rules
+// Requires authentication
+// Adds a rule for classifying archived videos. Admins only. Changing the rules reclassifies every archived video.
+// Form: category, field (tag, title, uploader or site), pattern (a regular expression for titles, matched whole and
+// ignoring case otherwise), weight (defaults to 1) and priority (defaults to 0)
+// Response: the new rule
+func (v RouteHandler) handleAddInferenceRule(c echo.Context) error {
+	entry, err := inferenceEntryForm(c)
+	if err != nil {
+		return c.String(http.StatusBadRequest, err.Error())
+	}
+
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = v.u.AddAuditEvent(context.TODO(), &userproto.NewAuditEventRequest{
+		Message: fmt.Sprintf("User attempted to add inference rule %s %q for category %s", entry.Field, entry.Pattern, entry.Category),
+		User_ID: profile.UserID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if profile.Rank != 2 {
+		return c.String(http.StatusForbidden, "Insufficient user status")
+	}
+
+	resp, err := v.s.AddInferenceCategory(context.TODO(), entry)
+	if err != nil {
+		return inferenceErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, inferenceRule(resp))
+}
+
+// inferenceEntryForm reads a rule from the request's form
+func inferenceEntryForm(c echo.Context) (*schedulerproto.InferenceEntry, error) {
+	entry := schedulerproto.InferenceEntry{
+		Category: c.FormValue("category"),
+		Field:    c.FormValue("field"),
+		Pattern:  c.FormValue("pattern"),
+	}
+
+	if weight := c.FormValue("weight"); weight != "" {
+		w, err := strconv.ParseFloat(weight, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight")
+		}
+		entry.Weight = w
+	}
+
+	if priority := c.FormValue("priority"); priority != "" {
+		p, err := strconv.ParseInt(priority, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid priority")
+		}
+		entry.Priority = int32(p)
+	}
+
+	return &entry, nil
+}
+
+// inferenceErr reports errors from changing the rules with the matching status
+func inferenceErr(c echo.Context, err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	switch st.Code() {
+	case codes.InvalidArgument:
+		return c.String(http.StatusBadRequest, st.Message())
+	case codes.NotFound:
+		return c.String(http.StatusNotFound, st.Message())
+	}
+
+	return err
+}
@@ -0,0 +1,53 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	userproto "github.com/KIRAKIRA-DOUGA/KIRAKIRA-golang-backend/user_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// Route: POST /api/inference-rules/:id
+// Requires authentication
+// Replaces a rule for classifying archived videos. Admins only.
+// Form: as for POST /api/inference-rules
+// Response: the updated rule
+func (v RouteHandler) handleUpdateInferenceRule(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid rule id")
+	}
+
+	entry, err := inferenceEntryForm(c)
+	if err != nil {
+		return c.String(http.StatusBadRequest, err.Error())
+	}
+	entry.ID = id
+
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = v.u.AddAuditEvent(context.TODO(), &userproto.NewAuditEventRequest{
+		Message: fmt.Sprintf("User attempted to change inference rule id %d to %s %q for category %s", id, entry.Field, entry.Pattern, entry.Category),
+		User_ID: profile.UserID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if profile.Rank != 2 {
+		return c.String(http.StatusForbidden, "Insufficient user status")
+	}
+
+	resp, err := v.s.UpdateInferenceCategory(context.TODO(), entry)
+	if err != nil {
+		return inferenceErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, inferenceRule(resp))
+}
@@ -0,0 +1,93 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Route: POST /api/playlists
+// Requires authentication
+// Creates a playlist (mylist)
+// Form: title, description (optional) and visibility: public (listed on the user's profile), unlisted (seen by anyone
+// with the link) or private (the default, only seen by the user)
+// Response: the new playlist
+func (v RouteHandler) handleCreatePlaylist(c echo.Context) error {
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return c.String(http.StatusUnauthorized, err.Error())
+	}
+
+	resp, err := v.v.CreatePlaylist(context.TODO(), playlistForm(c, 0, profile.UserID))
+	if err != nil {
+		return playlistErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, playlistData(resp))
+}
+
+// playlistForm reads a playlist's details from the request's form
+func playlistForm(c echo.Context, id, userID int64) *videoproto.Playlist {
+	return &videoproto.Playlist{
+		Id:          id,
+		UserId:      userID,
+		Title:       c.FormValue("title"),
+		Description: c.FormValue("description"),
+		Visibility:  c.FormValue("visibility"),
+	}
+}
+
+func playlistData(p *videoproto.Playlist) Playlist {
+	ret := Playlist{
+		ID:          p.Id,
+		UserID:      p.UserId,
+		Title:       p.Title,
+		Description: p.Description,
+		Visibility:  p.Visibility,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+		EntryCount:  p.EntryCount,
+	}
+
+	for _, entry := range p.Entries {
+		ret.Entries = append(ret.Entries, *playlistEntryData(entry))
+	}
+
+	return ret
+}
+
+func playlistEntryData(entry *videoproto.PlaylistEntry) *PlaylistEntry {
+	if entry == nil {
+		return nil
+	}
+
+	return &PlaylistEntry{
+		Video:    videoData(entry.Video),
+		Position: entry.Position,
+		Note:     entry.Note,
+		AddedAt:  entry.AddedAt,
+	}
+}
+
+// playlistErr reports errors from playlist requests with the matching status
+func playlistErr(c echo.Context, err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	switch st.Code() {
+	case codes.InvalidArgument:
+		return c.String(http.StatusBadRequest, st.Message())
+	case codes.NotFound:
+		return c.String(http.StatusNotFound, st.Message())
+	case codes.AlreadyExists, codes.ResourceExhausted:
+		return c.String(http.StatusConflict, st.Message())
+	}
+
+	return err
+}
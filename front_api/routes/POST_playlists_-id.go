@@ -0,0 +1,33 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Route: POST /api/playlists/:id
+// Requires authentication
+// Changes the user's playlist
+// Form: as for POST /api/playlists
+// Response: the updated playlist, without its entries
+func (v RouteHandler) handleUpdatePlaylist(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid playlist id")
+	}
+
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return c.String(http.StatusUnauthorized, err.Error())
+	}
+
+	resp, err := v.v.UpdatePlaylist(context.TODO(), playlistForm(c, id, profile.UserID))
+	if err != nil {
+		return playlistErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, playlistData(resp))
+}
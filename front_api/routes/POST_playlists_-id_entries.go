@@ -0,0 +1,65 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// Route: POST /api/playlists/:id/entries
+// Requires authentication
+// Adds a video to the user's playlist
+// Form: videoID, note (optional) and position (optional, from 1), which defaults to the end
+// Response: the new entry
+func (v RouteHandler) handleAddPlaylistEntry(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid playlist id")
+	}
+
+	videoID, err := strconv.ParseInt(c.FormValue("videoID"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid video id")
+	}
+
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return c.String(http.StatusUnauthorized, err.Error())
+	}
+
+	req, err := playlistEntryForm(c, id, profile.UserID, videoID)
+	if err != nil {
+		return c.String(http.StatusBadRequest, err.Error())
+	}
+
+	resp, err := v.v.AddPlaylistEntry(context.TODO(), req)
+	if err != nil {
+		return playlistErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, playlistEntryData(resp))
+}
+
+// playlistEntryForm reads an entry's note and position from the request's form
+func playlistEntryForm(c echo.Context, playlistID, userID, videoID int64) (*videoproto.PlaylistEntryReq, error) {
+	req := videoproto.PlaylistEntryReq{
+		PlaylistId: playlistID,
+		UserId:     userID,
+		VideoId:    videoID,
+		Note:       c.FormValue("note"),
+	}
+
+	if position := c.FormValue("position"); position != "" {
+		p, err := strconv.ParseInt(position, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid position")
+		}
+		req.Position = p
+	}
+
+	return &req, nil
+}
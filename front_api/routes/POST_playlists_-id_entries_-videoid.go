@@ -0,0 +1,43 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Route: POST /api/playlists/:id/entries/:videoid
+// Requires authentication
+// Changes an entry in the user's playlist
+// Form: note, which replaces the entry's note, and position (optional, from 1) to move the entry to
+// Response: the updated entry
+func (v RouteHandler) handleUpdatePlaylistEntry(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid playlist id")
+	}
+
+	videoID, err := strconv.ParseInt(c.Param("videoid"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid video id")
+	}
+
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return c.String(http.StatusUnauthorized, err.Error())
+	}
+
+	req, err := playlistEntryForm(c, id, profile.UserID, videoID)
+	if err != nil {
+		return c.String(http.StatusBadRequest, err.Error())
+	}
+
+	resp, err := v.v.UpdatePlaylistEntry(context.TODO(), req)
+	if err != nil {
+		return playlistErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, playlistEntryData(resp))
+}
@@ -0,0 +1,113 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	subtitleFileKey = "file"
+	// Subtitle files are small, and have to fit in a single gRPC message
+	maxSubtitleFileSize = 2 * 1024 * 1024
+)
+
+// Route: POST /api/subtitles/:id
+// Requires authentication
+// Only the video's author and trusted users can add subtitles.
+// Form: file is an SRT, ASS or WebVTT file, which is converted to WebVTT. language is a BCP 47 tag (e.g. en or ja-JP),
+// kind is subtitles (the default), captions or descriptions, and label is optional. A track with the same language and
+// kind as an existing one replaces it.
+// Response: the new track
+func (v RouteHandler) handleUploadSubtitles(c echo.Context) error {
+	id := c.Param("id")
+	videoID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid video id")
+	}
+
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return c.String(http.StatusUnauthorized, err.Error())
+	}
+
+	video, err := v.v.GetVideo(context.Background(), &videoproto.VideoRequest{VideoID: id})
+	if err != nil {
+		return err
+	}
+
+	if profile.Banned || (video.AuthorID != profile.UserID && profile.Rank < 1) {
+		return c.String(http.StatusForbidden, "Insufficient user status")
+	}
+
+	fileHeader, err := c.FormFile(subtitleFileKey)
+	if err != nil {
+		return c.String(http.StatusBadRequest, fmt.Sprintf("missing %s", subtitleFileKey))
+	}
+
+	if fileHeader.Size > maxSubtitleFileSize {
+		return c.String(http.StatusRequestEntityTooLarge, fmt.Sprintf("subtitle files can be at most %d bytes", maxSubtitleFileSize))
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxSubtitleFileSize))
+	if err != nil {
+		return err
+	}
+
+	track, err := v.v.UploadSubtitle(context.TODO(), &videoproto.SubtitleUpload{
+		VideoId:  videoID,
+		UserId:   profile.UserID,
+		Language: c.FormValue("language"),
+		Kind:     c.FormValue("kind"),
+		Label:    c.FormValue("label"),
+		Data:     data,
+	})
+	if err != nil {
+		return subtitleErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, subtitleTrack(track))
+}
+
+func subtitleTrack(track *videoproto.SubtitleTrack) SubtitleTrack {
+	return SubtitleTrack{
+		ID:       track.Id,
+		Language: track.Language,
+		Kind:     track.Kind,
+		Label:    track.Label,
+		Location: track.Location,
+		Imported: track.Imported,
+	}
+}
+
+// subtitleErr reports errors from adding or deleting subtitles with the matching status
+func subtitleErr(c echo.Context, err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	switch st.Code() {
+	case codes.InvalidArgument:
+		return c.String(http.StatusBadRequest, st.Message())
+	case codes.PermissionDenied:
+		return c.String(http.StatusForbidden, st.Message())
+	case codes.NotFound:
+		return c.String(http.StatusNotFound, st.Message())
+	}
+
+	return err
+}
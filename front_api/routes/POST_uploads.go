@@ -0,0 +1,78 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// handleCreateUpload creates a resumable upload session. The video's metadata is passed the same way as for /api/upload,
+// and the thumbnail is sent as a multipart form file. Upload-Length is the size of the video, and Upload-Checksum is
+// optionally the checksum of the whole video.
+func (v RouteHandler) handleCreateUpload(c echo.Context) error {
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return err
+	}
+
+	if profile.Rank != 2 {
+		return c.String(http.StatusForbidden, "Insufficient user status")
+	}
+
+	size, err := strconv.ParseInt(c.Request().Header.Get(uploadLengthHeader), 10, 64)
+	if err != nil || size <= 0 {
+		return c.String(http.StatusBadRequest, fmt.Sprintf("%s must be a positive integer", uploadLengthHeader))
+	}
+
+	checksum, err := parseUploadChecksum(c.Request().Header.Get(uploadChecksumHeader))
+	if err != nil {
+		return c.String(http.StatusBadRequest, err.Error())
+	}
+
+	thumbFileHeader, err := c.FormFile(thumbnailKey)
+	if err != nil {
+		return err
+	}
+
+	thumbFile, err := thumbFileHeader.Open()
+	if err != nil {
+		return err
+	}
+	defer thumbFile.Close()
+
+	thumbBytes, err := io.ReadAll(io.LimitReader(thumbFile, maxThumbnailSize))
+	if err != nil {
+		return err
+	}
+
+	session, err := v.v.CreateUploadSession(context.Background(), &videoproto.NewUploadSession{
+		Meta: &videoproto.InputFileMetadata{
+			Title:             c.QueryParam("title"),
+			Description:       c.QueryParam("description"),
+			AuthorUID:         "0",
+			OriginalVideoLink: "0",
+			AuthorUsername:    profile.Username,
+			OriginalSite:      "blank",
+			OriginalID:        "0",
+			DomesticAuthorID:  profile.UserID,
+			Tags:              c.QueryParams()["tags"],
+			Category:          c.QueryParam("category"),
+			Thumbnail:         thumbBytes,
+		},
+		Size:   size,
+		Sha256: checksum,
+		UserID: profile.UserID,
+	})
+	if err != nil {
+		return uploadErr(c, err)
+	}
+
+	setUploadSessionHeaders(c, session)
+	c.Response().Header().Set("Location", fmt.Sprintf("/api/uploads/%s", session.SessionID))
+	return c.JSON(http.StatusCreated, session)
+}
@@ -0,0 +1,31 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// handleFinalizeUpload creates the video once the whole upload has been received, and returns its ID
+func (v RouteHandler) handleFinalizeUpload(c echo.Context) error {
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return err
+	}
+
+	if profile.Rank != 2 {
+		return c.String(http.StatusForbidden, "Insufficient user status")
+	}
+
+	resp, err := v.v.FinalizeUpload(context.Background(), &videoproto.UploadSessionReq{
+		SessionID: c.Param("id"),
+		UserID:    profile.UserID,
+	})
+	if err != nil {
+		return uploadErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp.VideoID)
+}
@@ -0,0 +1,58 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	userproto "github.com/KIRAKIRA-DOUGA/KIRAKIRA-golang-backend/user_service/protocol"
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// Route: POST /api/videos/:id/tag-history/:editid/revert
+// Requires authentication
+// Puts the video's tags back to how they were before the edit, e.g. to undo vandalism. Moderators only.
+// Response: the video's tags
+func (v RouteHandler) handleRevertTags(c echo.Context) error {
+	videoID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid video id")
+	}
+
+	editID, err := strconv.ParseInt(c.Param("editid"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid edit id")
+	}
+
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return err
+	}
+
+	// Make an audit event even if they don't pass the permission check
+	_, err = v.u.AddAuditEvent(context.TODO(), &userproto.NewAuditEventRequest{
+		Message: fmt.Sprintf("User attempted to revert the tags of video %d to before edit %d", videoID, editID),
+		User_ID: profile.UserID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if profile.Rank < 1 {
+		return c.String(http.StatusForbidden, "Insufficient user status")
+	}
+
+	resp, err := v.v.RevertTags(context.TODO(), &videoproto.TagRevertReq{
+		VideoId:   videoID,
+		UserId:    profile.UserID,
+		Moderator: true,
+		EditId:    editID,
+	})
+	if err != nil {
+		return tagErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, videoTags(resp))
+}
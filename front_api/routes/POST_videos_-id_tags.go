@@ -0,0 +1,79 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Route: POST /api/videos/:id/tags
+// Requires authentication
+// Adds a tag to the video. Every change to a video's tags goes in its tag history.
+// Form: tag
+// Response: the video's tags
+func (v RouteHandler) handleAddTag(c echo.Context) error {
+	videoID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid video id")
+	}
+
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return c.String(http.StatusUnauthorized, err.Error())
+	}
+
+	if profile.Banned {
+		return c.String(http.StatusForbidden, "Insufficient user status")
+	}
+
+	resp, err := v.v.AddTag(context.TODO(), tagEditReq(c, videoID, profile))
+	if err != nil {
+		return tagErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, videoTags(resp))
+}
+
+// tagEditReq reads the tag from the request's form. Trusted users and admins are moderators.
+func tagEditReq(c echo.Context, videoID int64, profile *LoggedInUserData) *videoproto.TagEditReq {
+	return &videoproto.TagEditReq{
+		VideoId:   videoID,
+		UserId:    profile.UserID,
+		Moderator: profile.Rank >= 1,
+		Tag:       c.FormValue("tag"),
+	}
+}
+
+func videoTags(resp *videoproto.VideoTags) []VideoTag {
+	tags := make([]VideoTag, 0, len(resp.Tags))
+	for _, t := range resp.Tags {
+		tags = append(tags, VideoTag{Tag: t.Tag, Locked: t.Locked})
+	}
+	return tags
+}
+
+// tagErr reports errors from editing tags with the matching status
+func tagErr(c echo.Context, err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	switch st.Code() {
+	case codes.InvalidArgument:
+		return c.String(http.StatusBadRequest, st.Message())
+	case codes.PermissionDenied:
+		return c.String(http.StatusForbidden, st.Message())
+	case codes.NotFound:
+		return c.String(http.StatusNotFound, st.Message())
+	case codes.AlreadyExists, codes.ResourceExhausted:
+		return c.String(http.StatusConflict, st.Message())
+	}
+
+	return err
+}
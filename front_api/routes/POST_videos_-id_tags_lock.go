@@ -0,0 +1,51 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	userproto "github.com/KIRAKIRA-DOUGA/KIRAKIRA-golang-backend/user_service/protocol"
+	"github.com/labstack/echo/v4"
+)
+
+// Route: POST /api/videos/:id/tags/lock
+// Requires authentication
+// Locks or unlocks one of the video's tags. Only the uploader or a moderator can.
+// Form: tag, and locked (true or false)
+// Response: the video's tags
+func (v RouteHandler) handleSetTagLock(c echo.Context) error {
+	videoID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid video id")
+	}
+
+	locked, err := strconv.ParseBool(c.FormValue("locked"))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid locked")
+	}
+
+	profile, err := v.getUserProfileInfo(c)
+	if err != nil {
+		return c.String(http.StatusUnauthorized, err.Error())
+	}
+
+	req := tagEditReq(c, videoID, profile)
+	req.Locked = locked
+
+	_, err = v.u.AddAuditEvent(context.TODO(), &userproto.NewAuditEventRequest{
+		Message: fmt.Sprintf("User attempted to set the lock on tag %q of video %d to %t", req.Tag, videoID, locked),
+		User_ID: profile.UserID,
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := v.v.SetTagLock(context.TODO(), req)
+	if err != nil {
+		return tagErr(c, err)
+	}
+
+	return c.JSON(http.StatusOK, videoTags(resp))
+}
@@ -131,6 +131,9 @@ type EmailValidationParams struct {
 type FollowFeedParams struct {
 	// ShowMature show mature
 	ShowMature bool `json:"showMature"`
+
+	// Cursor cursor from a previous response's X-Next-Cursor or X-Prev-Cursor header
+	Cursor *string `json:"cursor,omitempty"`
 }
 
 // GetUnapprovedVideosParams defines parameters for GetUnapprovedVideos.
@@ -287,11 +290,53 @@ type VideosParams struct {
 	// ShowMature show mature
 	ShowMature bool `json:"showMature"`
 
+	// LostUpstream only show archived videos which are no longer available where they came from
+	LostUpstream *bool `json:"lostUpstream,omitempty"`
+
 	// PageNumber page number
 	PageNumber *int `json:"pageNumber,omitempty"`
 
+	// Cursor cursor from a previous page's NextCursor or PrevCursor, used instead of the page number
+	Cursor *string `json:"cursor,omitempty"`
+
 	// Category category
 	Category *[]byte `json:"category,omitempty"`
+
+	// Tags tags which are all required, comma separated and each URI encoded
+	Tags *[]string `json:"tags,omitempty"`
+
+	// ExcludedTags tags which are not allowed, comma separated and each URI encoded
+	ExcludedTags *[]string `json:"excludedTags,omitempty"`
+
+	// AnyTags tags of which at least one is required, comma separated and each URI encoded
+	AnyTags *[]string `json:"anyTags,omitempty"`
+
+	// Durations any of short (under 4 minutes), medium (4 to 20 minutes) or long
+	Durations *[]string `json:"durations,omitempty"`
+
+	// UploadedSince only videos uploaded on or after this date (YYYY-MM-DD)
+	UploadedSince *string `json:"uploadedSince,omitempty"`
+
+	// UploadedBefore only videos uploaded before this date (YYYY-MM-DD)
+	UploadedBefore *string `json:"uploadedBefore,omitempty"`
+
+	// OriginalUploadedSince only archived videos uploaded to the site they came from on or after this date (YYYY-MM-DD)
+	OriginalUploadedSince *string `json:"originalUploadedSince,omitempty"`
+
+	// OriginalUploadedBefore only archived videos uploaded to the site they came from before this date (YYYY-MM-DD)
+	OriginalUploadedBefore *string `json:"originalUploadedBefore,omitempty"`
+
+	// Sites any of nicovideo, bilibili, youtube, domestic (not archived) or the host of another site
+	Sites *[]string `json:"sites,omitempty"`
+
+	// MinRating minimum rating
+	MinRating *int `json:"minRating,omitempty"`
+
+	// MinViews minimum views
+	MinViews *int `json:"minViews,omitempty"`
+
+	// HasDanmaku only videos with danmaku
+	HasDanmaku *bool `json:"hasDanmaku,omitempty"`
 }
 
 // UploadMultipartRequestBody defines body for Upload for multipart/form-data ContentType.
@@ -1433,6 +1478,17 @@ func NewFollowFeedRequest(server string, params *FollowFeedParams) (*http.Reques
 
 	req.Header.Set("showMature", headerParam0)
 
+	if params.Cursor != nil {
+		var headerParam1 string
+
+		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "cursor", runtime.ParamLocationHeader, *params.Cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("cursor", headerParam1)
+	}
+
 	return req, nil
 }
 
@@ -2259,26 +2315,180 @@ func NewVideosRequest(server string, params *VideosParams) (*http.Request, error
 
 	req.Header.Set("showMature", headerParam4)
 
-	if params.PageNumber != nil {
+	if params.LostUpstream != nil {
 		var headerParam5 string
 
-		headerParam5, err = runtime.StyleParamWithLocation("simple", false, "pageNumber", runtime.ParamLocationHeader, *params.PageNumber)
+		headerParam5, err = runtime.StyleParamWithLocation("simple", false, "lostUpstream", runtime.ParamLocationHeader, *params.LostUpstream)
 		if err != nil {
 			return nil, err
 		}
 
-		req.Header.Set("pageNumber", headerParam5)
+		req.Header.Set("lostUpstream", headerParam5)
 	}
 
-	if params.Category != nil {
+	if params.PageNumber != nil {
 		var headerParam6 string
 
-		headerParam6, err = runtime.StyleParamWithLocation("simple", false, "category", runtime.ParamLocationHeader, *params.Category)
+		headerParam6, err = runtime.StyleParamWithLocation("simple", false, "pageNumber", runtime.ParamLocationHeader, *params.PageNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("pageNumber", headerParam6)
+	}
+
+	if params.Cursor != nil {
+		var headerParam7 string
+
+		headerParam7, err = runtime.StyleParamWithLocation("simple", false, "cursor", runtime.ParamLocationHeader, *params.Cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("cursor", headerParam7)
+	}
+
+	if params.Category != nil {
+		var headerParam8 string
+
+		headerParam8, err = runtime.StyleParamWithLocation("simple", false, "category", runtime.ParamLocationHeader, *params.Category)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("category", headerParam8)
+	}
+
+	if params.Tags != nil {
+		var headerParam9 string
+
+		headerParam9, err = runtime.StyleParamWithLocation("simple", false, "tags", runtime.ParamLocationHeader, *params.Tags)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("tags", headerParam9)
+	}
+
+	if params.ExcludedTags != nil {
+		var headerParam10 string
+
+		headerParam10, err = runtime.StyleParamWithLocation("simple", false, "excludedTags", runtime.ParamLocationHeader, *params.ExcludedTags)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("excludedTags", headerParam10)
+	}
+
+	if params.AnyTags != nil {
+		var headerParam11 string
+
+		headerParam11, err = runtime.StyleParamWithLocation("simple", false, "anyTags", runtime.ParamLocationHeader, *params.AnyTags)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("anyTags", headerParam11)
+	}
+
+	if params.Durations != nil {
+		var headerParam12 string
+
+		headerParam12, err = runtime.StyleParamWithLocation("simple", false, "durations", runtime.ParamLocationHeader, *params.Durations)
 		if err != nil {
 			return nil, err
 		}
 
-		req.Header.Set("category", headerParam6)
+		req.Header.Set("durations", headerParam12)
+	}
+
+	if params.UploadedSince != nil {
+		var headerParam13 string
+
+		headerParam13, err = runtime.StyleParamWithLocation("simple", false, "uploadedSince", runtime.ParamLocationHeader, *params.UploadedSince)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("uploadedSince", headerParam13)
+	}
+
+	if params.UploadedBefore != nil {
+		var headerParam14 string
+
+		headerParam14, err = runtime.StyleParamWithLocation("simple", false, "uploadedBefore", runtime.ParamLocationHeader, *params.UploadedBefore)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("uploadedBefore", headerParam14)
+	}
+
+	if params.OriginalUploadedSince != nil {
+		var headerParam15 string
+
+		headerParam15, err = runtime.StyleParamWithLocation("simple", false, "originalUploadedSince", runtime.ParamLocationHeader, *params.OriginalUploadedSince)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("originalUploadedSince", headerParam15)
+	}
+
+	if params.OriginalUploadedBefore != nil {
+		var headerParam16 string
+
+		headerParam16, err = runtime.StyleParamWithLocation("simple", false, "originalUploadedBefore", runtime.ParamLocationHeader, *params.OriginalUploadedBefore)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("originalUploadedBefore", headerParam16)
+	}
+
+	if params.Sites != nil {
+		var headerParam17 string
+
+		headerParam17, err = runtime.StyleParamWithLocation("simple", false, "sites", runtime.ParamLocationHeader, *params.Sites)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("sites", headerParam17)
+	}
+
+	if params.MinRating != nil {
+		var headerParam18 string
+
+		headerParam18, err = runtime.StyleParamWithLocation("simple", false, "minRating", runtime.ParamLocationHeader, *params.MinRating)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("minRating", headerParam18)
+	}
+
+	if params.MinViews != nil {
+		var headerParam19 string
+
+		headerParam19, err = runtime.StyleParamWithLocation("simple", false, "minViews", runtime.ParamLocationHeader, *params.MinViews)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("minViews", headerParam19)
+	}
+
+	if params.HasDanmaku != nil {
+		var headerParam20 string
+
+		headerParam20, err = runtime.StyleParamWithLocation("simple", false, "hasDanmaku", runtime.ParamLocationHeader, *params.HasDanmaku)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("hasDanmaku", headerParam20)
 	}
 
 	return req, nil
@@ -2498,10 +2708,12 @@ type ArchiveEventsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *[]struct {
-		Message   *string `json:"Message,omitempty"`
-		ParnetUrl *string `json:"ParnetUrl,omitempty"`
-		Timestamp *string `json:"Timestamp,omitempty"`
-		VideoUrl  *string `json:"VideoUrl,omitempty"`
+		// FailureClass why the download failed, e.g. removed, private, geo_blocked, rate_limited, too_large, auth_required, network or unknown
+		FailureClass *string `json:"FailureClass,omitempty"`
+		Message      *string `json:"Message,omitempty"`
+		ParnetUrl    *string `json:"ParnetUrl,omitempty"`
+		Timestamp    *string `json:"Timestamp,omitempty"`
+		VideoUrl     *string `json:"VideoUrl,omitempty"`
 	}
 }
 
@@ -2530,6 +2742,8 @@ type ArchiveRequestsResponse struct {
 		CurrentTotalVideos   *int    `json:"CurrentTotalVideos,omitempty"`
 		DownloadID           *int    `json:"DownloadID,omitempty"`
 		LastSynced           *string `json:"LastSynced,omitempty"`
+		NextSync             *string `json:"NextSync,omitempty"`
+		SyncReason           *string `json:"SyncReason,omitempty"`
 		UndownloadableVideos *int    `json:"UndownloadableVideos,omitempty"`
 		Url                  *string `json:"Url,omitempty"`
 		UserID               *int    `json:"UserID,omitempty"`
@@ -2561,6 +2775,8 @@ type AuditEventsResponse struct {
 		CurrentTotalVideos   *int    `json:"CurrentTotalVideos,omitempty"`
 		DownloadID           *int    `json:"DownloadID,omitempty"`
 		LastSynced           *string `json:"LastSynced,omitempty"`
+		NextSync             *string `json:"NextSync,omitempty"`
+		SyncReason           *string `json:"SyncReason,omitempty"`
 		UndownloadableVideos *int    `json:"UndownloadableVideos,omitempty"`
 		Url                  *string `json:"Url,omitempty"`
 		UserID               *int    `json:"UserID,omitempty"`
@@ -2608,15 +2824,20 @@ type CommentsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *[]struct {
+		// Archived imported from the video's original site
+		Archived              *bool    `json:"archived,omitempty"`
 		AuthoredByCurrentUser *bool    `json:"authored_by_current_user,omitempty"`
 		Content               *string  `json:"content,omitempty"`
 		Created               *string  `json:"created,omitempty"`
 		Fullname              *string  `json:"fullname,omitempty"`
 		Id                    *float32 `json:"id,omitempty"`
-		ProfilePictureUrl     *string  `json:"profile_picture_url,omitempty"`
-		UpvoteCount           *float32 `json:"upvote_count,omitempty"`
-		UserHasDownvoted      *bool    `json:"user_has_downvoted,omitempty"`
-		UserHasUpvoted        *bool    `json:"user_has_upvoted,omitempty"`
+
+		// LikeCount likes on the original site, for archived comments
+		LikeCount         *float32 `json:"like_count,omitempty"`
+		ProfilePictureUrl *string  `json:"profile_picture_url,omitempty"`
+		UpvoteCount       *float32 `json:"upvote_count,omitempty"`
+		UserHasDownvoted  *bool    `json:"user_has_downvoted,omitempty"`
+		UserHasUpvoted    *bool    `json:"user_has_upvoted,omitempty"`
 	}
 }
 
@@ -2666,9 +2887,15 @@ type GetDanmakuResponse struct {
 		CreationDate *string `json:"CreationDate,omitempty"`
 		FontSize     *string `json:"FontSize,omitempty"`
 		ID           *int    `json:"ID,omitempty"`
-		Message      *string `json:"Message,omitempty"`
-		Timestamp    *string `json:"Timestamp,omitempty"`
-		Type         *string `json:"Type,omitempty"`
+
+		// Imported whether the danmaku was archived from the original site
+		Imported *bool   `json:"Imported,omitempty"`
+		Message  *string `json:"Message,omitempty"`
+
+		// Source the site imported danmaku came from
+		Source    *string `json:"Source,omitempty"`
+		Timestamp *string `json:"Timestamp,omitempty"`
+		Type      *string `json:"Type,omitempty"`
 	}
 }
 
@@ -3144,9 +3371,32 @@ type VideosResponse struct {
 			Cardinality *float32 `json:"Cardinality,omitempty"`
 			Name        *string  `json:"Name,omitempty"`
 		} `json:"Categories,omitempty"`
+
+		// Facets counts of the matching videos by the values they have for each filter
+		Facets *struct {
+			Durations *[]struct {
+				Count *float32 `json:"Count,omitempty"`
+				Value *string  `json:"Value,omitempty"`
+			} `json:"Durations,omitempty"`
+			Sites *[]struct {
+				Count *float32 `json:"Count,omitempty"`
+				Value *string  `json:"Value,omitempty"`
+			} `json:"Sites,omitempty"`
+			Tags *[]struct {
+				Count *float32 `json:"Count,omitempty"`
+				Value *string  `json:"Value,omitempty"`
+			} `json:"Tags,omitempty"`
+			WithDanmaku *float32 `json:"WithDanmaku,omitempty"`
+		} `json:"Facets,omitempty"`
 		PaginationData *struct {
-			CurrentPage   *float32 `json:"CurrentPage,omitempty"`
+			CurrentPage *float32 `json:"CurrentPage,omitempty"`
+
+			// NextCursor cursor to the next page, empty on the last page
+			NextCursor    *string  `json:"NextCursor,omitempty"`
 			NumberOfItems *float32 `json:"NumberOfItems,omitempty"`
+
+			// PrevCursor cursor to the previous page, empty on the first page
+			PrevCursor *string `json:"PrevCursor,omitempty"`
 		} `json:"PaginationData,omitempty"`
 		ProfilePictureURL *string  `json:"ProfilePictureURL,omitempty"`
 		UserID            *float32 `json:"UserID,omitempty"`
@@ -3185,23 +3435,45 @@ type VideoDetailResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
-		AuthorID         *float32                `json:"AuthorID,omitempty"`
-		Comments         *map[string]interface{} `json:"Comments,omitempty"`
-		IsMature         *bool                   `json:"IsMature,omitempty"`
-		MPDLoc           *string                 `json:"MPDLoc,omitempty"`
-		ProfilePicture   *string                 `json:"ProfilePicture,omitempty"`
-		Rating           *float32                `json:"Rating,omitempty"`
-		Tags             *[]string               `json:"Tags,omitempty"`
-		Thumbnail        *string                 `json:"Thumbnail,omitempty"`
-		Title            *string                 `json:"Title,omitempty"`
-		UploadDate       *string                 `json:"UploadDate,omitempty"`
-		UserDescription  *string                 `json:"UserDescription,omitempty"`
-		UserSubscribers  *float32                `json:"UserSubscribers,omitempty"`
-		Username         *string                 `json:"Username,omitempty"`
-		VideoDescription *string                 `json:"VideoDescription,omitempty"`
-		VideoDuration    *float32                `json:"VideoDuration,omitempty"`
-		VideoID          *float32                `json:"VideoID,omitempty"`
-		Views            *float32                `json:"Views,omitempty"`
+		AuthorID       *float32                `json:"AuthorID,omitempty"`
+		Comments       *map[string]interface{} `json:"Comments,omitempty"`
+		HLSLoc         *string                 `json:"HLSLoc,omitempty"`
+		IsMature       *bool                   `json:"IsMature,omitempty"`
+		MPDLoc         *string                 `json:"MPDLoc,omitempty"`
+		ProfilePicture *string                 `json:"ProfilePicture,omitempty"`
+		Rating         *float32                `json:"Rating,omitempty"`
+		Subtitles      *[]struct {
+			ID *float32 `json:"ID,omitempty"`
+
+			// Imported whether the track was archived from the original site
+			Imported *bool `json:"Imported,omitempty"`
+
+			// Kind subtitles, captions or descriptions
+			Kind     *string `json:"Kind,omitempty"`
+			Label    *string `json:"Label,omitempty"`
+			Language *string `json:"Language,omitempty"`
+
+			// Location the WebVTT file
+			Location *string `json:"Location,omitempty"`
+		} `json:"Subtitles,omitempty"`
+		Tags              *[]string `json:"Tags,omitempty"`
+		Thumbnail         *string   `json:"Thumbnail,omitempty"`
+		Title             *string   `json:"Title,omitempty"`
+		UploadDate        *string   `json:"UploadDate,omitempty"`
+		UpstreamCheckedAt *string   `json:"UpstreamCheckedAt,omitempty"`
+
+		// UpstreamStatus whether the original is still available (online, removed, private, geo_blocked or auth_required), empty if it hasn't been checked
+		UpstreamStatus *string `json:"UpstreamStatus,omitempty"`
+
+		// UpstreamStatusSince when the original was first seen with its current status
+		UpstreamStatusSince *string  `json:"UpstreamStatusSince,omitempty"`
+		UserDescription     *string  `json:"UserDescription,omitempty"`
+		UserSubscribers     *float32 `json:"UserSubscribers,omitempty"`
+		Username            *string  `json:"Username,omitempty"`
+		VideoDescription    *string  `json:"VideoDescription,omitempty"`
+		VideoDuration       *float32 `json:"VideoDuration,omitempty"`
+		VideoID             *float32 `json:"VideoID,omitempty"`
+		Views               *float32 `json:"Views,omitempty"`
 	}
 }
 
@@ -3539,10 +3811,12 @@ func ParseArchiveEventsResponse(rsp *http.Response) (*ArchiveEventsResponse, err
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest []struct {
-			Message   *string `json:"Message,omitempty"`
-			ParnetUrl *string `json:"ParnetUrl,omitempty"`
-			Timestamp *string `json:"Timestamp,omitempty"`
-			VideoUrl  *string `json:"VideoUrl,omitempty"`
+			// FailureClass why the download failed, e.g. removed, private, geo_blocked, rate_limited, too_large, auth_required, network or unknown
+			FailureClass *string `json:"FailureClass,omitempty"`
+			Message      *string `json:"Message,omitempty"`
+			ParnetUrl    *string `json:"ParnetUrl,omitempty"`
+			Timestamp    *string `json:"Timestamp,omitempty"`
+			VideoUrl     *string `json:"VideoUrl,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -3575,6 +3849,8 @@ func ParseArchiveRequestsResponse(rsp *http.Response) (*ArchiveRequestsResponse,
 			CurrentTotalVideos   *int    `json:"CurrentTotalVideos,omitempty"`
 			DownloadID           *int    `json:"DownloadID,omitempty"`
 			LastSynced           *string `json:"LastSynced,omitempty"`
+			NextSync             *string `json:"NextSync,omitempty"`
+			SyncReason           *string `json:"SyncReason,omitempty"`
 			UndownloadableVideos *int    `json:"UndownloadableVideos,omitempty"`
 			Url                  *string `json:"Url,omitempty"`
 			UserID               *int    `json:"UserID,omitempty"`
@@ -3610,6 +3886,8 @@ func ParseAuditEventsResponse(rsp *http.Response) (*AuditEventsResponse, error)
 			CurrentTotalVideos   *int    `json:"CurrentTotalVideos,omitempty"`
 			DownloadID           *int    `json:"DownloadID,omitempty"`
 			LastSynced           *string `json:"LastSynced,omitempty"`
+			NextSync             *string `json:"NextSync,omitempty"`
+			SyncReason           *string `json:"SyncReason,omitempty"`
 			UndownloadableVideos *int    `json:"UndownloadableVideos,omitempty"`
 			Url                  *string `json:"Url,omitempty"`
 			UserID               *int    `json:"UserID,omitempty"`
@@ -3656,15 +3934,20 @@ func ParseCommentsResponse(rsp *http.Response) (*CommentsResponse, error) {
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest []struct {
+			// Archived imported from the video's original site
+			Archived              *bool    `json:"archived,omitempty"`
 			AuthoredByCurrentUser *bool    `json:"authored_by_current_user,omitempty"`
 			Content               *string  `json:"content,omitempty"`
 			Created               *string  `json:"created,omitempty"`
 			Fullname              *string  `json:"fullname,omitempty"`
 			Id                    *float32 `json:"id,omitempty"`
-			ProfilePictureUrl     *string  `json:"profile_picture_url,omitempty"`
-			UpvoteCount           *float32 `json:"upvote_count,omitempty"`
-			UserHasDownvoted      *bool    `json:"user_has_downvoted,omitempty"`
-			UserHasUpvoted        *bool    `json:"user_has_upvoted,omitempty"`
+
+			// LikeCount likes on the original site, for archived comments
+			LikeCount         *float32 `json:"like_count,omitempty"`
+			ProfilePictureUrl *string  `json:"profile_picture_url,omitempty"`
+			UpvoteCount       *float32 `json:"upvote_count,omitempty"`
+			UserHasDownvoted  *bool    `json:"user_has_downvoted,omitempty"`
+			UserHasUpvoted    *bool    `json:"user_has_upvoted,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -3713,9 +3996,15 @@ func ParseGetDanmakuResponse(rsp *http.Response) (*GetDanmakuResponse, error) {
 			CreationDate *string `json:"CreationDate,omitempty"`
 			FontSize     *string `json:"FontSize,omitempty"`
 			ID           *int    `json:"ID,omitempty"`
-			Message      *string `json:"Message,omitempty"`
-			Timestamp    *string `json:"Timestamp,omitempty"`
-			Type         *string `json:"Type,omitempty"`
+
+			// Imported whether the danmaku was archived from the original site
+			Imported *bool   `json:"Imported,omitempty"`
+			Message  *string `json:"Message,omitempty"`
+
+			// Source the site imported danmaku came from
+			Source    *string `json:"Source,omitempty"`
+			Timestamp *string `json:"Timestamp,omitempty"`
+			Type      *string `json:"Type,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -4136,9 +4425,32 @@ func ParseVideosResponse(rsp *http.Response) (*VideosResponse, error) {
 				Cardinality *float32 `json:"Cardinality,omitempty"`
 				Name        *string  `json:"Name,omitempty"`
 			} `json:"Categories,omitempty"`
+
+			// Facets counts of the matching videos by the values they have for each filter
+			Facets *struct {
+				Durations *[]struct {
+					Count *float32 `json:"Count,omitempty"`
+					Value *string  `json:"Value,omitempty"`
+				} `json:"Durations,omitempty"`
+				Sites *[]struct {
+					Count *float32 `json:"Count,omitempty"`
+					Value *string  `json:"Value,omitempty"`
+				} `json:"Sites,omitempty"`
+				Tags *[]struct {
+					Count *float32 `json:"Count,omitempty"`
+					Value *string  `json:"Value,omitempty"`
+				} `json:"Tags,omitempty"`
+				WithDanmaku *float32 `json:"WithDanmaku,omitempty"`
+			} `json:"Facets,omitempty"`
 			PaginationData *struct {
-				CurrentPage   *float32 `json:"CurrentPage,omitempty"`
+				CurrentPage *float32 `json:"CurrentPage,omitempty"`
+
+				// NextCursor cursor to the next page, empty on the last page
+				NextCursor    *string  `json:"NextCursor,omitempty"`
 				NumberOfItems *float32 `json:"NumberOfItems,omitempty"`
+
+				// PrevCursor cursor to the previous page, empty on the first page
+				PrevCursor *string `json:"PrevCursor,omitempty"`
 			} `json:"PaginationData,omitempty"`
 			ProfilePictureURL *string  `json:"ProfilePictureURL,omitempty"`
 			UserID            *float32 `json:"UserID,omitempty"`
@@ -4181,23 +4493,45 @@ func ParseVideoDetailResponse(rsp *http.Response) (*VideoDetailResponse, error)
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			AuthorID         *float32                `json:"AuthorID,omitempty"`
-			Comments         *map[string]interface{} `json:"Comments,omitempty"`
-			IsMature         *bool                   `json:"IsMature,omitempty"`
-			MPDLoc           *string                 `json:"MPDLoc,omitempty"`
-			ProfilePicture   *string                 `json:"ProfilePicture,omitempty"`
-			Rating           *float32                `json:"Rating,omitempty"`
-			Tags             *[]string               `json:"Tags,omitempty"`
-			Thumbnail        *string                 `json:"Thumbnail,omitempty"`
-			Title            *string                 `json:"Title,omitempty"`
-			UploadDate       *string                 `json:"UploadDate,omitempty"`
-			UserDescription  *string                 `json:"UserDescription,omitempty"`
-			UserSubscribers  *float32                `json:"UserSubscribers,omitempty"`
-			Username         *string                 `json:"Username,omitempty"`
-			VideoDescription *string                 `json:"VideoDescription,omitempty"`
-			VideoDuration    *float32                `json:"VideoDuration,omitempty"`
-			VideoID          *float32                `json:"VideoID,omitempty"`
-			Views            *float32                `json:"Views,omitempty"`
+			AuthorID       *float32                `json:"AuthorID,omitempty"`
+			Comments       *map[string]interface{} `json:"Comments,omitempty"`
+			HLSLoc         *string                 `json:"HLSLoc,omitempty"`
+			IsMature       *bool                   `json:"IsMature,omitempty"`
+			MPDLoc         *string                 `json:"MPDLoc,omitempty"`
+			ProfilePicture *string                 `json:"ProfilePicture,omitempty"`
+			Rating         *float32                `json:"Rating,omitempty"`
+			Subtitles      *[]struct {
+				ID *float32 `json:"ID,omitempty"`
+
+				// Imported whether the track was archived from the original site
+				Imported *bool `json:"Imported,omitempty"`
+
+				// Kind subtitles, captions or descriptions
+				Kind     *string `json:"Kind,omitempty"`
+				Label    *string `json:"Label,omitempty"`
+				Language *string `json:"Language,omitempty"`
+
+				// Location the WebVTT file
+				Location *string `json:"Location,omitempty"`
+			} `json:"Subtitles,omitempty"`
+			Tags              *[]string `json:"Tags,omitempty"`
+			Thumbnail         *string   `json:"Thumbnail,omitempty"`
+			Title             *string   `json:"Title,omitempty"`
+			UploadDate        *string   `json:"UploadDate,omitempty"`
+			UpstreamCheckedAt *string   `json:"UpstreamCheckedAt,omitempty"`
+
+			// UpstreamStatus whether the original is still available (online, removed, private, geo_blocked or auth_required), empty if it hasn't been checked
+			UpstreamStatus *string `json:"UpstreamStatus,omitempty"`
+
+			// UpstreamStatusSince when the original was first seen with its current status
+			UpstreamStatusSince *string  `json:"UpstreamStatusSince,omitempty"`
+			UserDescription     *string  `json:"UserDescription,omitempty"`
+			UserSubscribers     *float32 `json:"UserSubscribers,omitempty"`
+			Username            *string  `json:"Username,omitempty"`
+			VideoDescription    *string  `json:"VideoDescription,omitempty"`
+			VideoDuration       *float32 `json:"VideoDuration,omitempty"`
+			VideoID             *float32 `json:"VideoID,omitempty"`
+			Views               *float32 `json:"Views,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -4914,6 +5248,21 @@ func (w *ServerInterfaceWrapper) FollowFeed(ctx echo.Context) error {
 	} else {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter showMature is required, but not found"))
 	}
+	// ------------- Optional header parameter "cursor" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("cursor")]; found {
+		var Cursor string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for cursor, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "cursor", runtime.ParamLocationHeader, valueList[0], &Cursor)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+		}
+
+		params.Cursor = &Cursor
+	}
 
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.FollowFeed(ctx, params)
@@ -5740,6 +6089,21 @@ func (w *ServerInterfaceWrapper) Videos(ctx echo.Context) error {
 	} else {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter showMature is required, but not found"))
 	}
+	// ------------- Optional header parameter "lostUpstream" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("lostUpstream")]; found {
+		var LostUpstream bool
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for lostUpstream, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "lostUpstream", runtime.ParamLocationHeader, valueList[0], &LostUpstream)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter lostUpstream: %s", err))
+		}
+
+		params.LostUpstream = &LostUpstream
+	}
 	// ------------- Optional header parameter "pageNumber" -------------
 	if valueList, found := headers[http.CanonicalHeaderKey("pageNumber")]; found {
 		var PageNumber int
@@ -5755,6 +6119,21 @@ func (w *ServerInterfaceWrapper) Videos(ctx echo.Context) error {
 
 		params.PageNumber = &PageNumber
 	}
+	// ------------- Optional header parameter "cursor" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("cursor")]; found {
+		var Cursor string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for cursor, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "cursor", runtime.ParamLocationHeader, valueList[0], &Cursor)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+		}
+
+		params.Cursor = &Cursor
+	}
 	// ------------- Optional header parameter "category" -------------
 	if valueList, found := headers[http.CanonicalHeaderKey("category")]; found {
 		var Category []byte
@@ -5770,6 +6149,186 @@ func (w *ServerInterfaceWrapper) Videos(ctx echo.Context) error {
 
 		params.Category = &Category
 	}
+	// ------------- Optional header parameter "tags" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("tags")]; found {
+		var Tags []string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for tags, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "tags", runtime.ParamLocationHeader, valueList[0], &Tags)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tags: %s", err))
+		}
+
+		params.Tags = &Tags
+	}
+	// ------------- Optional header parameter "excludedTags" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("excludedTags")]; found {
+		var ExcludedTags []string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for excludedTags, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "excludedTags", runtime.ParamLocationHeader, valueList[0], &ExcludedTags)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter excludedTags: %s", err))
+		}
+
+		params.ExcludedTags = &ExcludedTags
+	}
+	// ------------- Optional header parameter "anyTags" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("anyTags")]; found {
+		var AnyTags []string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for anyTags, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "anyTags", runtime.ParamLocationHeader, valueList[0], &AnyTags)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter anyTags: %s", err))
+		}
+
+		params.AnyTags = &AnyTags
+	}
+	// ------------- Optional header parameter "durations" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("durations")]; found {
+		var Durations []string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for durations, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "durations", runtime.ParamLocationHeader, valueList[0], &Durations)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter durations: %s", err))
+		}
+
+		params.Durations = &Durations
+	}
+	// ------------- Optional header parameter "uploadedSince" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("uploadedSince")]; found {
+		var UploadedSince string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for uploadedSince, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "uploadedSince", runtime.ParamLocationHeader, valueList[0], &UploadedSince)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter uploadedSince: %s", err))
+		}
+
+		params.UploadedSince = &UploadedSince
+	}
+	// ------------- Optional header parameter "uploadedBefore" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("uploadedBefore")]; found {
+		var UploadedBefore string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for uploadedBefore, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "uploadedBefore", runtime.ParamLocationHeader, valueList[0], &UploadedBefore)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter uploadedBefore: %s", err))
+		}
+
+		params.UploadedBefore = &UploadedBefore
+	}
+	// ------------- Optional header parameter "originalUploadedSince" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("originalUploadedSince")]; found {
+		var OriginalUploadedSince string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for originalUploadedSince, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "originalUploadedSince", runtime.ParamLocationHeader, valueList[0], &OriginalUploadedSince)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter originalUploadedSince: %s", err))
+		}
+
+		params.OriginalUploadedSince = &OriginalUploadedSince
+	}
+	// ------------- Optional header parameter "originalUploadedBefore" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("originalUploadedBefore")]; found {
+		var OriginalUploadedBefore string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for originalUploadedBefore, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "originalUploadedBefore", runtime.ParamLocationHeader, valueList[0], &OriginalUploadedBefore)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter originalUploadedBefore: %s", err))
+		}
+
+		params.OriginalUploadedBefore = &OriginalUploadedBefore
+	}
+	// ------------- Optional header parameter "sites" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("sites")]; found {
+		var Sites []string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for sites, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "sites", runtime.ParamLocationHeader, valueList[0], &Sites)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sites: %s", err))
+		}
+
+		params.Sites = &Sites
+	}
+	// ------------- Optional header parameter "minRating" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("minRating")]; found {
+		var MinRating int
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for minRating, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "minRating", runtime.ParamLocationHeader, valueList[0], &MinRating)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minRating: %s", err))
+		}
+
+		params.MinRating = &MinRating
+	}
+	// ------------- Optional header parameter "minViews" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("minViews")]; found {
+		var MinViews int
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for minViews, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "minViews", runtime.ParamLocationHeader, valueList[0], &MinViews)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minViews: %s", err))
+		}
+
+		params.MinViews = &MinViews
+	}
+	// ------------- Optional header parameter "hasDanmaku" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("hasDanmaku")]; found {
+		var HasDanmaku bool
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for hasDanmaku, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "hasDanmaku", runtime.ParamLocationHeader, valueList[0], &HasDanmaku)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hasDanmaku: %s", err))
+		}
+
+		params.HasDanmaku = &HasDanmaku
+	}
 
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.Videos(ctx, params)
@@ -5856,46 +6415,63 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xc3W/jNhL/Vwi+9MWO0xZ3B/jpNvHuIofsXpCPfTkUBi2ObXYlUiUp53yB//cDSX1Y",
-	"NmnJkeJN2jwUdcQRh5z5zSepfcKMzwUeP+FIcE0ibX5CQliMx3gpJDH//fy3v//jnwvz8CwSCR5gThLA",
-	"Y3wjRaKzGaAPN1foHkiCNwNMQUWSpZoJjsf4fulG50KighwPcMwi4AoMs3yui7vJ8Bc8wJm0nLVO1Xg0",
-	"WjC9zGaG66hYDIXVKJUiAb2ETJn5RrNYzEYJYXx0fXX58evdR7MOzXRcW+QFib4Dp2Y5eIBXIJVb4vnZ",
-	"+dnP5g2RAicpw2P869n52Tke4JTopTKLHJE0lWIFQyoeeSwINQ9Toay4RAqSmP1eUTzGHxzlpCA0s0iS",
-	"gAap8Pg/TzsCWjEKAl1NkBaIVu8wM7YEQkFW8ra0VxM8wBL+yJgEisdaZjDAKlpCQsxi9Do1pIxrWIDE",
-	"m81glyPJ9BJFQnxnoBDoKMTt0pJgz+RKS8YXeLP5zaxEpYIrsGL65fzc/K/Oj0IMGpDKogiUchCZkyzW",
-	"+6QPHP6bQqSBIpBS2OVjlSUJkWs8xreg5RoRGS3ZCpCRAShtaUr9WBE1KuebpXqzmkmIzqRXMzMhYiD8",
-	"Nag918hL6909HMIKuLaLWYBP747so6NqUHyhbMSo0f2cxRokEjwksYK+nf6bpGj8MHC7B5KmMYvsLka/",
-	"K7O2p635mIbEvphKs1nN3DRfQCmyAA/HAb4hkoN+MC7WM3rPElCaJKl31NqM/1Xjbd0TMfsdIo2rB0RK",
-	"ssabzV5giJnSSMzRXMSxeERzAIqsFXVCymfQJU5ySNRgkmOnESi3BV0DVF7eqLrCId8Q/eZk6/FDA2wi",
-	"o5jPP5FIC+knucykBK7vhSbxoakmlS14x6+J0ndrHgH1guyBF8ZEZjEcYhQC8YMC6WfeBaU7vqc3jFbz",
-	"WZRmlOlGV2aI2jmyHDsoJQtAPEtmFpJegBqSrwVFlxiWKZBtHSejrz6VeTe/v475RSJJcl37s8fLnKDB",
-	"7FJidIXy6ZBNCwJGJ910XWygYJPkcT/AqwDyIWZzIROiTRq51maivTAf4P2TQkWy/OcqXXKF95HDFlMJ",
-	"joiTVg10avTE6Cbo9PO3VduapRCMKWCf729fyCcaLQoJdDpbTyPn2KYmbPjqmME2tz1vE0kgOuDL5lkc",
-	"u317Btn2O3lk3AzMOucshmnKIlNhTbOAl8vSldAwjURWW1g1kdnOdEnU1PhTQ0v9myvp3IzUX8od7zgX",
-	"oHOTpKAJi5VtwBCkUojYnEUFAru50QK7dvISehbWlPCEfM8O+FKruklO1rYUN4xo+Y7X9r/14ml0UQu1",
-	"YVkSH1X/7fFs8ODVcI8e3BCYCBuVoc3L+97M02lzRZiKRCxk2G+7wR74zIXx2+x/QXF+ElzfufHONXt9",
-	"CTmokfVOTPBewoc1F8ThsQTjtp0djh6fQR9paK86fnyw8SOU4joM+bz2Za6PCdH+qFBCwjcY4neo33K4",
-	"o2KNqt9uyjY26r46H6lcdY4f25wd7vRHwm57YunrXZLW/bSriVmjXpaJt6kSJWi57qe19ldrejsm08a6",
-	"xSmtZfXSXLa8gbLdn8Y7edEu2nCiRKQMmFYR9sBsuAJpciviZgop46Oh/UZiRh1hgzrcWVxAQMVgzwHM",
-	"LhGtyjX2HMBgZ3onQ9cIHs7BZcHeQPbJ0nwyJA1iU0vxiMpzEq/wDMmXgqJRglU6frqoVtUTbvBrqJy5",
-	"Jdr88r15v8ySGScsvhZRIEbZc9JQx3+SyRLQe5MXubZ/DB6VZ+T1HBY82JqrXo47DmU65TdhB8MmCNpO",
-	"6AunUnWObl19WKtPNAvQw4znx3p0uCq7g6Gc86EkzjuJb/4w5ZJoWAgjIW9P9PY6bEevuyl6IMmIxYId",
-	"CGfXdrjJG4OZGuUiCajV2Iv9eUQ8G2Cl1/aah6l88X6qoYTUKCrUFuzDKvUoJO3CuZWBWmH1YZ/XYmHz",
-	"aHfawktNiUwHLfLaDbdcp8jKtuc8i7uu1a7TcLcL5fDYvuL4Co/HlRuZjE1dkTMIok3G3RoNJ79PYfdD",
-	"4p6TMa/NS3DprcvPGnrTt3Xi07YYXlItg4aI/p5QvvmE0oFxB+5d2+K1yVVhUQumtDvj8Pu524KiRVpp",
-	"AOd6J+U7vYXUE8bQZ7I6vurd47NdqqNI0DJM/JGBZVwcJmzRXTqyvpN2p0O5VWgD7dajKJBko24BPwV6",
-	"WuroAAgV6JtKlQeRKGKKttTu1ZWIaT/IMLGqiRmHx36YnTq2FxJH0ZLwRVftK9CVqHL1a7lun3LZauC9",
-	"x9tsuaZoetkWb1lwt7h5Xtbb73fPT9qGz1JKNAzzywMH9GPpbnKyBt0Yf7cVvp8f3o8+EjacF8BpOK0o",
-	"R3vmOmNSL42MQoy3CTrGkhkTYS6i095aIdOBAeWg6adnZ2c0oPhJFRMXCG3wHGkbd1FPmjVZqOCFCDcW",
-	"FmFZsuzVCDuZ+8Dvs9zHPcHrGG6wS36Y352pnoWCV42ky72FhqR3a/zI0x7rqS4EXe8UlUkWa5YSqUcG",
-	"0ENKNKnXlfVy0qCpuE9Vqq8yBcaJXVyjQneLNVudnbgl5fCOiO2AbLW43S2sw+0O1xo//hS15z6HWypS",
-	"kThwwpUPNvKpSukfHbDzg4ce3KGpxG3DJrdlA+8tJdunDec8bjFHfSz2FrT9ivKz/tTtO7Ey6m9oXj4Y",
-	"kpOe5L2VrmLd+18w4Q3XF2VG5hv97BJF39C/BAtfxLohC8aLu1qe1eSfQ9zU711VrUL3Jcu/51c7acah",
-	"lmGel9+4q7+hs7y97xsqpg9FNh5sfaoX6tZeqRwg3jvGf7JerieB+DG3n73BpeFUvN1BeKvjUkeEOxVd",
-	"rdqthuiyoum7myuk+7PveaurCkdO/nI3hwb7Xwod92HeUd8FtS8oOpe2zwwqOaryv4J3LiRlnMRMr/2+",
-	"3u8Vmz3Je5h5DzOdwkz9nA8RTlFaIiqPCf2fJLrfh7NaJzUb+05RuJRi7dc9HMRq+UVcNVjp8jBSv9xM",
-	"QkCsW+fRR+NkoY7pbm1ZxpFm4RoYwfzZ+IjJtpoDNHfZzBDNLDSe5WuauJzKfn9UErhnoYYC5Kowtuqf",
-	"MxqPRrGISLwUSo9/PT8/H5GU4c1vm/8HAAD//6vLy1eDSQAA",
+	"H4sIAAAAAAAC/+wcXXPbuPGvYPCS3Axl+a7XdqqnJlZydeukHn/dZTo3GohciTiTAA8ApagZ//fOAiD1",
+	"BYqSRTvJNQ+esYgldrHfuwD4iXIxkXTwicZSGBYb/BdyxjM6oKlUDP++//Nf/vr3KT48iWVOIypYDnRA",
+	"L5XMTTkG8urynNwAy+lDRBPQseKF4VLQAb1J3ehEKlKB04hmPAahAZH5uV5fD3s/0IiWymI2ptCDfn/K",
+	"TVqOEWu/IiaBWb9QMgeTQqlxvv44k+N+zrjoX5yfvXl//QbpMNxka0S+ZvE9iATJoRGdgdKOxNOT05Pv",
+	"8Q1ZgGAFpwP6p5PTk1Ma0YKZVCORfVYUSs6gl8i5yCRL8GEhtWWXLEAxXO95Qgf0lYMcVoA4i2I5GFCa",
+	"Dv7zaYNBM56AJOdDYiRJlu9wHEuBJaCW/Law50MaUQW/l1xBQgdGlRBRHaeQMyTGLAoE5cLAFBR9eIg2",
+	"MbLSpCSW8p6DJmDiJmxnFoQGJtdGcTGlDw+/IiW6kEKDZdMPp6d0sIkvgQwMEF3GMWjtVGTCysxsg94K",
+	"+FhAbCAhoJS05FNd5jlTCzqgV2DUgjAVp3wGBHkA2liYWj6WRa3CubNQX61kcmZKFZTMWMoMmPgSxO4l",
+	"8tRydw97MANhLDFTCMndgb1xUC2Cr4RNeIKyn/DMgCJSNHGsgt9P/m1cRD8Mwq6BFUXGY7uK/m8aafu0",
+	"Mh83kNsXC4WLNdxN85bxrFRwljGttzk9TxfEpFArNJkwnkESETiZnhAFuZzhr0LxGTMQkSnI0TiT8T0+",
+	"VczAKOM5N/jLSDnKmJpCRFC3RtXKIyLAzKW6J1KRUtwLOUfWbSw/ou9AazaFAGsiesmUAHOrsuDoDc9B",
+	"G5YXwVFr3OFXH2oy5Pg3iA1dPmBKsQV9eNiKYBnXhsgJmcgsk3MyAUiINfejVPonMLVCe91d02ev5K0a",
+	"fVXBtej001v/sXrrF5TcOd4GHGZEMYTLyeQti41UYZCzUikQ5kYalu2aarg02uD4BdPmeiFiSIJK9h4+",
+	"2uHgIA5cAfMr3xq+FZX1sXEGu4hsMoBbDSpM+DEavuFgO9Pv5XxWw8uEm1Z/jUD7eWuvd6RgUyCizMeg",
+	"mpQbQd5XEMcE6lKD2jc68OSLz9e+me43093HdGOZ515Pwun1mQdoMdmCoZyJn46cD5tU2gEeaT8Vmtzn",
+	"Gw24KiPYhWwiVc4MHdDxwsB2TtOI+4UmVTXxx6rtvMC7SPKrqaQgzHFrTel0/xNPHhoDhn9b71vUVYzB",
+	"Cv/xvvqJ/Km3vmSbhzwvpEIOTpTMbSJvF/RCE6n4lAuWEc1XVbMuCCOrG1JBMhovRrFztSMMZKHyMVpd",
+	"w5YPixUw0+BdJ2WWOW4GBvnqOz5WP2A36B5GsSxFQGtwTKNS4GLXFhnZplLFKxIvNWALQaHkhGcwKnhs",
+	"SgWjssE5l8VMmhVStiZCfo1SpkcYBhA2CXOvhiuLRqjH+PspGO9JEjCMZ9rxgOgCYj7hcWU4x3n/ipV2",
+	"8tpirDUmTOTsvtwRAqxuDD3Yvi0WRJTU7wRd1l0nDtJUpeM+KGvgg+r6LZwtgWc53GHgQQBMDOI6Igdx",
+	"3yyK3Yj3jXAklplUzeHGDXaAZyIx3PD/NrLzrRTm2o0f3YtZJ8ErNbHuj0vRSdTDuYAImNfKuGpnu4Pe",
+	"T2AONLQvOuy9sgGqKat3OhTy2mdeHkNmwmGnVonQYBO+cx9qQz00MCko10fzOjFnehmK6uDcHpR3NcGu",
+	"Zali2MaPM+OEpM4GKipiloPFTqNDu2bWE3TbMVtV6PUAU9Fbxxev9HanoLfRA2uONUMLv94J27u5ez5E",
+	"GpGXHg9W8wqMWnTT5/1/24FxSEatNaIT2p6VYnuJ+BW0V8Ilk+NXcow0HCsJq6O8FYTdve3NQGFCyNxM",
+	"TcJ4g7B3LOOJA2wRh526iUHVYMdR15JIZjWNHUdd2Jje8dA1+3vY7G+Mvm8tzFsEaWGbTuWc1Jt2QeYh",
+	"yLsKopWDO7b54lJpqVwIYqRQMOOy1KTi8wtNfulhB6x35gClIr/0LhXMqgc1ZUE63fSfpcm4nR0s6zI3",
+	"+L6p7rxiBv8LvXmTlvlYMJ5dyLhhs8meI2jaaBqWqraxrcmrmiU8BnMdGOl4jyryIrSo1kS/bTVed4y0",
+	"YVHAR9dVjwgba9+awYGMaTewUw0iuqZXbdhqTQ1hnHC1D8qHI1zCrS3U11tPjp11Dh52oc4NtLkAu2Pw",
+	"xPn3OkZHVxfeMsSaKZheKfwef9Kb1Z3wpkLltga+qxTzK9+wPGMGplItVlCu9v+vLpqdxpe9AbAjycvk",
+	"lO9IJy7scFs0BJyaeJY0iBXtxf57QD4RUW0W6Kttu4Rux0YtlSFxJbbGPQet51Ilx2Dey0Ats7qwzws5",
+	"tV7S7UqKWlKyNI0WeeGG96RTlnWLf1Jmx9Jq6UTsllAB8/0rvvcwP6zcK1WGEcYjaNQ2lR3XnXr2w1V2",
+	"PSyrpNJRMhy0eQWuvHD5ccs+zNU68PP2pZ5SLFFLRO8qof+WPX+27Nkp44a6H7uXsja5rixqyrUB1ezn",
+	"riqIPdJKVDjXu6rf6SykPmMMfSSqw7sOW3hWWyUklkkdJn4vQS2WqO5W4M4cWNdJu5OhWml0QHJcj6jS",
+	"JBt1K/XTYEa1jHYooQZzuRTlTk2UWUJWxB6UlcySbjQDY1UbMgHzbpA9d2yvOE7ilInpsdLXYJas8uI3",
+	"arF/ymWrgW899nbLxaLpaVvspdj/Gkpdb3+7iPKs2yBlkTADPX/iZId8LNylB2uRDfq7lfD9+PB+8DkC",
+	"xDwFsaMjXI92jHXMlUmRR02IVwGOjCVjLpuxyKPWtpdmOmUgXmm66dnZGVEpXuhq4kpDWzxHsY+7WE+a",
+	"DZvqJg76sWYW1iXLVo2wkblHYZ/lbvo1YfeDx+SH/sDV8llT8FoDOQJjW9K7Mn7gbpv1VK9lstgoKvMy",
+	"M7xgyvRRoXsJM2y9rlwvJ1GbqlN+tfiWpsAFs8S1CnSzWLPV2TO3pJy+E2Y7ICstbnd0b3e7w7XGD9/F",
+	"7rjP4UglOpY7dhj9YCueZSn9uQO233jowB1iJW4bNt6WUb1XhGyftuzzOGIOujn6NUj7C8rPuhN3aMcK",
+	"xd/SvMS7GfpZd/K+lq7iuvd/zWUwXL+uM7LQ6E8uUQwN/VPy5tN7lwzP0PkDfgFq/LWhy/VjdMtWobvx",
+	"9e/J+Uaasatl6PPyS3devGkvb+suzxLpbZWNN7Y+9RN1a8+1V5DgwfQ/WC83kEB8niPzweDSsiu+30b4",
+	"XtulDogeVXTt1W5FoLMlTNfdXKncz67nXR5VOHDyZzy5JUW2IBZhfZDYKRCZpzxOCVNAhCSZFFNQhM0Y",
+	"z/ASIpmnoICYFBZr53+DxGZSm9tCGwUsP/D7EYferz3oil740BrO90ITPLO0PK2GZ4rcrwitLiFcaAMs",
+	"qTqNe9C5xyG2Y0qyI2wQa+UVcbMsI8vPKmAZwYgGdBfoi5hICLA4JbdX5wRELBNIWorwLoruDRqFNEin",
+	"nB9LInyMszKB5KZjUuWkotaQDBi2LQQQrjviLBOLDilmYoEE6xS92stSJKDIjyTnojSgv4tIDgkvc/Ly",
+	"R2zT/nBaj6BloGdoojLxwbkrOq2z8u7JNZQgwdN6GFonxl6O4JrYHtTLDx8+fOi9e9cbDr9r9M9+imsu",
+	"4gP34YOUjGEiFTySiNf25UdQsem3a3L8GUd7aWPdUR/Bs+pyye2RvHsM1Y/i7ybBj+Gztw/BY2nJjciY",
+	"Zxz/IrKQpSnHEJFE5qANj8lL65z8+qyN4IJS6c/MCWnv8fjLOeHozg10ZTM5Fzwvc6JcDt6AMefiqgI4",
+	"JIZWs89sNt08+Z0fP2TuVRubc5O2XZxMmV5eTHu2stTnpf5X46lNlaAScrMIV4vhuqq9FonoWxaDCXxz",
+	"yV4n1lV+kjMTp1xMK36O3QeZZiwrQTs7S9kMbJViY5D7wgiNNhYyrD36jsU2XmS+Q3SPXOe1tYnnxmqD",
+	"7HMj/ZmbdLi88rxP5+DYbkWd7B5wPh/ywixCx/O3P37S0gyJ6DK/PvDI/hoRayf292D8t4bLH6bhsn7i",
+	"zebSRW0UvjvS/Zk69//u/q7jmu0CPUcLv2Zrt2Fup67W30EZfNqUVET/cXHdpGq7lfjd5bDpxXXDPfT8",
+	"6HU5thu0u2yzYaX73c82isX3j76d/S8uAvPriuiIxMw+1JhargDpkOu9YGMIf3bkgolp2XQL/EIuL3Fu",
+	"3wP/GcZ3NzfEHyLpIqy2pLUrbutAn+XS/sY2f9WWOksBvzb5yuyEujbMlHq37Gv5ck204Vm20jR7KUXG",
+	"BUS7P3lpS7PVb1t+V4U5PiHckJRp8cKQMYAgsaObRm1EuzotRPnGR25Qa10c1YjAJt7caOI/3kO040EU",
+	"jpPD1dkbYul1OUagsb8e+Iig2obluQLV5+r7b4UihAA1q6LK8nPWg35fTLn4OPjb6elpnxWcPvz68L8B",
+	"AGdtBsR/WwAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
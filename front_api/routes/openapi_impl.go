@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -18,9 +19,12 @@ import (
 	userproto "github.com/KIRAKIRA-DOUGA/KIRAKIRA-golang-backend/user_service/protocol"
 	"github.com/davegardnerisme/deephash"
 	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/horahoradev/horahora/front_api/sockets"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
 	"github.com/zhenghaoz/gorse/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // You can customize this to whichever value you see fit
@@ -328,8 +332,23 @@ func (s Server) Videos(ctx echo.Context, params VideosParams) error {
 		ShowMature:     params.ShowMature,
 	}
 
-	videoList, err := s.r.v.GetVideoList(context.TODO(), &req)
+	if params.LostUpstream != nil {
+		req.LostUpstreamOnly = *params.LostUpstream
+	}
+
+	if params.Cursor != nil {
+		req.Cursor = *params.Cursor
+	}
+
+	err = setVideoFilters(&req, params)
 	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	videoList, err := s.r.v.GetVideoList(context.TODO(), &req)
+	if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+		return echo.NewHTTPError(http.StatusBadRequest, st.Message())
+	} else if err != nil {
 		log.Errorf("Could not retrieve video list. Err: %s", err)
 		return errors.New("Could not retrieve video list")
 	}
@@ -346,10 +365,21 @@ func (s Server) Videos(ctx echo.Context, params VideosParams) error {
 		PaginationData: PaginationData{
 			NumberOfItems: int(videoList.NumberOfVideos),
 			CurrentPage:   pageNumber,
+			NextCursor:    videoList.NextCursor,
+			PrevCursor:    videoList.PrevCursor,
 		},
 		Categories: cats,
 	}
 
+	if f := videoList.Facets; f != nil {
+		data.Facets = Facets{
+			Tags:        facetCounts(f.Tags),
+			Durations:   facetCounts(f.Durations),
+			Sites:       facetCounts(f.Sites),
+			WithDanmaku: f.WithDanmaku,
+		}
+	}
+
 	data.Videos = []Video{}
 	for _, video := range videoList.Videos {
 		data.Videos = append(data.Videos, Video{
@@ -373,6 +403,91 @@ func (s Server) Videos(ctx echo.Context, params VideosParams) error {
 	return ctx.JSON(http.StatusOK, data)
 }
 
+// setVideoFilters copies the structured filters from the request. Tags are URI encoded, since headers can't hold most
+// of them as they are.
+func setVideoFilters(req *videoproto.VideoQueryConfig, params VideosParams) error {
+	var err error
+	req.Tags, err = uriDecoded(params.Tags)
+	if err != nil {
+		return err
+	}
+
+	req.ExcludedTags, err = uriDecoded(params.ExcludedTags)
+	if err != nil {
+		return err
+	}
+
+	req.AnyTags, err = uriDecoded(params.AnyTags)
+	if err != nil {
+		return err
+	}
+
+	if params.Durations != nil {
+		req.Durations = *params.Durations
+	}
+
+	if params.Sites != nil {
+		req.Sites = *params.Sites
+	}
+
+	if params.UploadedSince != nil {
+		req.UploadedSince = *params.UploadedSince
+	}
+
+	if params.UploadedBefore != nil {
+		req.UploadedBefore = *params.UploadedBefore
+	}
+
+	if params.OriginalUploadedSince != nil {
+		req.OriginalUploadedSince = *params.OriginalUploadedSince
+	}
+
+	if params.OriginalUploadedBefore != nil {
+		req.OriginalUploadedBefore = *params.OriginalUploadedBefore
+	}
+
+	if params.MinRating != nil {
+		req.MinRating = int64(*params.MinRating)
+	}
+
+	if params.MinViews != nil {
+		if *params.MinViews < 0 {
+			return errors.New("minViews can't be negative")
+		}
+		req.MinViews = uint64(*params.MinViews)
+	}
+
+	if params.HasDanmaku != nil {
+		req.HasDanmaku = *params.HasDanmaku
+	}
+
+	return nil
+}
+
+func uriDecoded(values *[]string) ([]string, error) {
+	if values == nil {
+		return nil, nil
+	}
+
+	decoded := make([]string, 0, len(*values))
+	for _, v := range *values {
+		d, err := url.PathUnescape(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tag %s. Err: %s", v, err)
+		}
+		decoded = append(decoded, d)
+	}
+	return decoded, nil
+}
+
+func facetCounts(counts []*videoproto.FacetCount) []FacetCount {
+	res := []FacetCount{}
+	for _, c := range counts {
+		res = append(res, FacetCount{Value: c.Value, Count: c.Count})
+	}
+	return res
+}
+
 func (s Server) VideoDetail(ctx echo.Context, id float32) error {
 	videoID := int64(id)
 
@@ -422,6 +537,7 @@ func (s Server) VideoDetail(ctx echo.Context, id float32) error {
 	data := VideoDetail{
 		Title:            videoInfo.VideoTitle,
 		MPDLoc:           videoInfo.VideoLoc, // FIXME: fix this in videoservice LOL this is embarrassing
+		HLSLoc:           videoInfo.HLSLoc,
 		Views:            videoInfo.Views,
 		Rating:           rating,
 		AuthorID:         videoInfo.AuthorID, // TODO
@@ -436,10 +552,19 @@ func (s Server) VideoDetail(ctx echo.Context, id float32) error {
 		VideoDuration:    videoInfo.VideoDuration,
 		Thumbnail:        videoInfo.Thumbnail,
 		IsMature:         videoInfo.IsMature,
+		Subtitles:        make([]SubtitleTrack, 0, len(videoInfo.Subtitles)),
+
+		UpstreamStatus:      videoInfo.UpstreamStatus,
+		UpstreamStatusSince: videoInfo.UpstreamStatusSince,
+		UpstreamCheckedAt:   videoInfo.UpstreamCheckedAt,
 
 		// L: profile,
 	}
 
+	for _, track := range videoInfo.Subtitles {
+		data.Subtitles = append(data.Subtitles, subtitleTrack(track))
+	}
+
 	err = s.writeThroughCache(id, data)
 	if err != nil {
 		log.Errorf("Failed to set cache: %v", err)
@@ -500,6 +625,8 @@ func (s Server) Comments(c echo.Context, videoIDInt int) error {
 			CurrUserHasUpvoted:    comment.CurrentUserHasUpvoted,
 			CurrUserHasDownvoted:  comment.CurrentUserHasDownvoted,
 			AuthoredByCurrentUser: comment.AuthorId == uid,
+			Archived:              comment.Archived,
+			LikeCount:             comment.LikeCount,
 		}
 
 		if comment.ParentId != 0 {
@@ -721,11 +848,18 @@ func (s Server) FollowFeed(ctx echo.Context, params FollowFeedParams) error {
 		User_ID: profile.UserID,
 	})
 
-	videos, err := s.r.v.GetFollowFeed(context.Background(), &videoproto.FeedReq{
+	req := videoproto.FeedReq{
 		FollowedUsers: users.Users,
 		ShowMature:    params.ShowMature,
-	})
-	if err != nil {
+	}
+	if params.Cursor != nil {
+		req.Cursor = *params.Cursor
+	}
+
+	videos, err := s.r.v.GetFollowFeed(context.Background(), &req)
+	if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+		return echo.NewHTTPError(http.StatusBadRequest, st.Message())
+	} else if err != nil {
 		return err
 	}
 
@@ -746,6 +880,14 @@ func (s Server) FollowFeed(ctx echo.Context, params FollowFeedParams) error {
 
 			recVideos = append(recVideos, vid)
 		}
+
+		// The feed is a bare list, so its cursors go in headers
+		if videos.NextCursor != "" {
+			ctx.Response().Header().Set("X-Next-Cursor", videos.NextCursor)
+		}
+		if videos.PrevCursor != "" {
+			ctx.Response().Header().Set("X-Prev-Cursor", videos.PrevCursor)
+		}
 	}
 
 	return ctx.JSON(http.StatusOK, recVideos)
@@ -791,7 +933,9 @@ type Danmaku struct {
 	CreationDate *string `json:"CreationDate,omitempty"`
 	FontSize     *string `json:"FontSize,omitempty"`
 	ID           *int64  `json:"ID,omitempty"`
+	Imported     *bool   `json:"Imported,omitempty"`
 	Message      *string `json:"Message,omitempty"`
+	Source       *string `json:"Source,omitempty"`
 	Timestamp    *string `json:"Timestamp,omitempty"`
 	Type         *string `json:"Type,omitempty"`
 }
@@ -812,7 +956,9 @@ func (s Server) GetDanmaku(ctx echo.Context, id int) error {
 			Color:     &dn.Color,
 			FontSize:  &dn.FontSize,
 			ID:        &dn.Id,
+			Imported:  &dn.Imported,
 			Message:   &dn.Message,
+			Source:    &dn.Source,
 			Timestamp: &dn.Timestamp,
 			Type:      &dn.Type,
 		}
@@ -828,7 +974,7 @@ func (s Server) CreateDanmaku(ctx echo.Context, params CreateDanmakuParams) erro
 		return err
 	}
 
-	_, err = s.r.v.AddDanmaku(context.TODO(), &videoproto.Danmaku{
+	dn, err := s.r.v.AddDanmaku(context.TODO(), &videoproto.Danmaku{
 		VideoId:   int64(params.VideoID),
 		Timestamp: params.Timestamp,
 		Message:   string(params.Message),
@@ -838,9 +984,11 @@ func (s Server) CreateDanmaku(ctx echo.Context, params CreateDanmakuParams) erro
 		FontSize:  params.FontSize,
 	})
 	if err != nil {
-		return err
+		return danmakuErr(ctx, err)
 	}
 
+	sockets.BroadcastDanmaku(s.r.srv, dn)
+
 	return ctx.JSON(http.StatusOK, nil)
 }
 
@@ -878,6 +1026,8 @@ func (s Server) ArchiveRequests(c echo.Context, params ArchiveRequestsParams) er
 			BackoffFactor:        archivalReq.BackoffFactor,
 			DownloadID:           archivalReq.DownloadID,
 			UndownloadableVideos: archivalReq.UndownloadableVideos,
+			NextSync:             archivalReq.NextSync,
+			SyncReason:           archivalReq.SyncReason,
 		}
 		requests = append(requests, req)
 	}
@@ -0,0 +1,88 @@
+package routes
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Resumable uploads follow the core tus protocol (https://tus.io/protocols/resumable-upload), along with its checksum
+// extension: create a session with POST /api/uploads, send the video with any number of PATCH requests, check how much
+// was received with HEAD after a failure, then POST /api/uploads/:id/finalize to create the video.
+
+const (
+	uploadOffsetHeader   = "Upload-Offset"
+	uploadLengthHeader   = "Upload-Length"
+	uploadChecksumHeader = "Upload-Checksum"
+	uploadExpiresHeader  = "Upload-Expires"
+	tusResumableHeader   = "Tus-Resumable"
+	tusVersion           = "1.0.0"
+	uploadContentType    = "application/offset+octet-stream"
+	// Not in net/http, defined by the checksum extension
+	statusChecksumMismatch = 460
+)
+
+// parseUploadChecksum converts the value of an Upload-Checksum header ("sha256 <base64 digest>") to a hex digest
+func parseUploadChecksum(header string) (string, error) {
+	if header == "" {
+		return "", nil
+	}
+
+	spl := strings.SplitN(header, " ", 2)
+	if len(spl) != 2 || spl[0] != "sha256" {
+		return "", fmt.Errorf("unsupported checksum %q, only sha256 is supported", header)
+	}
+
+	digest, err := base64.StdEncoding.DecodeString(spl[1])
+	if err != nil {
+		return "", fmt.Errorf("invalid checksum. Err: %s", err)
+	}
+
+	return hex.EncodeToString(digest), nil
+}
+
+func setUploadSessionHeaders(c echo.Context, session *videoproto.UploadSession) {
+	h := c.Response().Header()
+	h.Set(tusResumableHeader, tusVersion)
+	h.Set(uploadOffsetHeader, strconv.FormatInt(session.Offset, 10))
+	h.Set(uploadLengthHeader, strconv.FormatInt(session.Size, 10))
+	h.Set(uploadExpiresHeader, session.ExpiresAt)
+	h.Set("Cache-Control", "no-store")
+}
+
+// uploadErr responds with the status corresponding to an error from the video service's upload session rpcs
+func uploadErr(c echo.Context, err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	var code int
+	switch st.Code() {
+	case codes.NotFound:
+		code = http.StatusNotFound
+	case codes.FailedPrecondition:
+		code = http.StatusConflict
+	case codes.Aborted:
+		code = http.StatusLocked
+	case codes.OutOfRange:
+		code = http.StatusRequestEntityTooLarge
+	case codes.InvalidArgument:
+		code = http.StatusBadRequest
+	case codes.DataLoss:
+		code = statusChecksumMismatch
+	default:
+		return err
+	}
+
+	c.Response().Header().Set(tusResumableHeader, tusVersion)
+	return c.String(code, st.Message())
+}
@@ -52,6 +52,7 @@ func SetupRoutes(e *echo.Echo, cfg *config.Config, srv *socketio.Server) {
 	e.GET("/api/audit-events", wrapper.AuditEvents)
 
 	e.GET("/api/videos/:id", wrapper.VideoDetail)
+	e.GET("/api/videos/:id/download", r.handleDownloadVideo)
 	e.POST("/api/upvotevideo/:id", wrapper.UpvoteVideo)
 
 	e.POST("/api/login", wrapper.Login)
@@ -65,6 +66,10 @@ func SetupRoutes(e *echo.Echo, cfg *config.Config, srv *socketio.Server) {
 
 	e.GET("/api/upvote/:id", wrapper.Upvote)
 	e.POST("/api/upload", wrapper.Upload)
+	e.POST("/api/uploads", r.handleCreateUpload)
+	e.PATCH("/api/uploads/:id", r.handleAppendUpload)
+	e.HEAD("/api/uploads/:id", r.handleGetUploadOffset)
+	e.POST("/api/uploads/:id/finalize", r.handleFinalizeUpload)
 
 	e.POST("/api/ban/:id", r.handleBan)
 	e.POST("/api/delete/:id", r.handleDelete)
@@ -73,6 +78,10 @@ func SetupRoutes(e *echo.Echo, cfg *config.Config, srv *socketio.Server) {
 
 	// Scheduler
 	e.GET("/api/downloadsinprogress", r.handleGetDownloadsInProgress)
+	e.GET("/api/inference-rules", r.handleGetInferenceRules)
+	e.POST("/api/inference-rules", r.handleAddInferenceRule)
+	e.POST("/api/inference-rules/:id", r.handleUpdateInferenceRule)
+	e.POST("/api/delete-inference-rule/:id", r.handleDeleteInferenceRule)
 	e.GET("/api/archive-requests", wrapper.ArchiveRequests)
 	e.GET("/api/archive-events", wrapper.ArchiveEvents)
 	e.POST("/api/new-archive-request", wrapper.NewArchiveRequest)
@@ -89,6 +98,35 @@ func SetupRoutes(e *echo.Echo, cfg *config.Config, srv *socketio.Server) {
 	// Danmaku goes here
 	e.POST("/api/danmaku", wrapper.CreateDanmaku)
 	e.GET("/api/danmaku/:id", wrapper.GetDanmaku)
+	e.GET("/api/danmaku/:id/export", r.handleExportDanmaku)
+	e.POST("/api/danmaku/:id/import", r.handleImportDanmaku)
+	e.POST("/api/delete-danmaku/:id", r.handleDeleteDanmaku)
+	e.POST("/api/delete-user-danmaku/:id", r.handleDeleteUserDanmaku)
+
+	e.POST("/api/subtitles/:id", r.handleUploadSubtitles)
+	e.POST("/api/delete-subtitles/:id", r.handleDeleteSubtitles)
+
+	e.GET("/api/videos/:id/tags", r.handleGetTags)
+	e.POST("/api/videos/:id/tags", r.handleAddTag)
+	e.POST("/api/delete-tag/:id", r.handleRemoveTag)
+	e.POST("/api/videos/:id/tags/lock", r.handleSetTagLock)
+	e.GET("/api/videos/:id/tag-history", r.handleGetTagHistory)
+	e.POST("/api/videos/:id/tag-history/:editid/revert", r.handleRevertTags)
+
+	e.GET("/api/favorites", r.handleGetFavorites)
+	e.GET("/api/favorites/:id", r.handleGetFavoriteStatus)
+	e.POST("/api/favorites/:id", r.handleAddFavorite)
+	e.POST("/api/delete-favorite/:id", r.handleRemoveFavorite)
+
+	e.GET("/api/playlists", r.handleListPlaylists)
+	e.POST("/api/playlists", r.handleCreatePlaylist)
+	e.GET("/api/playlists/:id", r.handleGetPlaylist)
+	e.POST("/api/playlists/:id", r.handleUpdatePlaylist)
+	e.POST("/api/delete-playlist/:id", r.handleDeletePlaylist)
+	e.POST("/api/playlists/:id/entries", r.handleAddPlaylistEntry)
+	e.POST("/api/playlists/:id/entries/:videoid", r.handleUpdatePlaylistEntry)
+	e.POST("/api/delete-playlist-entry/:id/:videoid", r.handleRemovePlaylistEntry)
+	e.GET("/api/playlists/:id/neighbours/:videoid", r.handleGetPlaylistNeighbours)
 
 	e.GET("/api/get-unapproved-videos", wrapper.GetUnapprovedVideos)
 	e.POST("/api/unapprove-download", wrapper.UnapproveDownload)
@@ -120,6 +158,16 @@ type VideoInProgress struct {
 	DlStatus string
 }
 
+// InferenceRule is a rule the scheduler classifies archived videos with
+type InferenceRule struct {
+	ID       int64
+	Category string
+	Field    string // tag, title (a regular expression), uploader or site
+	Pattern  string
+	Weight   float64
+	Priority int32
+}
+
 type UnapprovedVideo struct {
 	URL      string
 	VideoID  string
@@ -135,11 +183,26 @@ type HomePageData struct {
 	PaginationData PaginationData
 	Videos         []Video
 	Categories     []Category
+	Facets         Facets
+}
+
+type FacetCount struct {
+	Value string
+	Count uint64
+}
+
+// Facets count the videos in a list by the values they have for each filter
+type Facets struct {
+	Tags        []FacetCount
+	Durations   []FacetCount
+	Sites       []FacetCount
+	WithDanmaku uint64
 }
 
 type VideoDetail struct {
 	Title             string
 	MPDLoc            string
+	HLSLoc            string
 	Thumbnail         string
 	Views             uint64
 	Rating            int64
@@ -156,6 +219,77 @@ type VideoDetail struct {
 	L                 *LoggedInUserData
 	VideoDuration     float32
 	IsMature          bool
+	Subtitles         []SubtitleTrack
+	// UpstreamStatus is whether the original is still available, "" if it hasn't been checked
+	UpstreamStatus      string
+	UpstreamStatusSince string
+	UpstreamCheckedAt   string
+}
+
+type SubtitleTrack struct {
+	ID       int64
+	Language string
+	Kind     string // subtitles, captions or descriptions
+	Label    string
+	Location string // WebVTT
+	Imported bool
+}
+
+type FavoriteStatus struct {
+	Favorite bool   // Whether the user has favorited the video
+	Count    uint64 // How many users have
+}
+
+type FavoritesData struct {
+	PaginationData PaginationData
+	Videos         []Video
+}
+
+type Playlist struct {
+	ID          int64
+	UserID      int64 // The owner
+	Title       string
+	Description string
+	Visibility  string // public, unlisted or private
+	CreatedAt   string
+	UpdatedAt   string
+	EntryCount  int64
+	Entries     []PlaylistEntry `json:",omitempty"` // In order, only when getting a single playlist
+}
+
+type PlaylistEntry struct {
+	Video    Video
+	Position int64 // From 1
+	Note     string
+	AddedAt  string
+}
+
+// PlaylistNeighbours are the entries either side of a video in a playlist, for playing it through
+type PlaylistNeighbours struct {
+	Previous *PlaylistEntry
+	Next     *PlaylistEntry
+}
+
+type VideoTag struct {
+	Tag    string
+	Locked bool // Locked tags can only be removed by the uploader or a moderator
+}
+
+type TagEdit struct {
+	ID             int64
+	UserID         int64
+	Username       string
+	Action         string // add, remove, lock, unlock or revert
+	Tag            string // Empty for reverts
+	RevertedEditID int64  // For reverts, the edit the tags were put back to before
+	TagsBefore     []string
+	TagsAfter      []string
+	CreatedAt      string
+}
+
+type TagHistoryData struct {
+	PaginationData PaginationData
+	Edits          []TagEdit // Newest first
 }
 
 type ProfileData struct {
@@ -187,6 +321,9 @@ type AuditData struct {
 type PaginationData struct {
 	NumberOfItems int
 	CurrentPage   int
+	// Cursors to the pages on either side, if there are any
+	NextCursor string `json:",omitempty"`
+	PrevCursor string `json:",omitempty"`
 }
 
 type ArchiveRequestsPageData struct {
@@ -206,6 +343,8 @@ type ArchivalRequest struct {
 	BackoffFactor        uint32
 	DownloadID           uint64
 	UndownloadableVideos uint64
+	NextSync             string
+	SyncReason           string
 }
 
 func setCookie(c echo.Context, jwt string) error {
@@ -236,6 +375,8 @@ type CommentData struct {
 	CurrUserHasDownvoted  bool   `json:"user_has_downvoted"`
 	ParentID              int64  `json:"parent,omitempty"`
 	AuthoredByCurrentUser bool   `json:"authored_by_current_user"`
+	Archived              bool   `json:"archived"`
+	LikeCount             int64  `json:"like_count,omitempty"`
 }
 
 const (
@@ -0,0 +1,60 @@
+package sockets
+
+import (
+	"fmt"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+
+	socketio "github.com/googollee/go-socket.io"
+)
+
+// Danmaku sent to the room of the video they were posted on, so that everyone watching sees them live
+type DanmakuEvent struct {
+	ID        int64  `json:"ID"`
+	VideoID   int64  `json:"videoID"`
+	Timestamp string `json:"timestamp"`
+	Message   string `json:"message"`
+	AuthorID  int64  `json:"authorID"`
+	Type      string `json:"type"`
+	Color     string `json:"color"`
+	FontSize  string `json:"fontSize"`
+}
+
+// Sent when moderators delete danmaku, so that players can remove them
+type DanmakuDeletedEvent struct {
+	VideoID int64   `json:"videoID"`
+	IDs     []int64 `json:"IDs"`
+}
+
+func videoRoom(videoID int64) string {
+	return fmt.Sprintf("video:%d", videoID)
+}
+
+// BroadcastDanmaku sends a newly posted danmaku to everyone watching its video
+func BroadcastDanmaku(s *socketio.Server, dn *videoproto.Danmaku) {
+	s.BroadcastToRoom("/", videoRoom(dn.VideoId), "danmaku", DanmakuEvent{
+		ID:        dn.Id,
+		VideoID:   dn.VideoId,
+		Timestamp: dn.Timestamp,
+		Message:   dn.Message,
+		AuthorID:  dn.AuthorId,
+		Type:      dn.Type,
+		Color:     dn.Color,
+		FontSize:  dn.FontSize,
+	})
+}
+
+// BroadcastDanmakuDeleted tells everyone watching the affected videos which danmaku were deleted
+func BroadcastDanmakuDeleted(s *socketio.Server, deleted []*videoproto.Danmaku) {
+	byVideo := make(map[int64][]int64)
+	for _, dn := range deleted {
+		byVideo[dn.VideoId] = append(byVideo[dn.VideoId], dn.Id)
+	}
+
+	for videoID, ids := range byVideo {
+		s.BroadcastToRoom("/", videoRoom(videoID), "danmaku_deleted", DanmakuDeletedEvent{
+			VideoID: videoID,
+			IDs:     ids,
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package sockets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	schedulerproto "github.com/horahoradev/horahora/scheduler/protocol"
+	log "github.com/sirupsen/logrus"
+
+	socketio "github.com/googollee/go-socket.io"
+)
+
+type DownloadEvent struct {
+	Type       string  `json:"type"`
+	VideoID    string  `json:"videoID"`
+	Website    string  `json:"website"`
+	URL        string  `json:"url"`
+	ParentURL  string  `json:"parentURL"`
+	DownloadID uint64  `json:"downloadID"`
+	Progress   float64 `json:"progress"`
+	Message    string  `json:"message"`
+	Timestamp  string  `json:"timestamp"`
+}
+
+func userRoom(userID int64) string {
+	return fmt.Sprintf("user:%d", userID)
+}
+
+func downloadRoom(downloadID uint64) string {
+	return fmt.Sprintf("download:%d", downloadID)
+}
+
+// checkDownloadSubscription returns an error unless the user is subscribed to the archival request. Archival requests
+// are private to their subscribers, so only they can join its room.
+func checkDownloadSubscription(client schedulerproto.SchedulerClient, userID int64, downloadID uint64) error {
+	if userID == 0 {
+		return errors.New("must be logged in to watch archival requests")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.ListArchivalEntries(ctx, &schedulerproto.ListArchivalEntriesRequest{UserID: userID})
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range resp.Entries {
+		if entry.DownloadID == downloadID {
+			return nil
+		}
+	}
+
+	return errors.New("not subscribed to the archival request")
+}
+
+// RelayDownloadEvents watches the scheduler's downloads, and sends each event to the rooms of the archival request
+// and of every user subscribed to it. Reconnects if the stream breaks.
+func RelayDownloadEvents(ctx context.Context, s *socketio.Server, client schedulerproto.SchedulerClient) {
+	for {
+		err := relayDownloadEvents(ctx, s, client)
+		if err != nil {
+			log.Errorf("Download event stream failed, reconnecting. Err: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Second * 5):
+		}
+	}
+}
+
+func relayDownloadEvents(ctx context.Context, s *socketio.Server, client schedulerproto.SchedulerClient) error {
+	stream, err := client.WatchDownloads(ctx, &schedulerproto.WatchDownloadsRequest{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		payload := DownloadEvent{
+			Type:       event.Type.String(),
+			VideoID:    event.VideoID,
+			Website:    event.Website,
+			URL:        event.Url,
+			ParentURL:  event.ParentURL,
+			DownloadID: event.DownloadID,
+			Progress:   event.Progress,
+			Message:    event.Message,
+			Timestamp:  event.Timestamp,
+		}
+
+		s.BroadcastToRoom("/", downloadRoom(event.DownloadID), "download", payload)
+		for _, userID := range event.UserIDs {
+			s.BroadcastToRoom("/", userRoom(userID), "download", payload)
+		}
+	}
+}
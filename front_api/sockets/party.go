@@ -3,20 +3,65 @@ package sockets
 import (
 	"net/http"
 
+	custommiddleware "github.com/horahoradev/horahora/front_api/middleware"
+	schedulerproto "github.com/horahoradev/horahora/scheduler/protocol"
 	log "github.com/sirupsen/logrus"
 
 	socketio "github.com/googollee/go-socket.io"
 )
 
-func New() *socketio.Server {
+func New(auth *custommiddleware.JWTGRPCAuthenticator, scheduler schedulerproto.SchedulerClient) *socketio.Server {
 	s := socketio.NewServer(nil)
 	s.OnConnect("/", func(s socketio.Conn) error {
-		s.SetContext("")
-		log.Errorf("connected:", s.ID())
+		// The context is the ID of the logged in user, or 0
+		s.SetContext(int64(0))
+		log.Infof("connected: %s", s.ID())
 		s.Join("bcast")
+
+		// Logged in users get their own room, so that they can be told about their own archival requests
+		req := http.Request{Header: s.RemoteHeader()}
+		cookie, err := req.Cookie("jwt")
+		if err != nil || cookie.Value == "" {
+			return nil
+		}
+
+		uid, err := auth.AuthenticateCookie(cookie.Value)
+		if err != nil {
+			log.Errorf("Could not authenticate socket connection. Err: %s", err)
+			return nil
+		}
+
+		s.SetContext(uid)
+		s.Join(userRoom(uid))
 		return nil
 	})
 
+	// Subscribers watching an archival request's page join its room
+	s.OnEvent("/", "watch_download", func(s socketio.Conn, downloadID uint64) {
+		uid, _ := s.Context().(int64)
+		err := checkDownloadSubscription(scheduler, uid, downloadID)
+		if err != nil {
+			log.Errorf("Socket %s could not watch archival request %d. Err: %s", s.ID(), downloadID, err)
+			s.Emit("download_error", err.Error())
+			return
+		}
+
+		s.Join(downloadRoom(downloadID))
+	})
+
+	s.OnEvent("/", "unwatch_download", func(s socketio.Conn, downloadID uint64) {
+		s.Leave(downloadRoom(downloadID))
+	})
+
+	// Clients playing a video join its room to get danmaku as they're posted
+	s.OnEvent("/", "watch_video", func(s socketio.Conn, videoID int64) {
+		s.Join(videoRoom(videoID))
+	})
+
+	s.OnEvent("/", "unwatch_video", func(s socketio.Conn, videoID int64) {
+		s.Leave(videoRoom(videoID))
+	})
+
 	return s
 }
 
@@ -75,13 +75,18 @@ export default function Comments({ videoID, cookie, commentsInp }) {
                 </div>
                 <div className="inline-block text-white-800 ml-2 text-text-single-200 text-bottom font-normal">
                   {moment(item.created, "YYYY-MM-DDTh:mm:ssZ").fromNow()}
+                  {item.archived ? " · archived from the original site" : null}
                 </div>
                 <div className="leading-5 text-text-single-200 font-normal">
                   {item.content}
                 </div>
                 <div className="font-sans text-white-700">
                   <HandThumbUpIcon className="w-4 inline-block relative align-middle" />
-                  <span className="align-middle ml-1">{item.upvoteCount}</span>
+                  <span className="align-middle ml-1">
+                    {item.archived
+                      ? (item.likeCount ?? 0) + (item.upvoteCount ?? 0)
+                      : item.upvoteCount}
+                  </span>
                   <HandThumbDownIcon className="ml-2 w-4 inline-block relative align-middle" />
                 </div>
               </div>
@@ -90,6 +90,7 @@ export default function Video() {
                 <TableCell align="left">Undownloadable</TableCell>
                 <TableCell align="left">Backoff factor</TableCell>
                 <TableCell align="left">Last Sync</TableCell>
+                <TableCell align="left">Next Sync</TableCell>
               </TableRow>
             </TableHead>
             <TableBody>
@@ -118,6 +119,9 @@ export default function Video() {
                       </TableCell>
                       <TableCell align="left">{row.backoffFactor}</TableCell>
                       <TableCell align="left">{row.lastSynced}</TableCell>
+                      <TableCell align="left" title={row.syncReason}>
+                        {row.nextSync}
+                      </TableCell>
                     </TableRow>
                   ))
                 : null}
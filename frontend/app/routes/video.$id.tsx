@@ -98,7 +98,8 @@ export default function Video() {
     controls: true,
     sources: [
       {
-        src: "/" + video.mPDLoc,
+        // Older videos only have the HLS playlist, which the backend falls back to
+        src: "/" + (video.hLSLoc || video.mPDLoc),
         type: "application/x-mpegURL",
       },
     ],
@@ -17,6 +17,10 @@ export class ArchiveEvents200ResponseInner {
     'parnetUrl'?: string;
     'message'?: string;
     'timestamp'?: string;
+    /**
+    * why the download failed, e.g. removed, private, geo_blocked, rate_limited, too_large, auth_required, network or unknown
+    */
+    'failureClass'?: string;
 
     static readonly discriminator: string | undefined = undefined;
 
@@ -44,6 +48,12 @@ export class ArchiveEvents200ResponseInner {
             "baseName": "Timestamp",
             "type": "string",
             "format": ""
+        },
+        {
+            "name": "failureClass",
+            "baseName": "FailureClass",
+            "type": "string",
+            "format": ""
         }    ];
 
     static getAttributeTypeMap() {
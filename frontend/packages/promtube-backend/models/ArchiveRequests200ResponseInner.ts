@@ -21,6 +21,8 @@ export class ArchiveRequests200ResponseInner {
     'backoffFactor'?: number;
     'downloadID'?: number;
     'undownloadableVideos'?: number;
+    'nextSync'?: string;
+    'syncReason'?: string;
 
     static readonly discriminator: string | undefined = undefined;
 
@@ -72,6 +74,18 @@ export class ArchiveRequests200ResponseInner {
             "baseName": "UndownloadableVideos",
             "type": "number",
             "format": ""
+        },
+        {
+            "name": "nextSync",
+            "baseName": "NextSync",
+            "type": "string",
+            "format": ""
+        },
+        {
+            "name": "syncReason",
+            "baseName": "SyncReason",
+            "type": "string",
+            "format": ""
         }    ];
 
     static getAttributeTypeMap() {
@@ -22,6 +22,14 @@ export class Comments200ResponseInner {
     'userHasUpvoted'?: boolean;
     'userHasDownvoted'?: boolean;
     'authoredByCurrentUser'?: boolean;
+    /**
+    * imported from the video's original site
+    */
+    'archived'?: boolean;
+    /**
+    * likes on the original site, for archived comments
+    */
+    'likeCount'?: number;
 
     static readonly discriminator: string | undefined = undefined;
 
@@ -79,6 +87,18 @@ export class Comments200ResponseInner {
             "baseName": "authored_by_current_user",
             "type": "boolean",
             "format": ""
+        },
+        {
+            "name": "archived",
+            "baseName": "archived",
+            "type": "boolean",
+            "format": ""
+        },
+        {
+            "name": "likeCount",
+            "baseName": "like_count",
+            "type": "number",
+            "format": ""
         }    ];
 
     static getAttributeTypeMap() {
@@ -21,6 +21,14 @@ export class GetDanmaku200ResponseInner {
     'color'?: string;
     'creationDate'?: string;
     'fontSize'?: string;
+    /**
+    * whether the danmaku was archived from the original site
+    */
+    'imported'?: boolean;
+    /**
+    * the site imported danmaku came from
+    */
+    'source'?: string;
 
     static readonly discriminator: string | undefined = undefined;
 
@@ -72,6 +80,18 @@ export class GetDanmaku200ResponseInner {
             "baseName": "FontSize",
             "type": "string",
             "format": ""
+        },
+        {
+            "name": "imported",
+            "baseName": "Imported",
+            "type": "boolean",
+            "format": ""
+        },
+        {
+            "name": "source",
+            "baseName": "Source",
+            "type": "string",
+            "format": ""
         }    ];
 
     static getAttributeTypeMap() {
@@ -6,8 +6,11 @@ export * from '../models/GetUnapprovedVideos200ResponseInner';
 export * from '../models/Recommendations200ResponseInner';
 export * from '../models/Users200Response';
 export * from '../models/VideoDetail200Response';
+export * from '../models/VideoDetail200ResponseSubtitlesInner';
 export * from '../models/Videos200Response';
 export * from '../models/Videos200ResponseCategoriesInner';
+export * from '../models/Videos200ResponseFacets';
+export * from '../models/Videos200ResponseFacetsTagsInner';
 export * from '../models/Videos200ResponsePaginationData';
 export * from '../models/Videos200ResponseVideosInner';
 
@@ -19,8 +22,11 @@ import { GetUnapprovedVideos200ResponseInner } from '../models/GetUnapprovedVide
 import { Recommendations200ResponseInner } from '../models/Recommendations200ResponseInner';
 import { Users200Response } from '../models/Users200Response';
 import { VideoDetail200Response } from '../models/VideoDetail200Response';
+import { VideoDetail200ResponseSubtitlesInner } from '../models/VideoDetail200ResponseSubtitlesInner';
 import { Videos200Response } from '../models/Videos200Response';
 import { Videos200ResponseCategoriesInner } from '../models/Videos200ResponseCategoriesInner';
+import { Videos200ResponseFacets } from '../models/Videos200ResponseFacets';
+import { Videos200ResponseFacetsTagsInner } from '../models/Videos200ResponseFacetsTagsInner';
 import { Videos200ResponsePaginationData } from '../models/Videos200ResponsePaginationData';
 import { Videos200ResponseVideosInner } from '../models/Videos200ResponseVideosInner';
 
@@ -58,8 +64,11 @@ let typeMap: {[index: string]: any} = {
     "Recommendations200ResponseInner": Recommendations200ResponseInner,
     "Users200Response": Users200Response,
     "VideoDetail200Response": VideoDetail200Response,
+    "VideoDetail200ResponseSubtitlesInner": VideoDetail200ResponseSubtitlesInner,
     "Videos200Response": Videos200Response,
     "Videos200ResponseCategoriesInner": Videos200ResponseCategoriesInner,
+    "Videos200ResponseFacets": Videos200ResponseFacets,
+    "Videos200ResponseFacetsTagsInner": Videos200ResponseFacetsTagsInner,
     "Videos200ResponsePaginationData": Videos200ResponsePaginationData,
     "Videos200ResponseVideosInner": Videos200ResponseVideosInner,
 }
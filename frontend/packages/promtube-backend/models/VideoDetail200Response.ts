@@ -10,11 +10,13 @@
  * Do not edit the class manually.
  */
 
+import { VideoDetail200ResponseSubtitlesInner } from '../models/VideoDetail200ResponseSubtitlesInner';
 import { HttpFile } from '../http/http';
 
 export class VideoDetail200Response {
     'title'?: string;
     'mPDLoc'?: string;
+    'hLSLoc'?: string;
     'views'?: number;
     'rating'?: number;
     'videoID'?: number;
@@ -30,6 +32,16 @@ export class VideoDetail200Response {
     'isMature'?: boolean;
     'tags'?: Array<string>;
     'videoDuration'?: number;
+    'subtitles'?: Array<VideoDetail200ResponseSubtitlesInner>;
+    /**
+    * whether the original is still available (online, removed, private, geo_blocked or auth_required), empty if it hasn't been checked
+    */
+    'upstreamStatus'?: string;
+    /**
+    * when the original was first seen with its current status
+    */
+    'upstreamStatusSince'?: string;
+    'upstreamCheckedAt'?: string;
 
     static readonly discriminator: string | undefined = undefined;
 
@@ -46,6 +58,12 @@ export class VideoDetail200Response {
             "type": "string",
             "format": ""
         },
+        {
+            "name": "hLSLoc",
+            "baseName": "HLSLoc",
+            "type": "string",
+            "format": ""
+        },
         {
             "name": "views",
             "baseName": "Views",
@@ -135,6 +153,30 @@ export class VideoDetail200Response {
             "baseName": "VideoDuration",
             "type": "number",
             "format": ""
+        },
+        {
+            "name": "subtitles",
+            "baseName": "Subtitles",
+            "type": "Array<VideoDetail200ResponseSubtitlesInner>",
+            "format": ""
+        },
+        {
+            "name": "upstreamStatus",
+            "baseName": "UpstreamStatus",
+            "type": "string",
+            "format": ""
+        },
+        {
+            "name": "upstreamStatusSince",
+            "baseName": "UpstreamStatusSince",
+            "type": "string",
+            "format": ""
+        },
+        {
+            "name": "upstreamCheckedAt",
+            "baseName": "UpstreamCheckedAt",
+            "type": "string",
+            "format": ""
         }    ];
 
     static getAttributeTypeMap() {
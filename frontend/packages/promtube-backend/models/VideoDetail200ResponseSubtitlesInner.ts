@@ -0,0 +1,79 @@
+/**
+ * Promtube Backend API
+ * The API for Promtube
+ *
+ * OpenAPI spec version: 0.0.1
+ * Contact: horahora1567@gmail.com
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { HttpFile } from '../http/http';
+
+export class VideoDetail200ResponseSubtitlesInner {
+    'ID'?: number;
+    'language'?: string;
+    /**
+    * subtitles, captions or descriptions
+    */
+    'kind'?: string;
+    'label'?: string;
+    /**
+    * the WebVTT file
+    */
+    'location'?: string;
+    /**
+    * whether the track was archived from the original site
+    */
+    'imported'?: boolean;
+
+    static readonly discriminator: string | undefined = undefined;
+
+    static readonly attributeTypeMap: Array<{name: string, baseName: string, type: string, format: string}> = [
+        {
+            "name": "ID",
+            "baseName": "ID",
+            "type": "number",
+            "format": ""
+        },
+        {
+            "name": "language",
+            "baseName": "Language",
+            "type": "string",
+            "format": ""
+        },
+        {
+            "name": "kind",
+            "baseName": "Kind",
+            "type": "string",
+            "format": ""
+        },
+        {
+            "name": "label",
+            "baseName": "Label",
+            "type": "string",
+            "format": ""
+        },
+        {
+            "name": "location",
+            "baseName": "Location",
+            "type": "string",
+            "format": ""
+        },
+        {
+            "name": "imported",
+            "baseName": "Imported",
+            "type": "boolean",
+            "format": ""
+        }    ];
+
+    static getAttributeTypeMap() {
+        return VideoDetail200ResponseSubtitlesInner.attributeTypeMap;
+    }
+
+    public constructor() {
+    }
+}
+
@@ -11,6 +11,7 @@
  */
 
 import { Videos200ResponseCategoriesInner } from '../models/Videos200ResponseCategoriesInner';
+import { Videos200ResponseFacets } from '../models/Videos200ResponseFacets';
 import { Videos200ResponsePaginationData } from '../models/Videos200ResponsePaginationData';
 import { Videos200ResponseVideosInner } from '../models/Videos200ResponseVideosInner';
 import { HttpFile } from '../http/http';
@@ -22,6 +23,7 @@ export class Videos200Response {
     'profilePictureURL'?: string;
     'videos'?: Array<Videos200ResponseVideosInner>;
     'categories'?: Array<Videos200ResponseCategoriesInner>;
+    'facets'?: Videos200ResponseFacets;
 
     static readonly discriminator: string | undefined = undefined;
 
@@ -61,6 +63,12 @@ export class Videos200Response {
             "baseName": "Categories",
             "type": "Array<Videos200ResponseCategoriesInner>",
             "format": ""
+        },
+        {
+            "name": "facets",
+            "baseName": "Facets",
+            "type": "Videos200ResponseFacets",
+            "format": ""
         }    ];
 
     static getAttributeTypeMap() {
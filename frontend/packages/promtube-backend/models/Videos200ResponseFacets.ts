@@ -0,0 +1,60 @@
+/**
+ * Promtube Backend API
+ * The API for Promtube
+ *
+ * OpenAPI spec version: 0.0.1
+ * Contact: horahora1567@gmail.com
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { Videos200ResponseFacetsTagsInner } from '../models/Videos200ResponseFacetsTagsInner';
+import { HttpFile } from '../http/http';
+
+/**
+* counts of the matching videos by the values they have for each filter
+*/
+export class Videos200ResponseFacets {
+    'tags'?: Array<Videos200ResponseFacetsTagsInner>;
+    'durations'?: Array<Videos200ResponseFacetsTagsInner>;
+    'sites'?: Array<Videos200ResponseFacetsTagsInner>;
+    'withDanmaku'?: number;
+
+    static readonly discriminator: string | undefined = undefined;
+
+    static readonly attributeTypeMap: Array<{name: string, baseName: string, type: string, format: string}> = [
+        {
+            "name": "tags",
+            "baseName": "Tags",
+            "type": "Array<Videos200ResponseFacetsTagsInner>",
+            "format": ""
+        },
+        {
+            "name": "durations",
+            "baseName": "Durations",
+            "type": "Array<Videos200ResponseFacetsTagsInner>",
+            "format": ""
+        },
+        {
+            "name": "sites",
+            "baseName": "Sites",
+            "type": "Array<Videos200ResponseFacetsTagsInner>",
+            "format": ""
+        },
+        {
+            "name": "withDanmaku",
+            "baseName": "WithDanmaku",
+            "type": "number",
+            "format": ""
+        }    ];
+
+    static getAttributeTypeMap() {
+        return Videos200ResponseFacets.attributeTypeMap;
+    }
+
+    public constructor() {
+    }
+}
+
@@ -0,0 +1,42 @@
+/**
+ * Promtube Backend API
+ * The API for Promtube
+ *
+ * OpenAPI spec version: 0.0.1
+ * Contact: horahora1567@gmail.com
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { HttpFile } from '../http/http';
+
+export class Videos200ResponseFacetsTagsInner {
+    'value'?: string;
+    'count'?: number;
+
+    static readonly discriminator: string | undefined = undefined;
+
+    static readonly attributeTypeMap: Array<{name: string, baseName: string, type: string, format: string}> = [
+        {
+            "name": "value",
+            "baseName": "Value",
+            "type": "string",
+            "format": ""
+        },
+        {
+            "name": "count",
+            "baseName": "Count",
+            "type": "number",
+            "format": ""
+        }    ];
+
+    static getAttributeTypeMap() {
+        return Videos200ResponseFacetsTagsInner.attributeTypeMap;
+    }
+
+    public constructor() {
+    }
+}
+
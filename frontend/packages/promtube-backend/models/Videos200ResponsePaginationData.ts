@@ -15,6 +15,14 @@ import { HttpFile } from '../http/http';
 export class Videos200ResponsePaginationData {
     'numberOfItems'?: number;
     'currentPage'?: number;
+    /**
+    * cursor to the next page, empty on the last page
+    */
+    'nextCursor'?: string;
+    /**
+    * cursor to the previous page, empty on the first page
+    */
+    'prevCursor'?: string;
 
     static readonly discriminator: string | undefined = undefined;
 
@@ -30,6 +38,18 @@ export class Videos200ResponsePaginationData {
             "baseName": "CurrentPage",
             "type": "number",
             "format": ""
+        },
+        {
+            "name": "nextCursor",
+            "baseName": "NextCursor",
+            "type": "string",
+            "format": ""
+        },
+        {
+            "name": "prevCursor",
+            "baseName": "PrevCursor",
+            "type": "string",
+            "format": ""
         }    ];
 
     static getAttributeTypeMap() {
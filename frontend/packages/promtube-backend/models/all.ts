@@ -6,7 +6,10 @@ export * from '../models/GetUnapprovedVideos200ResponseInner'
 export * from '../models/Recommendations200ResponseInner'
 export * from '../models/Users200Response'
 export * from '../models/VideoDetail200Response'
+export * from '../models/VideoDetail200ResponseSubtitlesInner'
 export * from '../models/Videos200Response'
 export * from '../models/Videos200ResponseCategoriesInner'
+export * from '../models/Videos200ResponseFacets'
+export * from '../models/Videos200ResponseFacetsTagsInner'
 export * from '../models/Videos200ResponsePaginationData'
 export * from '../models/Videos200ResponseVideosInner'
@@ -3,8 +3,9 @@ module github.com/horahoradev/horahora/scheduler
 go 1.20
 
 require (
-	github.com/KIRAKIRA-DOUGA/KIRAKIRA-golang-backend/video_service v0.0.0-20230805223518-014bed829350
+	github.com/horahoradev/PrometheusTube/backend/video_service v0.0.0
 	github.com/caarlos0/env v3.5.0+incompatible
+	github.com/go-redis/redis/v8 v8.11.5
 	github.com/go-redsync/redsync v1.4.2
 	github.com/go-stomp/stomp/v3 v3.0.5
 	github.com/grpc-ecosystem/go-grpc-middleware v1.3.0
@@ -25,6 +26,7 @@ require (
 	github.com/beorn7/perks v1.0.1 // indirect
 	github.com/cespare/xxhash/v2 v2.1.2 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
 	github.com/golang/protobuf v1.5.2 // indirect
 	github.com/gomodule/redigo v2.0.0+incompatible // indirect
 	github.com/hashicorp/errwrap v1.0.0 // indirect
@@ -42,3 +44,6 @@ require (
 	google.golang.org/genproto v0.0.0-20221010155953-15ba04fc1c0e // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+// The video service is built from this repo, so the image has to be built from the project root (see the Dockerfile)
+replace github.com/horahoradev/PrometheusTube/backend/video_service => ../video_service
@@ -0,0 +1,197 @@
+package classifier
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// This package picks a category for archived videos from the metadata we already have for them, according to rules
+// which admins maintain. Each rule that matches a video votes for its category with its weight; the category with the
+// highest priority rule among those which matched wins, and ties are broken by total weight.
+
+// DefaultCategory is given to videos which no rule matches
+const DefaultCategory = "General"
+
+type Field string
+
+// Fields of the video's metadata which rules can match on
+const (
+	// Tags match a tag exactly, ignoring case
+	FieldTag Field = "tag"
+	// Titles are matched against a regular expression, ignoring case
+	FieldTitle Field = "title"
+	// Uploaders match the uploader's name or ID exactly, ignoring case
+	FieldUploader Field = "uploader"
+	// Sites match the host the video was archived from, or any of its subdomains
+	FieldSite Field = "site"
+)
+
+var (
+	ErrInvalidField    = errors.New("invalid rule field")
+	ErrMissingCategory = errors.New("rule has no category")
+	ErrMissingPattern  = errors.New("rule has no pattern")
+	ErrInvalidWeight   = errors.New("rule weight must be positive")
+)
+
+type Rule struct {
+	ID       int64
+	Category string
+	Field    Field
+	Pattern  string
+	Weight   float64
+	// Priority ranks rules above any weight of lower priority rules, e.g. so that a channel which only uploads one kind of
+	// video can outrank its tags
+	Priority int
+}
+
+// Validate checks that the rule can be used
+func (r Rule) Validate() error {
+	switch {
+	case strings.TrimSpace(r.Category) == "":
+		return ErrMissingCategory
+	case strings.TrimSpace(r.Pattern) == "":
+		return ErrMissingPattern
+	case r.Weight <= 0:
+		return ErrInvalidWeight
+	}
+
+	switch r.Field {
+	case FieldTag, FieldUploader, FieldSite:
+		return nil
+	case FieldTitle:
+		_, err := compileTitle(r.Pattern)
+		if err != nil {
+			return fmt.Errorf("invalid title pattern. Err: %s", err)
+		}
+		return nil
+	}
+
+	return ErrInvalidField
+}
+
+func compileTitle(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile("(?i)" + pattern)
+}
+
+// Video is the metadata which rules are matched against
+type Video struct {
+	Title      string
+	Uploader   string
+	UploaderID string
+	// Site is the host the video was archived from, e.g. www.nicovideo.jp
+	Site string
+	Tags []string
+}
+
+type Result struct {
+	Category string
+	// Confidence is the winning category's share of the weight of every rule which matched, from 0 (no rule matched) to 1
+	// (every rule which matched agreed)
+	Confidence float64
+}
+
+type compiledRule struct {
+	Rule
+	pattern string
+	title   *regexp.Regexp
+}
+
+type Classifier struct {
+	rules []compiledRule
+}
+
+// New creates a classifier from the rules. Invalid rules are an error, so that one bad rule can't quietly change how
+// everything is classified.
+func New(rules []Rule) (*Classifier, error) {
+	c := Classifier{rules: make([]compiledRule, 0, len(rules))}
+	for _, r := range rules {
+		err := r.Validate()
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", r.ID, err)
+		}
+
+		cr := compiledRule{Rule: r, pattern: strings.ToLower(strings.TrimSpace(r.Pattern))}
+		if r.Field == FieldTitle {
+			// Validate already compiled it
+			cr.title, _ = compileTitle(r.Pattern)
+		}
+
+		c.rules = append(c.rules, cr)
+	}
+
+	return &c, nil
+}
+
+func (r compiledRule) matches(v Video) bool {
+	switch r.Field {
+	case FieldTag:
+		for _, tag := range v.Tags {
+			if strings.ToLower(strings.TrimSpace(tag)) == r.pattern {
+				return true
+			}
+		}
+	case FieldTitle:
+		return r.title.MatchString(v.Title)
+	case FieldUploader:
+		return strings.ToLower(v.Uploader) == r.pattern || strings.ToLower(v.UploaderID) == r.pattern
+	case FieldSite:
+		site := strings.ToLower(v.Site)
+		return site == r.pattern || strings.HasSuffix(site, "."+r.pattern)
+	}
+
+	return false
+}
+
+type score struct {
+	category string
+	priority int
+	weight   float64
+}
+
+// Classify returns the category the rules pick for the video, or DefaultCategory if none of them match
+func (c *Classifier) Classify(v Video) Result {
+	scores := make(map[string]*score)
+	var total float64
+	for _, r := range c.rules {
+		if !r.matches(v) {
+			continue
+		}
+
+		s, ok := scores[r.Category]
+		if !ok {
+			s = &score{category: r.Category, priority: r.Priority}
+			scores[r.Category] = s
+		}
+
+		if r.Priority > s.priority {
+			s.priority = r.Priority
+		}
+		s.weight += r.Weight
+		total += r.Weight
+	}
+
+	if len(scores) == 0 {
+		return Result{Category: DefaultCategory}
+	}
+
+	ranked := make([]*score, 0, len(scores))
+	for _, s := range scores {
+		ranked = append(ranked, s)
+	}
+
+	sort.Slice(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		switch {
+		case a.priority != b.priority:
+			return a.priority > b.priority
+		case a.weight != b.weight:
+			return a.weight > b.weight
+		}
+		return a.category < b.category
+	})
+
+	return Result{Category: ranked[0].category, Confidence: ranked[0].weight / total}
+}
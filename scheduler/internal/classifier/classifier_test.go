@@ -0,0 +1,67 @@
+package classifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassify(t *testing.T) {
+	c, err := New([]Rule{
+		{ID: 1, Category: "Otomad", Field: FieldTag, Pattern: "音MAD", Weight: 2},
+		{ID: 2, Category: "Music", Field: FieldTag, Pattern: "music", Weight: 1},
+		{ID: 3, Category: "Music", Field: FieldTitle, Pattern: `\bcover\b`, Weight: 1},
+		{ID: 4, Category: "Gaming", Field: FieldUploader, Pattern: "SpeedrunArchive", Weight: 1, Priority: 10},
+		{ID: 5, Category: "Otomad", Field: FieldSite, Pattern: "nicovideo.jp", Weight: 0.5},
+	})
+	assert.NoError(t, err)
+
+	// Tags match whole, not as substrings
+	assert.Equal(t, Result{Category: DefaultCategory}, c.Classify(Video{Tags: []string{"musical"}}))
+
+	res := c.Classify(Video{Title: "Song (Cover)", Tags: []string{"MUSIC"}, Site: "www.youtube.com"})
+	assert.Equal(t, Result{Category: "Music", Confidence: 1}, res)
+
+	// Otomad has 2.5 of the 4.5 weight which matched
+	res = c.Classify(Video{Title: "a cover", Tags: []string{"音MAD", "music"}, Site: "www.nicovideo.jp"})
+	assert.Equal(t, "Otomad", res.Category)
+	assert.InDelta(t, 2.5/4.5, res.Confidence, 0.0001)
+
+	// Priority beats weight
+	res = c.Classify(Video{Title: "any% cover", Tags: []string{"音MAD", "music"}, UploaderID: "speedrunarchive"})
+	assert.Equal(t, "Gaming", res.Category)
+	assert.InDelta(t, 1.0/5, res.Confidence, 0.0001)
+
+	// Sites match subdomains, but not other domains ending the same way
+	assert.Equal(t, "Otomad", c.Classify(Video{Site: "nicovideo.jp"}).Category)
+	assert.Equal(t, DefaultCategory, c.Classify(Video{Site: "notnicovideo.jp"}).Category)
+}
+
+func TestClassifyTies(t *testing.T) {
+	c, err := New([]Rule{
+		{ID: 1, Category: "B", Field: FieldTag, Pattern: "x", Weight: 1},
+		{ID: 2, Category: "A", Field: FieldTag, Pattern: "x", Weight: 1},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, Result{Category: "A", Confidence: 0.5}, c.Classify(Video{Tags: []string{"x"}}))
+}
+
+func TestValidate(t *testing.T) {
+	valid := Rule{Category: "Music", Field: FieldTag, Pattern: "music", Weight: 1}
+	assert.NoError(t, valid.Validate())
+
+	cases := map[string]Rule{
+		"category": {Field: FieldTag, Pattern: "music", Weight: 1},
+		"pattern":  {Category: "Music", Field: FieldTag, Pattern: " ", Weight: 1},
+		"weight":   {Category: "Music", Field: FieldTag, Pattern: "music"},
+		"field":    {Category: "Music", Field: "description", Pattern: "music", Weight: 1},
+		"regexp":   {Category: "Music", Field: FieldTitle, Pattern: "(", Weight: 1},
+	}
+	for name, r := range cases {
+		assert.Error(t, r.Validate(), name)
+	}
+
+	_, err := New([]Rule{valid, cases["regexp"]})
+	assert.Error(t, err)
+}
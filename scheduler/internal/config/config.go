@@ -6,10 +6,12 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
-	proto "github.com/KIRAKIRA-DOUGA/KIRAKIRA-golang-backend/video_service/protocol"
 	"github.com/caarlos0/env"
+	"github.com/go-redis/redis/v8"
 	"github.com/go-redsync/redsync"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
+	proto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/horahoradev/horahora/scheduler/internal/ratelimit"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
 	"google.golang.org/grpc"
@@ -23,19 +25,41 @@ type PostgresInfo struct {
 	Db       string `env:"pgs_db,required"`
 }
 
+type RedisInfo struct {
+	Hostname string `env:"redis_host,required"`
+	Port     int    `env:"redis_port,required"`
+	Password string `env:"redis_pass"`
+}
+
 type config struct {
 	PostgresInfo
+	RedisInfo
+	RedisConn               *redis.Client
 	Redlock                 *redsync.Redsync
 	VideoOutputLoc          string
 	VideoServiceGRPCAddress string `env:"VideoServiceGRPCAddress,required"`
 	NumberOfRetries         int    `env:"NumberOfRetries,required"`
 	Conn                    *sqlx.DB
+	ConnStr                 string
 	GRPCConn                *grpc.ClientConn
 	Client                  proto.VideoServiceClient
 	SocksConnStr            string        `env:"SocksConn,required"`
 	SyncPollDelay           time.Duration `env:"SyncPollDelay,required"`
 	MaxFS                   uint64        `env:"MaxDLFileSize,required"`
 	AcceptLanguage          string        `env:"AcceptLanguage"`
+	JobLeaseDuration        time.Duration `env:"JobLeaseDuration" envDefault:"2m"`
+	NumDownloaders          int           `env:"NumDownloaders" envDefault:"7"`
+	// Longest time to go without syncing a category of content, however stagnant it is
+	MaxSyncInterval time.Duration `env:"MaxSyncInterval" envDefault:"192h"`
+	// Per-site politeness, see ratelimit.ParseSiteLimits. The * entry applies to sites without their own.
+	SiteLimitsSpec string `env:"SiteLimits" envDefault:"*:rpm=30,concurrency=3,cooldown=10m;nicovideo.jp:rpm=10,concurrency=2,cooldown=15m;bilibili.com:rpm=10,concurrency=2,cooldown=15m"`
+	SiteLimits     map[string]ratelimit.Limits
+	// How often the originals of archived videos are checked, 0 to not check them
+	UpstreamCheckInterval time.Duration `env:"UpstreamCheckInterval" envDefault:"336h"`
+	// How often originals which have gone are checked, in case they come back
+	UpstreamLostCheckInterval time.Duration `env:"UpstreamLostCheckInterval" envDefault:"720h"`
+	// Pause between upstream checks, on top of the site limits
+	UpstreamCheckDelay time.Duration `env:"UpstreamCheckDelay" envDefault:"30s"`
 }
 
 func New() (*config, error) {
@@ -45,16 +69,37 @@ func New() (*config, error) {
 		return nil, err
 	}
 
+	err = env.Parse(&config.RedisInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	config.RedisConn = redis.NewClient(&redis.Options{
+		Addr:     fmt.Sprintf("%v:%v", config.RedisInfo.Hostname, config.RedisInfo.Port),
+		Password: config.RedisInfo.Password,
+		DB:       0,
+	})
+
 	err = env.Parse(&config)
 	if err != nil {
 		return nil, err
 	}
 	config.VideoOutputLoc = "/tmp"
 
+	if config.NumDownloaders < 1 {
+		return nil, fmt.Errorf("NumDownloaders must be at least 1")
+	}
+
+	config.SiteLimits, err = ratelimit.ParseSiteLimits(config.SiteLimitsSpec)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse site limits. Err: %s", err)
+	}
+
 	// I'm putting this here because it makes it easier to do integration tests
 	// https://www.calhoun.io/connecting-to-a-postgresql-database-with-gos-database-sql-package/
-	config.Conn, err = sqlx.Connect("postgres", fmt.Sprintf("host=%s user=%s password=%s dbname=%s sslmode=disable connect_timeout=180",
-		config.PostgresInfo.Hostname, config.PostgresInfo.Username, config.PostgresInfo.Password, config.PostgresInfo.Db))
+	config.ConnStr = fmt.Sprintf("host=%s user=%s password=%s dbname=%s sslmode=disable connect_timeout=180",
+		config.PostgresInfo.Hostname, config.PostgresInfo.Username, config.PostgresInfo.Password, config.PostgresInfo.Db)
+	config.Conn, err = sqlx.Connect("postgres", config.ConnStr)
 	if err != nil {
 		log.Fatalf("Could not connect to postgres. Err: %s", err)
 	}
@@ -2,51 +2,54 @@ package downloader
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math/rand"
 	"os"
-	"os/exec"
-	"path/filepath"
 	"strings"
-	"sync"
 	"time"
 
-	videoproto "github.com/KIRAKIRA-DOUGA/KIRAKIRA-golang-backend/video_service/protocol"
+	"github.com/horahoradev/PrometheusTube/backend/video_service/danmaku"
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/horahoradev/horahora/scheduler/internal/classifier"
+	"github.com/horahoradev/horahora/scheduler/internal/extractor"
+	"github.com/horahoradev/horahora/scheduler/internal/failure"
 	"github.com/horahoradev/horahora/scheduler/internal/models"
+	"github.com/horahoradev/horahora/scheduler/internal/ratelimit"
 	log "github.com/sirupsen/logrus"
 )
 
 type downloader struct {
-	downloadQueue   chan *models.VideoDLRequest
-	outputLoc       string
-	videoClient     videoproto.VideoServiceClient
-	numberOfRetries int
-	socksConnStr    string
-	maxFS           uint64
-	acceptLanguage  string
+	jobs        *models.JobQueue
+	outputLoc   string
+	videoClient videoproto.VideoServiceClient
+	policies    map[failure.Class]failure.Policy
+	extractors  *extractor.Registry
+	limiter     *ratelimit.Limiter
+	pausedUntil time.Time // set when the daily upload limit is hit
 }
 
-func New(dlQueue chan *models.VideoDLRequest, outputLoc string, client videoproto.VideoServiceClient, numberOfRetries int,
-	socksConnStr string, maxFS uint64, acceptLanguage string) downloader {
+// How long to wait before checking the job queue again if it was empty
+const claimPollDelay = time.Second * 5
+
+func New(jobs *models.JobQueue, outputLoc string, client videoproto.VideoServiceClient, policies map[failure.Class]failure.Policy,
+	extractors *extractor.Registry, limiter *ratelimit.Limiter) downloader {
 	return downloader{
-		downloadQueue:   dlQueue,
-		outputLoc:       outputLoc,
-		videoClient:     client,
-		numberOfRetries: numberOfRetries,
-		socksConnStr:    socksConnStr,
-		maxFS:           maxFS,
-		acceptLanguage:  acceptLanguage,
+		jobs:        jobs,
+		outputLoc:   outputLoc,
+		videoClient: client,
+		policies:    policies,
+		extractors:  extractors,
+		limiter:     limiter,
 	}
 }
 
-// SubscribeAndDownload reads from the download queue
-// FIXME: should provide slightly better graceful shutdown behavior here
-func (d *downloader) SubscribeAndDownload(ctx context.Context, m *sync.Mutex) error {
-	// This is pretty awkward, but guarantees a return on the next iteration,
-	// and guarantees that the function will return if it was waiting on a download
-	// request.
+// SubscribeAndDownload claims jobs from the download queue until the context is canceled.
+// The lease on a job is kept alive while it's being worked on; if this process dies, the job will be picked up by another worker
+// once the lease expires.
+func (d *downloader) SubscribeAndDownload(ctx context.Context) error {
 	for {
 		select {
 		case <-ctx.Done():
@@ -55,33 +58,105 @@ func (d *downloader) SubscribeAndDownload(ctx context.Context, m *sync.Mutex) er
 		default:
 		}
 
+		job, err := d.jobs.Claim()
+		if err != nil {
+			log.Errorf("Could not claim download job. Err: %s", err)
+		}
+
+		if job == nil {
+			select {
+			case <-ctx.Done():
+				log.Info("Context done, downloader returning")
+				return nil
+			case <-time.After(claimPollDelay):
+			}
+			continue
+		}
+
+		d.processJob(ctx, job)
+	}
+}
+
+func (d *downloader) processJob(ctx context.Context, job *models.VideoDLRequest) {
+	if job.Attempts > models.MAX_JOB_ATTEMPTS {
+		log.Errorf("Job %d for video %s has been claimed %d times, giving up", job.JobID, job.VideoID, job.Attempts)
+		reason := "the download was abandoned by its worker too many times"
+		err := job.SetDownloadFailed(string(failure.Unknown), false, reason)
+		if err != nil {
+			log.Errorf("Could not set download failed for video %s. Err: %s", job.VideoID, err)
+		}
+
+		err = job.RecordFailure(string(failure.Unknown), reason)
+		if err != nil {
+			log.Errorf("Could not record error event. Err: %s", err)
+		}
+
+		d.completeJob(job)
+		return
+	}
+
+	stopHeartbeat := d.jobs.KeepAlive(job)
+	requeueAfter, requeue := d.downloadVideoReq(ctx, job)
+	stopHeartbeat()
+
+	if !requeue {
+		d.completeJob(job)
+		return
+	}
+
+	err := d.jobs.Release(job, requeueAfter)
+	if err != nil {
+		log.Errorf("Could not release job %d for video %s. Err: %s", job.JobID, job.VideoID, err)
+	}
+
+	if time.Now().Before(d.pausedUntil) {
 		select {
 		case <-ctx.Done():
-			log.Info("Context done, downloader returning")
-			return nil
-		case r := <-d.downloadQueue:
-			err := d.downloadVideoReq(ctx, r, m)
-			if err != nil {
-				log.Errorf("Encountered error in downloader. Err: %s. Continuing...", err)
-				// FIXME: increase robustness
-				//return err
-			}
+		case <-time.After(time.Until(d.pausedUntil)):
 		}
 	}
 }
 
-// Deals with a particular video download request
-func (d *downloader) downloadVideoReq(ctx context.Context, video *models.VideoDLRequest, m *sync.Mutex) error {
-	if strings.HasPrefix(video.VideoID, "so") {
-		err := video.SetDownloadFailed()
+// How often progress events are published for a single download
+const progressPublishInterval = time.Second * 2
+
+// progressPublisher returns a progress callback which publishes progress events for the video, at most once per interval
+func progressPublisher(video *models.VideoDLRequest) func(float64) {
+	var lastPublished time.Time
+	lastPercent := -1.0
+
+	return func(percent float64) {
+		if percent == lastPercent || (percent < 100 && time.Since(lastPublished) < progressPublishInterval) {
+			return
+		}
+
+		lastPublished = time.Now()
+		lastPercent = percent
+
+		err := video.PublishEvent(models.EventProgress, percent, "")
 		if err != nil {
-			log.Errorf("Could not set download failed for video %s. Err: %s", video.VideoID, err)
+			log.Errorf("Could not publish progress for video %s. Err: %s", video.VideoID, err)
 		}
-		log.Info("Video VideoID has the bad prefix so, skipping...")
-		return nil
 	}
+}
+
+func (d *downloader) completeJob(job *models.VideoDLRequest) {
+	err := d.jobs.Complete(job)
+	if err != nil {
+		log.Errorf("Could not complete job %d for video %s. Err: %s", job.JobID, job.VideoID, err)
+	}
+}
 
-	err := video.SetDownloadInProgress()
+// Deals with a particular video download request.
+// Returns whether the job should be handed back to the queue rather than completed, and after how long.
+func (d *downloader) downloadVideoReq(ctx context.Context, video *models.VideoDLRequest) (time.Duration, bool) {
+	ext, err := d.extractors.ForURL(video.URL)
+	if err != nil {
+		log.Errorf("Could not find an extractor for %s. Err: %s", video.URL, err)
+		return 0, false
+	}
+
+	err = video.SetDownloadInProgress()
 	if err != nil {
 		log.Errorf("Failed to set download in progress: %v", err)
 	}
@@ -89,7 +164,7 @@ func (d *downloader) downloadVideoReq(ctx context.Context, video *models.VideoDL
 	website, err := models.GetWebsiteFromURL(video.URL)
 	if err != nil {
 		log.Errorf("Failed to extract website domain from %s", video.URL)
-		return nil
+		return 0, false
 	}
 
 	videoReq := videoproto.ForeignVideoCheck{
@@ -101,7 +176,8 @@ func (d *downloader) downloadVideoReq(ctx context.Context, video *models.VideoDL
 	if err != nil {
 		err := fmt.Errorf("could not check whether video exists for video VideoID %s. Err: %s", video.VideoID, err)
 		log.Error(err)
-		return nil
+		// Videoservice is probably down, try again later
+		return time.Minute, true
 	}
 
 	if videoExists.Exists {
@@ -110,171 +186,144 @@ func (d *downloader) downloadVideoReq(ctx context.Context, video *models.VideoDL
 		if err != nil {
 			log.Errorf("Could not set download succeeded for video %s. Err: %s", video.VideoID, err)
 		}
-		return nil
-	}
-
-	// LOL
-	// there's a race condition in which ffprobe can stall indefinitely if its cookies are invalidated before its stream metadata
-	// request succeeds. This is my attempt to lower the likelihood of this issue occurring.
-	// FIXME TODO
-	m.Lock()
-	go func() {
-		time.Sleep(time.Second * 10)
-		m.Unlock()
-	}()
-
-	// The + 10 is just a precaution against channel blockages in case i've overlooked something
-	errCh := make(chan error, d.numberOfRetries+10)
-currVideoLoop:
-	for currentRetryNum := 1; currentRetryNum <= d.numberOfRetries+1; currentRetryNum++ {
-		select {
-		case <-ctx.Done():
-			log.Infof("Context done, returning from download request loop for parent url", video.ParentURL)
-			return nil
-		default:
+		return 0, false
+	}
+
+	select {
+	case <-ctx.Done():
+		log.Infof("Context done, returning from download request for parent url %s", video.ParentURL)
+		// Hand the job back so that another worker can pick it up immediately
+		return 0, true
+	default:
+	}
+
+	if video.Failures > 0 {
+		log.Infof("Attempting to download %s, %d previous failures", video.URL, video.Failures)
+	}
+
+	// Each attempt counts against the site's limits
+	slot, err := d.limiter.Acquire(ctx, website)
+	var waitErr *ratelimit.WaitError
+	switch {
+	case ctx.Err() != nil:
+		return 0, true
+	case errors.As(err, &waitErr):
+		// Let the job go rather than sitting on it, the worker can download from another site in the meantime
+		log.Infof("Handing back video %s. Err: %s", video.VideoID, err)
+		return waitErr.RetryAfter, true
+	case err != nil:
+		log.Errorf("Could not check rate limits for video %s. Err: %s", video.VideoID, err)
+		return time.Minute, true
+	}
+
+	res, err := ext.Download(context.Background(), extractor.DownloadRequest{
+		URL:            video.URL,
+		VideoID:        video.VideoID,
+		OutputDir:      d.outputLoc,
+		OnProgress:     progressPublisher(video),
+		BytesPerSecond: slot.BytesPerSecond,
+	})
+	slot.Release()
+
+	if failure.Classify(err) == failure.RateLimited {
+		coolErr := d.limiter.CoolDown(ctx, website)
+		if coolErr != nil {
+			log.Errorf("Could not start cooldown for %s. Err: %s", website, coolErr)
 		}
+	}
 
-		switch {
-		case currentRetryNum == d.numberOfRetries+1:
-			log.Errorf("Failed to download %s in %d attempts", video.URL, d.numberOfRetries)
-			err := video.SetDownloadFailed()
-			if err != nil {
-				log.Errorf("Could not set download failed for video %s. Err: %s", video.VideoID, err)
-			}
-
-			close(errCh)
-			err = <-errCh // just get the last error for now, TODO
-			err = video.RecordEvent(models.Error, err.Error())
-			if err != nil {
-				log.Errorf("Could not record error event. Err: %s", err)
-			}
-
-			break currVideoLoop
-		case currentRetryNum > 1:
-			log.Infof("Attempting to download %s, attempt %d of %d", video.URL, currentRetryNum, d.numberOfRetries)
-		}
+	if err == nil {
+		log.Infof("Download succeeded for video %s", video.VideoID)
 
-		metafile, metadata, err := d.downloadVideo(video)
+		// Background is used here to try to ensure that the service will deal with whatever it's currently
+		// downloading before shutting down.
+		err = d.uploadToVideoService(context.Background(), video, res)
 		if err == nil {
-			log.Infof("Download succeeded for video %s", video.VideoID)
-
-			// Background is used here to try to ensure that the service will deal with whatever it's currently
-			// downloading before shutting down.
-			err = d.uploadToVideoService(context.Background(), metadata, video, metafile)
-			if err != nil {
-				errCh <- err
-				log.Infof("failed to upload to video service. Err: %s. Continuing...", err)
-				continue
-			}
-
 			err = video.SetDownloadSucceeded()
 			if err != nil {
-				errCh <- err
 				log.Errorf("Could not set download succeeded for video %s. Err: %s", video.VideoID, err)
 			}
+			return 0, false
+		}
 
-			//err := video.SetDownloaded()
-			//
-			//// TODO: handle better? retry?
-			//if err != nil {
-			//	log.Errorf("Could not set latest video. Err: %s", err)
-			//}
+		err = fmt.Errorf("failed to upload to video service. Err: %s", err)
+	}
 
-			break
+	if strings.Contains(err.Error(), "the daily upload limit has been exceeded") {
+		// sleep until next day
+		today, err := time.Parse("01-02-2006", time.Now().Format("01-02-2006"))
+		if err != nil {
+			log.Errorf("Received time parse error: %v", err)
+			return 0, false
 		}
-		if err.Error() == "the daily upload limit has been exceeded" {
-			// sleep until next day
-			today, err := time.Parse("01-02-2006", time.Now().Format("01-02-2006"))
-			if err != nil {
-				log.Errorf("Received time parse error: %v", err)
-				return err
-			}
 
-			nextDay := today.Add(time.Hour * 24)
+		nextDay := today.Add(time.Hour * 24)
 
-			log.Infof("Received error on daily upload limit, sleeping until %v", nextDay)
-			time.Sleep(time.Until(nextDay))
-		}
-		// Just keep trying to download until we succeed
-		// TODO: check for specific errors indicating we should skip to the next entry
-		errCh <- err
-		log.Errorf("Failed to download video %s. Err: %s", video.VideoID, err)
+		// Don't hold onto the job while sleeping
+		log.Infof("Received error on daily upload limit, sleeping until %v", nextDay)
+		d.pausedUntil = nextDay
+		return time.Until(nextDay), true
 	}
-	return nil
-}
 
-func (d *downloader) downloadVideo(video *models.VideoDLRequest) (*os.File, *YTDLMetadata, error) {
-	log.Infof("Downloading %v+", video)
+	return d.handleFailure(video, err)
+}
 
-	args, err := d.getVideoDownloadArgs(video)
-	if err != nil {
-		return nil, nil, err
+// handleFailure records a failed attempt at downloading the video, and decides whether to try again (and when) based
+// on the class of the failure. Backoff is based on the number of failures of that class in a row recorded on the job,
+// so it carries over between workers and restarts, and one class doesn't use up another's retries.
+func (d *downloader) handleFailure(video *models.VideoDLRequest, err error) (time.Duration, bool) {
+	class := failure.Classify(err)
+	policy, ok := d.policies[class]
+	if !ok {
+		policy = d.policies[failure.Unknown]
 	}
 
-	ytdlLog, err := os.Create(fmt.Sprintf("%s/%s.ytdl", d.outputLoc, video.VideoID))
-	if err != nil {
-		return nil, nil, err
+	failures := video.FailuresAfter(string(class))
+	recordErr := d.jobs.RecordFailure(video, string(class), err.Error())
+	if recordErr != nil {
+		log.Errorf("Could not record failure for video %s. Err: %s", video.VideoID, recordErr)
 	}
-	defer ytdlLog.Close()
 
-	ctxTimeout, _ := context.WithTimeout(context.Background(), time.Second*900)
-
-	cmd := exec.CommandContext(ctxTimeout, args[0], args[1:]...)
-	cmd.Stdout = ytdlLog
-	cmd.Stderr = ytdlLog
-
-	err = cmd.Run()
-	if err != nil {
-		log.Errorf("Command %s failed with %s.", cmd, err)
-		return nil, nil, err
+	if policy.ShouldRetry(failures) {
+		delay := policy.Delay(failures, rand.Float64())
+		log.Infof("Failed to download video %s (%s, %d failures), retrying in %s. Err: %s", video.VideoID, class, failures, delay, err)
+		return delay, true
 	}
 
-	file, err := os.Open(fmt.Sprintf("%s/%s.info.json", d.outputLoc, video.VideoID))
-	if err != nil {
-		return nil, nil, err
+	if policy.Permanent {
+		log.Errorf("Failed to download video %s (%s), not retrying. Err: %s", video.VideoID, class, err)
+	} else {
+		log.Errorf("Failed to download video %s (%s) in %d attempts. Err: %s", video.VideoID, class, failures, err)
 	}
 
-	buf, err := ioutil.ReadAll(file)
-	if err != nil {
-		return nil, nil, err
+	setErr := video.SetDownloadFailed(string(class), policy.Permanent, err.Error())
+	if setErr != nil {
+		log.Errorf("Could not set download failed for video %s. Err: %s", video.VideoID, setErr)
 	}
 
-	metadata := YTDLMetadata{}
-
-	err = json.Unmarshal(buf, &metadata)
-	if err != nil {
-		return nil, nil, err
+	recordErr = video.RecordFailure(string(class), err.Error())
+	if recordErr != nil {
+		log.Errorf("Could not record error event. Err: %s", recordErr)
 	}
 
-	return file, &metadata, nil
+	return 0, false
 }
 
 // FIXME: this function is quite long and complicated
-func (d *downloader) uploadToVideoService(ctx context.Context, metadata *YTDLMetadata, video *models.VideoDLRequest, metafile *os.File) error {
+func (d *downloader) uploadToVideoService(ctx context.Context, video *models.VideoDLRequest, res *extractor.DownloadResult) error {
+	metadata := res.Metadata
+
 	stream, err := d.videoClient.UploadVideo(ctx)
 	if err != nil {
 		return fmt.Errorf("could not start video upload stream. Err: %s", err)
 	}
 
-	var (
-		videoExts = []string{"mp4", "webm", "flv", "mkv"}
-		thumbExts = []string{"png", "webp", "jpg"}
-	)
-
-	// FIXME: extend to multiple file extensions, this is bad, not DRY
-	// I could use walk but I don't want to. I will fix this eventually!
-	generatedVideoFiles, err := globWithExtensions(fmt.Sprintf("%s/*%s", d.outputLoc, video.VideoID), videoExts)
-	if err != nil {
-		return err
-	}
-
-	// TODO: fix for other extensions?? this is dumb
-	generatedThumbnailFiles, err := globWithExtensions(fmt.Sprintf("%s/*%s", d.outputLoc, video.VideoID), thumbExts)
+	metafile, err := os.Open(res.MetadataPath)
 	if err != nil {
-		return err
+		return fmt.Errorf("could not open metadata. Err: %s", err)
 	}
 
-	thumb, err := os.Open(generatedThumbnailFiles[0])
+	thumb, err := os.Open(res.ThumbnailPath)
 	if err != nil {
 		return fmt.Errorf("could not open thumbnail. Err: %s", err)
 	}
@@ -296,21 +345,35 @@ func (d *downloader) uploadToVideoService(ctx context.Context, metadata *YTDLMet
 		log.Errorf("failed to extract website from url: %s", video.URL)
 	}
 
+	classification, err := video.Classify(classifier.Video{
+		Title:      metadata.Title,
+		Uploader:   metadata.Uploader,
+		UploaderID: metadata.UploaderID,
+		Site:       website,
+		Tags:       metadata.Tags,
+	})
+	if err != nil {
+		// Not worth failing the upload over, the video is classified again once the rules change
+		log.Errorf("Could not classify video %s. Err: %s", video.VideoID, err)
+		classification.Category = classifier.DefaultCategory
+	}
+
 	// Send metadata
 	// REFACTOR TODO
 	metaPayload := videoproto.InputVideoChunk{
 		Payload: &videoproto.InputVideoChunk_Meta{
 			Meta: &videoproto.InputFileMetadata{
-				Title:             metadata.Title,
-				Description:       metadata.Description,
-				AuthorUID:         metadata.UploaderID,
-				OriginalVideoLink: video.URL,
-				AuthorUsername:    metadata.Uploader,
-				OriginalSite:      website,
-				OriginalID:        metadata.ID,
-				Tags:              metadata.Tags,
-				Thumbnail:         thumbnailContents, // nothing to see here...
-				Category:          "Otomad",
+				Title:              metadata.Title,
+				Description:        metadata.Description,
+				AuthorUID:          metadata.UploaderID,
+				OriginalVideoLink:  video.URL,
+				AuthorUsername:     metadata.Uploader,
+				OriginalSite:       website,
+				OriginalID:         metadata.ID,
+				Tags:               metadata.Tags,
+				Thumbnail:          thumbnailContents, // nothing to see here...
+				Category:           classification.Category,
+				OriginalUploadDate: metadata.OriginalUploadDate(),
 			},
 		},
 	}
@@ -320,7 +383,7 @@ func (d *downloader) uploadToVideoService(ctx context.Context, metadata *YTDLMet
 		return fmt.Errorf("could not send metadata. Err: %s", err)
 	}
 
-	file, err := os.Open(generatedVideoFiles[0])
+	file, err := os.Open(res.VideoPath)
 	if err != nil {
 		return fmt.Errorf("could not open globbed file. Err: %s", err)
 	}
@@ -341,6 +404,24 @@ func (d *downloader) uploadToVideoService(ctx context.Context, metadata *YTDLMet
 		return fmt.Errorf("failed to send video raw metadata. Err: %s", err)
 	}
 
+	if res.DanmakuPath != "" {
+		defer os.Remove(res.DanmakuPath)
+
+		err = sendDanmaku(res.DanmakuPath, stream)
+		if err != nil {
+			return fmt.Errorf("failed to send danmaku. Err: %s", err)
+		}
+	}
+
+	for _, sub := range res.Subtitles {
+		defer os.Remove(sub.Path)
+	}
+
+	err = sendSubtitles(res.Subtitles, stream)
+	if err != nil {
+		return fmt.Errorf("failed to send subtitles. Err: %s", err)
+	}
+
 	resp, err := stream.CloseAndRecv()
 	if err != nil {
 		return fmt.Errorf("received error after closing stream: %s", err)
@@ -355,6 +436,81 @@ func (d *downloader) uploadToVideoService(ctx context.Context, metadata *YTDLMet
 	return nil
 }
 
+// danmakuBatchSize is how many danmaku are sent per message, to stay well under gRPC's message size limit
+const danmakuBatchSize = 1000
+
+// sendDanmaku sends the danmaku archived from the original site. Danmaku which can't be parsed are skipped rather than
+// failing the whole upload, since the video itself is fine.
+func sendDanmaku(path string, stream videoproto.VideoService_UploadVideoClient) error {
+	comments, err := danmaku.ParseFile(path)
+	if err != nil {
+		log.Errorf("Could not parse danmaku from %s, skipping. Err: %s", path, err)
+		return nil
+	}
+
+	for len(comments) > 0 {
+		n := len(comments)
+		if n > danmakuBatchSize {
+			n = danmakuBatchSize
+		}
+
+		batch := videoproto.DanmakuList{Comments: make([]*videoproto.Danmaku, 0, n)}
+		for _, c := range comments[:n] {
+			dn := c.ToProto()
+			dn.Imported = true
+			batch.Comments = append(batch.Comments, dn)
+		}
+		comments = comments[n:]
+
+		err = stream.Send(&videoproto.InputVideoChunk{
+			Payload: &videoproto.InputVideoChunk_Danmaku{Danmaku: &batch},
+		})
+		switch {
+		case err == io.EOF:
+			return fmt.Errorf("videoservice closed stream prematurely")
+		case err != nil:
+			return fmt.Errorf("could not send to videoservice. Err: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// maxSubtitleSize is the largest subtitle file which is sent, to stay under gRPC's message size limit
+const maxSubtitleSize = 2 * 1024 * 1024
+
+// sendSubtitles sends the subtitle tracks archived from the original site, one per message. Video service converts them
+// to WebVTT.
+func sendSubtitles(subs []extractor.SubtitleFile, stream videoproto.VideoService_UploadVideoClient) error {
+	for _, sub := range subs {
+		data, err := os.ReadFile(sub.Path)
+		if err != nil {
+			log.Errorf("Could not read subtitles from %s, skipping. Err: %s", sub.Path, err)
+			continue
+		}
+
+		if len(data) > maxSubtitleSize {
+			log.Errorf("Subtitles at %s are over %d bytes, skipping", sub.Path, maxSubtitleSize)
+			continue
+		}
+
+		err = stream.Send(&videoproto.InputVideoChunk{
+			Payload: &videoproto.InputVideoChunk_Subtitle{Subtitle: &videoproto.SubtitleUpload{
+				Language: sub.Language,
+				Data:     data,
+			}},
+		})
+		switch {
+		case err == io.EOF:
+			return fmt.Errorf("videoservice closed stream prematurely")
+		case err != nil:
+			return fmt.Errorf("could not send to videoservice. Err: %s", err)
+		}
+	}
+
+	return nil
+}
+
 func sendLoop(file *os.File, stream videoproto.VideoService_UploadVideoClient, isMeta bool) error {
 	_, err := file.Seek(0, 0)
 	if err != nil {
@@ -410,259 +566,3 @@ loop:
 
 	return nil
 }
-
-func (d *downloader) getVideoDownloadArgs(video *models.VideoDLRequest) ([]string, error) {
-	acceptLanguageString := "Accept-Language:en"
-	if d.acceptLanguage != "" {
-		acceptLanguageString = fmt.Sprintf("Accept-Language:%s", d.acceptLanguage)
-	}
-	bin := "yt-dlp"
-	args := []string{
-		bin,
-		video.URL,
-		"--write-info-json", // I'd like to use -j, but doesn't seem to work for some videos
-		"--write-thumbnail",
-		// This line was originally authored by Soichiro
-		// according to him, it was licensed under the
-		// "Do What The Fuck You Want license", for which
-		// usage is permitted as long as the name is changed
-		// Thank you for your work!
-		"-S",
-		"res,hdr,fps,vcodec:av01:h265:vp9.2:vp9:h264,vbr",
-		"--add-header",
-		"Accept:*/*",
-		// "Why do we need this?"
-		// Previously ffprobe would stall indefinitely if nico's cookies were invalidated by the time it made a request
-		// (or something like that).
-		"--add-header",
-		acceptLanguageString,
-		"--socket-timeout",
-		"1800",
-		"--verbose",
-		"-o",
-		// Some websites have two IDs per video, so I made it explicit just to avoid issues
-		fmt.Sprintf("%s/%s.%s", d.outputLoc, video.VideoID, "%(ext)s"),
-	}
-
-	if d.maxFS != 0 {
-		args = append(args, []string{"--max-filesize", fmt.Sprintf("%dm", d.maxFS)}...)
-	}
-
-	// FIXME: This is dumb
-	// youtube doesn't support get-comments
-	if !strings.HasPrefix(video.URL, "https://www.youtube.com") {
-		args = append(args, "--get-comments")
-
-	}
-
-	if d.socksConnStr != "" {
-		args = append(args, []string{"--proxy", d.socksConnStr}...)
-	}
-
-	return args, nil
-}
-
-func globWithExtensions(basepath string, extensions []string) ([]string, error) {
-	var ret []string
-	for _, ext := range extensions {
-		path := fmt.Sprintf("%s.%s*", basepath, ext)
-		g, err := filepath.Glob(path)
-		if err != nil {
-			return nil, err
-		}
-
-		ret = append(ret, g...)
-	}
-
-	if len(ret) != 1 {
-		return nil, fmt.Errorf("incorrect match length for extensions %s, length: %d, contents: %s", extensions, len(ret), ret)
-	}
-
-	return ret, nil
-}
-
-type YTDLMetadata struct {
-	ID      string `json:"id"`
-	Title   string `json:"title"`
-	Formats []struct {
-		URL         string  `json:"url"`
-		Ext         string  `json:"ext"`
-		FormatID    string  `json:"format_id"`
-		FormatNote  string  `json:"format_note,omitempty"`
-		Container   string  `json:"container,omitempty"`
-		Quality     float64 `json:"quality"`
-		Filesize    int     `json:"filesize,omitempty"`
-		Format      string  `json:"format"`
-		Protocol    string  `json:"protocol"`
-		HTTPHeaders struct {
-			UserAgent      string `json:"User-Agent"`
-			AcceptCharset  string `json:"Accept-Charset"`
-			Accept         string `json:"Accept"`
-			AcceptEncoding string `json:"Accept-Encoding"`
-			AcceptLanguage string `json:"Accept-Language"`
-			Cookie         string `json:"Cookie"`
-		} `json:"http_headers"`
-		Abr    float64 `json:"abr,omitempty"`
-		Vbr    float64 `json:"vbr,omitempty"`
-		Height int     `json:"height,omitempty"`
-		Width  int     `json:"width,omitempty"`
-		Tbr    float64 `json:"tbr,omitempty"`
-	} `json:"formats"`
-	Thumbnails []struct {
-		URL string `json:"url"`
-		Ext string `json:"ext"`
-		ID  string `json:"id"`
-	} `json:"thumbnails"`
-	Description  string   `json:"description"`
-	Uploader     string   `json:"uploader"`
-	Timestamp    int      `json:"timestamp"`
-	UploaderID   string   `json:"uploader_id"`
-	ChannelID    string   `json:"channel_id"`
-	ViewCount    int      `json:"view_count"`
-	Tags         []string `json:"tags"`
-	Genre        string   `json:"genre"`
-	CommentCount int      `json:"comment_count"`
-	RawComments  struct {
-		En []struct {
-			Ping struct {
-				Content string `json:"content"`
-			} `json:"ping,omitempty"`
-			Thread struct {
-				Resultcode    int    `json:"resultcode"`
-				Thread        string `json:"thread"`
-				ServerTime    int    `json:"server_time"`
-				LastRes       int    `json:"last_res"`
-				Ticket        string `json:"ticket"`
-				Revision      int    `json:"revision"`
-				ClickRevision int    `json:"click_revision"`
-			} `json:"thread,omitempty"`
-			Leaf struct {
-				Thread string `json:"thread"`
-				Count  int    `json:"count"`
-			} `json:"leaf,omitempty"`
-			Chat struct {
-				Thread    string `json:"thread"`
-				Language  int    `json:"language"`
-				No        int    `json:"no"`
-				Vpos      int    `json:"vpos"`
-				Date      int    `json:"date"`
-				Premium   int    `json:"premium"`
-				Anonymity int    `json:"anonymity"`
-				UserID    string `json:"user_id"`
-				Mail      string `json:"mail"`
-				Content   string `json:"content"`
-			} `json:"chat,omitempty"`
-		} `json:"en"`
-		Jp []struct {
-			Ping struct {
-				Content string `json:"content"`
-			} `json:"ping,omitempty"`
-			Thread struct {
-				Resultcode    int    `json:"resultcode"`
-				Thread        string `json:"thread"`
-				ServerTime    int    `json:"server_time"`
-				LastRes       int    `json:"last_res"`
-				Ticket        string `json:"ticket"`
-				Revision      int    `json:"revision"`
-				ClickRevision int    `json:"click_revision"`
-			} `json:"thread,omitempty"`
-			Leaf struct {
-				Thread string `json:"thread"`
-				Count  int    `json:"count"`
-			} `json:"leaf,omitempty"`
-			Chat struct {
-				Thread    string `json:"thread"`
-				No        int    `json:"no"`
-				Vpos      int    `json:"vpos"`
-				Leaf      int    `json:"leaf"`
-				Date      int    `json:"date"`
-				Anonymity int    `json:"anonymity"`
-				UserID    string `json:"user_id"`
-				Mail      string `json:"mail"`
-				Content   string `json:"content"`
-			} `json:"chat,omitempty"`
-		} `json:"jp"`
-		Cn []struct {
-			Ping struct {
-				Content string `json:"content"`
-			} `json:"ping,omitempty"`
-			Thread struct {
-				Resultcode    int    `json:"resultcode"`
-				Thread        string `json:"thread"`
-				ServerTime    int    `json:"server_time"`
-				LastRes       int    `json:"last_res"`
-				Ticket        string `json:"ticket"`
-				Revision      int    `json:"revision"`
-				ClickRevision int    `json:"click_revision"`
-			} `json:"thread,omitempty"`
-			Leaf struct {
-				Thread string `json:"thread"`
-				Count  int    `json:"count"`
-			} `json:"leaf,omitempty"`
-			Chat struct {
-				Thread    string `json:"thread"`
-				Language  int    `json:"language"`
-				No        int    `json:"no"`
-				Vpos      int    `json:"vpos"`
-				Leaf      int    `json:"leaf"`
-				Date      int    `json:"date"`
-				Anonymity int    `json:"anonymity"`
-				UserID    string `json:"user_id"`
-				Mail      string `json:"mail"`
-				Content   string `json:"content"`
-			} `json:"chat,omitempty"`
-		} `json:"cn"`
-	} `json:"raw_comments"`
-	//Comments []struct {
-	//	Parent    interface{} `json:"parent"`
-	//	ID        int         `json:"id"`
-	//	AuthorID  string      `json:"author_id"`
-	//	Text      string      `json:"text"`
-	//	Timestamp int         `json:"timestamp"`
-	//	Language  string      `json:"language"`
-	//} `json:"comments"`
-	Subtitles struct {
-		DanmakuEn []struct {
-			Ext  string `json:"ext"`
-			Data string `json:"data"`
-		} `json:"danmaku-en"`
-		DanmakuJp []struct {
-			Ext  string `json:"ext"`
-			Data string `json:"data"`
-		} `json:"danmaku-jp"`
-		DanmakuCn []struct {
-			Ext  string `json:"ext"`
-			Data string `json:"data"`
-		} `json:"danmaku-cn"`
-	} `json:"subtitles"`
-	Duration           float64     `json:"duration"`
-	WebpageURL         string      `json:"webpage_url"`
-	Extractor          string      `json:"extractor"`
-	WebpageURLBasename string      `json:"webpage_url_basename"`
-	ExtractorKey       string      `json:"extractor_key"`
-	Playlist           interface{} `json:"playlist"`
-	PlaylistIndex      interface{} `json:"playlist_index"`
-	Thumbnail          string      `json:"thumbnail"`
-	DisplayID          string      `json:"display_id"`
-	UploadDate         string      `json:"upload_date"`
-	URL                string      `json:"url"`
-	FormatID           string      `json:"format_id"`
-	Ext                string      `json:"ext"`
-	Abr                float64     `json:"abr"`
-	Vbr                float64     `json:"vbr"`
-	Height             int         `json:"height"`
-	Width              int         `json:"width"`
-	Quality            float64     `json:"quality"`
-	Tbr                float64     `json:"tbr"`
-	Format             string      `json:"format"`
-	Protocol           string      `json:"protocol"`
-	HTTPHeaders        struct {
-		UserAgent      string `json:"User-Agent"`
-		AcceptCharset  string `json:"Accept-Charset"`
-		Accept         string `json:"Accept"`
-		AcceptEncoding string `json:"Accept-Encoding"`
-		AcceptLanguage string `json:"Accept-Language"`
-	} `json:"http_headers"`
-	Fulltitle string `json:"fulltitle"`
-	Filename  string `json:"_filename"`
-}
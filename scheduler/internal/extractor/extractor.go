@@ -0,0 +1,140 @@
+package extractor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// This package is responsible for getting videos and their metadata out of content sites.
+// Anything site-specific should live behind an Extractor, so that the downloader and sync loops don't need to care
+// where a video came from.
+
+// ErrUnsupportedVideo is returned by Download for videos which the extractor knows it can't download
+var ErrUnsupportedVideo = errors.New("video is not supported by this extractor")
+
+// ErrThrottled is returned if the site refused the request because we're making too many (HTTP 429 or 403)
+var ErrThrottled = errors.New("throttled by the site")
+
+// ErrTooLarge is returned by Download if the video is bigger than the extractor's maximum file size
+var ErrTooLarge = errors.New("video is larger than the maximum file size")
+
+type Extractor interface {
+	// ListPlaylist returns the videos in a category of content (tag, channel, playlist...), oldest first
+	ListPlaylist(ctx context.Context, url string, opts ListOptions) ([]PlaylistEntry, error)
+	// FetchMetadata returns the metadata for a single video without downloading it
+	FetchMetadata(ctx context.Context, url string) (*Metadata, error)
+	// Download downloads the video, its thumbnail and its metadata into req.OutputDir
+	Download(ctx context.Context, req DownloadRequest) (*DownloadResult, error)
+}
+
+type ListOptions struct {
+	// Limit is the maximum number of entries to fetch from the start of the playlist. 0 fetches everything.
+	Limit int
+}
+
+type PlaylistEntry struct {
+	Type  string `json:"_type"`
+	URL   string `json:"original_url"`
+	IeKey string `json:"ie_key"`
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+type DownloadRequest struct {
+	URL       string
+	VideoID   string // Foreign ID, used to name the output files
+	OutputDir string
+	// OnProgress is called with the download percentage as the download progresses, if set
+	OnProgress func(percent float64)
+	// BytesPerSecond limits the download speed, 0 for no limit
+	BytesPerSecond int64
+}
+
+type DownloadResult struct {
+	VideoPath     string
+	ThumbnailPath string
+	MetadataPath  string
+	Metadata      *Metadata
+	// DanmakuPath is the file the site's danmaku were written to, or "" if there weren't any
+	DanmakuPath string
+	Subtitles   []SubtitleFile
+}
+
+// SubtitleFile is a subtitle track the site had for the video
+type SubtitleFile struct {
+	Path     string
+	Language string
+}
+
+// Registry maps sites to the extractor which should be used for them
+type Registry struct {
+	extractors map[string]Extractor
+	fallback   Extractor
+}
+
+// NewRegistry creates a registry which uses fallback for any site without a registered extractor
+func NewRegistry(fallback Extractor) *Registry {
+	return &Registry{
+		extractors: make(map[string]Extractor),
+		fallback:   fallback,
+	}
+}
+
+// Register sets the extractor for a site. site is a hostname like "nicovideo.jp", and also matches its subdomains.
+func (r *Registry) Register(site string, e Extractor) {
+	r.extractors[strings.ToLower(site)] = e
+}
+
+// ForURL returns the extractor for the site which u belongs to
+func (r *Registry) ForURL(u string) (Extractor, error) {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return nil, err
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	// Most specific match wins, so check www.nicovideo.jp, then nicovideo.jp, then jp
+	for host != "" {
+		if e, ok := r.extractors[host]; ok {
+			return e, nil
+		}
+
+		i := strings.Index(host, ".")
+		if i == -1 {
+			break
+		}
+		host = host[i+1:]
+	}
+
+	if r.fallback == nil {
+		return nil, fmt.Errorf("no extractor registered for %s", u)
+	}
+
+	return r.fallback, nil
+}
+
+// NewDefaultRegistry returns a registry using yt-dlp for everything, with the site quirks we know about
+func NewDefaultRegistry(opts YTDLPOptions) *Registry {
+	r := NewRegistry(NewYTDLP(opts))
+
+	// youtube doesn't support get-comments
+	youtube := NewYTDLP(opts)
+	youtube.GetComments = false
+	r.Register("youtube.com", youtube)
+	r.Register("youtu.be", youtube)
+
+	// so-prefixed videos are channel videos, which are paywalled
+	nico := NewYTDLP(opts)
+	nico.SkipIDPrefixes = []string{"so"}
+	nico.DanmakuLangs = []string{"comments"}
+	r.Register("nicovideo.jp", nico)
+
+	bilibili := NewYTDLP(opts)
+	bilibili.DanmakuLangs = []string{"danmaku"}
+	r.Register("bilibili.com", bilibili)
+
+	return r
+}
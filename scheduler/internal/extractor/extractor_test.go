@@ -0,0 +1,156 @@
+package extractor
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistryForURL(t *testing.T) {
+	nico := &Fake{}
+	fallback := &Fake{}
+
+	r := NewRegistry(fallback)
+	r.Register("nicovideo.jp", nico)
+
+	e, err := r.ForURL("https://www.nicovideo.jp/watch/sm9")
+	assert.NoError(t, err)
+	assert.True(t, e == nico)
+
+	e, err = r.ForURL("https://nicovideo.jp/tag/YTPMV")
+	assert.NoError(t, err)
+	assert.True(t, e == nico)
+
+	e, err = r.ForURL("https://www.bilibili.com/video/BV1xx411c7mD")
+	assert.NoError(t, err)
+	assert.True(t, e == fallback)
+
+	_, err = NewRegistry(nil).ForURL("https://www.bilibili.com/video/BV1xx411c7mD")
+	assert.Error(t, err)
+}
+
+func TestDefaultRegistryQuirks(t *testing.T) {
+	r := NewDefaultRegistry(YTDLPOptions{SocksConnStr: "socks5://proxy:1080", MaxFS: 100})
+
+	e, err := r.ForURL("https://www.youtube.com/watch?v=dQw4w9WgXcQ")
+	assert.NoError(t, err)
+	args := e.(*YTDLP).downloadArgs(DownloadRequest{URL: "https://www.youtube.com/watch?v=dQw4w9WgXcQ", VideoID: "dQw4w9WgXcQ", OutputDir: "/tmp"})
+	assert.NotContains(t, args, "--get-comments")
+	assert.Contains(t, args, "--write-subs")
+	assert.Contains(t, args, "all,-live_chat")
+	assert.Contains(t, args, "100m")
+	assert.Contains(t, args, "socks5://proxy:1080")
+
+	e, err = r.ForURL("https://www.nicovideo.jp/watch/so12345")
+	assert.NoError(t, err)
+	args = e.(*YTDLP).downloadArgs(DownloadRequest{URL: "https://www.nicovideo.jp/watch/so12345", VideoID: "so12345", OutputDir: "/tmp"})
+	assert.Contains(t, args, "--get-comments")
+	assert.Contains(t, args, "all,-live_chat,comments")
+	assert.NotContains(t, args, "--limit-rate")
+
+	args = e.(*YTDLP).downloadArgs(DownloadRequest{URL: "https://www.nicovideo.jp/watch/sm9", VideoID: "sm9", OutputDir: "/tmp", BytesPerSecond: 1048576})
+	assert.Contains(t, args, "--limit-rate")
+	assert.Contains(t, args, "1048576")
+
+	_, err = e.Download(context.Background(), DownloadRequest{URL: "https://www.nicovideo.jp/watch/so12345", VideoID: "so12345", OutputDir: t.TempDir()})
+	assert.True(t, errors.Is(err, ErrUnsupportedVideo))
+
+	e, err = r.ForURL("https://www.bilibili.com/video/BV1xx411c7mD")
+	assert.NoError(t, err)
+	args = e.(*YTDLP).downloadArgs(DownloadRequest{URL: "https://www.bilibili.com/video/BV1xx411c7mD", VideoID: "BV1xx411c7mD", OutputDir: "/tmp"})
+	assert.Contains(t, args, "--write-subs")
+	assert.Contains(t, args, "all,-live_chat,danmaku")
+}
+
+func TestListArgs(t *testing.T) {
+	y := NewYTDLP(YTDLPOptions{})
+
+	assert.Equal(t, []string{"yt-dlp", "-j", "--flat-playlist", "https://example.com/list"}, y.listArgs("https://example.com/list", ListOptions{}))
+	assert.Equal(t, []string{"yt-dlp", "-j", "--flat-playlist", "--playlist-end", "400", "https://example.com/list"}, y.listArgs("https://example.com/list", ListOptions{Limit: 400}))
+}
+
+func TestParsePlaylist(t *testing.T) {
+	payload := `{"_type": "url", "original_url": "https://example.com/3", "id": "3"}
+{"_type": "url", "original_url": "https://example.com/2", "id": "2"}
+{"_type": "url", "original_url": "https://example.com/1", "id": "1"}
+`
+
+	entries, err := parsePlaylist([]byte(payload))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 3)
+	assert.Equal(t, "1", entries[0].ID)
+	assert.Equal(t, "https://example.com/3", entries[2].URL)
+
+	_, err = parsePlaylist([]byte("\n"))
+	assert.Error(t, err)
+}
+
+func TestIsThrottled(t *testing.T) {
+	assert.True(t, isThrottled([]byte("ERROR: [niconico] sm9: Unable to download webpage: HTTP Error 429: Too Many Requests")))
+	assert.True(t, isThrottled([]byte("ERROR: [BiliBili] BV1xx411c7mD: HTTP Error 403: Forbidden")))
+	assert.False(t, isThrottled([]byte("ERROR: [niconico] sm9: HTTP Error 404: Not Found")))
+}
+
+func TestOutputError(t *testing.T) {
+	exit := errors.New("exit status 1")
+
+	err := outputError(exit, []byte("ERROR: [youtube] abc: HTTP Error 403: Forbidden; Private video\n"))
+	assert.ErrorIs(t, err, ErrThrottled)
+	// The message is kept, so that it can be told apart from actual throttling
+	assert.Contains(t, err.Error(), "Private video")
+
+	err = outputError(exit, []byte("ERROR: [youtube] abc: Video unavailable\n"))
+	assert.NotErrorIs(t, err, ErrThrottled)
+	assert.Equal(t, "exit status 1: [youtube] abc: Video unavailable", err.Error())
+
+	assert.Equal(t, exit, outputError(exit, nil))
+}
+
+func TestErrorLines(t *testing.T) {
+	output := "[youtube] abc: Downloading webpage\nERROR: [youtube] abc: Private video\nWARNING: something\nERROR: second\n"
+	assert.Equal(t, "[youtube] abc: Private video; second", errorLines([]byte(output)))
+	assert.Equal(t, "", errorLines([]byte("[youtube] abc: Downloading webpage\n")))
+}
+
+func TestFakeDownload(t *testing.T) {
+	f := &Fake{Metadata: map[string]*Metadata{
+		"https://example.com/1": {ID: "1", Title: "test video", Tags: []string{"YTPMV"}},
+	}}
+
+	dir := t.TempDir()
+	res, err := f.Download(context.Background(), DownloadRequest{URL: "https://example.com/1", VideoID: "1", OutputDir: dir})
+	assert.NoError(t, err)
+	assert.Equal(t, "test video", res.Metadata.Title)
+
+	collected, err := collectDownload(DownloadRequest{VideoID: "1", OutputDir: dir})
+	assert.NoError(t, err)
+	assert.Equal(t, res.VideoPath, collected.VideoPath)
+	assert.Equal(t, res.ThumbnailPath, collected.ThumbnailPath)
+	assert.Equal(t, []string{"YTPMV"}, collected.Metadata.Tags)
+	assert.Equal(t, "", collected.DanmakuPath)
+	assert.Len(t, f.Downloaded, 1)
+
+	danmakuPath := dir + "/1.danmaku.xml"
+	assert.NoError(t, ioutil.WriteFile(danmakuPath, []byte("<i></i>"), 0644))
+	collected, err = collectDownload(DownloadRequest{VideoID: "1", OutputDir: dir}, "danmaku")
+	assert.NoError(t, err)
+	assert.Equal(t, danmakuPath, collected.DanmakuPath)
+	assert.Equal(t, res.VideoPath, collected.VideoPath)
+	assert.Empty(t, collected.Subtitles)
+
+	subtitlePath := dir + "/1.en-US.vtt"
+	assert.NoError(t, ioutil.WriteFile(subtitlePath, []byte("WEBVTT\n"), 0644))
+	collected, err = collectDownload(DownloadRequest{VideoID: "1", OutputDir: dir}, "danmaku")
+	assert.NoError(t, err)
+	assert.Equal(t, []SubtitleFile{{Path: subtitlePath, Language: "en-US"}}, collected.Subtitles)
+	assert.Equal(t, danmakuPath, collected.DanmakuPath)
+}
+
+func TestOriginalUploadDate(t *testing.T) {
+	assert.Equal(t, "2007-03-06", Metadata{UploadDate: "20070306"}.OriginalUploadDate())
+	assert.Equal(t, "2009-02-13", Metadata{Timestamp: 1234567890}.OriginalUploadDate())
+	assert.Equal(t, "", Metadata{}.OriginalUploadDate())
+}
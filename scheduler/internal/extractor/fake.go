@@ -0,0 +1,91 @@
+package extractor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sync"
+)
+
+// Fake is an in-memory extractor for tests. It never touches the network, and Download writes placeholder files.
+type Fake struct {
+	Playlists map[string][]PlaylistEntry
+	Metadata  map[string]*Metadata
+	// Err is returned from every call if set
+	Err error
+
+	mu         sync.Mutex
+	Downloaded []DownloadRequest
+}
+
+func (f *Fake) ListPlaylist(ctx context.Context, url string, opts ListOptions) ([]PlaylistEntry, error) {
+	if f.Err != nil {
+		return nil, f.Err
+	}
+
+	entries, ok := f.Playlists[url]
+	if !ok {
+		return nil, fmt.Errorf("no playlist for %s", url)
+	}
+
+	if opts.Limit > 0 && len(entries) > opts.Limit {
+		entries = entries[len(entries)-opts.Limit:]
+	}
+
+	return entries, nil
+}
+
+func (f *Fake) FetchMetadata(ctx context.Context, url string) (*Metadata, error) {
+	if f.Err != nil {
+		return nil, f.Err
+	}
+
+	metadata, ok := f.Metadata[url]
+	if !ok {
+		return nil, fmt.Errorf("no metadata for %s", url)
+	}
+
+	return metadata, nil
+}
+
+func (f *Fake) Download(ctx context.Context, req DownloadRequest) (*DownloadResult, error) {
+	f.mu.Lock()
+	f.Downloaded = append(f.Downloaded, req)
+	f.mu.Unlock()
+
+	if f.Err != nil {
+		return nil, f.Err
+	}
+
+	metadata, ok := f.Metadata[req.URL]
+	if !ok {
+		metadata = &Metadata{ID: req.VideoID, Title: req.VideoID}
+	}
+
+	payload, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	res := DownloadResult{
+		VideoPath:     fmt.Sprintf("%s/%s.mp4", req.OutputDir, req.VideoID),
+		ThumbnailPath: fmt.Sprintf("%s/%s.jpg", req.OutputDir, req.VideoID),
+		MetadataPath:  fmt.Sprintf("%s/%s.info.json", req.OutputDir, req.VideoID),
+		Metadata:      metadata,
+	}
+
+	files := map[string][]byte{
+		res.VideoPath:     []byte("video"),
+		res.ThumbnailPath: []byte("thumbnail"),
+		res.MetadataPath:  payload,
+	}
+	for path, contents := range files {
+		err = ioutil.WriteFile(path, contents, 0644)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &res, nil
+}
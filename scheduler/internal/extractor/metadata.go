@@ -0,0 +1,204 @@
+package extractor
+
+import "time"
+
+// Metadata is the video metadata written by yt-dlp to <video id>.info.json
+type Metadata struct {
+	ID      string `json:"id"`
+	Title   string `json:"title"`
+	Formats []struct {
+		URL         string  `json:"url"`
+		Ext         string  `json:"ext"`
+		FormatID    string  `json:"format_id"`
+		FormatNote  string  `json:"format_note,omitempty"`
+		Container   string  `json:"container,omitempty"`
+		Quality     float64 `json:"quality"`
+		Filesize    int     `json:"filesize,omitempty"`
+		Format      string  `json:"format"`
+		Protocol    string  `json:"protocol"`
+		HTTPHeaders struct {
+			UserAgent      string `json:"User-Agent"`
+			AcceptCharset  string `json:"Accept-Charset"`
+			Accept         string `json:"Accept"`
+			AcceptEncoding string `json:"Accept-Encoding"`
+			AcceptLanguage string `json:"Accept-Language"`
+			Cookie         string `json:"Cookie"`
+		} `json:"http_headers"`
+		Abr    float64 `json:"abr,omitempty"`
+		Vbr    float64 `json:"vbr,omitempty"`
+		Height int     `json:"height,omitempty"`
+		Width  int     `json:"width,omitempty"`
+		Tbr    float64 `json:"tbr,omitempty"`
+	} `json:"formats"`
+	Thumbnails []struct {
+		URL string `json:"url"`
+		Ext string `json:"ext"`
+		ID  string `json:"id"`
+	} `json:"thumbnails"`
+	Description  string   `json:"description"`
+	Uploader     string   `json:"uploader"`
+	Timestamp    int      `json:"timestamp"`
+	UploaderID   string   `json:"uploader_id"`
+	ChannelID    string   `json:"channel_id"`
+	ViewCount    int      `json:"view_count"`
+	Tags         []string `json:"tags"`
+	Genre        string   `json:"genre"`
+	CommentCount int      `json:"comment_count"`
+	RawComments  struct {
+		En []struct {
+			Ping struct {
+				Content string `json:"content"`
+			} `json:"ping,omitempty"`
+			Thread struct {
+				Resultcode    int    `json:"resultcode"`
+				Thread        string `json:"thread"`
+				ServerTime    int    `json:"server_time"`
+				LastRes       int    `json:"last_res"`
+				Ticket        string `json:"ticket"`
+				Revision      int    `json:"revision"`
+				ClickRevision int    `json:"click_revision"`
+			} `json:"thread,omitempty"`
+			Leaf struct {
+				Thread string `json:"thread"`
+				Count  int    `json:"count"`
+			} `json:"leaf,omitempty"`
+			Chat struct {
+				Thread    string `json:"thread"`
+				Language  int    `json:"language"`
+				No        int    `json:"no"`
+				Vpos      int    `json:"vpos"`
+				Date      int    `json:"date"`
+				Premium   int    `json:"premium"`
+				Anonymity int    `json:"anonymity"`
+				UserID    string `json:"user_id"`
+				Mail      string `json:"mail"`
+				Content   string `json:"content"`
+			} `json:"chat,omitempty"`
+		} `json:"en"`
+		Jp []struct {
+			Ping struct {
+				Content string `json:"content"`
+			} `json:"ping,omitempty"`
+			Thread struct {
+				Resultcode    int    `json:"resultcode"`
+				Thread        string `json:"thread"`
+				ServerTime    int    `json:"server_time"`
+				LastRes       int    `json:"last_res"`
+				Ticket        string `json:"ticket"`
+				Revision      int    `json:"revision"`
+				ClickRevision int    `json:"click_revision"`
+			} `json:"thread,omitempty"`
+			Leaf struct {
+				Thread string `json:"thread"`
+				Count  int    `json:"count"`
+			} `json:"leaf,omitempty"`
+			Chat struct {
+				Thread    string `json:"thread"`
+				No        int    `json:"no"`
+				Vpos      int    `json:"vpos"`
+				Leaf      int    `json:"leaf"`
+				Date      int    `json:"date"`
+				Anonymity int    `json:"anonymity"`
+				UserID    string `json:"user_id"`
+				Mail      string `json:"mail"`
+				Content   string `json:"content"`
+			} `json:"chat,omitempty"`
+		} `json:"jp"`
+		Cn []struct {
+			Ping struct {
+				Content string `json:"content"`
+			} `json:"ping,omitempty"`
+			Thread struct {
+				Resultcode    int    `json:"resultcode"`
+				Thread        string `json:"thread"`
+				ServerTime    int    `json:"server_time"`
+				LastRes       int    `json:"last_res"`
+				Ticket        string `json:"ticket"`
+				Revision      int    `json:"revision"`
+				ClickRevision int    `json:"click_revision"`
+			} `json:"thread,omitempty"`
+			Leaf struct {
+				Thread string `json:"thread"`
+				Count  int    `json:"count"`
+			} `json:"leaf,omitempty"`
+			Chat struct {
+				Thread    string `json:"thread"`
+				Language  int    `json:"language"`
+				No        int    `json:"no"`
+				Vpos      int    `json:"vpos"`
+				Leaf      int    `json:"leaf"`
+				Date      int    `json:"date"`
+				Anonymity int    `json:"anonymity"`
+				UserID    string `json:"user_id"`
+				Mail      string `json:"mail"`
+				Content   string `json:"content"`
+			} `json:"chat,omitempty"`
+		} `json:"cn"`
+	} `json:"raw_comments"`
+	//Comments []struct {
+	//	Parent    interface{} `json:"parent"`
+	//	ID        int         `json:"id"`
+	//	AuthorID  string      `json:"author_id"`
+	//	Text      string      `json:"text"`
+	//	Timestamp int         `json:"timestamp"`
+	//	Language  string      `json:"language"`
+	//} `json:"comments"`
+	Subtitles struct {
+		DanmakuEn []struct {
+			Ext  string `json:"ext"`
+			Data string `json:"data"`
+		} `json:"danmaku-en"`
+		DanmakuJp []struct {
+			Ext  string `json:"ext"`
+			Data string `json:"data"`
+		} `json:"danmaku-jp"`
+		DanmakuCn []struct {
+			Ext  string `json:"ext"`
+			Data string `json:"data"`
+		} `json:"danmaku-cn"`
+	} `json:"subtitles"`
+	Duration           float64     `json:"duration"`
+	WebpageURL         string      `json:"webpage_url"`
+	Extractor          string      `json:"extractor"`
+	WebpageURLBasename string      `json:"webpage_url_basename"`
+	ExtractorKey       string      `json:"extractor_key"`
+	Playlist           interface{} `json:"playlist"`
+	PlaylistIndex      interface{} `json:"playlist_index"`
+	Thumbnail          string      `json:"thumbnail"`
+	DisplayID          string      `json:"display_id"`
+	UploadDate         string      `json:"upload_date"`
+	URL                string      `json:"url"`
+	FormatID           string      `json:"format_id"`
+	Ext                string      `json:"ext"`
+	Abr                float64     `json:"abr"`
+	Vbr                float64     `json:"vbr"`
+	Height             int         `json:"height"`
+	Width              int         `json:"width"`
+	Quality            float64     `json:"quality"`
+	Tbr                float64     `json:"tbr"`
+	Format             string      `json:"format"`
+	Protocol           string      `json:"protocol"`
+	HTTPHeaders        struct {
+		UserAgent      string `json:"User-Agent"`
+		AcceptCharset  string `json:"Accept-Charset"`
+		Accept         string `json:"Accept"`
+		AcceptEncoding string `json:"Accept-Encoding"`
+		AcceptLanguage string `json:"Accept-Language"`
+	} `json:"http_headers"`
+	Fulltitle string `json:"fulltitle"`
+	Filename  string `json:"_filename"`
+}
+
+// OriginalUploadDate returns when the video was uploaded to the site as YYYY-MM-DD, or "" if the site didn't say
+func (m Metadata) OriginalUploadDate() string {
+	t, err := time.Parse("20060102", m.UploadDate)
+	if err == nil {
+		return t.Format("2006-01-02")
+	}
+
+	if m.Timestamp > 0 {
+		return time.Unix(int64(m.Timestamp), 0).UTC().Format("2006-01-02")
+	}
+
+	return ""
+}
@@ -0,0 +1,49 @@
+package extractor
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+)
+
+// Matches yt-dlp's progress lines, e.g. "[download]  45.3% of ~10.00MiB at  1.00MiB/s ETA 00:05"
+var progressRegex = regexp.MustCompile(`^\[download\]\s+(\d+(?:\.\d+)?)%`)
+
+// parseProgress returns the percentage from a line of yt-dlp output, if it's a progress line
+func parseProgress(line []byte) (float64, bool) {
+	match := progressRegex.FindSubmatch(bytes.TrimSpace(line))
+	if match == nil {
+		return 0, false
+	}
+
+	percent, err := strconv.ParseFloat(string(match[1]), 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return percent, true
+}
+
+// progressWriter splits yt-dlp's output into lines, and reports the progress lines
+type progressWriter struct {
+	onProgress func(percent float64)
+	buf        []byte
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	p.buf = append(p.buf, b...)
+
+	for {
+		i := bytes.IndexAny(p.buf, "\r\n")
+		if i == -1 {
+			break
+		}
+
+		if percent, ok := parseProgress(p.buf[:i]); ok {
+			p.onProgress(percent)
+		}
+		p.buf = p.buf[i+1:]
+	}
+
+	return len(b), nil
+}
@@ -0,0 +1,35 @@
+package extractor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseProgress(t *testing.T) {
+	percent, ok := parseProgress([]byte("[download]  45.3% of ~10.00MiB at  1.00MiB/s ETA 00:05"))
+	assert.True(t, ok)
+	assert.Equal(t, 45.3, percent)
+
+	percent, ok = parseProgress([]byte("[download] 100% of 10.00MiB in 00:10"))
+	assert.True(t, ok)
+	assert.Equal(t, 100.0, percent)
+
+	_, ok = parseProgress([]byte("[download] Destination: /tmp/sm9.mp4"))
+	assert.False(t, ok)
+
+	_, ok = parseProgress([]byte("[info] sm9: Downloading 1 format(s): 0"))
+	assert.False(t, ok)
+}
+
+func TestProgressWriter(t *testing.T) {
+	var reported []float64
+	w := &progressWriter{onProgress: func(percent float64) {
+		reported = append(reported, percent)
+	}}
+
+	w.Write([]byte("[download] Destination: /tmp/sm9.mp4\n[download]   1.0% of 10.00MiB\n[down"))
+	w.Write([]byte("load]  50.5% of 10.00MiB\r[download] 100.0% of 10.00MiB\n"))
+
+	assert.Equal(t, []float64{1.0, 50.5, 100.0}, reported)
+}
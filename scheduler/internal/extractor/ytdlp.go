@@ -0,0 +1,395 @@
+package extractor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type YTDLPOptions struct {
+	SocksConnStr   string
+	MaxFS          uint64 // in MB, 0 for no limit
+	AcceptLanguage string
+}
+
+// YTDLP extracts videos using yt-dlp
+type YTDLP struct {
+	YTDLPOptions
+	Bin             string
+	GetComments     bool
+	SkipIDPrefixes  []string
+	DownloadTimeout time.Duration
+	// DanmakuLangs are the subtitle languages under which yt-dlp exposes the site's danmaku, if it has any
+	DanmakuLangs []string
+	// SubtitleLangs are the subtitles to archive, as for yt-dlp's --sub-langs
+	SubtitleLangs []string
+}
+
+func NewYTDLP(opts YTDLPOptions) *YTDLP {
+	return &YTDLP{
+		YTDLPOptions:    opts,
+		Bin:             "yt-dlp",
+		GetComments:     true,
+		DownloadTimeout: time.Second * 900,
+		// YouTube's live chat replay is exposed as subtitles, and can be huge
+		SubtitleLangs: []string{"all", "-live_chat"},
+	}
+}
+
+func (y *YTDLP) ListPlaylist(ctx context.Context, url string, opts ListOptions) ([]PlaylistEntry, error) {
+	args := y.listArgs(url, opts)
+
+	// get the list of videos to download
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	payload, err := cmd.Output()
+	if err != nil {
+		log.Errorf("Command `%s` finished with err %s", cmd, err)
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return nil, outputError(err, exitErr.Stderr)
+		}
+		return nil, err
+	}
+
+	return parsePlaylist(payload)
+}
+
+// parsePlaylist parses yt-dlp's flat playlist output, which is one json object per line.
+// I assume that the list provided by yt-dlp will be in descending order by upload date, so it's reversed so that
+// we can resume at the newest download.
+func parsePlaylist(payload []byte) ([]PlaylistEntry, error) {
+	var videos []PlaylistEntry
+	spl := strings.Split(strings.TrimSpace(string(payload)), "\n")
+	for i := len(spl) - 1; i >= 0; i-- {
+		line := spl[i]
+		if line == "" {
+			continue
+		}
+
+		var video PlaylistEntry
+		err := json.Unmarshal([]byte(line), &video)
+		if err != nil {
+			log.Errorf("Failed to unmarshal json. Payload: %s. Err: %s", line, err)
+			return nil, err
+		}
+
+		videos = append(videos, video)
+	}
+
+	if len(videos) == 0 {
+		log.Errorf("Could not unmarshal, videolist len is 0")
+		return nil, errors.New("unmarshal failure")
+	}
+
+	return videos, nil
+}
+
+func (y *YTDLP) FetchMetadata(ctx context.Context, url string) (*Metadata, error) {
+	args := y.metadataArgs(url)
+
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	payload, err := cmd.Output()
+	if err != nil {
+		log.Errorf("Command `%s` finished with err %s", cmd, err)
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			// The upstream monitor needs to know why the video couldn't be fetched
+			return nil, outputError(err, exitErr.Stderr)
+		}
+		return nil, err
+	}
+
+	var metadata Metadata
+	err = json.Unmarshal(payload, &metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &metadata, nil
+}
+
+func (y *YTDLP) Download(ctx context.Context, req DownloadRequest) (*DownloadResult, error) {
+	for _, prefix := range y.SkipIDPrefixes {
+		if strings.HasPrefix(req.VideoID, prefix) {
+			return nil, fmt.Errorf("video ID %s has the prefix %s: %w", req.VideoID, prefix, ErrUnsupportedVideo)
+		}
+	}
+
+	log.Infof("Downloading %+v", req)
+
+	args := y.downloadArgs(req)
+
+	ytdlLog, err := os.Create(fmt.Sprintf("%s/%s.ytdl", req.OutputDir, req.VideoID))
+	if err != nil {
+		return nil, err
+	}
+	defer ytdlLog.Close()
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, y.DownloadTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctxTimeout, args[0], args[1:]...)
+	cmd.Stdout = ytdlLog
+	cmd.Stderr = ytdlLog
+	if req.OnProgress != nil {
+		cmd.Stdout = io.MultiWriter(ytdlLog, &progressWriter{onProgress: req.OnProgress})
+	}
+
+	err = cmd.Run()
+	if err != nil {
+		log.Errorf("Command %s failed with %s.", cmd, err)
+		output, readErr := os.ReadFile(ytdlLog.Name())
+		if readErr != nil {
+			return nil, err
+		}
+		// The exit status on its own says nothing about what went wrong
+		return nil, outputError(err, output)
+	}
+
+	// yt-dlp skips videos over --max-filesize without failing
+	output, err := os.ReadFile(ytdlLog.Name())
+	if err == nil && bytes.Contains(output, []byte("larger than max-filesize")) {
+		return nil, fmt.Errorf("video %s is over %dMB: %w", req.VideoID, y.MaxFS, ErrTooLarge)
+	}
+
+	return collectDownload(req, y.DanmakuLangs...)
+}
+
+// outputError adds the errors yt-dlp printed to err, which is usually just the exit status. Errors which might be from
+// throttling wrap ErrThrottled, though the message may say otherwise (e.g. a 403 for a private video), so failure
+// classification looks at the message first.
+func outputError(err error, output []byte) error {
+	msg := errorLines(output)
+	switch {
+	case isThrottled(output) && msg != "":
+		return fmt.Errorf("%s: %s: %w", err, msg, ErrThrottled)
+	case isThrottled(output):
+		return fmt.Errorf("%s: %w", err, ErrThrottled)
+	case msg != "":
+		return fmt.Errorf("%s: %s", err, msg)
+	}
+	return err
+}
+
+// errorLines returns the errors yt-dlp printed, joined into one line
+func errorLines(output []byte) string {
+	var errs []string
+	for _, line := range strings.Split(string(output), "\n") {
+		if strings.HasPrefix(line, "ERROR:") {
+			errs = append(errs, strings.TrimSpace(strings.TrimPrefix(line, "ERROR:")))
+		}
+	}
+	return strings.Join(errs, "; ")
+}
+
+// isThrottled checks yt-dlp's output for the site refusing our requests
+func isThrottled(output []byte) bool {
+	for _, marker := range []string{"HTTP Error 429", "HTTP Error 403"} {
+		if bytes.Contains(output, []byte(marker)) {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	videoExts = []string{"mp4", "webm", "flv", "mkv"}
+	thumbExts = []string{"png", "webp", "jpg"}
+	// niconico's comments are json, bilibili's are xml
+	danmakuExts = []string{"json", "xml"}
+	// The formats video service can convert to WebVTT
+	subtitleExts = []string{"vtt", "srt", "ass"}
+)
+
+// collectDownload finds the files yt-dlp wrote for a request
+func collectDownload(req DownloadRequest, danmakuLangs ...string) (*DownloadResult, error) {
+	res := DownloadResult{
+		MetadataPath: fmt.Sprintf("%s/%s.info.json", req.OutputDir, req.VideoID),
+	}
+
+	buf, err := ioutil.ReadFile(res.MetadataPath)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Metadata = &Metadata{}
+	err = json.Unmarshal(buf, res.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	generatedVideoFiles, err := globWithExtensions(fmt.Sprintf("%s/*%s", req.OutputDir, req.VideoID), videoExts)
+	if err != nil {
+		return nil, err
+	}
+	res.VideoPath = generatedVideoFiles[0]
+
+	generatedThumbnailFiles, err := globWithExtensions(fmt.Sprintf("%s/*%s", req.OutputDir, req.VideoID), thumbExts)
+	if err != nil {
+		return nil, err
+	}
+	res.ThumbnailPath = generatedThumbnailFiles[0]
+
+	// Danmaku are optional, plenty of videos don't have any
+	for _, lang := range danmakuLangs {
+		for _, ext := range danmakuExts {
+			path := fmt.Sprintf("%s/%s.%s.%s", req.OutputDir, req.VideoID, lang, ext)
+			if _, err := os.Stat(path); err == nil && res.DanmakuPath == "" {
+				res.DanmakuPath = path
+			}
+		}
+	}
+
+	// Subtitles are written to <video ID>.<language>.<ext>
+	for _, ext := range subtitleExts {
+		paths, err := filepath.Glob(fmt.Sprintf("%s/%s.*.%s", req.OutputDir, req.VideoID, ext))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, path := range paths {
+			lang := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(path), req.VideoID+"."), "."+ext)
+			if lang == "" || contains(danmakuLangs, lang) {
+				continue
+			}
+
+			res.Subtitles = append(res.Subtitles, SubtitleFile{Path: path, Language: lang})
+		}
+	}
+
+	return &res, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func globWithExtensions(basepath string, extensions []string) ([]string, error) {
+	var ret []string
+	for _, ext := range extensions {
+		path := fmt.Sprintf("%s.%s*", basepath, ext)
+		g, err := filepath.Glob(path)
+		if err != nil {
+			return nil, err
+		}
+
+		ret = append(ret, g...)
+	}
+
+	if len(ret) != 1 {
+		return nil, fmt.Errorf("incorrect match length for extensions %s, length: %d, contents: %s", extensions, len(ret), ret)
+	}
+
+	return ret, nil
+}
+
+func (y *YTDLP) acceptLanguageHeader() string {
+	if y.AcceptLanguage != "" {
+		return fmt.Sprintf("Accept-Language:%s", y.AcceptLanguage)
+	}
+	return "Accept-Language:en"
+}
+
+func (y *YTDLP) listArgs(url string, opts ListOptions) []string {
+	args := []string{y.Bin,
+		"-j",
+		"--flat-playlist",
+	}
+
+	if opts.Limit > 0 {
+		args = append(args, []string{
+			"--playlist-end",
+			fmt.Sprintf("%d", opts.Limit),
+		}...)
+	}
+
+	if y.SocksConnStr != "" {
+		args = append(args, []string{"--proxy", y.SocksConnStr}...)
+	}
+
+	return append(args, url)
+}
+
+func (y *YTDLP) metadataArgs(url string) []string {
+	args := []string{y.Bin,
+		"--add-header",
+		y.acceptLanguageHeader(),
+		"-j",
+	}
+
+	if y.SocksConnStr != "" {
+		args = append(args, []string{"--proxy", y.SocksConnStr}...)
+	}
+
+	return append(args, url)
+}
+
+func (y *YTDLP) downloadArgs(req DownloadRequest) []string {
+	args := []string{
+		y.Bin,
+		req.URL,
+		"--write-info-json", // I'd like to use -j, but doesn't seem to work for some videos
+		"--write-thumbnail",
+		// This line was originally authored by Soichiro
+		// according to him, it was licensed under the
+		// "Do What The Fuck You Want license", for which
+		// usage is permitted as long as the name is changed
+		// Thank you for your work!
+		"-S",
+		"res,hdr,fps,vcodec:av01:h265:vp9.2:vp9:h264,vbr",
+		"--add-header",
+		"Accept:*/*",
+		// "Why do we need this?"
+		// Previously ffprobe would stall indefinitely if nico's cookies were invalidated by the time it made a request
+		// (or something like that).
+		"--add-header",
+		y.acceptLanguageHeader(),
+		"--socket-timeout",
+		"1800",
+		"--verbose",
+		// Print each progress update on its own line, so that it can be parsed
+		"--newline",
+		"-o",
+		// Some websites have two IDs per video, so I made it explicit just to avoid issues
+		fmt.Sprintf("%s/%s.%s", req.OutputDir, req.VideoID, "%(ext)s"),
+	}
+
+	if req.BytesPerSecond > 0 {
+		args = append(args, []string{"--limit-rate", fmt.Sprintf("%d", req.BytesPerSecond)}...)
+	}
+
+	if y.MaxFS != 0 {
+		args = append(args, []string{"--max-filesize", fmt.Sprintf("%dm", y.MaxFS)}...)
+	}
+
+	if y.GetComments {
+		args = append(args, "--get-comments")
+	}
+
+	if langs := append(append([]string{}, y.SubtitleLangs...), y.DanmakuLangs...); len(langs) > 0 {
+		// Danmaku only come in the site's format, so they fall through to best
+		args = append(args, []string{"--write-subs", "--sub-langs", strings.Join(langs, ","), "--sub-format", "vtt/srt/ass/best"}...)
+	}
+
+	if y.SocksConnStr != "" {
+		args = append(args, []string{"--proxy", y.SocksConnStr}...)
+	}
+
+	return args
+}
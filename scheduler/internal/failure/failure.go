@@ -0,0 +1,132 @@
+package failure
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/horahoradev/horahora/scheduler/internal/extractor"
+)
+
+// This package sorts download and upload failures into classes, so that each class can be retried (or not) in its own
+// way, and so that archivists can see at a glance why a video is missing.
+
+type Class string
+
+const (
+	Removed      Class = "removed"       // deleted upstream, or never existed
+	Private      Class = "private"       // made private by the uploader
+	GeoBlocked   Class = "geo_blocked"   // not available from where we're downloading
+	RateLimited  Class = "rate_limited"  // the site is throttling us
+	TooLarge     Class = "too_large"     // over the maximum file size
+	AuthRequired Class = "auth_required" // needs an account, e.g. members only or age restricted
+	Unsupported  Class = "unsupported"   // the extractor can't download it
+	Network      Class = "network"       // transient network or service trouble
+	Unknown      Class = "unknown"
+)
+
+// The first matching entry wins, so more specific markers come first. Markers are matched case insensitively against
+// yt-dlp's error output and gRPC errors.
+var markers = []struct {
+	class   Class
+	markers []string
+}{
+	{RateLimited, []string{"http error 429", "too many requests", "code = resourceexhausted"}},
+	{GeoBlocked, []string{"available in your country", "geo restriction", "geo-restricted", "georestricted", "not available from your location"}},
+	{Private, []string{"private video", "video is private"}},
+	{AuthRequired, []string{"sign in to confirm", "only available to", "login required", "requires authentication", "only available for registered users",
+		"members-only", "members only", "--cookies"}},
+	{Removed, []string{"http error 404", "video unavailable", "has been removed", "has been deleted", "no longer available",
+		"does not exist", "video not found", "account has been terminated"}},
+	{TooLarge, []string{"larger than max-filesize"}},
+	{Unsupported, []string{"unsupported url"}},
+	{Network, []string{"timed out", "timeout", "connection reset", "connection refused", "temporary failure in name resolution",
+		"unable to download webpage", "http error 5", "unexpected eof", "code = unavailable", "code = deadlineexceeded", "transport is closing"}},
+}
+
+// Classify decides which class an error from the extractor or from uploading to video service falls into
+func Classify(err error) Class {
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, extractor.ErrTooLarge):
+		return TooLarge
+	case errors.Is(err, extractor.ErrUnsupportedVideo):
+		return Unsupported
+	case errors.Is(err, context.DeadlineExceeded):
+		return Network
+	}
+
+	class := classifyMessage(err.Error())
+
+	// The extractor treats every 403 as throttling, but sites also answer 403 for private and geo blocked videos. What the
+	// message says about the video wins, and otherwise it's assumed to be throttling.
+	if errors.Is(err, extractor.ErrThrottled) && (class == Network || class == Unknown) {
+		return RateLimited
+	}
+
+	return class
+}
+
+func classifyMessage(msg string) Class {
+	msg = strings.ToLower(msg)
+	for _, m := range markers {
+		for _, marker := range m.markers {
+			if strings.Contains(msg, marker) {
+				return m.class
+			}
+		}
+	}
+
+	return Unknown
+}
+
+// Policy is how a class of failure is retried
+type Policy struct {
+	// Permanent failures are never retried
+	Permanent bool
+	// MaxAttempts is the number of times the download is tried before giving up, 0 for no limit
+	MaxAttempts int
+	// The delay before the nth retry is BaseDelay * 2^(n-1), capped at MaxDelay, with jitter
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+// DefaultPolicies returns the retry policy for each class. maxAttempts applies to failures we know nothing about.
+func DefaultPolicies(maxAttempts int) map[Class]Policy {
+	return map[Class]Policy{
+		Removed:      {Permanent: true},
+		Private:      {Permanent: true},
+		GeoBlocked:   {Permanent: true},
+		TooLarge:     {Permanent: true},
+		AuthRequired: {Permanent: true},
+		Unsupported:  {Permanent: true},
+		// It's not the video's fault, so keep going for a good while. The site's cooldown in the rate limiter covers the
+		// short term.
+		RateLimited: {MaxAttempts: 30, BaseDelay: time.Minute * 10, MaxDelay: time.Hour * 12},
+		Network:     {MaxAttempts: 10, BaseDelay: time.Minute, MaxDelay: time.Hour * 2},
+		Unknown:     {MaxAttempts: maxAttempts, BaseDelay: time.Minute * 5, MaxDelay: time.Hour * 6},
+	}
+}
+
+// ShouldRetry indicates whether there's anything left to try after the given number of failures
+func (p Policy) ShouldRetry(failures int) bool {
+	return !p.Permanent && (p.MaxAttempts == 0 || failures < p.MaxAttempts)
+}
+
+// Delay returns how long to wait before retrying after the given number of failures. jitter is a random number in
+// [0, 1), which spreads the delay over its upper half so that videos which failed together don't retry together.
+func (p Policy) Delay(failures int, jitter float64) time.Duration {
+	if failures < 1 {
+		failures = 1
+	}
+
+	d := float64(p.BaseDelay) * math.Pow(2, float64(failures-1))
+	if d > float64(p.MaxDelay) {
+		d = float64(p.MaxDelay)
+	}
+
+	return time.Duration(d/2 + d/2*jitter)
+}
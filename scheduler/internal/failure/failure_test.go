@@ -0,0 +1,63 @@
+package failure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/horahoradev/horahora/scheduler/internal/extractor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassify(t *testing.T) {
+	cases := map[string]Class{
+		"exit status 1: [youtube] abc: Video unavailable. This video has been removed by the uploader": Removed,
+		"exit status 1: [niconico] sm9: HTTP Error 404: Not Found":                                     Removed,
+		"exit status 1: [youtube] abc: Private video. Sign in if you've been granted access":           Private,
+		"exit status 1: [youtube] abc: The uploader has not made this video available in your country": GeoBlocked,
+		"exit status 1: [BiliBili] BV1: This video is not available in your country":                   GeoBlocked,
+		"exit status 1: [youtube] abc: Sign in to confirm your age":                                    AuthRequired,
+		"exit status 1: [youtube] abc: Join this channel to get access to members-only content":        AuthRequired,
+		"exit status 1: [niconico] sm9: Unable to download webpage: <urlopen error timed out>":         Network,
+		"failed to send video data. Err: rpc error: code = Unavailable desc = transport is closing":    Network,
+		"exit status 1: [youtube] abc: Unsupported URL: https://example.com":                           Unsupported,
+		"exit status 1": Unknown,
+	}
+
+	for msg, class := range cases {
+		assert.Equal(t, class, Classify(errors.New(msg)), msg)
+	}
+
+	assert.Equal(t, RateLimited, Classify(fmt.Errorf("exit status 1: %w", extractor.ErrThrottled)))
+	assert.Equal(t, RateLimited, Classify(fmt.Errorf("exit status 1: [BiliBili] BV1: Unable to download webpage: HTTP Error 403: Forbidden: %w",
+		extractor.ErrThrottled)))
+	// Sites answer 403 for videos which can't be seen too, which isn't throttling
+	assert.Equal(t, Private, Classify(fmt.Errorf("exit status 1: [youtube] abc: HTTP Error 403: Forbidden; Private video: %w", extractor.ErrThrottled)))
+	assert.Equal(t, GeoBlocked, Classify(fmt.Errorf("exit status 1: [BiliBili] BV1: HTTP Error 403: This video is not available in your country: %w",
+		extractor.ErrThrottled)))
+	assert.Equal(t, TooLarge, Classify(fmt.Errorf("video sm9 is over 100MB: %w", extractor.ErrTooLarge)))
+	assert.Equal(t, Unsupported, Classify(fmt.Errorf("video ID so1 has the prefix so: %w", extractor.ErrUnsupportedVideo)))
+	assert.Equal(t, Network, Classify(context.DeadlineExceeded))
+	assert.Equal(t, Class(""), Classify(nil))
+}
+
+func TestPolicy(t *testing.T) {
+	policies := DefaultPolicies(3)
+
+	for _, class := range []Class{Removed, Private, GeoBlocked, TooLarge, AuthRequired, Unsupported} {
+		assert.False(t, policies[class].ShouldRetry(0), class)
+	}
+
+	unknown := policies[Unknown]
+	assert.True(t, unknown.ShouldRetry(2))
+	assert.False(t, unknown.ShouldRetry(3))
+
+	p := Policy{BaseDelay: time.Minute, MaxDelay: time.Hour}
+	assert.Equal(t, 30*time.Second, p.Delay(1, 0))
+	assert.Equal(t, 3*time.Minute, p.Delay(3, 0.5))
+	// Capped
+	assert.Equal(t, 30*time.Minute, p.Delay(20, 0))
+	assert.Equal(t, 30*time.Second, p.Delay(0, 0))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"time"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
@@ -20,11 +21,12 @@ import (
 
 type schedulerServer struct {
 	proto.UnimplementedSchedulerServer
-	M *models.ArchiveRequestRepo
+	M      *models.ArchiveRequestRepo
+	Events *models.DownloadEventBroker
 }
 
-func NewGRPCServer(ctx context.Context, conn *sqlx.DB, port int) error {
-	schedulerServer := initializeSchedulerServer(conn)
+func NewGRPCServer(ctx context.Context, conn *sqlx.DB, events *models.DownloadEventBroker, port int) error {
+	schedulerServer := initializeSchedulerServer(conn, events)
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 	if err != nil {
@@ -49,9 +51,10 @@ func NewGRPCServer(ctx context.Context, conn *sqlx.DB, port int) error {
 	return serv.Serve(lis)
 }
 
-func initializeSchedulerServer(conn *sqlx.DB) schedulerServer {
+func initializeSchedulerServer(conn *sqlx.DB, events *models.DownloadEventBroker) schedulerServer {
 	return schedulerServer{
-		M: models.NewArchiveRequest(conn),
+		M:      models.NewArchiveRequest(conn),
+		Events: events,
 	}
 }
 
@@ -80,10 +83,11 @@ func (s schedulerServer) ListArchivalEvents(ctx context.Context, req *proto.List
 	var protoEvents []*proto.ArchivalEvent
 	for _, event := range events {
 		eventObj := proto.ArchivalEvent{
-			VideoUrl:  event.VideoURL,
-			ParentUrl: event.ParentURL,
-			Message:   event.Message,
-			Timestamp: event.EventTimestamp,
+			VideoUrl:     event.VideoURL,
+			ParentUrl:    event.ParentURL,
+			Message:      event.Message,
+			Timestamp:    event.EventTimestamp,
+			FailureClass: event.FailureClass,
 		}
 		protoEvents = append(protoEvents, &eventObj)
 	}
@@ -111,6 +115,8 @@ func (s schedulerServer) ListArchivalEntries(ctx context.Context, req *proto.Lis
 			LastSynced:           archive.LastSynced,
 			DownloadID:           archive.DownloadID,
 			UndownloadableVideos: archive.Undownloadable,
+			NextSync:             archive.NextSync,
+			SyncReason:           archive.SyncReason,
 		}
 
 		entries = append(entries, &entry)
@@ -170,25 +176,47 @@ func (s schedulerServer) UnapproveVideo(ctx context.Context, req *proto.ApproveV
 	return &proto.Empty{}, err
 }
 
-func (s schedulerServer) GetInferenceCategories(ctx context.Context, req *proto.Empty) (*proto.InferenceList, error) {
-	entries, err := s.M.GetInferenceCategories()
-	if err != nil {
-		return nil, err
-	}
+var downloadEventTypes = map[models.DownloadEventType]proto.DownloadEventEventType{
+	models.EventQueued:   proto.DownloadEvent_Queued,
+	models.EventStarted:  proto.DownloadEvent_Started,
+	models.EventProgress: proto.DownloadEvent_Progress,
+	models.EventUploaded: proto.DownloadEvent_Uploaded,
+	models.EventFailed:   proto.DownloadEvent_Failed,
+}
 
-	var retList []*proto.InferenceEntry
-	for _, entry := range entries {
-		e := proto.InferenceEntry{
-			Category: entry.Category,
-			Tag:      entry.Tag,
+func (s schedulerServer) WatchDownloads(req *proto.WatchDownloadsRequest, stream proto.Scheduler_WatchDownloadsServer) error {
+	events := s.Events.Subscribe()
+	defer s.Events.Unsubscribe(events)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				// broker shut down
+				return nil
+			}
+
+			if !event.Matches(req.DownloadID, req.UserID) {
+				continue
+			}
+
+			err := stream.Send(&proto.DownloadEvent{
+				Type:       downloadEventTypes[event.Type],
+				VideoID:    event.VideoID,
+				Website:    event.Website,
+				Url:        event.URL,
+				ParentURL:  event.ParentURL,
+				DownloadID: uint64(event.DownloadID),
+				Progress:   event.Progress,
+				Message:    event.Message,
+				Timestamp:  event.Timestamp.Format(time.RFC3339),
+				UserIDs:    event.UserIDs,
+			})
+			if err != nil {
+				return err
+			}
 		}
-
-		retList = append(retList, &e)
 	}
-
-	return &proto.InferenceList{Entries: retList}, err
-}
-
-func (s schedulerServer) AddInferenceCategory(ctx context.Context, entry *proto.InferenceEntry) (*proto.Empty, error) {
-	return &proto.Empty{}, s.M.AddInferenceCategory(entry)
 }
@@ -0,0 +1,111 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+
+	"github.com/horahoradev/horahora/scheduler/internal/classifier"
+	"github.com/horahoradev/horahora/scheduler/internal/models"
+	proto "github.com/horahoradev/horahora/scheduler/protocol"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s schedulerServer) GetInferenceCategories(ctx context.Context, req *proto.Empty) (*proto.InferenceList, error) {
+	entries, err := s.M.GetInferenceCategories()
+	if err != nil {
+		return nil, err
+	}
+
+	var retList []*proto.InferenceEntry
+	for _, entry := range entries {
+		retList = append(retList, inferenceEntry(entry))
+	}
+
+	return &proto.InferenceList{Entries: retList}, err
+}
+
+func (s schedulerServer) AddInferenceCategory(ctx context.Context, entry *proto.InferenceEntry) (*proto.InferenceEntry, error) {
+	category, err := inferenceCategory(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.M.AddInferenceCategory(&category)
+	if err != nil {
+		return nil, err
+	}
+
+	return inferenceEntry(category), nil
+}
+
+func (s schedulerServer) UpdateInferenceCategory(ctx context.Context, entry *proto.InferenceEntry) (*proto.InferenceEntry, error) {
+	category, err := inferenceCategory(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.M.UpdateInferenceCategory(category)
+	if err != nil {
+		return nil, inferenceErr(err)
+	}
+
+	return inferenceEntry(category), nil
+}
+
+func (s schedulerServer) DeleteInferenceCategory(ctx context.Context, req *proto.InferenceDeletionReq) (*proto.Empty, error) {
+	return &proto.Empty{}, inferenceErr(s.M.DeleteInferenceCategory(req.ID))
+}
+
+func inferenceErr(err error) error {
+	if errors.Is(err, models.ErrInferenceCategoryNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
+
+// inferenceCategory validates the entry, and converts it to a rule
+func inferenceCategory(entry *proto.InferenceEntry) (models.InferenceCategory, error) {
+	category := models.InferenceCategory{
+		ID:       entry.ID,
+		Category: entry.Category,
+		Field:    entry.Field,
+		Pattern:  entry.Pattern,
+		Weight:   entry.Weight,
+		Priority: int(entry.Priority),
+	}
+
+	// Entries used to only have a tag
+	if category.Field == "" && entry.Tag != "" {
+		category.Field = string(classifier.FieldTag)
+		category.Pattern = entry.Tag
+	}
+
+	if category.Weight == 0 {
+		category.Weight = 1
+	}
+
+	err := category.Rule().Validate()
+	if err != nil {
+		return models.InferenceCategory{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return category, nil
+}
+
+func inferenceEntry(c models.InferenceCategory) *proto.InferenceEntry {
+	e := proto.InferenceEntry{
+		ID:       c.ID,
+		Category: c.Category,
+		Field:    c.Field,
+		Pattern:  c.Pattern,
+		Weight:   c.Weight,
+		Priority: int32(c.Priority),
+	}
+
+	if c.Field == string(classifier.FieldTag) {
+		e.Tag = c.Pattern
+	}
+
+	return &e
+}
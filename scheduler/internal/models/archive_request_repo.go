@@ -31,6 +31,8 @@ type Archival struct {
 	Undownloadable uint64
 	LastSynced     string
 	BackoffFactor  uint32
+	NextSync       string
+	SyncReason     string
 }
 
 type Event struct {
@@ -38,6 +40,7 @@ type Event struct {
 	VideoURL       string
 	Message        string
 	EventTimestamp string
+	FailureClass   string
 }
 
 func (m *ArchiveRequestRepo) GetAllUnapprovedVideos() (*proto.UnapprovedList, error) {
@@ -85,7 +88,7 @@ func (m *ArchiveRequestRepo) GetArchivalEvents(downloadID int64, showAll bool) (
 
 	switch showAll {
 	case true:
-		sql := "Select video_url, parent_url, event_message, event_time FROM archival_events ORDER BY event_time DESC LIMIT 100"
+		sql := "Select video_url, parent_url, event_message, event_time, coalesce(failure_class, '') FROM archival_events ORDER BY event_time DESC LIMIT 100"
 
 		rows, err = m.Db.Query(sql)
 		if err != nil {
@@ -93,7 +96,7 @@ func (m *ArchiveRequestRepo) GetArchivalEvents(downloadID int64, showAll bool) (
 		}
 
 	case false:
-		sql := "Select video_url, parent_url, event_message, event_time FROM archival_events WHERE download_id = $1 ORDER BY event_time DESC LIMIT 100"
+		sql := "Select video_url, parent_url, event_message, event_time, coalesce(failure_class, '') FROM archival_events WHERE download_id = $1 ORDER BY event_time DESC LIMIT 100"
 
 		rows, err = m.Db.Query(sql, downloadID)
 		if err != nil {
@@ -104,7 +107,7 @@ func (m *ArchiveRequestRepo) GetArchivalEvents(downloadID int64, showAll bool) (
 	for rows.Next() {
 		var event Event
 
-		err = rows.Scan(&event.VideoURL, &event.ParentURL, &event.Message, &event.EventTimestamp)
+		err = rows.Scan(&event.VideoURL, &event.ParentURL, &event.Message, &event.EventTimestamp, &event.FailureClass)
 		if err != nil {
 			return nil, err
 		}
@@ -120,7 +123,7 @@ func (m *ArchiveRequestRepo) GetContentArchivalRequests(userID int64) ([]Archiva
 	// TODO: this query should be joined on archival subscriptions, not the download user id
 	// This is an MVP fix
 	// nvm i misread it is lol
-	sql := "SELECT Url, coalesce(last_synced, Now()), backoff_factor, downloads.id FROM " +
+	sql := "SELECT Url, coalesce(last_synced, Now()), backoff_factor, downloads.id, coalesce(next_sync_at, Now()), sync_reason FROM " +
 		"downloads INNER JOIN user_download_subscriptions s ON downloads.id = s.download_id WHERE s.user_id=$1"
 
 	rows, err := m.Db.Query(sql, userID)
@@ -134,7 +137,7 @@ func (m *ArchiveRequestRepo) GetContentArchivalRequests(userID int64) ([]Archiva
 	for rows.Next() {
 		var archive Archival
 
-		err = rows.Scan(&archive.Url, &archive.LastSynced, &archive.BackoffFactor, &archive.DownloadID)
+		err = rows.Scan(&archive.Url, &archive.LastSynced, &archive.BackoffFactor, &archive.DownloadID, &archive.NextSync, &archive.SyncReason)
 		if err != nil {
 			return nil, err
 		}
@@ -201,7 +204,7 @@ func (m *ArchiveRequestRepo) New(url string, userID int64) error {
 func (m *ArchiveRequestRepo) GetUnsyncedCategoryDLRequests() ([]CategoryDLRequest, error) {
 	// Fetch all unsynced downloads, irrespective of priority
 	res, err := m.Db.Query("SELECT downloads.id, Url FROM downloads INNER JOIN user_download_subscriptions s ON downloads.id = s.download_id " +
-		"WHERE last_synced IS NULL or last_synced + interval '1 day' * backoff_factor < Now() GROUP BY downloads.id")
+		"WHERE next_sync_at IS NULL OR next_sync_at <= Now() GROUP BY downloads.id")
 	if err != nil {
 		return nil, err
 	}
@@ -234,8 +237,9 @@ func (m *ArchiveRequestRepo) DeleteArchivalRequest(userID, downloadID uint64) er
 	return err
 }
 
+// RetryArchivalRequest retries the request's failed downloads, apart from the ones which failed permanently
 func (m *ArchiveRequestRepo) RetryArchivalRequest(userID, downloadID uint64) error {
-	sql := "UPDATE videos SET dlstatus = 0 WHERE videos.id IN (SELECT videos.id FROM videos INNER JOIN downloads_to_videos ON downloads_to_videos.video_id = videos.id INNER JOIN user_download_subscriptions ON downloads_to_videos.download_id = user_download_subscriptions.download_id WHERE user_download_subscriptions.download_id = $1 AND user_download_subscriptions.user_id = $2 AND dlstatus = 2)"
+	sql := "UPDATE videos SET dlstatus = 0 WHERE videos.id IN (SELECT videos.id FROM videos INNER JOIN downloads_to_videos ON downloads_to_videos.video_id = videos.id INNER JOIN user_download_subscriptions ON downloads_to_videos.download_id = user_download_subscriptions.download_id WHERE user_download_subscriptions.download_id = $1 AND user_download_subscriptions.user_id = $2 AND dlstatus = 2 AND failure_permanent IS false)"
 	_, err := m.Db.Exec(sql, downloadID, userID)
 	return err
 }
@@ -254,50 +258,6 @@ func (m *ArchiveRequestRepo) GetDownloadsInProgress() ([]Video, error) {
 	return videos, err
 }
 
-type VideoURL struct {
-	URL string `db:"url"`
-	ID  int    `db:"id"`
-}
-
-func (m *ArchiveRequestRepo) GetUnclassifiedVideoURLs() ([]VideoURL, error) {
-	var videos []VideoURL
-	sql := "select url, id FROM videos WHERE content_category IS null ORDER BY dlStatus ASC"
-
-	err := m.Db.Select(&videos, sql)
-	return videos, err
-}
-
-func (m *ArchiveRequestRepo) UpdateClassification(category string, id int) error {
-	sql := "UPDATE videos SET content_category = $1 WHERE id = $2"
-	_, err := m.Db.Exec(sql, category, id)
-	return err
-}
-
-type InferenceCategory struct {
-	ID       int    `db:"id"`
-	Tag      string `db:"tag"`
-	Category string `db:"category"` // postgres lowercased it, lol
-}
-
-func (m *ArchiveRequestRepo) GetInferenceCategories() ([]InferenceCategory, error) {
-	var entries []InferenceCategory
-	sql := "select id, tag, category from inference_categories order by id DESC"
-	err := m.Db.Select(&entries, sql)
-	return entries, err
-}
-
-func (m *ArchiveRequestRepo) AddInferenceCategory(entry *proto.InferenceEntry) error {
-	sql := "INSERT INTO inference_categories (tag, category) VALUES ($1, $2)"
-	_, err := m.Db.Exec(sql, entry.Tag, entry.Category)
-	return err
-}
-
-func (m *ArchiveRequestRepo) WipeDownloadsInProgress() error {
-	sql := "UPDATE videos SET dlStatus = 0 WHERE dlStatus >= 3"
-	_, err := m.Db.Exec(sql)
-	return err
-}
-
 func GetWebsiteFromURL(u string) (string, error) {
 	urlParsed, err := url.Parse(u)
 	if err != nil {
@@ -2,6 +2,7 @@ package models
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"time"
 
@@ -23,67 +24,52 @@ func (v *CategoryDLRequest) RefreshLock() error {
 
 var NeverDownloaded = errors.New("no video for category")
 
-const (
-	MINIMUM_BACKOFF_TIME   = time.Hour * 24
-	MAXIMUM_BACKOFF_FACTOR = 8 // 8 days
-)
-
-// IsBackingOff indicates whether the archive request is backing off from full content syncs
-// Context: videos can be added to a category of content at any time; some categories are updated frequently, and some
-// tend to be stagnant. We should vary the rate at which we fully sync content from a given category based on how
-// frequently it's updated. Exponential backoff is used as the backoff strategy.
-func (v *CategoryDLRequest) IsBackingOff() (bool, error) {
-	var lastSynced time.Time
-	var backoffFactor int
-	sql := "SELECT last_synced, backoff_factor FROM downloads WHERE id = $1"
-
-	rows := v.Db.QueryRow(sql, v.Id)
-	err := rows.Scan(&lastSynced, &backoffFactor)
-	if err != nil {
-		return false, err
-	}
-
-	// I did what I had to do...
-	return time.Now().Sub(lastSynced.Add(MINIMUM_BACKOFF_TIME*time.Duration(backoffFactor))) < 0, nil
-}
-
-func (v *CategoryDLRequest) ReportSyncHit() error {
-	sql := "UPDATE downloads SET backoff_factor = 1, last_synced = Now() WHERE id = $1"
-	_, err := v.Db.Exec(sql, v.Id)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
+// MINIMUM_BACKOFF_TIME is the shortest interval between full syncs of a category of content, see SyncPolicy
+const MINIMUM_BACKOFF_TIME = time.Hour * 24
 
-func (v *CategoryDLRequest) ReportSyncMiss() error {
-	// maybe there's an easier way to do this? It doesn't really matter though
+// ReportSync records the outcome of a full sync of this category of content, and schedules the next one according to
+// the policy. syncErr is the error the sync failed with, if any.
+func (v *CategoryDLRequest) ReportSync(policy SyncPolicy, newVideos int, syncErr error) error {
 	tx, err := v.Db.BeginTx(context.Background(), nil)
 	if err != nil {
 		return err
 	}
-	var backoff_factor uint32
-	row := tx.QueryRow("SELECT backoff_factor FROM downloads WHERE id = $1", v.Id)
-	err = row.Scan(&backoff_factor)
+
+	var lastSynced sql.NullTime
+	var intervalSeconds int64
+	var state SyncState
+	row := tx.QueryRow("SELECT last_synced, sync_interval_seconds, new_video_rate, sync_failures FROM downloads WHERE id = $1 FOR UPDATE", v.Id)
+	err = row.Scan(&lastSynced, &intervalSeconds, &state.NewVideoRate, &state.Failures)
 	if err != nil {
 		tx.Rollback()
 		return err
 	}
 
-	sql := "UPDATE downloads SET backoff_factor = $1, last_synced = Now() WHERE id = $2"
-	_, err = v.Db.Exec(sql, min(MAXIMUM_BACKOFF_FACTOR, backoff_factor*2), v.Id)
-	if err != nil {
-		tx.Rollback()
-		return err
+	state.Interval = time.Duration(intervalSeconds) * time.Second
+	if lastSynced.Valid && lastSynced.Time.After(time.Unix(0, 0)) {
+		state.LastSynced = lastSynced.Time
 	}
 
-	err = tx.Commit()
+	now := time.Now()
+	next, wait, reason := policy.Next(state, newVideos, syncErr, now)
+
+	// backoff_factor is kept up to date for anything still reading it
+	backoffFactor := int64(next.Interval / MINIMUM_BACKOFF_TIME)
+	if backoffFactor < 1 {
+		backoffFactor = 1
+	}
+
+	lastSynced = sql.NullTime{Time: next.LastSynced, Valid: !next.LastSynced.IsZero()}
+	query := "UPDATE downloads SET last_synced = $1, sync_interval_seconds = $2, new_video_rate = $3, next_sync_at = $4, " +
+		"sync_reason = $5, backoff_factor = $6, sync_failures = $7 WHERE id = $8"
+	_, err = tx.Exec(query, lastSynced, int64(next.Interval/time.Second), next.NewVideoRate, now.Add(wait),
+		reason, backoffFactor, next.Failures, v.Id)
 	if err != nil {
+		tx.Rollback()
 		return err
 	}
 
-	return nil
+	return tx.Commit()
 }
 
 // Idempotent, ensures that videos are added and correct associations are created
@@ -132,10 +118,3 @@ func (v *CategoryDLRequest) AddVideo(videoID, url string) (bool, error) {
 
 	return rowsAffected >= 1, nil
 }
-
-func min(a, b uint32) uint32 {
-	if a < b {
-		return a
-	}
-	return b
-}
@@ -0,0 +1,173 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/horahoradev/horahora/scheduler/internal/classifier"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+var ErrInferenceCategoryNotFound = errors.New("inference category not found")
+
+// InferenceCategory is a classification rule, see the classifier package
+type InferenceCategory struct {
+	ID       int64   `db:"id"`
+	Category string  `db:"category"` // postgres lowercased it, lol
+	Field    string  `db:"field"`
+	Pattern  string  `db:"pattern"`
+	Weight   float64 `db:"weight"`
+	Priority int     `db:"priority"`
+}
+
+func (c InferenceCategory) Rule() classifier.Rule {
+	return classifier.Rule{
+		ID:       c.ID,
+		Category: c.Category,
+		Field:    classifier.Field(c.Field),
+		Pattern:  c.Pattern,
+		Weight:   c.Weight,
+		Priority: c.Priority,
+	}
+}
+
+func (m *ArchiveRequestRepo) GetInferenceCategories() ([]InferenceCategory, error) {
+	entries := make([]InferenceCategory, 0)
+	sql := "select id, category, field, pattern, weight, priority from inference_categories order by id DESC"
+	err := m.Db.Select(&entries, sql)
+	return entries, err
+}
+
+// AddInferenceCategory saves a new rule, and sets its ID
+func (m *ArchiveRequestRepo) AddInferenceCategory(entry *InferenceCategory) error {
+	return m.changeRules(func(tx *sqlx.Tx) error {
+		sql := "INSERT INTO inference_categories (category, field, pattern, weight, priority) VALUES ($1, $2, $3, $4, $5) RETURNING id"
+		return tx.Get(&entry.ID, sql, entry.Category, entry.Field, entry.Pattern, entry.Weight, entry.Priority)
+	})
+}
+
+func (m *ArchiveRequestRepo) UpdateInferenceCategory(entry InferenceCategory) error {
+	return m.changeRules(func(tx *sqlx.Tx) error {
+		sql := "UPDATE inference_categories SET category = $2, field = $3, pattern = $4, weight = $5, priority = $6 WHERE id = $1"
+		res, err := tx.Exec(sql, entry.ID, entry.Category, entry.Field, entry.Pattern, entry.Weight, entry.Priority)
+		if err != nil {
+			return err
+		}
+		return rowAffected(res)
+	})
+}
+
+func (m *ArchiveRequestRepo) DeleteInferenceCategory(id int64) error {
+	return m.changeRules(func(tx *sqlx.Tx) error {
+		res, err := tx.Exec("DELETE FROM inference_categories WHERE id = $1", id)
+		if err != nil {
+			return err
+		}
+		return rowAffected(res)
+	})
+}
+
+func rowAffected(res sql.Result) error {
+	n, err := res.RowsAffected()
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return ErrInferenceCategoryNotFound
+	}
+	return nil
+}
+
+// changeRules makes a change to the rules, and bumps their version so that videos are classified again
+func (m *ArchiveRequestRepo) changeRules(change func(tx *sqlx.Tx) error) error {
+	tx, err := m.Db.BeginTxx(context.TODO(), nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = change(tx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("SELECT nextval('inference_rules_version')")
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// LoadClassifier returns a classifier for the current rules, along with their version
+func LoadClassifier(db *sqlx.DB) (*classifier.Classifier, int64, error) {
+	// The version is read first, so that a change made in between is picked up by the next pass rather than missed
+	var version int64
+	err := db.Get(&version, "SELECT last_value FROM inference_rules_version")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var entries []InferenceCategory
+	err = db.Select(&entries, "select id, category, field, pattern, weight, priority from inference_categories order by id")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rules := make([]classifier.Rule, 0, len(entries))
+	for _, entry := range entries {
+		rules = append(rules, entry.Rule())
+	}
+
+	c, err := classifier.New(rules)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return c, version, nil
+}
+
+func (m *ArchiveRequestRepo) LoadClassifier() (*classifier.Classifier, int64, error) {
+	return LoadClassifier(m.Db)
+}
+
+// ClassifiableVideo is a downloaded video, with the metadata it's classified from
+type ClassifiableVideo struct {
+	ID         int            `db:"id"`
+	VideoID    string         `db:"video_id"` // Foreign ID
+	URL        string         `db:"url"`
+	Category   sql.NullString `db:"content_category"`
+	Title      string         `db:"title"`
+	Uploader   sql.NullString `db:"uploader"`
+	UploaderID sql.NullString `db:"uploader_id"`
+	Tags       pq.StringArray `db:"tags"`
+}
+
+func (v ClassifiableVideo) ClassifierVideo() classifier.Video {
+	// Videos only make it here with a URL we could parse when they were downloaded
+	site, _ := GetWebsiteFromURL(v.URL)
+	return classifier.Video{
+		Title:      v.Title,
+		Uploader:   v.Uploader.String,
+		UploaderID: v.UploaderID.String,
+		Site:       site,
+		Tags:       v.Tags,
+	}
+}
+
+// GetVideosToReclassify returns downloaded videos which were classified with rules older than version
+func (m *ArchiveRequestRepo) GetVideosToReclassify(version int64, limit int) ([]ClassifiableVideo, error) {
+	var videos []ClassifiableVideo
+	sql := "SELECT id, video_ID, url, content_category, title, uploader, uploader_id, tags FROM videos " +
+		"WHERE dlStatus = 1 AND title IS NOT NULL AND COALESCE(classified_version, 0) < $1 ORDER BY id LIMIT $2"
+	err := m.Db.Select(&videos, sql, version, limit)
+	return videos, err
+}
+
+func (m *ArchiveRequestRepo) UpdateClassification(id int, res classifier.Result, version int64) error {
+	sql := "UPDATE videos SET content_category = $2, category_confidence = $3, classified_version = $4 WHERE id = $1"
+	_, err := m.Db.Exec(sql, id, res.Category, res.Confidence, version)
+	return err
+}
@@ -0,0 +1,255 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	log "github.com/sirupsen/logrus"
+)
+
+// Download events are sent through Postgres' LISTEN/NOTIFY, so that every scheduler replica sees the events from every
+// other replica's downloaders. Notification payloads are capped at 8000 bytes, so they only have what's bounded in size;
+// subscribers are looked up when the event is received.
+const downloadEventsChannel = "download_events"
+
+const (
+	// Failure messages are cut down to this many bytes, so that the payload stays under the cap
+	maxEventMessageLength = 2000
+
+	// How long the subscribers of an archival request are cached by the broker
+	subscriberCacheTTL = 10 * time.Second
+)
+
+type DownloadEventType string
+
+const (
+	EventQueued   DownloadEventType = "queued"
+	EventStarted  DownloadEventType = "started"
+	EventProgress DownloadEventType = "progress"
+	EventUploaded DownloadEventType = "uploaded"
+	EventFailed   DownloadEventType = "failed"
+)
+
+type DownloadEvent struct {
+	Type       DownloadEventType `json:"type"`
+	VideoID    string            `json:"video_id"` // Foreign ID
+	Website    string            `json:"website"`
+	URL        string            `json:"url"`
+	ParentURL  string            `json:"parent_url"`
+	DownloadID int               `json:"download_id"`
+	Progress   float64           `json:"progress"` // percentage, only set for progress events
+	Message    string            `json:"message"`
+	Timestamp  time.Time         `json:"timestamp"`
+	UserIDs    []int64           `json:"-"` // users subscribed to the archival request, looked up by the broker
+}
+
+// PublishEvent notifies everyone watching downloads about a change in this video's download
+func (v *VideoDLRequest) PublishEvent(eventType DownloadEventType, progress float64, message string) error {
+	website, err := GetWebsiteFromURL(v.URL)
+	if err != nil {
+		return err
+	}
+
+	event := DownloadEvent{
+		Type:       eventType,
+		VideoID:    v.VideoID,
+		Website:    website,
+		URL:        v.URL,
+		ParentURL:  v.ParentURL,
+		DownloadID: v.DownloaddID,
+		Progress:   progress,
+		Message:    truncateMessage(message, maxEventMessageLength),
+		Timestamp:  time.Now(),
+	}
+
+	payload, err := json.Marshal(&event)
+	if err != nil {
+		return err
+	}
+
+	_, err = v.Db.Exec("SELECT pg_notify($1, $2)", downloadEventsChannel, string(payload))
+	return err
+}
+
+// truncateMessage cuts the message down to at most n bytes, without splitting a character
+func truncateMessage(message string, n int) string {
+	if len(message) <= n {
+		return message
+	}
+
+	for n > 0 && !utf8.RuneStart(message[n]) {
+		n--
+	}
+	return message[:n]
+}
+
+// DownloadEventBroker listens for download events, and fans them out to subscribers
+type DownloadEventBroker struct {
+	db       *sqlx.DB
+	listener *pq.Listener
+
+	// Archival request subscribers, by download ID. Only used by Run.
+	subscriberCache map[int]cachedSubscribers
+
+	mu          sync.Mutex
+	subscribers map[chan DownloadEvent]struct{}
+	closed      bool
+}
+
+type cachedSubscribers struct {
+	userIDs   []int64
+	fetchedAt time.Time
+}
+
+func NewDownloadEventBroker(db *sqlx.DB, connStr string) (*DownloadEventBroker, error) {
+	listener := pq.NewListener(connStr, time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Errorf("Download event listener error: %s", err)
+		}
+	})
+
+	err := listener.Listen(downloadEventsChannel)
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	return &DownloadEventBroker{
+		db:              db,
+		listener:        listener,
+		subscriberCache: make(map[int]cachedSubscribers),
+		subscribers:     make(map[chan DownloadEvent]struct{}),
+	}, nil
+}
+
+// Run fans out events until the context is canceled, then closes every subscription
+func (b *DownloadEventBroker) Run(ctx context.Context) {
+	defer func() {
+		b.listener.Close()
+
+		b.mu.Lock()
+		b.closed = true
+		for ch := range b.subscribers {
+			close(ch)
+			delete(b.subscribers, ch)
+		}
+		b.mu.Unlock()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case n := <-b.listener.Notify:
+			// nil is sent after the listener reconnects, events may have been lost in the meantime
+			if n == nil {
+				continue
+			}
+
+			var event DownloadEvent
+			err := json.Unmarshal([]byte(n.Extra), &event)
+			if err != nil {
+				log.Errorf("Could not unmarshal download event. Err: %s", err)
+				continue
+			}
+
+			event.UserIDs, err = b.downloadSubscribers(event.DownloadID)
+			if err != nil {
+				log.Errorf("Could not look up subscribers of archival request %d. Err: %s", event.DownloadID, err)
+				continue
+			}
+
+			b.publish(event)
+		case <-time.After(time.Minute * 5):
+			go b.listener.Ping()
+		}
+	}
+}
+
+// downloadSubscribers returns the users subscribed to the archival request. Progress events come thick and fast, so
+// they're cached for a little while.
+func (b *DownloadEventBroker) downloadSubscribers(downloadID int) ([]int64, error) {
+	if cached, ok := b.subscriberCache[downloadID]; ok && time.Since(cached.fetchedAt) < subscriberCacheTTL {
+		return cached.userIDs, nil
+	}
+
+	userIDs := []int64{}
+	err := b.db.Select(&userIDs, "SELECT user_id FROM user_download_subscriptions WHERE download_id = $1", downloadID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Expired entries are dropped now and then, so that the cache doesn't grow forever
+	if len(b.subscriberCache) > 1000 {
+		for id, cached := range b.subscriberCache {
+			if time.Since(cached.fetchedAt) >= subscriberCacheTTL {
+				delete(b.subscriberCache, id)
+			}
+		}
+	}
+
+	b.subscriberCache[downloadID] = cachedSubscribers{userIDs: userIDs, fetchedAt: time.Now()}
+	return userIDs, nil
+}
+
+func (b *DownloadEventBroker) publish(event DownloadEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+			// Slow consumers miss events rather than holding everyone else up
+		}
+	}
+}
+
+// Subscribe returns a channel which receives every download event. It's closed when the broker stops.
+func (b *DownloadEventBroker) Subscribe() chan DownloadEvent {
+	ch := make(chan DownloadEvent, 100)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		close(ch)
+		return ch
+	}
+
+	b.subscribers[ch] = struct{}{}
+	return ch
+}
+
+func (b *DownloadEventBroker) Unsubscribe(ch chan DownloadEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.subscribers[ch]; ok {
+		delete(b.subscribers, ch)
+		close(ch)
+	}
+}
+
+// Matches indicates whether an event is relevant to a watcher filtering by archival request and/or user
+func (e DownloadEvent) Matches(downloadID uint64, userID int64) bool {
+	if downloadID != 0 && uint64(e.DownloadID) != downloadID {
+		return false
+	}
+
+	if userID != 0 {
+		for _, id := range e.UserIDs {
+			if id == userID {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
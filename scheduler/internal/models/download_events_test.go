@@ -0,0 +1,33 @@
+package models
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncateMessage(t *testing.T) {
+	assert.Equal(t, "short", truncateMessage("short", 10))
+	assert.Equal(t, "abc", truncateMessage("abcdef", 3))
+	// ミ is 3 bytes, and isn't split
+	assert.Equal(t, "初音", truncateMessage("初音ミク", 8))
+}
+
+func TestDownloadEventPayload(t *testing.T) {
+	event := DownloadEvent{
+		Type:       EventFailed,
+		DownloadID: 1,
+		URL:        strings.Repeat("u", 255),
+		ParentURL:  strings.Repeat("p", 255),
+		Message:    truncateMessage(strings.Repeat("m", 10000), maxEventMessageLength),
+		UserIDs:    make([]int64, 10000),
+	}
+
+	// Subscribers aren't sent, however many there are
+	payload, err := json.Marshal(&event)
+	assert.NoError(t, err)
+	assert.Less(t, len(payload), 8000)
+	assert.NotContains(t, string(payload), "user_ids")
+}
@@ -0,0 +1,213 @@
+package models
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// MAX_JOB_ATTEMPTS is the number of times a job can be claimed before it's given up on. Jobs are only reclaimed if
+	// the worker holding them died (or lost its lease), so a video which keeps killing its worker won't be retried forever.
+	MAX_JOB_ATTEMPTS = 5
+	// MAX_QUEUED_JOBS stops the poller from enqueueing the entire backlog at once
+	MAX_QUEUED_JOBS = 100
+)
+
+var ErrLeaseLost = errors.New("lease is no longer held by this worker")
+
+// JobQueue is a durable download queue backed by the download_jobs table.
+// Workers claim jobs with a lease, and keep the lease alive with heartbeats while they work on them. If a worker dies,
+// its lease expires and the job becomes visible to the other workers (on this replica or any other) again.
+type JobQueue struct {
+	Db            *sqlx.DB
+	Owner         string
+	LeaseDuration time.Duration
+}
+
+func NewJobQueue(db *sqlx.DB, leaseDuration time.Duration) (*JobQueue, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+
+	return &JobQueue{
+		Db:            db,
+		Owner:         fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+		LeaseDuration: leaseDuration,
+	}, nil
+}
+
+// Worker returns a copy of the queue whose leases are owned by the nth worker of this replica
+func (q *JobQueue) Worker(n int) *JobQueue {
+	return &JobQueue{
+		Db:            q.Db,
+		Owner:         fmt.Sprintf("%s/%d", q.Owner, n),
+		LeaseDuration: q.LeaseDuration,
+	}
+}
+
+// Enqueue creates jobs for up to limit approved and undownloaded videos from the given download request, and marks them as queued.
+// Videos which already have a job are left alone.
+func (q *JobQueue) Enqueue(parentURL string, limit int) ([]*VideoDLRequest, error) {
+	sql := "WITH j AS (SELECT v.id AS video_id, downloads.id AS download_id FROM downloads INNER JOIN downloads_to_videos d ON downloads.id = d.download_id " +
+		"INNER JOIN videos v ON d.video_id = v.id WHERE downloads.url = $1 AND v.dlStatus = 0 AND is_approved IS true LIMIT $2), " +
+		"ins AS (INSERT INTO download_jobs (video_id, download_id, parent_url) SELECT video_id, download_id, $1 FROM j ON CONFLICT (video_id) DO NOTHING RETURNING id, video_id, download_id), " +
+		"up AS (UPDATE videos SET dlStatus = 4 WHERE videos.id IN (SELECT video_id FROM ins) RETURNING videos.id, videos.video_id, videos.url) " +
+		"SELECT ins.id, up.id, up.video_id, up.url, ins.download_id FROM ins INNER JOIN up ON up.id = ins.video_id"
+
+	rows, err := q.Db.Query(sql, parentURL, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ret []*VideoDLRequest
+	for rows.Next() {
+		req := VideoDLRequest{
+			ParentURL: parentURL,
+			Db:        q.Db,
+		}
+
+		err = rows.Scan(&req.JobID, &req.ID, &req.VideoID, &req.URL, &req.DownloaddID)
+		if err != nil {
+			return nil, err
+		}
+
+		ret = append(ret, &req)
+	}
+
+	return ret, rows.Err()
+}
+
+// QueuedCount returns the number of jobs which haven't been claimed yet
+func (q *JobQueue) QueuedCount() (int, error) {
+	var count int
+	err := q.Db.Get(&count, "SELECT count(*) FROM download_jobs WHERE status = 'queued'")
+	return count, err
+}
+
+// Claim leases the next available job. Jobs whose lease has expired are treated as available.
+// Returns nil if there's nothing to do.
+func (q *JobQueue) Claim() (*VideoDLRequest, error) {
+	claimSQL := "WITH claimed AS (UPDATE download_jobs SET status = 'running', lease_owner = $1, lease_expires_at = Now() + $2 * interval '1 second', " +
+		"heartbeat_at = Now(), attempts = attempts + 1 WHERE id = (SELECT id FROM download_jobs WHERE (status = 'queued' AND available_at <= Now()) " +
+		"OR (status = 'running' AND lease_expires_at < Now()) ORDER BY available_at, id LIMIT 1 FOR UPDATE SKIP LOCKED) " +
+		"RETURNING id, video_id, download_id, parent_url, attempts, failures, failure_class) " +
+		"SELECT claimed.id, claimed.attempts, claimed.failures, COALESCE(claimed.failure_class, ''), v.id, v.video_id, v.url, claimed.download_id, claimed.parent_url " +
+		"FROM claimed INNER JOIN videos v ON v.id = claimed.video_id"
+
+	req := VideoDLRequest{Db: q.Db}
+	row := q.Db.QueryRow(claimSQL, q.Owner, q.LeaseDuration.Seconds())
+	err := row.Scan(&req.JobID, &req.Attempts, &req.Failures, &req.FailureClass, &req.ID, &req.VideoID, &req.URL, &req.DownloaddID, &req.ParentURL)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+
+	return &req, nil
+}
+
+// Heartbeat extends the lease on a job. ErrLeaseLost is returned if the lease expired and another worker claimed the job.
+func (q *JobQueue) Heartbeat(job *VideoDLRequest) error {
+	sql := "UPDATE download_jobs SET heartbeat_at = Now(), lease_expires_at = Now() + $1 * interval '1 second' WHERE id = $2 AND lease_owner = $3 AND status = 'running'"
+	res, err := q.Db.Exec(sql, q.LeaseDuration.Seconds(), job.JobID, q.Owner)
+	if err != nil {
+		return err
+	}
+
+	return checkLeaseHeld(res)
+}
+
+// KeepAlive heartbeats the job in the background until the returned function is called
+func (q *JobQueue) KeepAlive(job *VideoDLRequest) func() {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(q.LeaseDuration / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				err := q.Heartbeat(job)
+				if err != nil {
+					log.Errorf("Could not heartbeat job %d for video %s. Err: %s", job.JobID, job.VideoID, err)
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+	}
+}
+
+// Complete removes a finished job from the queue. The outcome is recorded on the video itself.
+func (q *JobQueue) Complete(job *VideoDLRequest) error {
+	res, err := q.Db.Exec("DELETE FROM download_jobs WHERE id = $1 AND lease_owner = $2", job.JobID, q.Owner)
+	if err != nil {
+		return err
+	}
+
+	return checkLeaseHeld(res)
+}
+
+// Release gives up the lease on a job, and makes it available again after the given delay.
+// The claim isn't counted as an attempt, since the worker handed the job back rather than dying with it.
+func (q *JobQueue) Release(job *VideoDLRequest, delay time.Duration) error {
+	sql := "UPDATE download_jobs SET status = 'queued', lease_owner = NULL, lease_expires_at = NULL, available_at = Now() + $1 * interval '1 second', " +
+		"attempts = GREATEST(attempts - 1, 0) " +
+		"WHERE id = $2 AND lease_owner = $3"
+	res, err := q.Db.Exec(sql, delay.Seconds(), job.JobID, q.Owner)
+	if err != nil {
+		return err
+	}
+
+	return checkLeaseHeld(res)
+}
+
+// RecordFailure counts a failed attempt at the job, which is then either released to be retried later or completed.
+// Failures are counted per class, so the count starts again from 1 if the class is different from the last failure's.
+func (q *JobQueue) RecordFailure(job *VideoDLRequest, failureClass, errorMsg string) error {
+	failureSQL := "UPDATE download_jobs SET failures = CASE WHEN failure_class IS DISTINCT FROM $1 THEN 1 ELSE failures + 1 END, " +
+		"failure_class = $1, last_error = $2 WHERE id = $3 AND lease_owner = $4 RETURNING failures"
+	err := q.Db.Get(&job.Failures, failureSQL, failureClass, errorMsg, job.JobID, q.Owner)
+	if err == sql.ErrNoRows {
+		return ErrLeaseLost
+	} else if err != nil {
+		return err
+	}
+
+	job.FailureClass = failureClass
+	return nil
+}
+
+// FailuresAfter returns how many failures of the class in a row the job will have had after one more
+func (v *VideoDLRequest) FailuresAfter(failureClass string) int {
+	if v.FailureClass != failureClass {
+		return 1
+	}
+	return v.Failures + 1
+}
+
+func checkLeaseHeld(res sql.Result) error {
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrLeaseLost
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFailuresAfter(t *testing.T) {
+	job := VideoDLRequest{}
+	assert.Equal(t, 1, job.FailuresAfter("network"))
+
+	job = VideoDLRequest{Failures: 4, FailureClass: "network"}
+	assert.Equal(t, 5, job.FailuresAfter("network"))
+	// Another class starts its own count
+	assert.Equal(t, 1, job.FailuresAfter("rate_limited"))
+}
@@ -0,0 +1,150 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/horahoradev/horahora/scheduler/internal/ratelimit"
+)
+
+const (
+	// DefaultTargetNewPerSync is how many new videos we'd like to find on each sync of a busy category
+	DefaultTargetNewPerSync = 10
+	// How much weight the latest sync has in the new video rate
+	newVideoRateSmoothing = 0.5
+
+	// Failed syncs are retried after these delays, doubling with each failure in a row
+	DefaultRetryBaseDelay = 15 * time.Minute
+	DefaultRetryMaxDelay  = 6 * time.Hour
+)
+
+// SyncPolicy decides how long to wait between full syncs of a category of content.
+// Context: videos can be added to a category of content at any time; some categories are updated frequently, and some
+// tend to be stagnant. The policy tracks how many new videos each category gets per day, and spaces out syncs so that
+// each one finds roughly TargetNewPerSync of them. Categories which stop getting new videos back off exponentially.
+// Failed syncs are retried on their own, shorter backoff, so that one bad sync doesn't hide a category for the whole interval.
+type SyncPolicy struct {
+	MinInterval      time.Duration
+	MaxInterval      time.Duration
+	TargetNewPerSync float64
+	RetryBaseDelay   time.Duration
+	RetryMaxDelay    time.Duration
+}
+
+func NewSyncPolicy(maxInterval time.Duration) (SyncPolicy, error) {
+	if maxInterval < MINIMUM_BACKOFF_TIME {
+		return SyncPolicy{}, fmt.Errorf("max sync interval %s is less than the minimum of %s", maxInterval, MINIMUM_BACKOFF_TIME)
+	}
+
+	return SyncPolicy{
+		MinInterval:      MINIMUM_BACKOFF_TIME,
+		MaxInterval:      maxInterval,
+		TargetNewPerSync: DefaultTargetNewPerSync,
+		RetryBaseDelay:   DefaultRetryBaseDelay,
+		RetryMaxDelay:    DefaultRetryMaxDelay,
+	}, nil
+}
+
+// SyncState is what the policy knows about a category between syncs
+type SyncState struct {
+	// LastSynced is when the category was last synced successfully, zero if it never has been
+	LastSynced time.Time
+	Interval   time.Duration
+	// NewVideoRate is a moving average of new videos per day
+	NewVideoRate float64
+	// Failures is the number of syncs in a row which failed
+	Failures int
+}
+
+// Next returns the state after a sync which finished at now and found newVideos, how long to wait until the next sync,
+// and a human readable reason for it. A failed sync leaves the interval and rate alone, since it tells us nothing about
+// the category, and is retried sooner.
+func (p SyncPolicy) Next(state SyncState, newVideos int, syncErr error, now time.Time) (SyncState, time.Duration, string) {
+	next := state
+	next.Interval = p.clamp(state.Interval)
+
+	if syncErr != nil {
+		next.Failures++
+		wait := p.retryDelay(next.Failures, next.Interval, syncErr)
+		return next, wait, fmt.Sprintf("sync failed %d times in a row, trying again in %s: %s", next.Failures, wait, syncErr)
+	}
+
+	next, reason := p.afterSync(next, newVideos, now)
+	next.Failures = 0
+	return next, next.Interval, reason
+}
+
+// retryDelay returns how long to wait before retrying after the given number of failed syncs in a row. It's never
+// longer than the interval, since the sync would happen then anyway, and it waits out the rate limiter if that's what
+// the sync failed on.
+func (p SyncPolicy) retryDelay(failures int, interval time.Duration, syncErr error) time.Duration {
+	delay := p.RetryBaseDelay
+	for i := 1; i < failures && delay < p.RetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > p.RetryMaxDelay {
+		delay = p.RetryMaxDelay
+	}
+
+	var waitErr *ratelimit.WaitError
+	if errors.As(syncErr, &waitErr) && waitErr.RetryAfter > delay {
+		delay = waitErr.RetryAfter
+	}
+
+	if delay > interval {
+		delay = interval
+	}
+	return delay
+}
+
+// afterSync adapts the interval to how many new videos a successful sync found
+func (p SyncPolicy) afterSync(state SyncState, newVideos int, now time.Time) (SyncState, string) {
+	next := state
+	if state.LastSynced.IsZero() {
+		// Everything looks new on the first sync, so it says nothing about the rate
+		next.LastSynced = now
+		next.Interval = p.MinInterval
+		return next, fmt.Sprintf("first sync found %d videos", newVideos)
+	}
+
+	next.LastSynced = now
+
+	days := now.Sub(state.LastSynced).Hours() / 24
+	if days <= 0 {
+		days = p.MinInterval.Hours() / 24
+	}
+	observed := float64(newVideos) / days
+	next.NewVideoRate = newVideoRateSmoothing*observed + (1-newVideoRateSmoothing)*state.NewVideoRate
+
+	if newVideos == 0 {
+		next.Interval = p.clamp(next.Interval * 2)
+		if next.Interval == p.MaxInterval {
+			return next, fmt.Sprintf("no new videos, syncing at the maximum interval of %s", next.Interval)
+		}
+		return next, fmt.Sprintf("no new videos, backing off to %s", next.Interval)
+	}
+
+	desired := p.MaxInterval
+	if next.NewVideoRate > 0 {
+		desired = time.Duration(p.TargetNewPerSync / next.NewVideoRate * float64(24*time.Hour))
+	}
+
+	// Shrink as far as needed straight away, but grow gradually in case the last few syncs were a lull
+	if desired > next.Interval*2 {
+		desired = next.Interval * 2
+	}
+	next.Interval = p.clamp(desired)
+
+	return next, fmt.Sprintf("%d new videos (about %.1f per day), next sync in %s", newVideos, next.NewVideoRate, next.Interval)
+}
+
+func (p SyncPolicy) clamp(d time.Duration) time.Duration {
+	switch {
+	case d < p.MinInterval:
+		return p.MinInterval
+	case d > p.MaxInterval:
+		return p.MaxInterval
+	}
+	return d
+}
@@ -0,0 +1,89 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/horahoradev/horahora/scheduler/internal/ratelimit"
+	"github.com/stretchr/testify/assert"
+)
+
+const day = 24 * time.Hour
+
+func TestSyncPolicy(t *testing.T) {
+	policy, err := NewSyncPolicy(8 * day)
+	assert.NoError(t, err)
+
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	// First sync
+	next, wait, _ := policy.Next(SyncState{}, 500, nil, now)
+	assert.Equal(t, SyncState{LastSynced: now, Interval: day}, next)
+	assert.Equal(t, day, wait)
+
+	// Nothing new, so back off up to the max
+	next, _, _ = policy.Next(SyncState{LastSynced: now.Add(-day), Interval: day}, 0, nil, now)
+	assert.Equal(t, 2*day, next.Interval)
+	assert.Equal(t, now, next.LastSynced)
+
+	next, _, reason := policy.Next(SyncState{LastSynced: now.Add(-6 * day), Interval: 6 * day}, 0, nil, now)
+	assert.Equal(t, 8*day, next.Interval)
+	assert.Contains(t, reason, "maximum")
+
+	// Busy categories are synced as often as allowed
+	next, _, _ = policy.Next(SyncState{LastSynced: now.Add(-4 * day), Interval: 4 * day}, 200, nil, now)
+	assert.Equal(t, day, next.Interval)
+	assert.Equal(t, 25.0, next.NewVideoRate)
+
+	// Slow categories want a longer interval, but only get to double it at a time
+	next, _, _ = policy.Next(SyncState{LastSynced: now.Add(-day), Interval: day, NewVideoRate: 1}, 1, nil, now)
+	assert.Equal(t, 2*day, next.Interval)
+
+	// In between
+	next, _, _ = policy.Next(SyncState{LastSynced: now.Add(-4 * day), Interval: 4 * day, NewVideoRate: 4}, 16, nil, now)
+	assert.Equal(t, 4.0, next.NewVideoRate)
+	assert.Equal(t, 60*time.Hour, next.Interval)
+
+	_, err = NewSyncPolicy(time.Hour)
+	assert.Error(t, err)
+}
+
+func TestSyncPolicyFailures(t *testing.T) {
+	policy, err := NewSyncPolicy(8 * day)
+	assert.NoError(t, err)
+
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	state := SyncState{LastSynced: now.Add(-3 * day), Interval: 8 * day, NewVideoRate: 3}
+
+	// Failures leave the interval and rate alone, and are retried after a backoff instead of the whole interval
+	next, wait, reason := policy.Next(state, 0, errors.New("timed out"), now)
+	assert.Equal(t, SyncState{LastSynced: state.LastSynced, Interval: state.Interval, NewVideoRate: 3, Failures: 1}, next)
+	assert.Equal(t, DefaultRetryBaseDelay, wait)
+	assert.Contains(t, reason, "timed out")
+
+	next, wait, _ = policy.Next(next, 0, errors.New("timed out"), now)
+	assert.Equal(t, 2, next.Failures)
+	assert.Equal(t, 2*DefaultRetryBaseDelay, wait)
+
+	// The backoff is capped
+	next.Failures = 20
+	_, wait, _ = policy.Next(next, 0, errors.New("timed out"), now)
+	assert.Equal(t, DefaultRetryMaxDelay, wait)
+
+	// Rate limited syncs wait for the limiter
+	waitErr := fmt.Errorf("could not fetch download list. Err: %w", &ratelimit.WaitError{Site: "www.youtube.com", RetryAfter: 2 * time.Hour})
+	_, wait, _ = policy.Next(state, 0, waitErr, now)
+	assert.Equal(t, 2*time.Hour, wait)
+
+	// Retries never wait longer than the interval
+	short := SyncState{LastSynced: now.Add(-day), Interval: day}
+	_, wait, _ = policy.Next(short, 0, &ratelimit.WaitError{RetryAfter: 3 * day}, now)
+	assert.Equal(t, day, wait)
+
+	// The next successful sync starts over
+	next, wait, _ = policy.Next(next, 5, nil, now)
+	assert.Equal(t, 0, next.Failures)
+	assert.Equal(t, next.Interval, wait)
+}
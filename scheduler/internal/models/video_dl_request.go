@@ -3,7 +3,9 @@ package models
 import (
 	"fmt"
 
+	"github.com/horahoradev/horahora/scheduler/internal/classifier"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type VideoDLRequest struct {
@@ -13,29 +15,49 @@ type VideoDLRequest struct {
 	DownloaddID int
 	URL         string
 	ParentURL   string
+	JobID       int // ID of the download job this request was claimed from
+	Attempts    int // Number of times the job has been claimed, including this one
+	Failures    int // Number of times in a row downloading or uploading the video has failed with FailureClass
+	// Class of the last failure. Each class has its own retry budget, so the count restarts when the class changes.
+	FailureClass string
 }
 
 func (v *VideoDLRequest) SetDownloadSucceeded() error {
-	sql := "UPDATE videos SET dlstatus = 1 WHERE id = $1"
+	sql := "UPDATE videos SET dlstatus = 1, failure_class = NULL, failure_permanent = false WHERE id = $1"
 	_, err := v.Db.Exec(sql, v.ID)
 	if err != nil {
 		return err
 	}
-	// Publish into rabbitmq
-	return v.PublishVideoInprogress(1, "deletion")
+
+	return v.PublishEvent(EventUploaded, 100, "")
 }
 
-func (v *VideoDLRequest) SetDownloadFailed() error {
-	sql := "UPDATE videos SET dlstatus = 2 WHERE id = $1"
-	_, err := v.Db.Exec(sql, v.ID)
+// Classify picks the video's category from its metadata with the current rules, and saves the metadata so that the video
+// can be classified again when the rules change
+func (v *VideoDLRequest) Classify(video classifier.Video) (classifier.Result, error) {
+	c, version, err := LoadClassifier(v.Db)
 	if err != nil {
-		return err
+		return classifier.Result{}, err
 	}
-	// Publish into rabbitmq
-	return v.PublishVideoInprogress(2, "deletion")
+
+	res := c.Classify(video)
+
+	sql := "UPDATE videos SET title = $2, uploader = $3, uploader_id = $4, tags = $5, content_category = $6, category_confidence = $7, " +
+		"classified_version = $8 WHERE id = $1"
+	_, err = v.Db.Exec(sql, v.ID, video.Title, video.Uploader, video.UploaderID, pq.StringArray(video.Tags), res.Category, res.Confidence, version)
+	return res, err
 }
 
-const queueName = "/topic/state"
+// SetDownloadFailed gives up on the video. Permanent failures won't be retried, even if the archival request is.
+func (v *VideoDLRequest) SetDownloadFailed(failureClass string, permanent bool, reason string) error {
+	sql := "UPDATE videos SET dlstatus = 2, failure_class = $1, failure_permanent = $2 WHERE id = $3"
+	_, err := v.Db.Exec(sql, failureClass, permanent, v.ID)
+	if err != nil {
+		return err
+	}
+
+	return v.PublishEvent(EventFailed, 0, reason)
+}
 
 func (v *VideoDLRequest) SetDownloadInProgress() error {
 	sql := "UPDATE videos SET dlStatus = 3 WHERE id = $1"
@@ -43,8 +65,8 @@ func (v *VideoDLRequest) SetDownloadInProgress() error {
 	if err != nil {
 		return err
 	}
-	// Publish into rabbitmq
-	return v.PublishVideoInprogress(3, "insertion")
+
+	return v.PublishEvent(EventStarted, 0, "")
 }
 
 func (v *VideoDLRequest) SetDownloadQueued() error {
@@ -53,54 +75,8 @@ func (v *VideoDLRequest) SetDownloadQueued() error {
 	if err != nil {
 		return err
 	}
-	// Publish into rabbitmq
-	return v.PublishVideoInprogress(4, "insertion")
-}
-
-type VideoProgress struct {
-	VideoID  string
-	Website  string
-	DlStatus string
-}
-
-type ProgressNotification struct {
-	Type  string
-	Video VideoProgress
-}
 
-func (v *VideoDLRequest) PublishVideoInprogress(dlStatus int, action string) error {
-	// website, err := GetWebsiteFromURL(v.ParentURL)
-	// if err != nil {
-	// 	return err
-	// }
-
-	// dlStatusString := ""
-	// if dlStatus == 3 {
-	// 	dlStatusString = "Downloading"
-	// } else if dlStatus == 4 {
-	// 	dlStatusString = "Queued"
-	// }
-
-	// p := ProgressNotification{
-	// 	Video: VideoProgress{
-	// 		VideoID:  v.VideoID,
-	// 		Website:  website,
-	// 		DlStatus: dlStatusString,
-	// 	},
-	// 	Type: action, // insertion or deletion
-	// }
-
-	// payload, err := json.Marshal(&p)
-	// if err != nil {
-	// 	return err
-	// }
-
-	// err = v.Rabbitmq.Send("/topic/state", "text/json", payload, stomp.SendOpt.Receipt, stomp.SendOpt.Header("persistent", "false"), stomp.SendOpt.Header("expires", fmt.Sprintf("%d", time.Now().Local().UnixMilli()+30000)))
-	// if err != nil {
-	// 	return fmt.Errorf("Publish: %v", err)
-	// }
-
-	return nil
+	return v.PublishEvent(EventQueued, 0, "")
 }
 
 type event string
@@ -112,6 +88,15 @@ const (
 )
 
 func (v *VideoDLRequest) RecordEvent(inpEvent event, additionalErrorMsg string) error {
+	return v.recordEvent(inpEvent, "", additionalErrorMsg)
+}
+
+// RecordFailure records an error event along with the class of the failure
+func (v *VideoDLRequest) RecordFailure(failureClass, errorMsg string) error {
+	return v.recordEvent(Error, failureClass, errorMsg)
+}
+
+func (v *VideoDLRequest) recordEvent(inpEvent event, failureClass, additionalErrorMsg string) error {
 	website, err := GetWebsiteFromURL(v.ParentURL)
 	if err != nil {
 		return err
@@ -123,7 +108,7 @@ func (v *VideoDLRequest) RecordEvent(inpEvent event, additionalErrorMsg string)
 		formattedMsg += fmt.Sprintf("\n\nError message: %s", additionalErrorMsg)
 	}
 
-	sql := "insert into archival_events (video_url, download_id, parent_url, event_message, event_time) VALUES ($1, $2, $3, $4, Now())"
-	_, err = v.Db.Exec(sql, v.URL, v.DownloaddID, v.ParentURL, formattedMsg)
+	sql := "insert into archival_events (video_url, download_id, parent_url, event_message, event_time, failure_class) VALUES ($1, $2, $3, $4, Now(), NULLIF($5, ''))"
+	_, err = v.Db.Exec(sql, v.URL, v.DownloaddID, v.ParentURL, formattedMsg, failureClass)
 	return err
 }
@@ -0,0 +1,223 @@
+package ratelimit
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	log "github.com/sirupsen/logrus"
+)
+
+// This package keeps us polite towards the sites we download from. The state lives in Redis, so the limits hold across
+// every scheduler replica.
+//
+// For each site there are three keys:
+// - cooldown, which exists while we're backing off after being throttled
+// - requests, a sorted set of the requests made in the last minute, scored by time
+// - slots, a sorted set of the downloads in progress, scored by when their lease runs out. Leases are renewed while the
+//   download runs, so slots held by a dead replica free themselves up.
+
+const (
+	// How long a slot is held without being renewed
+	slotLeaseDuration = time.Minute
+	// How often slots are renewed
+	slotRenewInterval = time.Second * 20
+	// How long to wait before trying again when all of a site's slots are taken
+	slotPollDelay = time.Second * 5
+)
+
+// Returns 0 if the request may go ahead, the number of milliseconds to wait for the cooldown or rate limit otherwise,
+// or -1 if all slots are taken.
+// KEYS: cooldown, requests, slots. ARGV: requests per minute, max concurrent, slot lease in ms, token
+var acquireScript = redis.NewScript(`
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+
+local cooldown = redis.call('PTTL', KEYS[1])
+if cooldown > 0 then
+	return cooldown
+end
+
+local rpm = tonumber(ARGV[1])
+if rpm > 0 then
+	redis.call('ZREMRANGEBYSCORE', KEYS[2], '-inf', now - 60000)
+	if redis.call('ZCARD', KEYS[2]) >= rpm then
+		local oldest = redis.call('ZRANGE', KEYS[2], 0, 0, 'WITHSCORES')
+		return math.max(1, tonumber(oldest[2]) + 60000 - now)
+	end
+end
+
+local maxConcurrent = tonumber(ARGV[2])
+if maxConcurrent > 0 then
+	redis.call('ZREMRANGEBYSCORE', KEYS[3], '-inf', now)
+	if redis.call('ZCARD', KEYS[3]) >= maxConcurrent then
+		return -1
+	end
+	redis.call('ZADD', KEYS[3], now + tonumber(ARGV[3]), ARGV[4])
+	redis.call('PEXPIRE', KEYS[3], ARGV[3])
+end
+
+if rpm > 0 then
+	redis.call('ZADD', KEYS[2], now, ARGV[4])
+	redis.call('PEXPIRE', KEYS[2], 60000)
+end
+
+return 0
+`)
+
+// KEYS: slots. ARGV: slot lease in ms, token
+var renewScript = redis.NewScript(`
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+redis.call('ZADD', KEYS[1], 'XX', now + tonumber(ARGV[1]), ARGV[2])
+redis.call('PEXPIRE', KEYS[1], ARGV[1])
+return 0
+`)
+
+// MaxWait is how long Acquire waits by default before giving up with a WaitError
+const MaxWait = time.Minute
+
+type Limiter struct {
+	redis *redis.Client
+	sites map[string]Limits
+	// MaxWait is how long Acquire blocks for. Callers holding onto work (like a download job) shouldn't sit on it
+	// through a long cooldown, since the work could be handed to someone else in the meantime.
+	MaxWait time.Duration
+}
+
+func New(redisClient *redis.Client, sites map[string]Limits) *Limiter {
+	return &Limiter{redis: redisClient, sites: sites, MaxWait: MaxWait}
+}
+
+// WaitError is returned by Acquire if the site can't be used for longer than the limiter's MaxWait
+type WaitError struct {
+	Site       string
+	RetryAfter time.Duration
+}
+
+func (e *WaitError) Error() string {
+	return fmt.Sprintf("rate limited by %s for another %s", e.Site, e.RetryAfter)
+}
+
+// Slot is held for the duration of a request to a site
+type Slot struct {
+	// BytesPerSecond is this request's share of the site's bandwidth cap, 0 if there's no cap
+	BytesPerSecond int64
+
+	release func()
+	once    sync.Once
+}
+
+// Release frees the slot up for another request. It's safe to call more than once.
+func (s *Slot) Release() {
+	s.once.Do(s.release)
+}
+
+func key(site, name string) string {
+	return fmt.Sprintf("ratelimit:%s:%s", site, name)
+}
+
+// Acquire waits until a request can be made to host without exceeding its site's limits, or ctx is canceled.
+// If that would take longer than MaxWait, a WaitError is returned instead. The slot must be released once the request is done.
+func (l *Limiter) Acquire(ctx context.Context, host string) (*Slot, error) {
+	site, limits := siteFor(l.sites, host)
+
+	tokenBytes := make([]byte, 16)
+	_, err := rand.Read(tokenBytes)
+	if err != nil {
+		return nil, err
+	}
+	token := hex.EncodeToString(tokenBytes)
+
+	deadline := time.Now().Add(l.MaxWait)
+	keys := []string{key(site, "cooldown"), key(site, "requests"), key(site, "slots")}
+	for {
+		wait, err := acquireScript.Run(ctx, l.redis, keys, limits.RequestsPerMinute, limits.MaxConcurrent,
+			slotLeaseDuration.Milliseconds(), token).Int64()
+		switch {
+		case err != nil:
+			return nil, fmt.Errorf("could not acquire rate limit for %s. Err: %s", site, err)
+		case wait == 0:
+			return l.newSlot(site, limits, token), nil
+		}
+
+		delay := slotPollDelay
+		if wait > 0 {
+			delay = time.Duration(wait) * time.Millisecond
+		}
+
+		if time.Now().Add(delay).After(deadline) {
+			return nil, &WaitError{Site: site, RetryAfter: delay}
+		}
+
+		if wait > 0 {
+			log.Infof("Rate limited by %s, waiting %s", site, delay)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (l *Limiter) newSlot(site string, limits Limits, token string) *Slot {
+	slot := Slot{release: func() {}}
+
+	if limits.BytesPerSecond > 0 {
+		slot.BytesPerSecond = limits.BytesPerSecond
+		if limits.MaxConcurrent > 0 {
+			slot.BytesPerSecond /= int64(limits.MaxConcurrent)
+		}
+	}
+
+	if limits.MaxConcurrent == 0 {
+		return &slot
+	}
+
+	// Renew the lease until the slot is released
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(slotRenewInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				err := renewScript.Run(context.Background(), l.redis, []string{key(site, "slots")}, slotLeaseDuration.Milliseconds(), token).Err()
+				if err != nil {
+					log.Errorf("Could not renew rate limit slot for %s. Err: %s", site, err)
+				}
+			}
+		}
+	}()
+
+	slot.release = func() {
+		close(done)
+		err := l.redis.ZRem(context.Background(), key(site, "slots"), token).Err()
+		if err != nil {
+			// It'll expire on its own
+			log.Errorf("Could not release rate limit slot for %s. Err: %s", site, err)
+		}
+	}
+
+	return &slot
+}
+
+// CoolDown stops all requests to host's site for the site's cooldown period, e.g. after it's started throttling us
+func (l *Limiter) CoolDown(ctx context.Context, host string) error {
+	site, limits := siteFor(l.sites, host)
+	if limits.CoolDown == 0 {
+		return nil
+	}
+
+	log.Warnf("Throttled by %s, cooling down for %s", site, limits.CoolDown)
+	return l.redis.Set(ctx, key(site, "cooldown"), time.Now().String(), limits.CoolDown).Err()
+}
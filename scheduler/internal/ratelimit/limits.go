@@ -0,0 +1,118 @@
+package ratelimit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultSite is the entry which applies to sites without their own limits
+const DefaultSite = "*"
+
+// Limits are shared by every scheduler replica. Zero means no limit.
+type Limits struct {
+	RequestsPerMinute int
+	// MaxConcurrent is the number of downloads which can run at once
+	MaxConcurrent int
+	// BytesPerSecond is the bandwidth cap for the whole site, which is split evenly between its concurrent downloads
+	BytesPerSecond int64
+	// CoolDown is how long to leave the site alone after it throttles us (HTTP 429 or 403)
+	CoolDown time.Duration
+}
+
+// ParseSiteLimits parses a semicolon separated list of site:key=value,... entries, e.g.
+// *:rpm=30,concurrency=3,cooldown=10m;nicovideo.jp:rpm=10,concurrency=2,bandwidth=4M
+// Sites also match their subdomains. Bandwidth is in bytes per second, and accepts K, M and G suffixes.
+func ParseSiteLimits(spec string) (map[string]Limits, error) {
+	ret := make(map[string]Limits)
+
+	for _, entry := range strings.Split(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		site, params, ok := strings.Cut(entry, ":")
+		site = strings.ToLower(strings.TrimSpace(site))
+		if !ok || site == "" {
+			return nil, fmt.Errorf("invalid site limits %q, expected site:key=value,...", entry)
+		}
+
+		if _, ok := ret[site]; ok {
+			return nil, fmt.Errorf("duplicate limits for %s", site)
+		}
+
+		var l Limits
+		for _, param := range strings.Split(params, ",") {
+			key, val, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok {
+				return nil, fmt.Errorf("invalid limit %q for %s, expected key=value", param, site)
+			}
+
+			var err error
+			switch key {
+			case "rpm":
+				l.RequestsPerMinute, err = strconv.Atoi(val)
+			case "concurrency":
+				l.MaxConcurrent, err = strconv.Atoi(val)
+			case "bandwidth":
+				l.BytesPerSecond, err = parseBytes(val)
+			case "cooldown":
+				l.CoolDown, err = time.ParseDuration(val)
+			default:
+				err = fmt.Errorf("unknown limit %s", key)
+			}
+
+			if err == nil && (l.RequestsPerMinute < 0 || l.MaxConcurrent < 0 || l.BytesPerSecond < 0 || l.CoolDown < 0) {
+				err = fmt.Errorf("%s can't be negative", key)
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid limits for %s. Err: %s", site, err)
+			}
+		}
+
+		ret[site] = l
+	}
+
+	return ret, nil
+}
+
+func parseBytes(s string) (int64, error) {
+	mult := int64(1)
+	switch {
+	case strings.HasSuffix(s, "K"):
+		mult = 1024
+	case strings.HasSuffix(s, "M"):
+		mult = 1024 * 1024
+	case strings.HasSuffix(s, "G"):
+		mult = 1024 * 1024 * 1024
+	}
+
+	if mult != 1 {
+		s = s[:len(s)-1]
+	}
+
+	n, err := strconv.ParseInt(s, 10, 64)
+	return n * mult, err
+}
+
+// siteFor returns the configured site which host belongs to, preferring the most specific match
+func siteFor(sites map[string]Limits, host string) (string, Limits) {
+	host = strings.ToLower(host)
+	for h := host; h != ""; {
+		if l, ok := sites[h]; ok {
+			return h, l
+		}
+
+		i := strings.Index(h, ".")
+		if i == -1 {
+			break
+		}
+		h = h[i+1:]
+	}
+
+	// Unconfigured sites are still limited separately from each other
+	return host, sites[DefaultSite]
+}
@@ -0,0 +1,41 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSiteLimits(t *testing.T) {
+	sites, err := ParseSiteLimits("*:rpm=30,concurrency=3,cooldown=10m; nicovideo.jp:rpm=10,concurrency=2,bandwidth=4M")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]Limits{
+		"*":            {RequestsPerMinute: 30, MaxConcurrent: 3, CoolDown: 10 * time.Minute},
+		"nicovideo.jp": {RequestsPerMinute: 10, MaxConcurrent: 2, BytesPerSecond: 4 * 1024 * 1024},
+	}, sites)
+
+	sites, err = ParseSiteLimits("")
+	assert.NoError(t, err)
+	assert.Empty(t, sites)
+
+	for _, invalid := range []string{"nicovideo.jp", ":rpm=1", "a:rpm=x", "a:rpm=-1", "a:speed=1", "a:rpm=1;a:rpm=2", "a:bandwidth=4X", "a:cooldown=10"} {
+		_, err = ParseSiteLimits(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestSiteFor(t *testing.T) {
+	sites := map[string]Limits{
+		"*":            {RequestsPerMinute: 30},
+		"nicovideo.jp": {RequestsPerMinute: 10},
+	}
+
+	site, limits := siteFor(sites, "www.nicovideo.jp")
+	assert.Equal(t, "nicovideo.jp", site)
+	assert.Equal(t, 10, limits.RequestsPerMinute)
+
+	site, limits = siteFor(sites, "www.bilibili.com")
+	assert.Equal(t, "www.bilibili.com", site)
+	assert.Equal(t, 30, limits.RequestsPerMinute)
+}
@@ -14,7 +14,7 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-// This package is responsible for polling the database, and sending items into the channel
+// This package is responsible for polling the database, and creating download jobs for the downloaders to claim
 
 type poller struct {
 	Db           *sqlx.DB
@@ -26,7 +26,8 @@ func NewPoller(db *sqlx.DB, redsync *redsync.Redsync) (poller, error) {
 	return poller{Db: db, PollingDelay: time.Second * 15, Redsync: redsync}, nil
 }
 
-func (p *poller) PollDatabaseAndSendIntoQueue(ctx context.Context, videoQueue chan *models.VideoDLRequest) error {
+// PollDatabaseAndEnqueue creates download jobs for approved videos, keeping at most MAX_QUEUED_JOBS unclaimed jobs in the queue
+func (p *poller) PollDatabaseAndEnqueue(ctx context.Context, q *models.JobQueue) error {
 	for {
 		select {
 		case <-ctx.Done():
@@ -34,78 +35,71 @@ func (p *poller) PollDatabaseAndSendIntoQueue(ctx context.Context, videoQueue ch
 			return nil
 
 		default:
-			itemsToSchedule, err := p.getVideos()
+			queued, err := q.QueuedCount()
+			if err != nil {
+				log.Errorf("failed to count queued jobs. Err: %s", err)
+				time.Sleep(p.PollingDelay)
+				break
+			}
+
+			if queued >= models.MAX_QUEUED_JOBS {
+				log.Debugf("%d jobs already queued, backing off...", queued)
+				time.Sleep(p.PollingDelay)
+				break
+			}
+
+			itemsToSchedule, err := p.getVideos(q)
 			if err != nil {
 				if err != sql.ErrNoRows {
 					log.Errorf("failed to get items. Err: %s", err)
 				} else {
 					// Back off
 					log.Debugf("failed to get items. Backing off...")
-					time.Sleep(p.PollingDelay)
 				}
+				time.Sleep(p.PollingDelay)
 				break // try again
 			}
 
 			for _, item := range itemsToSchedule {
-				log.Infof("Sending url %s with parent %s to be processed", item.URL, item.ParentURL)
+				log.Infof("Queued url %s with parent %s as job %d", item.URL, item.ParentURL, item.JobID)
 				err = item.RecordEvent(models.Scheduled, "")
 				if err != nil {
 					log.Errorf("Could not record scheduled event. Err: %s. Continuing...", err)
 				}
 
-				videoQueue <- item
+				err = item.PublishEvent(models.EventQueued, 0, "")
+				if err != nil {
+					log.Errorf("Could not publish queued event. Err: %s. Continuing...", err)
+				}
 			}
 		}
 	}
 }
 
-func (p *poller) getVideos() ([]*models.VideoDLRequest, error) {
-	// TODO: put this in a repo later
-
+func (p *poller) getVideos(q *models.JobQueue) ([]*models.VideoDLRequest, error) {
 	log.Info("Fetching categories")
 	urls, err := p.getURLs()
 	if err != nil {
 		return nil, err
 	}
 
-	log.Info("Fetching videos to dl")
-
-	// The rand offset is a bit of a hack to prevent video downloads from being attempted many times per video, resulting in many lock acquisition failures
-	// TODO: improve
+	log.Info("Enqueueing videos to dl")
 
 	var ret []*models.VideoDLRequest
 	for _, url := range urls {
-
-		// TODO : AND is_approved IS true
-		sql := "WITH  j AS (SELECT v.id, v.video_id, v.url, downloads.id AS download_id FROM downloads INNER JOIN downloads_to_videos d ON downloads.id = d.download_id INNER JOIN videos v ON d.video_id = v.id WHERE downloads.url = $1 AND v.dlStatus = 0 AND is_approved IS true LIMIT 10), up as (UPDATE videos SET dlStatus=3 WHERE videos.id IN (select j.id FROM j) RETURNING videos.id)  SELECT id, j.video_id, j.URL, j.download_id FROM j WHERE j.id IN (select * from up);"
-		res, err := p.Db.Query(sql, url)
+		reqs, err := q.Enqueue(url, 10)
 		if err != nil {
 			return nil, err
 		}
 
-		for res.Next() {
-			req := models.VideoDLRequest{
-				ParentURL: url,
-				Db:        p.Db,
-			}
-
-			err = res.Scan(&req.ID, &req.VideoID, &req.URL, &req.DownloaddID)
-			if err != nil {
-				return nil, err
-			}
-
-			err = req.SetDownloadQueued()
-			if err != nil {
-				log.Errorf("Failed to set download queued")
-			}
-
+		for _, req := range reqs {
 			if req.VideoID == "" {
 				log.Errorf("Could not set video ID. Returning...")
 				return nil, errors.New("failed to set video id")
 			}
-
-			ret = append(ret, &req)
 		}
+
+		ret = append(ret, reqs...)
 	}
 
 	return ret, nil
@@ -2,27 +2,41 @@ package syncmanager
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
 	"fmt"
-	"os/exec"
-	"strings"
 	"time"
 
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/horahoradev/horahora/scheduler/internal/extractor"
+	"github.com/horahoradev/horahora/scheduler/internal/failure"
 	"github.com/horahoradev/horahora/scheduler/internal/models"
+	"github.com/horahoradev/horahora/scheduler/internal/ratelimit"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// How often to check whether the classification rules have changed
+	reclassifyDelay     = time.Minute
+	reclassifyBatchSize = 100
 )
 
 type SyncWorker struct {
 	R                 *models.ArchiveRequestRepo
-	SocksConnStr      string
+	VideoClient       videoproto.VideoServiceClient
+	Extractors        *extractor.Registry
+	Limiter           *ratelimit.Limiter
+	Policy            models.SyncPolicy
 	SyncDelay         time.Duration
 	RequestDLCountMap map[string]int
 }
 
-func NewWorker(r *models.ArchiveRequestRepo, socksConnStr string, syncDelay time.Duration) (*SyncWorker, error) {
+func NewWorker(r *models.ArchiveRequestRepo, videoClient videoproto.VideoServiceClient, extractors *extractor.Registry, limiter *ratelimit.Limiter, policy models.SyncPolicy, syncDelay time.Duration) (*SyncWorker, error) {
 	return &SyncWorker{R: r,
-		SocksConnStr:      socksConnStr,
+		VideoClient:       videoClient,
+		Extractors:        extractors,
+		Limiter:           limiter,
+		Policy:            policy,
 		SyncDelay:         syncDelay,
 		RequestDLCountMap: make(map[string]int),
 	}, nil
@@ -39,162 +53,138 @@ func (s *SyncWorker) Sync() error {
 		for _, dlReq := range dlReqs {
 			// TODO: distributed lock goes here!
 
-			// refresh cache if backoff period is up
-			log.Infof("Backoff period expired for download request %s, syncing all", dlReq.Id)
-			itemsAdded, err := s.syncDownloadList(&dlReq)
-
-			if itemsAdded {
-				err := dlReq.ReportSyncHit()
-				if err != nil {
-					log.Errorf("sync worker report sync hit: %s", err)
-				}
-			} else {
-				err := dlReq.ReportSyncMiss()
-				if err != nil {
-					log.Errorf("Sync worker report sync miss: %s", err)
-				}
+			log.Infof("Sync interval expired for download request %s, syncing all", dlReq.Id)
+			newVideos, syncErr := s.syncDownloadList(&dlReq)
+			if syncErr != nil {
+				log.Errorf("Sync worker dl list: %s", syncErr)
 			}
 
+			err = dlReq.ReportSync(s.Policy, newVideos, syncErr)
 			if err != nil {
-				log.Errorf("Sync worker dl list: %s", err)
-				continue
+				log.Errorf("Sync worker report sync: %s", err)
 			}
-
 		}
 
 		time.Sleep(s.SyncDelay)
 	}
 }
 
-func (s *SyncWorker) syncDownloadList(dlReq *models.CategoryDLRequest) (bool, error) {
+// syncDownloadList adds the category's videos, and returns how many of them are new
+func (s *SyncWorker) syncDownloadList(dlReq *models.CategoryDLRequest) (int, error) {
 	videos, err := s.getDownloadList(dlReq)
 	if err != nil {
-		return false, fmt.Errorf("could not fetch download list. Err: %s", err)
+		return 0, fmt.Errorf("could not fetch download list. Err: %w", err)
 	}
 
-	var newItemsAdded bool
+	var newVideos int
 
 	for _, video := range videos {
 
 		// TODO: batch?
-		itemsAdded, err := dlReq.AddVideo(video.ID, video.URL)
+		added, err := dlReq.AddVideo(video.ID, video.URL)
 		if err != nil {
-			return false, fmt.Errorf("could not add video. Err: %s", err)
+			return newVideos, fmt.Errorf("could not add video. Err: %s", err)
 		}
 
-		if itemsAdded {
-			newItemsAdded = true
+		if added {
+			newVideos++
 		}
 	}
 
-	return newItemsAdded, nil
+	return newVideos, nil
 }
 
-type VideoJSON struct {
-	Type  string `json:"_type"`
-	URL   string `json:"original_url"`
-	IeKey string `json:"ie_key"`
-	ID    string `json:"id"`
-	Title string `json:"title"`
-}
-
-func (s *SyncWorker) getDownloadList(dlReq *models.CategoryDLRequest) ([]VideoJSON, error) {
-	args, err := s.getVideoListString(dlReq)
+func (s *SyncWorker) getDownloadList(dlReq *models.CategoryDLRequest) ([]extractor.PlaylistEntry, error) {
+	ext, err := s.Extractors.ForURL(dlReq.Url)
 	if err != nil {
 		return nil, err
 	}
 
-	// get the list of videos to download
-	cmd := exec.Command(args[0], args[1:]...)
-	payload, err := cmd.Output()
+	website, err := models.GetWebsiteFromURL(dlReq.Url)
 	if err != nil {
-		log.Errorf("Command `%s` finished with err %s", cmd, err)
 		return nil, err
 	}
 
-	var videos []VideoJSON
-	// The json isn't formatted as a list LMAO please FIXME
-	// I assume that the list provided by youtube-dl will be in descending order by upload date.
-	// Download by upload date asc so that we can resume at newest download.
-	spl := strings.Split(string(payload), "\n")
-	for i := len(spl) - 2; i >= 0; i-- {
-		line := spl[i]
-		var video VideoJSON
-		log.Infof("Line: %s", line)
-		err = json.Unmarshal([]byte(line), &video)
-
-		if err != nil {
-			log.Errorf("Failed to unmarshal json. Payload: %s. Err: %s", line, err)
-			return nil, err
-		}
-
-		videos = append(videos, video)
+	// Listings count against the same limits as downloads, so a big category sync can't get us throttled on its own
+	slot, err := s.Limiter.Acquire(context.TODO(), website)
+	if err != nil {
+		return nil, err
 	}
+	defer slot.Release()
 
-	if len(videos) == 0 {
-		log.Errorf("Could not unmarshal, videolist len is 0")
-		return nil, errors.New("unmarshal failure")
+	videos, err := ext.ListPlaylist(context.TODO(), dlReq.Url, s.getListOptions(dlReq))
+	if failure.Classify(err) == failure.RateLimited {
+		coolErr := s.Limiter.CoolDown(context.TODO(), website)
+		if coolErr != nil {
+			log.Errorf("Could not start cooldown for %s. Err: %s", website, coolErr)
+		}
 	}
 
-	return videos, nil
+	return videos, err
 }
 
+// RunVideoClassificationLoop classifies downloaded videos again whenever the classification rules change, and passes
+// their new categories on to video service
 func (s *SyncWorker) RunVideoClassificationLoop(ctx context.Context) error {
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
-		default:
-			time.Sleep(time.Second * 30)
+		case <-time.After(reclassifyDelay):
 		}
 
-		urls, err := s.R.GetUnclassifiedVideoURLs()
+		err := s.reclassify(ctx)
+		if err != nil {
+			log.Errorf("Could not reclassify videos. Err: %s", err)
+		}
+	}
+}
+
+func (s *SyncWorker) reclassify(ctx context.Context) error {
+	c, version, err := s.R.LoadClassifier()
+	if err != nil {
+		return err
+	}
+
+	for {
+		videos, err := s.R.GetVideosToReclassify(version, reclassifyBatchSize)
 		if err != nil {
 			return err
 		}
 
-		for _, url := range urls {
-			classification, err := s.GetVideoClassification(url.URL)
-			if err != nil {
-				continue
+		if len(videos) == 0 {
+			return nil
+		}
+
+		for _, video := range videos {
+			res := c.Classify(video.ClassifierVideo())
+
+			if res.Category != video.Category.String {
+				website, _ := models.GetWebsiteFromURL(video.URL)
+				_, err = s.VideoClient.SetForeignVideoCategory(ctx, &videoproto.ForeignVideoCategory{
+					ForeignVideoID: video.VideoID,
+					ForeignWebsite: website,
+					Category:       res.Category,
+				})
+				// Videos which were deleted from video service have nothing to update
+				if err != nil && status.Code(err) != codes.NotFound {
+					return fmt.Errorf("could not update category of video %s. Err: %s", video.VideoID, err)
+				}
 			}
 
-			err = s.R.UpdateClassification(classification, url.ID)
+			err = s.R.UpdateClassification(video.ID, res, version)
 			if err != nil {
 				return err
 			}
 		}
-	}
-}
 
-func (s *SyncWorker) GetVideoClassification(videoURL string) (string, error) {
-	cmd := exec.Command("/bin/sh", "-c", fmt.Sprintf("yt-dlp --add-header 'Accept-Language:ja' -j %s | jq '.tags'", videoURL))
-	payload, err := cmd.Output()
-	if err != nil {
-		log.Errorf("Command `%s` finished with err %s", cmd, err)
-		return "", err
+		log.Infof("Reclassified %d videos with version %d of the rules", len(videos), version)
 	}
-
-	categories, err := s.R.GetInferenceCategories()
-	if err != nil {
-		return "", err
-	}
-
-	for _, category := range categories {
-		if strings.Index(string(payload), category.Tag) != -1 {
-			return category.Category, nil
-		}
-	}
-
-	return "General", nil
 }
 
-func (s *SyncWorker) getVideoListString(dlReq *models.CategoryDLRequest) ([]string, error) {
-	// TODO: type safety, switch to enum?
-	args := []string{"yt-dlp",
-		"-j",
-		"--flat-playlist",
-	}
+// Only the most recent 400 entries are listed, except for every 10th sync, which lists everything
+func (s *SyncWorker) getListOptions(dlReq *models.CategoryDLRequest) extractor.ListOptions {
+	var opts extractor.ListOptions
 
 	_, ok := s.RequestDLCountMap[dlReq.Id]
 	if !ok {
@@ -202,20 +192,10 @@ func (s *SyncWorker) getVideoListString(dlReq *models.CategoryDLRequest) ([]stri
 	}
 
 	if s.RequestDLCountMap[dlReq.Id]%10 != 0 {
-		args = append(args, []string{
-			"--playlist-end",
-			"400",
-		}...)
+		opts.Limit = 400
 	}
 
 	s.RequestDLCountMap[dlReq.Id]++
 
-	if s.SocksConnStr != "" {
-		args = append(args, []string{"--proxy", s.SocksConnStr}...)
-	}
-
-	args[0] = "yt-dlp"
-
-	args = append(args, dlReq.Url)
-	return args, nil
+	return opts
 }
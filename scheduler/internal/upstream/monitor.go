@@ -0,0 +1,186 @@
+package upstream
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+
+	proto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/horahoradev/horahora/scheduler/internal/extractor"
+	"github.com/horahoradev/horahora/scheduler/internal/failure"
+	"github.com/horahoradev/horahora/scheduler/internal/ratelimit"
+	log "github.com/sirupsen/logrus"
+)
+
+// This package keeps an eye on the originals of the videos we've archived, so that we know when one goes private, is
+// deleted or is region locked. Video service keeps the statuses and decides which videos are due; the monitor only
+// probes them, one at a time, within the same site limits as downloads.
+
+const (
+	// Online is the status of originals which could be fetched
+	Online = "online"
+
+	// How long to wait before claiming again when no videos are due
+	idleDelay = time.Minute * 10
+	// When to check again after a check which didn't tell us anything, e.g. because the site was down
+	inconclusiveRecheck = time.Hour * 24
+	// Videos claimed in one go. Claims are leased, so this is also the most a dead replica leaves hanging.
+	batchSize = 20
+	// The most a single probe is expected to take, used to size the lease
+	maxProbeDuration = time.Minute * 2
+)
+
+type Options struct {
+	// Interval is how often originals which were online are checked
+	Interval time.Duration
+	// LostInterval is how often originals which were gone are checked, in case they come back
+	LostInterval time.Duration
+	// Delay is the pause between probes
+	Delay time.Duration
+}
+
+type Monitor struct {
+	client     proto.VideoServiceClient
+	extractors *extractor.Registry
+	limiter    *ratelimit.Limiter
+	opts       Options
+}
+
+func New(client proto.VideoServiceClient, extractors *extractor.Registry, limiter *ratelimit.Limiter, opts Options) *Monitor {
+	return &Monitor{
+		client:     client,
+		extractors: extractors,
+		limiter:    limiter,
+		opts:       opts,
+	}
+}
+
+// Run checks originals until ctx is canceled
+func (m *Monitor) Run(ctx context.Context) error {
+	for {
+		res, err := m.client.ClaimUpstreamChecks(ctx, &proto.UpstreamCheckClaim{
+			Limit:           batchSize,
+			LeaseSeconds:    int64((batchSize * (m.opts.Delay + maxProbeDuration)).Seconds()),
+			IntervalSeconds: int64(m.opts.Interval.Seconds()),
+		})
+		if err != nil {
+			log.Errorf("Could not claim upstream checks. Err: %s", err)
+		}
+
+		var checks []*proto.UpstreamCheck
+		if res != nil {
+			checks = res.Checks
+		}
+
+		delay := idleDelay
+		if len(checks) > 0 {
+			delay = m.opts.Delay
+		}
+
+		for i, check := range checks {
+			m.check(ctx, check)
+
+			if i == len(checks)-1 {
+				break
+			}
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(m.opts.Delay):
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// check probes the video's original and reports what it found
+func (m *Monitor) check(ctx context.Context, check *proto.UpstreamCheck) {
+	report := proto.UpstreamStatusReport{VideoId: check.VideoId}
+
+	retryAfter, err := m.probe(ctx, check.Url)
+	if ctx.Err() != nil {
+		// The lease runs out on its own
+		return
+	}
+
+	status, conclusive := Status(err)
+	switch {
+	case !conclusive:
+		log.Infof("Upstream check of video %d was inconclusive. Err: %s", check.VideoId, err)
+		report.RecheckAfterSeconds = int64(inconclusiveRecheck.Seconds())
+		if retryAfter > 0 {
+			report.RecheckAfterSeconds = int64(retryAfter.Seconds())
+		}
+	case status == Online:
+		report.Status = status
+		report.RecheckAfterSeconds = int64(m.opts.Interval.Seconds())
+	default:
+		log.Infof("Original of video %d is %s. Err: %s", check.VideoId, status, err)
+		report.Status = status
+		report.Message = err.Error()
+		report.RecheckAfterSeconds = int64(m.opts.LostInterval.Seconds())
+	}
+
+	_, err = m.client.ReportUpstreamStatus(ctx, &report)
+	if err != nil {
+		log.Errorf("Could not report upstream status of video %d. Err: %s", check.VideoId, err)
+	}
+}
+
+// probe fetches the original's metadata, returning how long to wait before trying again if the site's limits got in the way
+func (m *Monitor) probe(ctx context.Context, videoURL string) (time.Duration, error) {
+	ext, err := m.extractors.ForURL(videoURL)
+	if err != nil {
+		return 0, err
+	}
+
+	// Site limits are looked up by host
+	u, err := url.Parse(videoURL)
+	if err != nil {
+		return 0, err
+	}
+	website := u.Hostname()
+
+	slot, err := m.limiter.Acquire(ctx, website)
+	var waitErr *ratelimit.WaitError
+	switch {
+	case errors.As(err, &waitErr):
+		return waitErr.RetryAfter, err
+	case err != nil:
+		return 0, err
+	}
+
+	_, err = ext.FetchMetadata(ctx, videoURL)
+	slot.Release()
+
+	if failure.Classify(err) == failure.RateLimited {
+		coolErr := m.limiter.CoolDown(ctx, website)
+		if coolErr != nil {
+			log.Errorf("Could not start cooldown for %s. Err: %s", website, coolErr)
+		}
+	}
+
+	return 0, err
+}
+
+// Status returns the upstream status which the result of fetching the original's metadata indicates. Failures which
+// say nothing about the video itself, like network trouble or being throttled, aren't conclusive.
+func Status(err error) (string, bool) {
+	if err == nil {
+		return Online, true
+	}
+
+	switch class := failure.Classify(err); class {
+	case failure.Removed, failure.Private, failure.GeoBlocked, failure.AuthRequired:
+		return string(class), true
+	}
+
+	return "", false
+}
@@ -0,0 +1,35 @@
+package upstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/horahoradev/horahora/scheduler/internal/extractor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatus(t *testing.T) {
+	cases := []struct {
+		err        error
+		status     string
+		conclusive bool
+	}{
+		{nil, Online, true},
+		{errors.New("exit status 1: [youtube] abc: Video unavailable. This video has been removed by the uploader"), "removed", true},
+		{errors.New("exit status 1: [youtube] abc: Private video. Sign in if you've been granted access"), "private", true},
+		{errors.New("exit status 1: [BiliBili] BV1: This video is not available in your country"), "geo_blocked", true},
+		{errors.New("exit status 1: [youtube] abc: Join this channel to get access to members-only content"), "auth_required", true},
+		{fmt.Errorf("exit status 1: %w", extractor.ErrThrottled), "", false},
+		{errors.New("exit status 1: [niconico] sm9: Unable to download webpage: <urlopen error timed out>"), "", false},
+		{context.DeadlineExceeded, "", false},
+		{errors.New("exit status 1"), "", false},
+	}
+
+	for _, c := range cases {
+		status, conclusive := Status(c.err)
+		assert.Equal(t, c.status, status, "%v", c.err)
+		assert.Equal(t, c.conclusive, conclusive, "%v", c.err)
+	}
+}
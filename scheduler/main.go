@@ -15,8 +15,12 @@ import (
 
 	"github.com/horahoradev/horahora/scheduler/internal/config"
 	"github.com/horahoradev/horahora/scheduler/internal/downloader"
+	"github.com/horahoradev/horahora/scheduler/internal/extractor"
+	"github.com/horahoradev/horahora/scheduler/internal/failure"
 	grpcserver "github.com/horahoradev/horahora/scheduler/internal/grpc"
+	"github.com/horahoradev/horahora/scheduler/internal/ratelimit"
 	"github.com/horahoradev/horahora/scheduler/internal/schedule"
+	"github.com/horahoradev/horahora/scheduler/internal/upstream"
 	_ "github.com/lib/pq"
 	log "github.com/sirupsen/logrus"
 
@@ -55,9 +59,12 @@ func main() {
 
 	wg := sync.WaitGroup{}
 
-	dlQueue := make(chan *models.VideoDLRequest, 100)
+	jobs, err := models.NewJobQueue(cfg.Conn, cfg.JobLeaseDuration)
+	if err != nil {
+		log.Fatalf("Could not create job queue. Err: %s", err)
+	}
 
-	// Start one publisher goroutine to poll postgres and send download requests into the channel
+	// Start one publisher goroutine to poll postgres and create download jobs
 	// could potentially expand this to multiple publishers
 	wg.Add(1)
 	poller, err := schedule.NewPoller(cfg.Conn, cfg.Redlock)
@@ -67,22 +74,29 @@ func main() {
 
 	log.Info("Starting poller")
 	go func() {
-		err := poller.PollDatabaseAndSendIntoQueue(ctx, dlQueue)
+		err := poller.PollDatabaseAndEnqueue(ctx, jobs)
 		if err != nil {
 			log.Errorf("Database polling failed. Err: %s", err)
-			// TODO: Might want to cancel context here since the poller returned, otherwise consumers will just wait indefinitely and perform no work
 		}
 		wg.Done()
 	}()
 
-	m := &sync.Mutex{}
-	// Start n goroutines to subscribe to channel and download items
-	numOfSubscribers := 7
-	for i := 0; i < numOfSubscribers; i++ {
+	extractors := extractor.NewDefaultRegistry(extractor.YTDLPOptions{
+		SocksConnStr:   cfg.SocksConnStr,
+		MaxFS:          cfg.MaxFS,
+		AcceptLanguage: cfg.AcceptLanguage,
+	})
+
+	// Shared by every replica through redis
+	limiter := ratelimit.New(cfg.RedisConn, cfg.SiteLimits)
+
+	// Start n goroutines to claim jobs from the queue and download them
+	// Jobs abandoned by a dead replica come back once their lease expires, so there's nothing to clean up on boot
+	for i := 0; i < cfg.NumDownloaders; i++ {
 		wg.Add(1)
-		dler := downloader.New(dlQueue, cfg.VideoOutputLoc, cfg.Client, cfg.NumberOfRetries, cfg.SocksConnStr, cfg.MaxFS, cfg.AcceptLanguage)
+		dler := downloader.New(jobs.Worker(i), cfg.VideoOutputLoc, cfg.Client, failure.DefaultPolicies(cfg.NumberOfRetries), extractors, limiter)
 		go func() {
-			err := dler.SubscribeAndDownload(ctx, m)
+			err := dler.SubscribeAndDownload(ctx)
 			if err != nil {
 				log.Errorf("Downloader failed. Err: %s", err)
 			}
@@ -92,14 +106,13 @@ func main() {
 
 	repo := models.NewArchiveRequest(cfg.Conn)
 
-	// Wipe the in-progress downloads that didn't finish
-	err = repo.WipeDownloadsInProgress()
+	policy, err := models.NewSyncPolicy(cfg.MaxSyncInterval)
 	if err != nil {
-		log.Fatal("Could not wipe downloads in progress. Err: %s", err)
+		log.Fatalf("Invalid sync policy. Err: %s", err)
 	}
 
 	// TODDO: sync worker exit becausse schcema isn't up yet
-	worker, err := syncmanager.NewWorker(repo, cfg.SocksConnStr, cfg.SyncPollDelay)
+	worker, err := syncmanager.NewWorker(repo, cfg.Client, extractors, limiter, policy, cfg.SyncPollDelay)
 	if err != nil {
 		log.Fatalf("Sync worker exited wth err: %s", err)
 	}
@@ -115,22 +128,52 @@ func main() {
 		}
 	}()
 
-	// Category inference worker
-	// wg.Add(1)
-	// go func() {
-	// 	defer wg.Done()
+	// Upstream monitor, which checks whether archived videos are still available where they came from
+	if cfg.UpstreamCheckInterval > 0 {
+		monitor := upstream.New(cfg.Client, extractors, limiter, upstream.Options{
+			Interval:     cfg.UpstreamCheckInterval,
+			LostInterval: cfg.UpstreamLostCheckInterval,
+			Delay:        cfg.UpstreamCheckDelay,
+		})
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			err := monitor.Run(ctx)
+			if err != nil && err != context.Canceled {
+				log.Errorf("Upstream monitor exited with err: %s", err)
+			}
+		}()
+	}
+
+	// Category inference worker, which reclassifies videos when the rules change
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		err := worker.RunVideoClassificationLoop(ctx)
+		if err != nil {
+			log.Errorf("Classification worker exited with err: %s", err)
+		}
+	}()
+
+	events, err := models.NewDownloadEventBroker(cfg.Conn, cfg.ConnStr)
+	if err != nil {
+		log.Fatalf("Could not listen for download events. Err: %s", err)
+	}
 
-	// 	err = worker.RunVideoClassificationLoop(ctx)
-	// 	if err != nil {
-	// 		log.Fatalf("Classification worker exited with err: %s", err)
-	// 	}
-	// }()
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		events.Run(ctx)
+	}()
 
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
 
-		err := grpcserver.NewGRPCServer(ctx, cfg.Conn, 7777)
+		err := grpcserver.NewGRPCServer(ctx, cfg.Conn, events, 7777)
 		if err != nil {
 			log.Error(err)
 		}
@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE download_jobs (
+    id SERIAL primary key,
+    video_id int NOT NULL REFERENCES videos(id) ON DELETE CASCADE,
+    download_id int NOT NULL REFERENCES downloads(id) ON DELETE CASCADE,
+    parent_url varchar(255) NOT NULL,
+    status varchar(32) NOT NULL DEFAULT 'queued', /* queued or running */
+    attempts int NOT NULL DEFAULT 0, /* number of times the job has been claimed */
+    lease_owner varchar(255), /* scheduler replica currently holding the job */
+    lease_expires_at timestamp, /* job becomes visible to other workers again after this */
+    heartbeat_at timestamp,
+    available_at timestamp NOT NULL DEFAULT Now(), /* job can't be claimed before this */
+    created_at timestamp NOT NULL DEFAULT Now(),
+    UNIQUE(video_id)
+);
+
+CREATE INDEX download_jobs_claim_idx ON download_jobs (status, available_at);
+
+-- The in-memory queue didn't survive restarts, so anything it was holding needs to be scheduled again
+UPDATE videos SET dlStatus = 0 WHERE dlStatus >= 3;
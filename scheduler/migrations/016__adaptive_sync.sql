@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE downloads ADD COLUMN sync_interval_seconds bigint NOT NULL DEFAULT 86400; /* time between full syncs, adjusted after each one */
+ALTER TABLE downloads ADD COLUMN new_video_rate double precision NOT NULL DEFAULT 0; /* moving average of new videos per day */
+ALTER TABLE downloads ADD COLUMN next_sync_at timestamp DEFAULT NULL; /* NULL means never synced, so sync as soon as possible */
+ALTER TABLE downloads ADD COLUMN sync_reason text NOT NULL DEFAULT '';
+
+UPDATE downloads SET sync_interval_seconds = 86400 * GREATEST(coalesce(backoff_factor, 1), 1);
+UPDATE downloads SET next_sync_at = last_synced + sync_interval_seconds * interval '1 second' WHERE last_synced IS NOT NULL;
+
+CREATE INDEX downloads_next_sync_idx ON downloads (next_sync_at);
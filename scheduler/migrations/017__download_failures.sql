@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE download_jobs ADD COLUMN failures int NOT NULL DEFAULT 0; /* number of failed attempts, drives the retry backoff */
+ALTER TABLE download_jobs ADD COLUMN failure_class varchar(32); /* class of the last failure */
+ALTER TABLE download_jobs ADD COLUMN last_error text;
+
+ALTER TABLE videos ADD COLUMN failure_class varchar(32); /* why the download failed, if it did */
+ALTER TABLE videos ADD COLUMN failure_permanent boolean NOT NULL DEFAULT false; /* permanent failures are never retried */
+
+ALTER TABLE archival_events ADD COLUMN failure_class varchar(32);
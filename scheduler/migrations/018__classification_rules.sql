@@ -0,0 +1,23 @@
+-- +goose Up
+/* Inference categories become weighted rules on any of a video's tags, title, uploader or site. Existing entries were tag rules. */
+ALTER TABLE inference_categories RENAME COLUMN tag TO pattern;
+ALTER TABLE inference_categories ADD COLUMN field varchar(32) NOT NULL DEFAULT 'tag';
+ALTER TABLE inference_categories ADD COLUMN weight real NOT NULL DEFAULT 1;
+ALTER TABLE inference_categories ADD COLUMN priority int NOT NULL DEFAULT 0;
+DELETE FROM inference_categories WHERE pattern IS NULL OR category IS NULL;
+ALTER TABLE inference_categories ALTER COLUMN pattern SET NOT NULL;
+ALTER TABLE inference_categories ALTER COLUMN category SET NOT NULL;
+
+/* Bumped whenever the rules change, so that videos classified with older rules are classified again */
+CREATE SEQUENCE inference_rules_version;
+SELECT nextval('inference_rules_version');
+
+/* The metadata videos are classified from, saved when they're downloaded */
+ALTER TABLE videos ADD COLUMN title text;
+ALTER TABLE videos ADD COLUMN uploader varchar(255);
+ALTER TABLE videos ADD COLUMN uploader_id varchar(255);
+ALTER TABLE videos ADD COLUMN tags text[];
+
+ALTER TABLE videos ADD COLUMN category_confidence real;
+ALTER TABLE videos ADD COLUMN classified_version bigint; /* the inference_rules_version the video was classified with */
+
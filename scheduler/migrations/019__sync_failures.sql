@@ -0,0 +1,3 @@
+-- +goose Up
+/* Failed syncs in a row, failed syncs are retried on their own backoff instead of waiting out the sync interval */
+ALTER TABLE downloads ADD COLUMN sync_failures int NOT NULL DEFAULT 0;
@@ -195,6 +195,16 @@ func (m *InferenceEntry) validate(all bool) error {
 
 	// no validation rules for Category
 
+	// no validation rules for ID
+
+	// no validation rules for Field
+
+	// no validation rules for Pattern
+
+	// no validation rules for Weight
+
+	// no validation rules for Priority
+
 	if len(errors) > 0 {
 		return InferenceEntryMultiError(errors)
 	}
@@ -273,6 +283,110 @@ var _ interface {
 	ErrorName() string
 } = InferenceEntryValidationError{}
 
+// Validate checks the field values on InferenceDeletionReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InferenceDeletionReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InferenceDeletionReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InferenceDeletionReqMultiError, or nil if none found.
+func (m *InferenceDeletionReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InferenceDeletionReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ID
+
+	if len(errors) > 0 {
+		return InferenceDeletionReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// InferenceDeletionReqMultiError is an error wrapping multiple validation
+// errors returned by InferenceDeletionReq.ValidateAll() if the designated
+// constraints aren't met.
+type InferenceDeletionReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InferenceDeletionReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InferenceDeletionReqMultiError) AllErrors() []error { return m }
+
+// InferenceDeletionReqValidationError is the validation error returned by
+// InferenceDeletionReq.Validate if the designated constraints aren't met.
+type InferenceDeletionReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InferenceDeletionReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InferenceDeletionReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InferenceDeletionReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InferenceDeletionReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InferenceDeletionReqValidationError) ErrorName() string {
+	return "InferenceDeletionReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InferenceDeletionReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInferenceDeletionReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InferenceDeletionReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InferenceDeletionReqValidationError{}
+
 // Validate checks the field values on DownloadsInProgressRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1776,6 +1890,8 @@ func (m *ArchivalEvent) validate(all bool) error {
 
 	// no validation rules for Timestamp
 
+	// no validation rules for FailureClass
+
 	if len(errors) > 0 {
 		return ArchivalEventMultiError(errors)
 	}
@@ -1995,6 +2111,10 @@ func (m *ContentArchivalEntry) validate(all bool) error {
 
 	// no validation rules for UndownloadableVideos
 
+	// no validation rules for NextSync
+
+	// no validation rules for SyncReason
+
 	if len(errors) > 0 {
 		return ContentArchivalEntryMultiError(errors)
 	}
@@ -2074,3 +2194,227 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ContentArchivalEntryValidationError{}
+
+// Validate checks the field values on WatchDownloadsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchDownloadsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchDownloadsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchDownloadsRequestMultiError, or nil if none found.
+func (m *WatchDownloadsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchDownloadsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadID
+
+	// no validation rules for UserID
+
+	if len(errors) > 0 {
+		return WatchDownloadsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchDownloadsRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchDownloadsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchDownloadsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchDownloadsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchDownloadsRequestMultiError) AllErrors() []error { return m }
+
+// WatchDownloadsRequestValidationError is the validation error returned by
+// WatchDownloadsRequest.Validate if the designated constraints aren't met.
+type WatchDownloadsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchDownloadsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchDownloadsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchDownloadsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchDownloadsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchDownloadsRequestValidationError) ErrorName() string {
+	return "WatchDownloadsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchDownloadsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchDownloadsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchDownloadsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchDownloadsRequestValidationError{}
+
+// Validate checks the field values on DownloadEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DownloadEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DownloadEventMultiError, or
+// nil if none found.
+func (m *DownloadEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for VideoID
+
+	// no validation rules for Website
+
+	// no validation rules for Url
+
+	// no validation rules for ParentURL
+
+	// no validation rules for DownloadID
+
+	// no validation rules for Progress
+
+	// no validation rules for Message
+
+	// no validation rules for Timestamp
+
+	if len(errors) > 0 {
+		return DownloadEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadEventMultiError is an error wrapping multiple validation errors
+// returned by DownloadEvent.ValidateAll() if the designated constraints
+// aren't met.
+type DownloadEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadEventMultiError) AllErrors() []error { return m }
+
+// DownloadEventValidationError is the validation error returned by
+// DownloadEvent.Validate if the designated constraints aren't met.
+type DownloadEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadEventValidationError) ErrorName() string { return "DownloadEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DownloadEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadEventValidationError{}
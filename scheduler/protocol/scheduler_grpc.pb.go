@@ -27,8 +27,13 @@ type SchedulerClient interface {
 	GetUnapprovedVideoList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*UnapprovedList, error)
 	ApproveVideo(ctx context.Context, in *ApproveVideoReq, opts ...grpc.CallOption) (*Empty, error)
 	UnapproveVideo(ctx context.Context, in *ApproveVideoReq, opts ...grpc.CallOption) (*Empty, error)
+	// Classification rules. Changing them reclassifies every downloaded video in the background.
 	GetInferenceCategories(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*InferenceList, error)
-	AddInferenceCategory(ctx context.Context, in *InferenceEntry, opts ...grpc.CallOption) (*Empty, error)
+	AddInferenceCategory(ctx context.Context, in *InferenceEntry, opts ...grpc.CallOption) (*InferenceEntry, error)
+	UpdateInferenceCategory(ctx context.Context, in *InferenceEntry, opts ...grpc.CallOption) (*InferenceEntry, error)
+	DeleteInferenceCategory(ctx context.Context, in *InferenceDeletionReq, opts ...grpc.CallOption) (*Empty, error)
+	// Streams download progress as it happens
+	WatchDownloads(ctx context.Context, in *WatchDownloadsRequest, opts ...grpc.CallOption) (Scheduler_WatchDownloadsClient, error)
 }
 
 type schedulerClient struct {
@@ -129,8 +134,8 @@ func (c *schedulerClient) GetInferenceCategories(ctx context.Context, in *Empty,
 	return out, nil
 }
 
-func (c *schedulerClient) AddInferenceCategory(ctx context.Context, in *InferenceEntry, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
+func (c *schedulerClient) AddInferenceCategory(ctx context.Context, in *InferenceEntry, opts ...grpc.CallOption) (*InferenceEntry, error) {
+	out := new(InferenceEntry)
 	err := c.cc.Invoke(ctx, "/proto.Scheduler/AddInferenceCategory", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -138,6 +143,56 @@ func (c *schedulerClient) AddInferenceCategory(ctx context.Context, in *Inferenc
 	return out, nil
 }
 
+func (c *schedulerClient) UpdateInferenceCategory(ctx context.Context, in *InferenceEntry, opts ...grpc.CallOption) (*InferenceEntry, error) {
+	out := new(InferenceEntry)
+	err := c.cc.Invoke(ctx, "/proto.Scheduler/UpdateInferenceCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) DeleteInferenceCategory(ctx context.Context, in *InferenceDeletionReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.Scheduler/DeleteInferenceCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) WatchDownloads(ctx context.Context, in *WatchDownloadsRequest, opts ...grpc.CallOption) (Scheduler_WatchDownloadsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Scheduler_ServiceDesc.Streams[0], "/proto.Scheduler/WatchDownloads", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &schedulerWatchDownloadsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Scheduler_WatchDownloadsClient interface {
+	Recv() (*DownloadEvent, error)
+	grpc.ClientStream
+}
+
+type schedulerWatchDownloadsClient struct {
+	grpc.ClientStream
+}
+
+func (x *schedulerWatchDownloadsClient) Recv() (*DownloadEvent, error) {
+	m := new(DownloadEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // SchedulerServer is the server API for Scheduler service.
 // All implementations must embed UnimplementedSchedulerServer
 // for forward compatibility
@@ -151,8 +206,13 @@ type SchedulerServer interface {
 	GetUnapprovedVideoList(context.Context, *Empty) (*UnapprovedList, error)
 	ApproveVideo(context.Context, *ApproveVideoReq) (*Empty, error)
 	UnapproveVideo(context.Context, *ApproveVideoReq) (*Empty, error)
+	// Classification rules. Changing them reclassifies every downloaded video in the background.
 	GetInferenceCategories(context.Context, *Empty) (*InferenceList, error)
-	AddInferenceCategory(context.Context, *InferenceEntry) (*Empty, error)
+	AddInferenceCategory(context.Context, *InferenceEntry) (*InferenceEntry, error)
+	UpdateInferenceCategory(context.Context, *InferenceEntry) (*InferenceEntry, error)
+	DeleteInferenceCategory(context.Context, *InferenceDeletionReq) (*Empty, error)
+	// Streams download progress as it happens
+	WatchDownloads(*WatchDownloadsRequest, Scheduler_WatchDownloadsServer) error
 	mustEmbedUnimplementedSchedulerServer()
 }
 
@@ -190,9 +250,18 @@ func (UnimplementedSchedulerServer) UnapproveVideo(context.Context, *ApproveVide
 func (UnimplementedSchedulerServer) GetInferenceCategories(context.Context, *Empty) (*InferenceList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInferenceCategories not implemented")
 }
-func (UnimplementedSchedulerServer) AddInferenceCategory(context.Context, *InferenceEntry) (*Empty, error) {
+func (UnimplementedSchedulerServer) AddInferenceCategory(context.Context, *InferenceEntry) (*InferenceEntry, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddInferenceCategory not implemented")
 }
+func (UnimplementedSchedulerServer) UpdateInferenceCategory(context.Context, *InferenceEntry) (*InferenceEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInferenceCategory not implemented")
+}
+func (UnimplementedSchedulerServer) DeleteInferenceCategory(context.Context, *InferenceDeletionReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInferenceCategory not implemented")
+}
+func (UnimplementedSchedulerServer) WatchDownloads(*WatchDownloadsRequest, Scheduler_WatchDownloadsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchDownloads not implemented")
+}
 func (UnimplementedSchedulerServer) mustEmbedUnimplementedSchedulerServer() {}
 
 // UnsafeSchedulerServer may be embedded to opt out of forward compatibility for this service.
@@ -404,6 +473,63 @@ func _Scheduler_AddInferenceCategory_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Scheduler_UpdateInferenceCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InferenceEntry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).UpdateInferenceCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Scheduler/UpdateInferenceCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).UpdateInferenceCategory(ctx, req.(*InferenceEntry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_DeleteInferenceCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InferenceDeletionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).DeleteInferenceCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Scheduler/DeleteInferenceCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).DeleteInferenceCategory(ctx, req.(*InferenceDeletionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_WatchDownloads_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDownloadsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SchedulerServer).WatchDownloads(m, &schedulerWatchDownloadsServer{stream})
+}
+
+type Scheduler_WatchDownloadsServer interface {
+	Send(*DownloadEvent) error
+	grpc.ServerStream
+}
+
+type schedulerWatchDownloadsServer struct {
+	grpc.ServerStream
+}
+
+func (x *schedulerWatchDownloadsServer) Send(m *DownloadEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Scheduler_ServiceDesc is the grpc.ServiceDesc for Scheduler service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -455,7 +581,21 @@ var Scheduler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddInferenceCategory",
 			Handler:    _Scheduler_AddInferenceCategory_Handler,
 		},
+		{
+			MethodName: "UpdateInferenceCategory",
+			Handler:    _Scheduler_UpdateInferenceCategory_Handler,
+		},
+		{
+			MethodName: "DeleteInferenceCategory",
+			Handler:    _Scheduler_DeleteInferenceCategory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchDownloads",
+			Handler:       _Scheduler_WatchDownloads_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "scheduler.proto",
 }
@@ -0,0 +1,91 @@
+// migrate_storage copies every video's objects (original, thumbnail, metadata, manifests and segments) from one storage
+// backend to another, verifying each copy's checksum. It can be interrupted and run again, and it will carry on where it
+// left off.
+//
+// Postgres is configured with the same environment variables as the video service, and the backends' credentials with
+// SRC_STORAGE_API_ID, SRC_STORAGE_API_KEY, DST_STORAGE_API_ID and DST_STORAGE_API_KEY.
+//
+//	migrate_storage -src-backend s3 -src-bucket otomads -src-endpoint http://horaminio:9000 \
+//		-dst-backend b2 -dst-bucket otomads -state /data/migrate.state
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/caarlos0/env"
+	"github.com/horahoradev/PrometheusTube/backend/video_service/internal/config"
+	"github.com/horahoradev/PrometheusTube/backend/video_service/internal/models"
+	"github.com/horahoradev/PrometheusTube/backend/video_service/internal/storagemigrate"
+	"github.com/horahoradev/PrometheusTube/backend/video_service/storage"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+	log "github.com/sirupsen/logrus"
+)
+
+func storageFlags(prefix string) *storage.Config {
+	var c storage.Config
+	flag.StringVar(&c.Backend, prefix+"-backend", "", "storage backend (b2, s3 or fs)")
+	flag.StringVar(&c.Bucket, prefix+"-bucket", "otomads", "bucket name (b2, s3)")
+	flag.StringVar(&c.Endpoint, prefix+"-endpoint", "", "endpoint for S3 compatible storage, e.g. MinIO (s3)")
+	flag.StringVar(&c.Root, prefix+"-root", "", "directory (fs)")
+	return &c
+}
+
+func main() {
+	src := storageFlags("src")
+	dst := storageFlags("dst")
+	dryRun := flag.Bool("dry-run", false, "log what would be copied without copying anything")
+	statePath := flag.String("state", "", "file to record progress in, so that the migration can be resumed")
+	flag.Parse()
+
+	src.APIID, src.APIKey = os.Getenv("SRC_STORAGE_API_ID"), os.Getenv("SRC_STORAGE_API_KEY")
+	dst.APIID, dst.APIKey = os.Getenv("DST_STORAGE_API_ID"), os.Getenv("DST_STORAGE_API_KEY")
+
+	if src.Backend == "" || dst.Backend == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var pgInfo config.PostgresInfo
+	err := env.Parse(&pgInfo)
+	if err != nil {
+		log.Fatalf("Failed to read postgres config. Err: %s", err)
+	}
+
+	db, err := sqlx.Connect("postgres", fmt.Sprintf("host=%s user=%s password=%s dbname=%s sslmode=disable", pgInfo.Hostname, pgInfo.Username, pgInfo.Password, pgInfo.Db))
+	if err != nil {
+		log.Fatalf("Could not connect to postgres. Err: %s", err)
+	}
+
+	srcStorage, err := storage.New(*src)
+	if err != nil {
+		log.Fatalf("Could not initialize source storage. Err: %s", err)
+	}
+
+	dstStorage, err := storage.New(*dst)
+	if err != nil {
+		log.Fatalf("Could not initialize destination storage. Err: %s", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stop()
+
+	m := storagemigrate.Migrator{
+		Videos:    models.NewVideoLocationModel(db),
+		Src:       srcStorage,
+		Dst:       dstStorage,
+		DryRun:    *dryRun,
+		StatePath: *statePath,
+	}
+
+	stats, err := m.Run(ctx)
+	log.Infof("Migrated %d videos: copied %d objects (%d bytes), skipped %d which were already there", stats.Videos, stats.Copied, stats.Bytes, stats.Skipped)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
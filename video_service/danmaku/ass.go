@@ -0,0 +1,390 @@
+package danmaku
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Danmaku are rendered into ASS the way niconico shows them: scrolling comments cross the screen right to left in lanes,
+// and fixed comments stack down from the top or up from the bottom. Lanes are reused as soon as the previous comment
+// won't be overlapped, and when every lane is busy the one which frees up first is shared.
+
+const (
+	assWidth  = 1920
+	assHeight = 1080
+	// How long comments are on screen, in seconds
+	assScrollDuration = 8.0
+	assFixedDuration  = 4.0
+	assLaneHeight     = 60
+	assLanes          = assHeight / assLaneHeight
+)
+
+var assFontSizes = map[string]int{
+	SizeSmall:  36,
+	SizeMedium: 48,
+	SizeBig:    64,
+}
+
+// ASS files start with this section
+const assSignature = "[Script Info]"
+
+const assHeader = `[Script Info]
+; Danmaku exported from PrometheusTube
+ScriptType: v4.00+
+PlayResX: %d
+PlayResY: %d
+WrapStyle: 2
+ScaledBorderAndShadow: yes
+
+[V4+ Styles]
+Format: Name, Fontname, Fontsize, PrimaryColour, SecondaryColour, OutlineColour, BackColour, Bold, Italic, Underline, StrikeOut, ScaleX, ScaleY, Spacing, Angle, BorderStyle, Outline, Shadow, Alignment, MarginL, MarginR, MarginV, Encoding
+Style: Danmaku,sans-serif,%d,&H33FFFFFF,&H33FFFFFF,&H33000000,&H33000000,1,0,0,0,100,100,0,0,1,2,0,7,0,0,0,1
+
+[Events]
+Format: Layer, Start, End, Style, Name, MarginL, MarginR, MarginV, Effect, Text
+`
+
+// WriteASS renders the comments as subtitles for a 1080p video, for players which don't understand danmaku. Comments
+// must be in the order they appear in the video.
+func WriteASS(w io.Writer, comments []Comment) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, assHeader, assWidth, assHeight, assFontSizes[SizeMedium])
+
+	var scrolling [assLanes]struct {
+		used         bool
+		start, width float64
+	}
+	var top, bottom [assLanes]float64 // when the lane's comment disappears
+
+	for _, comment := range comments {
+		size, ok := assFontSizes[comment.Size]
+		if !ok {
+			size = assFontSizes[SizeMedium]
+		}
+		width := assTextWidth(comment.Text, size)
+
+		var duration float64
+		var tags string
+		switch comment.Position {
+		case PositionTop, PositionBottom:
+			duration = assFixedDuration
+
+			lanes := &top
+			if comment.Position == PositionBottom {
+				lanes = &bottom
+			}
+
+			lane := 0
+			for i, end := range lanes {
+				if end <= comment.Time {
+					lane = i
+					break
+				}
+				if end < lanes[lane] {
+					lane = i
+				}
+			}
+			lanes[lane] = comment.Time + duration
+
+			if comment.Position == PositionTop {
+				tags = fmt.Sprintf(`\an8\pos(%d,%d)`, assWidth/2, lane*assLaneHeight)
+			} else {
+				tags = fmt.Sprintf(`\an2\pos(%d,%d)`, assWidth/2, assHeight-lane*assLaneHeight)
+			}
+
+		default:
+			duration = assScrollDuration
+
+			lane := 0
+			for i, l := range scrolling {
+				// The previous comment has to be all the way on screen, and this one can't catch up to it before it
+				// leaves
+				if !l.used || (l.start+duration*l.width/(assWidth+l.width) <= comment.Time &&
+					comment.Time+duration*assWidth/(assWidth+width) >= l.start+duration) {
+					lane = i
+					break
+				}
+				if l.start < scrolling[lane].start {
+					lane = i
+				}
+			}
+			scrolling[lane].used = true
+			scrolling[lane].start = comment.Time
+			scrolling[lane].width = width
+
+			y := lane * assLaneHeight
+			tags = fmt.Sprintf(`\an7\move(%d,%d,%d,%d)`, assWidth, y, -int(math.Ceil(width)), y)
+		}
+
+		if size != assFontSizes[SizeMedium] {
+			tags += fmt.Sprintf(`\fs%d`, size)
+		}
+
+		color := rgb(comment.Color)
+		if color != 0xffffff {
+			tags += `\c` + assColor(color)
+		}
+
+		// Dark text gets a light border so it can be read
+		r, g, b := color>>16, (color>>8)&0xff, color&0xff
+		if r*299+g*587+b*114 < 64*1000 {
+			tags += `\3c&HFFFFFF&`
+		}
+
+		fmt.Fprintf(bw, "Dialogue: 2,%s,%s,Danmaku,,0,0,0,,{%s}%s\n",
+			assTime(comment.Time), assTime(comment.Time+duration), tags, assEscape(comment.Text))
+	}
+
+	return bw.Flush()
+}
+
+// assTextWidth estimates how wide the text is when rendered, assuming wide characters are square and everything else
+// is half as wide
+func assTextWidth(text string, size int) float64 {
+	var width float64
+	for _, r := range text {
+		if r >= 0x1100 {
+			width += float64(size)
+		} else {
+			width += float64(size) / 2
+		}
+	}
+	return width
+}
+
+// assTime formats seconds as h:mm:ss.cc
+func assTime(seconds float64) string {
+	cs := int64(math.Round(seconds * 100))
+	return fmt.Sprintf("%d:%02d:%02d.%02d", cs/360000, cs/6000%60, cs/100%60, cs%100)
+}
+
+// assColor formats a color as ASS's &HBBGGRR&
+func assColor(color uint32) string {
+	return fmt.Sprintf("&H%02X%02X%02X&", color&0xff, (color>>8)&0xff, color>>16)
+}
+
+// Braces start override tags and backslashes start escapes, so swap them for their fullwidth forms
+var assEscaper = strings.NewReplacer("{", "｛", "}", "｝", `\`, "＼", "\r", "", "\n", " ")
+
+func assEscape(text string) string {
+	return assEscaper.Replace(text)
+}
+
+var (
+	assOverrideRe = regexp.MustCompile(`\{[^}]*\}`)
+	assAlignRe    = regexp.MustCompile(`\\an([1-9])`)
+	assPosRe      = regexp.MustCompile(`\\pos\(\s*[-\d.]+\s*,\s*([-\d.]+)\s*\)`)
+	assColorRe    = regexp.MustCompile(`\\1?c&H([0-9A-Fa-f]{1,8})&?`)
+	assFontSizeRe = regexp.MustCompile(`\\fs([\d.]+)`)
+)
+
+type assStyle struct {
+	fontSize float64
+	color    string
+}
+
+// parseASS reads danmaku rendered as subtitles, e.g. by WriteASS or DanmakuFactory. The position, color and size are
+// recovered from each line's style and override tags.
+func parseASS(data []byte) ([]Comment, error) {
+	playResY := float64(assHeight)
+	styles := make(map[string]assStyle)
+	var styleFormat, eventFormat []string
+	var comments []Comment
+	var section string
+	var sawEvents bool
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.ToLower(line)
+			sawEvents = sawEvents || section == "[events]"
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+
+		switch {
+		case section == "[script info]" && key == "PlayResY":
+			if y, err := strconv.ParseFloat(value, 64); err == nil && y > 0 {
+				playResY = y
+			}
+
+		case strings.HasSuffix(section, "styles]") && key == "Format":
+			styleFormat = assFields(value, -1)
+
+		case strings.HasSuffix(section, "styles]") && key == "Style":
+			fields := assFields(value, len(styleFormat))
+			var name string
+			var style assStyle
+			for i, field := range fields {
+				switch strings.ToLower(styleFormat[i]) {
+				case "name":
+					name = field
+				case "fontsize":
+					style.fontSize, _ = strconv.ParseFloat(field, 64)
+				case "primarycolour":
+					style.color = fromASSColor(field)
+				}
+			}
+			styles[name] = style
+
+		case section == "[events]" && key == "Format":
+			eventFormat = assFields(value, -1)
+
+		case section == "[events]" && key == "Dialogue":
+			if len(eventFormat) == 0 {
+				return nil, fmt.Errorf("%w: dialogue before the events format", ErrUnknownFormat)
+			}
+
+			fields := assFields(value, len(eventFormat))
+			if len(fields) != len(eventFormat) {
+				continue
+			}
+
+			var start float64
+			var style, text string
+			var err error
+			for i, field := range fields {
+				switch strings.ToLower(eventFormat[i]) {
+				case "start":
+					start, err = parseASSTime(field)
+				case "style":
+					style = field
+				case "text":
+					text = field
+				}
+			}
+			if err != nil {
+				continue
+			}
+
+			comments = appendComment(comments, fromASS(start, text, styles[style], style, playResY))
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read ass. Err: %s", err)
+	}
+
+	if !sawEvents {
+		return nil, fmt.Errorf("%w: no events in ass", ErrUnknownFormat)
+	}
+
+	return comments, nil
+}
+
+func fromASS(start float64, text string, style assStyle, styleName string, playResY float64) Comment {
+	comment := Comment{
+		Time:     start,
+		Position: PositionBottom,
+		Color:    "#ffffff",
+		Size:     SizeMedium,
+	}
+
+	if style.color != "" {
+		comment.Color = style.color
+	}
+
+	var tags string
+	for _, block := range assOverrideRe.FindAllString(text, -1) {
+		tags += block
+	}
+
+	lowerStyle := strings.ToLower(styleName)
+	align := assAlignRe.FindStringSubmatch(tags)
+	switch {
+	case strings.Contains(tags, `\move`):
+		comment.Position = PositionScroll
+	case align != nil && align[1] >= "7":
+		comment.Position = PositionTop
+	case align != nil && align[1] <= "3":
+		comment.Position = PositionBottom
+	case strings.Contains(lowerStyle, "top"):
+		comment.Position = PositionTop
+	case strings.Contains(lowerStyle, "btm") || strings.Contains(lowerStyle, "bottom"):
+		comment.Position = PositionBottom
+	case strings.Contains(lowerStyle, "r2l") || strings.Contains(lowerStyle, "l2r"):
+		comment.Position = PositionScroll
+	default:
+		if pos := assPosRe.FindStringSubmatch(tags); pos != nil {
+			if y, err := strconv.ParseFloat(pos[1], 64); err == nil && y < playResY/2 {
+				comment.Position = PositionTop
+			}
+		}
+	}
+
+	if c := assColorRe.FindStringSubmatch(tags); c != nil {
+		comment.Color = fromASSColor("&H" + c[1])
+	}
+
+	base := style.fontSize
+	if base <= 0 {
+		base = float64(assFontSizes[SizeMedium]) * playResY / assHeight
+	}
+	size := base
+	if fs := assFontSizeRe.FindStringSubmatch(tags); fs != nil {
+		size, _ = strconv.ParseFloat(fs[1], 64)
+	}
+	switch {
+	case size < base*0.85:
+		comment.Size = SizeSmall
+	case size > base*1.15:
+		comment.Size = SizeBig
+	}
+
+	text = assOverrideRe.ReplaceAllString(text, "")
+	comment.Text = strings.NewReplacer(`\N`, " ", `\n`, " ", `\h`, " ").Replace(text)
+
+	return comment
+}
+
+// assFields splits a comma separated list into at most n fields, so that commas in the last field are kept
+func assFields(s string, n int) []string {
+	fields := strings.SplitN(s, ",", n)
+	for i := range fields {
+		fields[i] = strings.TrimSpace(fields[i])
+	}
+	return fields
+}
+
+// parseASSTime parses h:mm:ss.cc
+func parseASSTime(s string) (float64, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid time %s", s)
+	}
+
+	var seconds float64
+	for _, part := range parts {
+		n, err := strconv.ParseFloat(part, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid time %s", s)
+		}
+		seconds = seconds*60 + n
+	}
+
+	return seconds, nil
+}
+
+// fromASSColor converts &HAABBGGRR (alpha is optional) to #rrggbb
+func fromASSColor(s string) string {
+	s = strings.TrimSuffix(strings.TrimPrefix(strings.ToUpper(s), "&H"), "&")
+	n, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return "#ffffff"
+	}
+
+	return fmt.Sprintf("#%02x%02x%02x", n&0xff, (n>>8)&0xff, (n>>16)&0xff)
+}
@@ -0,0 +1,133 @@
+package danmaku
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type bilibiliDanmaku struct {
+	XMLName xml.Name `xml:"d"`
+	P       string   `xml:"p,attr"`
+	Text    string   `xml:",chardata"`
+}
+
+// bilibili's modes
+const (
+	bilibiliScroll = 1
+	bilibiliBottom = 4
+	bilibiliTop    = 5
+)
+
+// bilibili's font sizes
+var bilibiliSizes = map[string]int{
+	SizeSmall:  18,
+	SizeMedium: 25,
+	SizeBig:    36,
+}
+
+// fromBilibili converts a bilibili danmaku. p is a comma separated list of attributes: the time in seconds, the mode,
+// the font size, the color as a decimal number and the unix timestamp it was posted at, followed by some we don't need.
+func fromBilibili(p, text string) (Comment, bool) {
+	attrs := strings.Split(p, ",")
+	if len(attrs) < 4 {
+		return Comment{}, false
+	}
+
+	seconds, err := strconv.ParseFloat(attrs[0], 64)
+	if err != nil {
+		return Comment{}, false
+	}
+
+	comment := Comment{
+		Time:     seconds,
+		Text:     text,
+		Position: PositionScroll,
+		Size:     SizeMedium,
+	}
+
+	switch attrs[1] {
+	case "1", "2", "3", "6":
+	case "4":
+		comment.Position = PositionBottom
+	case "5":
+		comment.Position = PositionTop
+	default:
+		// Advanced and scripted danmaku can't be shown as plain text
+		return Comment{}, false
+	}
+
+	size, err := strconv.Atoi(attrs[2])
+	switch {
+	case err != nil:
+	case size < bilibiliSizes[SizeMedium]:
+		comment.Size = SizeSmall
+	case size > bilibiliSizes[SizeMedium]:
+		comment.Size = SizeBig
+	}
+
+	color, err := strconv.ParseUint(attrs[3], 10, 32)
+	if err != nil {
+		color = 0xffffff
+	}
+	comment.Color = fmt.Sprintf("#%06x", color&0xffffff)
+
+	if len(attrs) > 4 {
+		posted, err := strconv.ParseInt(attrs[4], 10, 64)
+		if err == nil && posted > 0 {
+			comment.PostedAt = time.Unix(posted, 0).UTC()
+		}
+	}
+
+	return comment, true
+}
+
+// WriteBilibili writes the comments as bilibili's XML
+func WriteBilibili(w io.Writer, comments []Comment) error {
+	_, err := io.WriteString(w, xml.Header+"<i>\n<chatserver>chat.bilibili.com</chatserver>\n<chatid>0</chatid>\n"+
+		"<mission>0</mission>\n<maxlimit>"+strconv.Itoa(len(comments))+"</maxlimit>\n<state>0</state>\n<real_name>0</real_name>\n<source>k-v</source>\n")
+	if err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	for i, comment := range comments {
+		mode := bilibiliScroll
+		switch comment.Position {
+		case PositionTop:
+			mode = bilibiliTop
+		case PositionBottom:
+			mode = bilibiliBottom
+		}
+
+		size, ok := bilibiliSizes[comment.Size]
+		if !ok {
+			size = bilibiliSizes[SizeMedium]
+		}
+
+		var posted int64
+		if !comment.PostedAt.IsZero() {
+			posted = comment.PostedAt.Unix()
+		}
+
+		// The last four are the pool, the sender's hash, the danmaku's ID and its weight
+		err = enc.Encode(bilibiliDanmaku{
+			P:    fmt.Sprintf("%s,%d,%d,%d,%d,0,0,%d,10", strconv.FormatFloat(comment.Time, 'f', 5, 64), mode, size, rgb(comment.Color), posted, i+1),
+			Text: comment.Text,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = io.WriteString(w, "\n")
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = io.WriteString(w, "</i>\n")
+	return err
+}
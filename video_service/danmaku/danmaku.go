@@ -0,0 +1,193 @@
+package danmaku
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// This package converts scrolling comments (danmaku) between the form video service stores them in and the file
+// formats used by niconico, bilibili and offline players like mpv (through ASS subtitles).
+
+// Where the comment is shown
+const (
+	PositionScroll = "scroll"
+	PositionTop    = "top"
+	PositionBottom = "bottom"
+)
+
+// How big the comment is
+const (
+	SizeSmall  = "small"
+	SizeMedium = "medium"
+	SizeBig    = "big"
+)
+
+// MaxLength is the longest message video service can store, in characters. Longer ones are truncated.
+const MaxLength = 255
+
+// Comment is a single danmaku
+type Comment struct {
+	// Time is when the comment appears, in seconds from the start of the video
+	Time     float64
+	Text     string
+	Position string
+	Color    string // #rrggbb
+	Size     string
+	PostedAt time.Time // zero if unknown
+}
+
+type Format string
+
+const (
+	Bilibili Format = "bilibili" // bilibili's XML
+	Niconico Format = "niconico" // niconico's legacy XML
+	ASS      Format = "ass"      // Advanced SubStation Alpha subtitles, rendered for a 1080p video
+)
+
+var ErrUnknownFormat = errors.New("unrecognized danmaku format")
+
+// ParseFormat returns the format with the given name
+func ParseFormat(name string) (Format, error) {
+	switch f := Format(strings.ToLower(name)); f {
+	case Bilibili, Niconico, ASS:
+		return f, nil
+	}
+
+	return "", fmt.Errorf("%w: %s", ErrUnknownFormat, name)
+}
+
+// ContentType is the MIME type of files in the format
+func (f Format) ContentType() string {
+	if f == ASS {
+		return "text/x-ssa; charset=utf-8"
+	}
+	return "application/xml; charset=utf-8"
+}
+
+// Extension is the file extension for the format, without the dot
+func (f Format) Extension() string {
+	if f == ASS {
+		return "ass"
+	}
+	return "xml"
+}
+
+// Write writes the comments in the given format
+func Write(w io.Writer, f Format, comments []Comment) error {
+	switch f {
+	case Bilibili:
+		return WriteBilibili(w, comments)
+	case Niconico:
+		return WriteNiconico(w, comments)
+	case ASS:
+		return WriteASS(w, comments)
+	}
+
+	return fmt.Errorf("%w: %s", ErrUnknownFormat, f)
+}
+
+// ParseFile reads the danmaku yt-dlp wrote for a video. Niconico's comments are JSON (or XML from its legacy API), and
+// bilibili's are XML. Comments are returned in the order they appear in the video.
+func ParseFile(path string) ([]Comment, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(data)
+}
+
+// DetectFormat returns the format of a danmaku file, or "" if it isn't one we know. Niconico's JSON counts as niconico.
+func DetectFormat(data []byte) Format {
+	trimmed := trimFile(data)
+	switch {
+	case len(trimmed) == 0:
+		return ""
+	case len(trimmed) >= len(assSignature) && bytes.EqualFold(trimmed[:len(assSignature)], []byte(assSignature)):
+		return ASS
+	case trimmed[0] == '[' || trimmed[0] == '{':
+		return Niconico
+	case trimmed[0] == '<' && bytes.Contains(trimmed, []byte("<chat")):
+		return Niconico
+	case trimmed[0] == '<':
+		return Bilibili
+	}
+
+	return ""
+}
+
+// Parse reads danmaku in any of the formats we know, which it detects from the contents. Comments which can't be shown
+// (e.g. bilibili's scripted danmaku) or which are empty are skipped. Comments are returned in the order they appear in
+// the video.
+func Parse(data []byte) ([]Comment, error) {
+	var comments []Comment
+	var err error
+
+	trimmed := trimFile(data)
+	switch {
+	case len(trimmed) == 0:
+		return nil, nil
+	case DetectFormat(trimmed) == ASS:
+		comments, err = parseASS(trimmed)
+	case trimmed[0] == '[' || trimmed[0] == '{':
+		comments, err = parseNiconicoJSON(trimmed)
+	case trimmed[0] == '<':
+		comments, err = parseXML(trimmed)
+	default:
+		err = ErrUnknownFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(comments, func(i, j int) bool {
+		return comments[i].Time < comments[j].Time
+	})
+
+	return comments, nil
+}
+
+// trimFile removes the byte order mark and surrounding whitespace
+func trimFile(data []byte) []byte {
+	return bytes.TrimSpace(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf")))
+}
+
+func appendComment(comments []Comment, comment Comment) []Comment {
+	comment.Text = strings.TrimSpace(comment.Text)
+	if comment.Text == "" || comment.Time < 0 {
+		return comments
+	}
+
+	if utf8.RuneCountInString(comment.Text) > MaxLength {
+		comment.Text = string([]rune(comment.Text)[:MaxLength])
+	}
+
+	return append(comments, comment)
+}
+
+func isHexColor(s string) bool {
+	if len(s) != 7 || s[0] != '#' {
+		return false
+	}
+
+	_, err := strconv.ParseUint(s[1:], 16, 32)
+	return err == nil
+}
+
+// rgb returns the color as a number, white if it isn't a valid #rrggbb color
+func rgb(color string) uint32 {
+	if !isHexColor(color) {
+		return 0xffffff
+	}
+
+	n, _ := strconv.ParseUint(color[1:], 16, 32)
+	return uint32(n)
+}
@@ -0,0 +1,206 @@
+package danmaku
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNiconicoJSON(t *testing.T) {
+	threads := `[
+		{"fork": "owner", "comments": [
+			{"id": "1", "no": 1, "vposMs": 5000, "body": "owner comment", "commands": ["ue", "red", "big"], "postedAt": "2023-01-02T03:04:05+09:00"}
+		]},
+		{"fork": "main", "comments": [
+			{"id": "2", "no": 2, "vposMs": 1500, "body": "first", "commands": ["184"]},
+			{"id": "3", "no": 3, "vposMs": 2000, "body": "  ", "commands": []},
+			{"id": "4", "no": 4, "vposMs": 3000, "body": "hex", "commands": ["shita", "small", "#00FF00"]}
+		]}
+	]`
+
+	comments, err := Parse([]byte(threads))
+	assert.NoError(t, err)
+	assert.Equal(t, []Comment{
+		{Time: 1.5, Text: "first", Position: PositionScroll, Color: "#ffffff", Size: SizeMedium},
+		{Time: 3, Text: "hex", Position: PositionBottom, Color: "#00ff00", Size: SizeSmall},
+		{Time: 5, Text: "owner comment", Position: PositionTop, Color: "#ff0000", Size: SizeBig,
+			PostedAt: time.Date(2023, 1, 2, 3, 4, 5, 0, time.FixedZone("", 9*60*60))},
+	}, comments)
+
+	legacy := `[{"ping": {"content": "rs:0"}}, {"thread": {"resultcode": 0}},
+		{"chat": {"no": 1, "vpos": 250, "date": 1600000000, "mail": "184 ue pink", "content": "legacy"}},
+		{"chat": {"no": 2, "vpos": 300, "deleted": 1}}]`
+
+	comments, err = Parse([]byte(legacy))
+	assert.NoError(t, err)
+	assert.Equal(t, []Comment{
+		{Time: 2.5, Text: "legacy", Position: PositionTop, Color: "#ff8080", Size: SizeMedium, PostedAt: time.Unix(1600000000, 0).UTC()},
+	}, comments)
+}
+
+func TestParseXML(t *testing.T) {
+	niconico := `<?xml version="1.0" encoding="UTF-8"?>
+<packet>
+	<thread resultcode="0"/>
+	<chat thread="1" no="1" vpos="1000" date="1600000000" mail="shita" user_id="abc">nico</chat>
+</packet>`
+
+	comments, err := Parse([]byte(niconico))
+	assert.NoError(t, err)
+	assert.Equal(t, []Comment{
+		{Time: 10, Text: "nico", Position: PositionBottom, Color: "#ffffff", Size: SizeMedium, PostedAt: time.Unix(1600000000, 0).UTC()},
+	}, comments)
+
+	bilibili := `<?xml version="1.0" encoding="UTF-8"?>
+<i>
+	<chatserver>chat.bilibili.com</chatserver>
+	<d p="23.826,1,25,16777215,1422201084,0,057075e9,757076900">scroll</d>
+	<d p="3.5,5,36,16711680,1422201085,0,057075e9,757076901">top</d>
+	<d p="7,4,18,65280,0,0,057075e9,757076902">bottom</d>
+	<d p="8,7,25,16777215,1422201086,0,057075e9,757076903">[0,0,"1-1",4.5,"advanced"]</d>
+	<d p="bad">bad</d>
+</i>`
+
+	comments, err = Parse([]byte(bilibili))
+	assert.NoError(t, err)
+	assert.Equal(t, []Comment{
+		{Time: 3.5, Text: "top", Position: PositionTop, Color: "#ff0000", Size: SizeBig, PostedAt: time.Unix(1422201085, 0).UTC()},
+		{Time: 7, Text: "bottom", Position: PositionBottom, Color: "#00ff00", Size: SizeSmall},
+		{Time: 23.826, Text: "scroll", Position: PositionScroll, Color: "#ffffff", Size: SizeMedium, PostedAt: time.Unix(1422201084, 0).UTC()},
+	}, comments)
+}
+
+func TestParseInvalid(t *testing.T) {
+	comments, err := Parse(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, comments)
+
+	_, err = Parse([]byte("not danmaku"))
+	assert.Error(t, err)
+
+	_, err = Parse([]byte("[{"))
+	assert.Error(t, err)
+}
+
+func TestTruncate(t *testing.T) {
+	comments := appendComment(nil, Comment{Text: strings.Repeat("あ", MaxLength+1)})
+	assert.Equal(t, strings.Repeat("あ", MaxLength), comments[0].Text)
+}
+
+func TestWriteRoundTrip(t *testing.T) {
+	comments := []Comment{
+		{Time: 1.25, Text: "scroll <&>", Position: PositionScroll, Color: "#ffffff", Size: SizeMedium, PostedAt: time.Unix(1600000000, 0).UTC()},
+		{Time: 2, Text: "{top}", Position: PositionTop, Color: "#ff0000", Size: SizeBig},
+		{Time: 3.5, Text: `bottom \N`, Position: PositionBottom, Color: "#123456", Size: SizeSmall},
+	}
+
+	for _, format := range []Format{Bilibili, Niconico, ASS} {
+		var buf bytes.Buffer
+		assert.NoError(t, Write(&buf, format, comments), format)
+
+		parsed, err := Parse(buf.Bytes())
+		assert.NoError(t, err, format)
+		if !assert.Len(t, parsed, len(comments), format) {
+			continue
+		}
+
+		for i, comment := range comments {
+			assert.InDelta(t, comment.Time, parsed[i].Time, 0.01, format)
+			assert.Equal(t, comment.Position, parsed[i].Position, format)
+			assert.Equal(t, comment.Color, parsed[i].Color, format)
+			assert.Equal(t, comment.Size, parsed[i].Size, format)
+			if format != ASS {
+				assert.Equal(t, comment.Text, parsed[i].Text, format)
+			}
+		}
+
+		// ASS has no dates
+		if format != ASS {
+			assert.Equal(t, comments[0].PostedAt, parsed[0].PostedAt, format)
+		}
+	}
+}
+
+func TestParseASS(t *testing.T) {
+	// As written by DanmakuFactory
+	ass := "\xef\xbb\xbf[Script Info]\nScriptType: v4.00+\nPlayResX: 1920\nPlayResY: 1080\n\n" +
+		"[V4+ Styles]\nFormat: Name, Fontname, Fontsize, PrimaryColour, SecondaryColour, OutlineColour, BackColour, Bold, Italic, Underline, StrikeOut, ScaleX, ScaleY, Spacing, Angle, BorderStyle, Outline, Shadow, Alignment, MarginL, MarginR, MarginV, Encoding\n" +
+		"Style: R2L,Microsoft YaHei,38,&H30FFFFFF,&H30FFFFFF,&H30000000,&H30000000,1,0,0,0,100,100,0,0,1,2,0,7,0,0,0,1\n" +
+		"Style: TOP,Microsoft YaHei,38,&H30FFFFFF,&H30FFFFFF,&H30000000,&H30000000,1,0,0,0,100,100,0,0,1,2,0,8,0,0,0,1\n\n" +
+		"[Events]\nFormat: Layer, Start, End, Style, Name, MarginL, MarginR, MarginV, Effect, Text\n" +
+		"Dialogue: 0,0:01:02.50,0:01:14.50,R2L,,0000,0000,0000,,{\\move(1920,0,-200,0)\\c&H0000FF&}red, scrolling\n" +
+		"Dialogue: 0,0:00:01.00,0:00:05.00,TOP,,0000,0000,0000,,{\\pos(960,0)\\fs52}big top\n" +
+		"Dialogue: 0,bad,0:00:05.00,TOP,,0000,0000,0000,,bad time\n"
+
+	comments, err := Parse([]byte(ass))
+	assert.NoError(t, err)
+	assert.Equal(t, []Comment{
+		{Time: 1, Text: "big top", Position: PositionTop, Color: "#ffffff", Size: SizeBig},
+		{Time: 62.5, Text: "red, scrolling", Position: PositionScroll, Color: "#ff0000", Size: SizeMedium},
+	}, comments)
+
+	_, err = Parse([]byte("[Script Info]\nTitle: no events\n"))
+	assert.ErrorIs(t, err, ErrUnknownFormat)
+}
+
+func TestWriteASSLanes(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, WriteASS(&buf, []Comment{
+		{Time: 0, Text: "a", Position: PositionScroll, Color: "#ffffff", Size: SizeMedium},
+		{Time: 0, Text: "b", Position: PositionScroll, Color: "#ffffff", Size: SizeMedium},
+		{Time: 0, Text: "c", Position: PositionTop, Color: "#000000", Size: SizeMedium},
+	}))
+
+	out := buf.String()
+	assert.Contains(t, out, `{\an7\move(1920,0,-24,0)}a`)
+	// The first lane's busy, so the second comment goes underneath
+	assert.Contains(t, out, `{\an7\move(1920,60,-24,60)}b`)
+	assert.Contains(t, out, `{\an8\pos(960,0)\c&H000000&\3c&HFFFFFF&}c`)
+	assert.Contains(t, out, "Dialogue: 2,0:00:00.00,0:00:08.00,")
+}
+
+func TestParseFormat(t *testing.T) {
+	f, err := ParseFormat("ASS")
+	assert.NoError(t, err)
+	assert.Equal(t, ASS, f)
+	assert.Equal(t, "ass", f.Extension())
+
+	assert.Equal(t, ASS, DetectFormat([]byte("\xef\xbb\xbf[Script Info]\n")))
+	assert.Equal(t, Niconico, DetectFormat([]byte(`[{"fork": "main", "comments": []}]`)))
+	assert.Equal(t, Niconico, DetectFormat([]byte(`<packet><chat vpos="0">hi</chat></packet>`)))
+	assert.Equal(t, Bilibili, DetectFormat([]byte(`<i><d p="0,1,25,16777215">hi</d></i>`)))
+	assert.Equal(t, Format(""), DetectFormat([]byte("1\n00:00:01,000 --> 00:00:02,000\nsrt")))
+
+	_, err = ParseFormat("srt")
+	assert.ErrorIs(t, err, ErrUnknownFormat)
+}
+
+func TestValidate(t *testing.T) {
+	valid := Comment{Time: 1, Text: "hi", Position: PositionTop, Color: "#abcdef", Size: SizeBig}
+	assert.NoError(t, valid.Validate())
+
+	invalid := []Comment{
+		{Time: -1, Text: "hi", Position: PositionTop, Color: "#abcdef", Size: SizeBig},
+		{Time: 1, Text: " ", Position: PositionTop, Color: "#abcdef", Size: SizeBig},
+		{Time: 1, Text: strings.Repeat("a", MaxLength+1), Position: PositionTop, Color: "#abcdef", Size: SizeBig},
+		{Time: 1, Text: "hi", Position: "sideways", Color: "#abcdef", Size: SizeBig},
+		{Time: 1, Text: "hi", Position: PositionTop, Color: "red", Size: SizeBig},
+		{Time: 1, Text: "hi", Position: PositionTop, Color: "#abcdef", Size: "huge"},
+	}
+	for _, c := range invalid {
+		assert.Error(t, c.Validate(), c)
+	}
+}
+
+func TestProto(t *testing.T) {
+	c := Comment{Time: 1.5, Text: "hi", Position: PositionTop, Color: "#abcdef", Size: SizeBig}
+	assert.Equal(t, c, FromProto(c.ToProto()))
+
+	// Danmaku posted with values we don't know
+	assert.Equal(t, Comment{Time: 0, Text: "old", Position: PositionScroll, Color: "#ffffff", Size: SizeMedium},
+		FromProto(&videoproto.Danmaku{Timestamp: "soon", Message: "old", Type: "1", Color: "white", FontSize: "25"}))
+}
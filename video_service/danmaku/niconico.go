@@ -0,0 +1,248 @@
+package danmaku
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Niconico's current API returns a list of threads (owner comments, main comments, easy comments...)
+type niconicoThread struct {
+	Comments []struct {
+		VposMs   int64    `json:"vposMs"`
+		Body     string   `json:"body"`
+		Commands []string `json:"commands"`
+		PostedAt string   `json:"postedAt"`
+	} `json:"comments"`
+}
+
+// The legacy API returns a list of packets, of which the chats are the comments
+type niconicoChat struct {
+	XMLName xml.Name `json:"-" xml:"chat"`
+	Thread  string   `json:"thread" xml:"thread,attr"`
+	No      int      `json:"no" xml:"no,attr"`
+	Vpos    int64    `json:"vpos" xml:"vpos,attr"` // hundredths of a second
+	Date    int64    `json:"date" xml:"date,attr,omitempty"`
+	Mail    string   `json:"mail" xml:"mail,attr,omitempty"` // space separated commands
+	Content string   `json:"content" xml:",chardata"`
+	Deleted int      `json:"deleted" xml:"deleted,attr,omitempty"`
+}
+
+func parseNiconicoJSON(data []byte) ([]Comment, error) {
+	var packets []json.RawMessage
+	if data[0] == '{' {
+		// A single thread
+		packets = []json.RawMessage{data}
+	} else {
+		err := json.Unmarshal(data, &packets)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode niconico comments. Err: %s", err)
+		}
+	}
+
+	var comments []Comment
+	for _, packet := range packets {
+		var p struct {
+			niconicoThread
+			Chat *niconicoChat `json:"chat"`
+		}
+
+		err := json.Unmarshal(packet, &p)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode niconico comments. Err: %s", err)
+		}
+
+		for _, c := range p.Comments {
+			comment := fromNiconico(float64(c.VposMs)/1000, c.Body, c.Commands)
+			comment.PostedAt, _ = time.Parse(time.RFC3339, c.PostedAt)
+			comments = appendComment(comments, comment)
+		}
+
+		if p.Chat != nil && p.Chat.Deleted == 0 {
+			comments = appendComment(comments, fromNiconicoChat(*p.Chat))
+		}
+	}
+
+	return comments, nil
+}
+
+// parseXML reads niconico's and bilibili's XML, which can be told apart by their elements
+func parseXML(data []byte) ([]Comment, error) {
+	var doc struct {
+		XMLName xml.Name
+		// niconico
+		Chats []niconicoChat `xml:"chat"`
+		// bilibili
+		D []bilibiliDanmaku `xml:"d"`
+	}
+
+	err := xml.Unmarshal(data, &doc)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode danmaku xml. Err: %s", err)
+	}
+
+	var comments []Comment
+	for _, chat := range doc.Chats {
+		if chat.Deleted == 0 {
+			comments = appendComment(comments, fromNiconicoChat(chat))
+		}
+	}
+
+	for _, d := range doc.D {
+		comment, ok := fromBilibili(d.P, d.Text)
+		if ok {
+			comments = appendComment(comments, comment)
+		}
+	}
+
+	return comments, nil
+}
+
+func fromNiconicoChat(chat niconicoChat) Comment {
+	comment := fromNiconico(float64(chat.Vpos)/100, chat.Content, strings.Fields(chat.Mail))
+	if chat.Date > 0 {
+		comment.PostedAt = time.Unix(chat.Date, 0).UTC()
+	}
+	return comment
+}
+
+// The colors anyone can use come first, so that they're preferred when exporting
+var niconicoColorNames = []string{"white", "red", "pink", "orange", "yellow", "green", "cyan", "blue", "purple", "black"}
+
+var niconicoColors = map[string]string{
+	"white":  "#ffffff",
+	"red":    "#ff0000",
+	"pink":   "#ff8080",
+	"orange": "#ffc000",
+	"yellow": "#ffff00",
+	"green":  "#00ff00",
+	"cyan":   "#00ffff",
+	"blue":   "#0000ff",
+	"purple": "#c000ff",
+	"black":  "#000000",
+	// Premium colors
+	"white2":         "#cccc99",
+	"niconicowhite":  "#cccc99",
+	"red2":           "#cc0033",
+	"truered":        "#cc0033",
+	"pink2":          "#ff33cc",
+	"orange2":        "#ff6600",
+	"passionorange":  "#ff6600",
+	"yellow2":        "#999900",
+	"madyellow":      "#999900",
+	"green2":         "#00cc66",
+	"elementalgreen": "#00cc66",
+	"cyan2":          "#00cccc",
+	"blue2":          "#3399ff",
+	"marineblue":     "#3399ff",
+	"purple2":        "#6633cc",
+	"nobleviolet":    "#6633cc",
+	"black2":         "#666666",
+}
+
+// fromNiconico converts a niconico comment. Its position, color and size are given by commands, e.g. "ue red big".
+func fromNiconico(seconds float64, text string, commands []string) Comment {
+	comment := Comment{
+		Time:     seconds,
+		Text:     text,
+		Position: PositionScroll,
+		Color:    niconicoColors["white"],
+		Size:     SizeMedium,
+	}
+
+	for _, command := range commands {
+		command = strings.ToLower(command)
+		switch command {
+		case "ue":
+			comment.Position = PositionTop
+		case "shita":
+			comment.Position = PositionBottom
+		case "naka":
+			comment.Position = PositionScroll
+		case "big":
+			comment.Size = SizeBig
+		case "small":
+			comment.Size = SizeSmall
+		case "medium":
+			comment.Size = SizeMedium
+		default:
+			if color, ok := niconicoColors[command]; ok {
+				comment.Color = color
+			} else if isHexColor(command) {
+				comment.Color = command
+			}
+		}
+	}
+
+	return comment
+}
+
+// niconicoCommands is the inverse of fromNiconico
+func niconicoCommands(comment Comment) string {
+	var commands []string
+	switch comment.Position {
+	case PositionTop:
+		commands = append(commands, "ue")
+	case PositionBottom:
+		commands = append(commands, "shita")
+	}
+
+	switch comment.Size {
+	case SizeBig:
+		commands = append(commands, "big")
+	case SizeSmall:
+		commands = append(commands, "small")
+	}
+
+	color := strings.ToLower(comment.Color)
+	if isHexColor(color) && color != niconicoColors["white"] {
+		name := color
+		for _, n := range niconicoColorNames {
+			if niconicoColors[n] == color {
+				name = n
+				break
+			}
+		}
+		commands = append(commands, name)
+	}
+
+	return strings.Join(commands, " ")
+}
+
+// WriteNiconico writes the comments as niconico's legacy XML, which most danmaku players and converters understand
+func WriteNiconico(w io.Writer, comments []Comment) error {
+	_, err := io.WriteString(w, xml.Header+"<packet>\n")
+	if err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	for i, comment := range comments {
+		chat := niconicoChat{
+			Thread:  "0",
+			No:      i + 1,
+			Vpos:    int64(comment.Time*100 + 0.5),
+			Mail:    niconicoCommands(comment),
+			Content: comment.Text,
+		}
+		if !comment.PostedAt.IsZero() {
+			chat.Date = comment.PostedAt.Unix()
+		}
+
+		err = enc.Encode(chat)
+		if err != nil {
+			return err
+		}
+
+		_, err = io.WriteString(w, "\n")
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = io.WriteString(w, "</packet>\n")
+	return err
+}
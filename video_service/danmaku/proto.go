@@ -0,0 +1,72 @@
+package danmaku
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+)
+
+// Validate checks that the comment can be stored as is
+func (c Comment) Validate() error {
+	switch {
+	case c.Time < 0 || math.IsNaN(c.Time) || math.IsInf(c.Time, 0):
+		return fmt.Errorf("invalid time %v", c.Time)
+	case strings.TrimSpace(c.Text) == "":
+		return fmt.Errorf("empty message at %v", c.Time)
+	case utf8.RuneCountInString(c.Text) > MaxLength:
+		return fmt.Errorf("message at %v is longer than %d characters", c.Time, MaxLength)
+	case c.Position != PositionScroll && c.Position != PositionTop && c.Position != PositionBottom:
+		return fmt.Errorf("invalid position %s", c.Position)
+	case c.Size != SizeSmall && c.Size != SizeMedium && c.Size != SizeBig:
+		return fmt.Errorf("invalid size %s", c.Size)
+	case !isHexColor(c.Color):
+		return fmt.Errorf("invalid color %s", c.Color)
+	}
+
+	return nil
+}
+
+// ToProto converts the comment to the form video service stores it in
+func (c Comment) ToProto() *videoproto.Danmaku {
+	return &videoproto.Danmaku{
+		Timestamp: strconv.FormatFloat(c.Time, 'f', -1, 64),
+		Message:   c.Text,
+		Type:      c.Position,
+		Color:     c.Color,
+		FontSize:  c.Size,
+	}
+}
+
+// FromProto converts danmaku from video service. Danmaku posted before the fields were validated may have values we
+// don't know, so those fall back to the defaults rather than failing.
+func FromProto(dn *videoproto.Danmaku) Comment {
+	c := Comment{
+		Text:     dn.Message,
+		Position: dn.Type,
+		Color:    strings.ToLower(dn.Color),
+		Size:     dn.FontSize,
+	}
+
+	c.Time, _ = strconv.ParseFloat(dn.Timestamp, 64)
+	if c.Time < 0 || math.IsNaN(c.Time) || math.IsInf(c.Time, 0) {
+		c.Time = 0
+	}
+
+	if c.Position != PositionTop && c.Position != PositionBottom {
+		c.Position = PositionScroll
+	}
+
+	if c.Size != SizeSmall && c.Size != SizeBig {
+		c.Size = SizeMedium
+	}
+
+	if !isHexColor(c.Color) {
+		c.Color = "#ffffff"
+	}
+
+	return c
+}
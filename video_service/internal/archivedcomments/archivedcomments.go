@@ -0,0 +1,161 @@
+package archivedcomments
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// This package reads the comments which yt-dlp archives (with --get-comments) into a video's info JSON.
+
+// MaxLength is the longest comment the comments table can hold, longer ones are truncated
+const MaxLength = 4096
+
+// Comment is a comment from the original site
+type Comment struct {
+	ID string
+	// ParentID is the ID of the comment being replied to, or "" for top level comments
+	ParentID       string
+	Text           string
+	AuthorID       string
+	AuthorUsername string
+	CreatedAt      time.Time // zero if the site didn't say
+	LikeCount      int64
+}
+
+// The fields of yt-dlp's comment dicts we care about. Numbers are floats, since some extractors write them that way.
+type ytdlpComment struct {
+	ID        jsonID   `json:"id"`
+	Parent    jsonID   `json:"parent"`
+	Text      string   `json:"text"`
+	Author    string   `json:"author"`
+	AuthorID  jsonID   `json:"author_id"`
+	Timestamp *float64 `json:"timestamp"`
+	LikeCount *float64 `json:"like_count"`
+}
+
+// jsonID is an ID which is either a string or a number, e.g. bilibili's comment and user IDs are numbers
+type jsonID string
+
+func (id *jsonID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*id = ""
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		err := json.Unmarshal(data, &s)
+		*id = jsonID(s)
+		return err
+	}
+
+	var n json.Number
+	err := json.Unmarshal(data, &n)
+	if err != nil {
+		return fmt.Errorf("id must be a string or a number, got %s", data)
+	}
+	*id = jsonID(n.String())
+	return nil
+}
+
+// Parse reads the comments from a yt-dlp info JSON. Comments are returned in an order where each reply comes after the
+// comment it's replying to. Replies to comments which weren't archived become top level comments.
+func Parse(r io.Reader) ([]Comment, error) {
+	var info struct {
+		Comments []ytdlpComment `json:"comments"`
+	}
+
+	err := json.NewDecoder(r).Decode(&info)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode info json. Err: %s", err)
+	}
+
+	ids := make(map[string]bool)
+	var comments []Comment
+	for _, c := range info.Comments {
+		id, authorID, author := string(c.ID), string(c.AuthorID), c.Author
+
+		// Comments without an ID can't be deduplicated
+		if id == "" || ids[id] || strings.TrimSpace(c.Text) == "" {
+			continue
+		}
+
+		// Some sites only give us one of the two. Comments without either can't be attributed to anyone.
+		if authorID == "" {
+			authorID = author
+		}
+		if author == "" {
+			author = authorID
+		}
+		if authorID == "" {
+			continue
+		}
+
+		comment := Comment{
+			ID:             id,
+			Text:           truncate(c.Text, MaxLength),
+			AuthorID:       authorID,
+			AuthorUsername: author,
+		}
+
+		if c.Parent != "root" {
+			comment.ParentID = string(c.Parent)
+		}
+
+		if c.Timestamp != nil && *c.Timestamp > 0 {
+			comment.CreatedAt = time.Unix(int64(*c.Timestamp), 0).UTC()
+		}
+
+		if c.LikeCount != nil && *c.LikeCount > 0 {
+			comment.LikeCount = int64(*c.LikeCount)
+		}
+
+		ids[id] = true
+		comments = append(comments, comment)
+	}
+
+	return threadOrder(comments, ids), nil
+}
+
+// threadOrder orders comments so that parents come before their replies
+func threadOrder(comments []Comment, ids map[string]bool) []Comment {
+	children := make(map[string][]Comment)
+	var roots []Comment
+	for _, c := range comments {
+		if c.ParentID == "" || !ids[c.ParentID] || c.ParentID == c.ID {
+			c.ParentID = ""
+			roots = append(roots, c)
+			continue
+		}
+		children[c.ParentID] = append(children[c.ParentID], c)
+	}
+
+	ret := make([]Comment, 0, len(comments))
+	queue := roots
+	for len(queue) > 0 {
+		c := queue[0]
+		queue = queue[1:]
+
+		ret = append(ret, c)
+		queue = append(queue, children[c.ID]...)
+	}
+
+	// Anything left over is part of a reply cycle, which can't be threaded
+	return ret
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+
+	// Don't cut a multibyte character in half
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
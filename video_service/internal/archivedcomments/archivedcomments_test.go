@@ -0,0 +1,67 @@
+package archivedcomments
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	info := `{
+		"id": "abc",
+		"title": "test video",
+		"comments": [
+			{"id": "3", "parent": "1", "text": "reply", "author": "bob", "author_id": "UC2", "timestamp": 1600000100},
+			{"id": "1", "parent": "root", "text": "first", "author": "alice", "author_id": "UC1", "timestamp": 1600000000, "like_count": 12},
+			{"id": "4", "parent": "3", "text": "reply to reply", "author": "alice", "author_id": "UC1"},
+			{"id": "5", "parent": "missing", "text": "orphan", "author_id": "UC3", "like_count": null},
+			{"id": "", "parent": "root", "text": "no id", "author_id": "UC1"},
+			{"id": "6", "parent": "root", "text": "   ", "author_id": "UC1"},
+			{"id": "7", "parent": "root", "text": "anonymous"},
+			{"id": "1", "parent": "root", "text": "duplicate", "author_id": "UC1"}
+		]
+	}`
+
+	comments, err := Parse(strings.NewReader(info))
+	assert.NoError(t, err)
+	assert.Equal(t, []Comment{
+		{ID: "1", Text: "first", AuthorID: "UC1", AuthorUsername: "alice", CreatedAt: time.Unix(1600000000, 0).UTC(), LikeCount: 12},
+		{ID: "5", Text: "orphan", AuthorID: "UC3", AuthorUsername: "UC3"},
+		{ID: "3", ParentID: "1", Text: "reply", AuthorID: "UC2", AuthorUsername: "bob", CreatedAt: time.Unix(1600000100, 0).UTC()},
+		{ID: "4", ParentID: "3", Text: "reply to reply", AuthorID: "UC1", AuthorUsername: "alice"},
+	}, comments)
+
+	// bilibili's comment and user IDs (rpid and mid) are numbers
+	bilibili := `{
+		"id": "BV1xx411c7mD",
+		"comments": [
+			{"id": 4508021937, "parent": "root", "text": "前排", "author": "某用户", "author_id": 1234567, "timestamp": 1600000000},
+			{"id": 4508030012, "parent": 4508021937, "text": "回复", "author": "另一个", "author_id": 7654321, "like_count": 3}
+		]
+	}`
+	comments, err = Parse(strings.NewReader(bilibili))
+	assert.NoError(t, err)
+	assert.Equal(t, []Comment{
+		{ID: "4508021937", Text: "前排", AuthorID: "1234567", AuthorUsername: "某用户", CreatedAt: time.Unix(1600000000, 0).UTC()},
+		{ID: "4508030012", ParentID: "4508021937", Text: "回复", AuthorID: "7654321", AuthorUsername: "另一个", LikeCount: 3},
+	}, comments)
+
+	_, err = Parse(strings.NewReader(`{"comments": [{"id": {"nested": true}, "text": "bad"}]}`))
+	assert.Error(t, err)
+
+	comments, err = Parse(strings.NewReader(`{"id": "abc"}`))
+	assert.NoError(t, err)
+	assert.Empty(t, comments)
+
+	_, err = Parse(strings.NewReader(`not json`))
+	assert.Error(t, err)
+}
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "abc", truncate("abc", 5))
+	assert.Equal(t, "ab", truncate("abc", 2))
+	// あ is 3 bytes
+	assert.Equal(t, "a", truncate("aあ", 2))
+}
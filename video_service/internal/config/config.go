@@ -10,6 +10,7 @@ import (
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
+	"github.com/horahoradev/PrometheusTube/backend/video_service/internal/dashutils"
 	userproto "github.com/horahoradev/horahora/user_service/protocol"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
@@ -54,6 +55,35 @@ type config struct {
 	StorageEndpoint   string `env:"StorageEndpoint"`
 	ApprovalThreshold int    `env:"ApprovalThreshold,required"`
 	MaxDLFileSize     int64  `env:"MaxDLFileSize,required"`
+	// Comma separated list of height:bitrate[:codec], e.g. 1080:5000k,720:2800k:libx264
+	TranscodeLadderSpec    string `env:"TranscodeLadder"`
+	TranscodeLadder        []dashutils.Rendition
+	NumTranscodingWorkers  int           `env:"NumTranscodingWorkers" envDefault:"1"`
+	MaxTranscodeAttempts   int           `env:"MaxTranscodeAttempts" envDefault:"3"`
+	TranscodeLeaseDuration time.Duration `env:"TranscodeLeaseDuration" envDefault:"5m"`
+	// Partial resumable uploads are kept here, this should be a persistent volume
+	UploadSessionDir string        `env:"UploadSessionDir" envDefault:"/tmp/upload_sessions"`
+	UploadSessionTTL time.Duration `env:"UploadSessionTTL" envDefault:"24h"`
+	// Used by the "fs" storage backend
+	StorageRoot    string        `env:"StorageRoot" envDefault:"/data/otomads"`
+	FileSigningKey string        `env:"FileSigningKey"`
+	SignedURLTTL   time.Duration `env:"SignedURLTTL" envDefault:"6h"`
+	// Writes are replicated to this backend as well if it's set
+	ReplicaStorageBackend  string `env:"ReplicaStorageBackend"`
+	ReplicaBucketName      string `env:"ReplicaBucketName"`
+	ReplicaStorageEndpoint string `env:"ReplicaStorageEndpoint"`
+	ReplicaStorageAPIID    string `env:"ReplicaStorageAPIID"`
+	ReplicaStorageAPIKey   string `env:"ReplicaStorageAPIKey"`
+	ReplicaStorageRoot     string `env:"ReplicaStorageRoot"`
+	// Limits on importing danmaku from files
+	MaxDanmakuPerImport    int `env:"MaxDanmakuPerImport" envDefault:"10000"`
+	MaxDailyDanmakuImports int `env:"MaxDailyDanmakuImports" envDefault:"50000"` // per user
+	DanmakuPostsPerMinute  int `env:"DanmakuPostsPerMinute" envDefault:"10"`     // per user
+	// Comma separated list of words which can't be used in danmaku
+	DanmakuBannedWords string `env:"DanmakuBannedWords"`
+	// Video lists are searched with elasticsearch (through ZomboDB) or postgres
+	SearchBackend    string `env:"SearchBackend" envDefault:"elasticsearch"`
+	ElasticsearchURL string `env:"ElasticsearchURL" envDefault:"http://elasticsearch:9200"`
 }
 
 func New() (*config, error) {
@@ -79,6 +109,15 @@ func New() (*config, error) {
 		return nil, err
 	}
 
+	config.TranscodeLadder, err = dashutils.ParseLadder(config.TranscodeLadderSpec)
+	if err != nil {
+		return nil, fmt.Errorf("Could not parse transcode ladder. Err: %s", err)
+	}
+
+	if config.NumTranscodingWorkers < 1 || config.MaxTranscodeAttempts < 1 {
+		return nil, fmt.Errorf("NumTranscodingWorkers and MaxTranscodeAttempts must be at least 1")
+	}
+
 	config.SqlClient, err = sqlx.Connect("postgres", fmt.Sprintf("host=%s user=%s password=%s dbname=%s sslmode=disable connect_timeout=180", config.PostgresInfo.Hostname, config.PostgresInfo.Username, config.PostgresInfo.Password, config.PostgresInfo.Db))
 	if err != nil {
 		return nil, fmt.Errorf("Could not connect to postgres. Err: %s", err)
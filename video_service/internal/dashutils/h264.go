@@ -1,17 +1,45 @@
 package dashutils
 
 import (
+	"context"
 	"fmt"
 	"os/exec"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 )
 
+const SCRIPT_DIR = "/horahora/videoservice/scripts"
+
+// How long a cancelled script's output is waited on after its processes are killed
+const scriptWaitDelay = 10 * time.Second
+
+// scriptCommand runs a script in its own process group, so that cancelling the context kills the ffmpeg it started
+// too, rather than only the shell, and ffmpeg doesn't carry on holding the output pipe open
+func scriptCommand(ctx context.Context, name string, args ...string) *exec.Cmd {
+	return groupCommand(ctx, SCRIPT_DIR+"/"+name, args...)
+}
+
+func groupCommand(ctx context.Context, name string, args ...string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+	cmd.WaitDelay = scriptWaitDelay
+	return cmd
+}
+
 type H264Transcoder struct {
+	// Ladder is the set of renditions to generate, DefaultLadder if empty
+	Ladder []Rendition
 }
 
-func (h H264Transcoder) TranscodeAndGenerateManifest(path string, local bool) (*DASHVideo, error) {
+func (h H264Transcoder) TranscodeAndGenerateManifest(ctx context.Context, path string, local bool) (*DASHVideo, error) {
 	// var encodeArgs []string
 	// switch local {
 	// case true:
@@ -24,15 +52,39 @@ func (h H264Transcoder) TranscodeAndGenerateManifest(path string, local bool) (*
 	// 	encodeArgs = []string{path, "-r 24 -deadline good -cpu-used 2"}
 	// }
 
-	cmd := exec.Command("/horahora/videoservice/scripts/transcode.sh", []string{path}...)
+	ladder := h.Ladder
+	if len(ladder) == 0 {
+		ladder = DefaultLadder
+	}
+
+	height, err := probeHeight(ctx, path)
+	if err != nil {
+		// Not fatal, the transcode script won't upscale anyway
+		log.Errorf("Could not probe height of %s, using the full ladder. Err: %s", path, err)
+	}
+	ladder = ladderForHeight(ladder, height)
+
+	var renditionNames []string
+	for _, r := range ladder {
+		cmd := scriptCommand(ctx, "transcode.sh", path, r.Name, strconv.Itoa(r.Height), r.Bitrate, r.Codec)
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			log.Errorf("%s", out)
+			return nil, fmt.Errorf("failed to transcode rendition %s. Err: %s", r.Name, err)
+		}
+
+		renditionNames = append(renditionNames, r.Name)
+	}
+
+	cmd := scriptCommand(ctx, "transcode_audio.sh", path)
 	out, err := cmd.CombinedOutput()
 	if err != nil {
 		log.Errorf("%s", out)
-		return nil, err
+		return nil, fmt.Errorf("failed to transcode audio. Err: %s", err)
 	}
 
-	// At this point it's been transcoded, so generate the DASH manifest
-	cmd = exec.Command("/horahora/videoservice/scripts/manifest.sh", []string{filepath.Base(path)}...)
+	// At this point it's been transcoded, so generate the DASH manifest and HLS master playlist
+	cmd = scriptCommand(ctx, "manifest.sh", append([]string{filepath.Base(path)}, renditionNames...)...)
 	out, err = cmd.CombinedOutput()
 	if err != nil {
 		log.Errorf("%s", out)
@@ -52,12 +104,62 @@ func (h H264Transcoder) TranscodeAndGenerateManifest(path string, local bool) (*
 		fileList = append(fileList, fileName)
 	}
 
+	var renditions []RenditionOutput
+	for _, r := range ladder {
+		renditions = append(renditions, RenditionOutput{
+			Rendition:    r,
+			MediaPath:    fmt.Sprintf("%s_%s.mp4", path, r.Name),
+			PlaylistPath: fmt.Sprintf("%s_video_%s.m3u8", path, r.Name),
+		})
+	}
+
 	manifest := fmt.Sprintf("%s.mpd", path)
+	hlsMaster := fmt.Sprintf("%s.m3u8", path)
 
 	return &DASHVideo{
 		ManifestPath:     &manifest,
+		HLSMasterPath:    &hlsMaster,
 		QualityMap:       fileList,
+		Renditions:       renditions,
 		OriginalFilePath: path,
 		ThumbnailPath:    path + ".jpg",
 	}, nil
 }
+
+// probeHeight returns the height of the first video stream
+func probeHeight(ctx context.Context, path string) (int, error) {
+	cmd := exec.CommandContext(ctx, "ffprobe", "-v", "error", "-select_streams", "v:0", "-show_entries", "stream=height", "-of", "csv=p=0", path)
+	out, err := cmd.Output()
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(strings.TrimSpace(string(out)))
+}
+
+// ladderForHeight drops the renditions which would have to be upscaled from the source. The smallest rendition is
+// always kept, so that tiny videos still get something to play.
+// A height of 0 means that it's unknown, and the full ladder is returned.
+func ladderForHeight(ladder []Rendition, height int) []Rendition {
+	if height <= 0 {
+		return ladder
+	}
+
+	var ret []Rendition
+	smallest := ladder[0]
+	for _, r := range ladder {
+		if r.Height <= height {
+			ret = append(ret, r)
+		}
+
+		if r.Height < smallest.Height {
+			smallest = r
+		}
+	}
+
+	if len(ret) == 0 {
+		ret = []Rendition{smallest}
+	}
+
+	return ret
+}
@@ -2,15 +2,52 @@
 package dashutils
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
 )
 
 type DASHVideo struct {
-	ManifestPath     *string
-	ThumbnailPath    string
-	QualityMap       []string
+	ManifestPath *string // DASH manifest
+	// HLSMasterPath is the HLS master playlist, which references the same media files as the DASH manifest
+	HLSMasterPath *string
+	ThumbnailPath string
+	// QualityMap contains every generated media file and media playlist
+	QualityMap []string
+	// Renditions lists the quality levels which were generated, and the files which make up each of them
+	Renditions       []RenditionOutput
 	OriginalFilePath string
+	// TextTracks are added to the DASH manifest when it's uploaded
+	TextTracks []TextTrack
+}
+
+// RenditionOutput describes the files generated for a rendition
+type RenditionOutput struct {
+	Rendition
+	MediaPath    string
+	PlaylistPath string // HLS media playlist
 }
 
 type Transcoder interface {
-	TranscodeAndGenerateManifest(path string, local bool) (*DASHVideo, error)
+	// TranscodeAndGenerateManifest transcodes the video at path. Canceling ctx kills the transcode.
+	TranscodeAndGenerateManifest(ctx context.Context, path string, local bool) (*DASHVideo, error)
+}
+
+// RemoveGeneratedFiles removes everything which was generated for the video at path, e.g. after a failed transcode
+func RemoveGeneratedFiles(path string) error {
+	generatedFiles, err := filepath.Glob(fmt.Sprintf("%s_*", path))
+	if err != nil {
+		return err
+	}
+
+	generatedFiles = append(generatedFiles, path+".mpd", path+".m3u8")
+	for _, fileName := range generatedFiles {
+		err = os.Remove(fileName)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,72 @@
+package dashutils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Rendition is a single rung of the bitrate ladder
+type Rendition struct {
+	Name    string // used in the output filenames, e.g. 720p
+	Height  int
+	Bitrate string // target video bitrate, in ffmpeg's format (e.g. 2800k)
+	Codec   string // ffmpeg video encoder
+}
+
+const DEFAULT_CODEC = "libx264"
+
+// DefaultLadder is used if no ladder is configured. Renditions taller than the source video are skipped by the
+// transcode script, so a 480p upload will only get 480p and 360p versions.
+var DefaultLadder = []Rendition{
+	{Name: "1080p", Height: 1080, Bitrate: "5000k", Codec: DEFAULT_CODEC},
+	{Name: "720p", Height: 720, Bitrate: "2800k", Codec: DEFAULT_CODEC},
+	{Name: "480p", Height: 480, Bitrate: "1400k", Codec: DEFAULT_CODEC},
+	{Name: "360p", Height: 360, Bitrate: "800k", Codec: DEFAULT_CODEC},
+}
+
+// ParseLadder parses a ladder from a comma separated list of height:bitrate[:codec], e.g. "1080:5000k,720:2800k:libx264".
+// An empty string returns the default ladder.
+func ParseLadder(s string) ([]Rendition, error) {
+	if strings.TrimSpace(s) == "" {
+		return DefaultLadder, nil
+	}
+
+	var ladder []Rendition
+	seen := make(map[int]bool)
+
+	for _, rung := range strings.Split(s, ",") {
+		spl := strings.Split(strings.TrimSpace(rung), ":")
+		if len(spl) < 2 || len(spl) > 3 {
+			return nil, fmt.Errorf("invalid rendition %q, expected height:bitrate[:codec]", rung)
+		}
+
+		height, err := strconv.Atoi(spl[0])
+		if err != nil || height <= 0 || height%2 != 0 {
+			return nil, fmt.Errorf("invalid height for rendition %q, must be a positive even number", rung)
+		}
+
+		if seen[height] {
+			return nil, fmt.Errorf("duplicate rendition height %d", height)
+		}
+		seen[height] = true
+
+		if spl[1] == "" {
+			return nil, fmt.Errorf("missing bitrate for rendition %q", rung)
+		}
+
+		codec := DEFAULT_CODEC
+		if len(spl) == 3 && spl[2] != "" {
+			codec = spl[2]
+		}
+
+		ladder = append(ladder, Rendition{
+			Name:    fmt.Sprintf("%dp", height),
+			Height:  height,
+			Bitrate: spl[1],
+			Codec:   codec,
+		})
+	}
+
+	return ladder, nil
+}
@@ -0,0 +1,40 @@
+package dashutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLadder(t *testing.T) {
+	ladder, err := ParseLadder("")
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultLadder, ladder)
+
+	ladder, err = ParseLadder("1080:5000k:libx265, 360:800k")
+	assert.NoError(t, err)
+	assert.Equal(t, []Rendition{
+		{Name: "1080p", Height: 1080, Bitrate: "5000k", Codec: "libx265"},
+		{Name: "360p", Height: 360, Bitrate: "800k", Codec: DEFAULT_CODEC},
+	}, ladder)
+
+	for _, invalid := range []string{"1080", "abc:5000k", "1081:5000k", "720:", "720:1k,720:2k", "720:1k:libx264:extra"} {
+		_, err = ParseLadder(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestLadderForHeight(t *testing.T) {
+	heights := func(ladder []Rendition) []int {
+		var ret []int
+		for _, r := range ladder {
+			ret = append(ret, r.Height)
+		}
+		return ret
+	}
+
+	assert.Equal(t, []int{1080, 720, 480, 360}, heights(ladderForHeight(DefaultLadder, 2160)))
+	assert.Equal(t, []int{480, 360}, heights(ladderForHeight(DefaultLadder, 540)))
+	assert.Equal(t, []int{360}, heights(ladderForHeight(DefaultLadder, 240)))
+	assert.Equal(t, []int{1080, 720, 480, 360}, heights(ladderForHeight(DefaultLadder, 0)))
+}
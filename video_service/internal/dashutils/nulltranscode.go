@@ -1,10 +1,12 @@
 package dashutils
 
+import "context"
+
 // This implementation just uses the original encoding
 
 type NullTrancoder struct {}
 
-func (h NullTrancoder) TranscodeAndGenerateManifest(path string, local bool) (*DASHVideo, error) {
+func (h NullTrancoder) TranscodeAndGenerateManifest(ctx context.Context, path string, local bool) (*DASHVideo, error) {
 	return &DASHVideo{
 		ManifestPath:     nil,
 		QualityMap:       []string{},
@@ -0,0 +1,63 @@
+package dashutils
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestTranscodeScripts runs the transcode scripts once against a stub ffmpeg which records its arguments, so that
+// mistakes in the scripts themselves (e.g. unbound variables) show up without a real transcode
+func TestTranscodeScripts(t *testing.T) {
+	if _, err := exec.LookPath("bash"); err != nil {
+		t.Skip("bash is required to run the scripts")
+	}
+
+	dir := t.TempDir()
+	argsFile := filepath.Join(dir, "ffmpeg_args")
+	stub := "#!/bin/bash\necho \"$@\" >> " + argsFile + "\n"
+	err := os.WriteFile(filepath.Join(dir, "ffmpeg"), []byte(stub), 0755)
+	assert.NoError(t, err)
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	input := filepath.Join(dir, "video")
+	for _, script := range [][]string{
+		{"transcode.sh", input, "720p", "720", "2500k", DEFAULT_CODEC},
+		{"transcode_audio.sh", input},
+	} {
+		out, err := exec.Command(filepath.Join("..", "..", "scripts", script[0]), script[1:]...).CombinedOutput()
+		assert.NoError(t, err, string(out))
+	}
+
+	args, err := os.ReadFile(argsFile)
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(args)), "\n")
+	if assert.Len(t, lines, 2) {
+		assert.Contains(t, lines[0], "-i "+input+" ")
+		assert.Contains(t, lines[0], "-c:v "+DEFAULT_CODEC)
+		assert.True(t, strings.HasSuffix(lines[0], input+"_720p.mp4"), lines[0])
+		assert.True(t, strings.HasSuffix(lines[1], input+"_audio.m4a"), lines[1])
+	}
+}
+
+// TestGroupCommandCancel checks that cancelling a script kills what it started, and that waiting on it returns
+// straight away rather than once the child closes the output pipe
+func TestGroupCommandCancel(t *testing.T) {
+	if _, err := exec.LookPath("bash"); err != nil {
+		t.Skip("bash is required to run the scripts")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := groupCommand(ctx, "bash", "-c", "sleep 30; true").CombinedOutput()
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
@@ -0,0 +1,86 @@
+package dashutils
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+)
+
+// TextTrack is a WebVTT subtitle track to be listed in the DASH manifest
+type TextTrack struct {
+	ID       int64
+	Language string
+	Kind     string // subtitles, captions or descriptions
+	Label    string
+	// Key is the track's file, which must be stored next to the manifest
+	Key string
+}
+
+// DASH roles for the kinds of track
+var textTrackRoles = map[string]string{
+	"subtitles":    "subtitle",
+	"captions":     "caption",
+	"descriptions": "description",
+}
+
+// The packager never writes text adaptation sets, so any in the manifest were added by SetTextTracks
+var textAdaptationSetRe = regexp.MustCompile(`(?s)\s*<AdaptationSet[^>]*contentType="text"[^>]*>.*?</AdaptationSet>`)
+
+// SetTextTracks replaces the text tracks in a DASH manifest, so that players can offer the video's subtitles
+func SetTextTracks(mpd []byte, tracks []TextTrack) ([]byte, error) {
+	mpd = textAdaptationSetRe.ReplaceAll(mpd, nil)
+
+	end := bytes.LastIndex(mpd, []byte("</Period>"))
+	if end == -1 {
+		return nil, errors.New("manifest has no period")
+	}
+
+	// Added on their own lines, before the line which closes the period
+	end = bytes.LastIndexByte(mpd[:end], '\n') + 1
+
+	var sets bytes.Buffer
+	for _, track := range tracks {
+		role, ok := textTrackRoles[track.Kind]
+		if !ok {
+			role = textTrackRoles["subtitles"]
+		}
+
+		fmt.Fprintf(&sets, "    <AdaptationSet contentType=\"text\" mimeType=\"text/vtt\" lang=\"%s\">\n", escapeXML(track.Language))
+		fmt.Fprintf(&sets, "      <Role schemeIdUri=\"urn:mpeg:dash:role:2011\" value=\"%s\"/>\n", role)
+		if track.Label != "" {
+			fmt.Fprintf(&sets, "      <Label>%s</Label>\n", escapeXML(track.Label))
+		}
+		fmt.Fprintf(&sets, "      <Representation id=\"subtitles_%d\" bandwidth=\"256\">\n", track.ID)
+		fmt.Fprintf(&sets, "        <BaseURL>%s</BaseURL>\n", escapeXML(track.Key))
+		sets.WriteString("      </Representation>\n    </AdaptationSet>\n")
+	}
+
+	ret := make([]byte, 0, len(mpd)+sets.Len())
+	ret = append(ret, mpd[:end]...)
+	ret = append(ret, sets.Bytes()...)
+	return append(ret, mpd[end:]...), nil
+}
+
+func escapeXML(s string) string {
+	var buf bytes.Buffer
+	xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
+
+// SetTextTracksInFile replaces the text tracks in the DASH manifest at path
+func SetTextTracksInFile(path string, tracks []TextTrack) error {
+	mpd, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	mpd, err = SetTextTracks(mpd, tracks)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, mpd, 0644)
+}
@@ -0,0 +1,59 @@
+package dashutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testManifest = `<?xml version="1.0" encoding="UTF-8"?>
+<MPD xmlns="urn:mpeg:dash:schema:mpd:2011" profiles="urn:mpeg:dash:profile:isoff-on-demand:2011" type="static">
+  <Period id="0">
+    <AdaptationSet id="0" contentType="audio">
+      <Representation id="0" bandwidth="128000">
+        <BaseURL>abc_audio.m4a</BaseURL>
+      </Representation>
+    </AdaptationSet>
+  </Period>
+</MPD>
+`
+
+func TestSetTextTracks(t *testing.T) {
+	mpd, err := SetTextTracks([]byte(testManifest), []TextTrack{
+		{ID: 3, Language: "en", Kind: "captions", Label: "English & more", Key: "abc_subtitles_3.vtt"},
+		{ID: 4, Language: "ja", Key: "abc_subtitles_4.vtt"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<MPD xmlns="urn:mpeg:dash:schema:mpd:2011" profiles="urn:mpeg:dash:profile:isoff-on-demand:2011" type="static">
+  <Period id="0">
+    <AdaptationSet id="0" contentType="audio">
+      <Representation id="0" bandwidth="128000">
+        <BaseURL>abc_audio.m4a</BaseURL>
+      </Representation>
+    </AdaptationSet>
+    <AdaptationSet contentType="text" mimeType="text/vtt" lang="en">
+      <Role schemeIdUri="urn:mpeg:dash:role:2011" value="caption"/>
+      <Label>English &amp; more</Label>
+      <Representation id="subtitles_3" bandwidth="256">
+        <BaseURL>abc_subtitles_3.vtt</BaseURL>
+      </Representation>
+    </AdaptationSet>
+    <AdaptationSet contentType="text" mimeType="text/vtt" lang="ja">
+      <Role schemeIdUri="urn:mpeg:dash:role:2011" value="subtitle"/>
+      <Representation id="subtitles_4" bandwidth="256">
+        <BaseURL>abc_subtitles_4.vtt</BaseURL>
+      </Representation>
+    </AdaptationSet>
+  </Period>
+</MPD>
+`, string(mpd))
+
+	// Tracks are replaced rather than added to
+	mpd, err = SetTextTracks(mpd, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, testManifest, string(mpd))
+
+	_, err = SetTextTracks([]byte("<MPD></MPD>"), nil)
+	assert.Error(t, err)
+}
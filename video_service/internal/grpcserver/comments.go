@@ -0,0 +1,41 @@
+package grpcserver
+
+import (
+	"context"
+	"os"
+
+	"github.com/horahoradev/PrometheusTube/backend/video_service/internal/archivedcomments"
+	log "github.com/sirupsen/logrus"
+)
+
+// importArchivedComments saves the comments yt-dlp archived into the video's raw metadata as archived comments.
+// The metadata is parsed straight away, since the file is removed once the upload returns, but the comments are saved in
+// the background: registering every commenter as a foreign user can take a while, and the upload has already succeeded.
+func (g GRPCServer) importArchivedComments(videoID int64, originalSite, rawMetaPath string) {
+	f, err := os.Open(rawMetaPath)
+	if err != nil {
+		log.Errorf("Could not open raw metadata for video %d. Err: %s", videoID, err)
+		return
+	}
+	defer f.Close()
+
+	comments, err := archivedcomments.Parse(f)
+	if err != nil {
+		log.Errorf("Could not parse archived comments for video %d. Err: %s", videoID, err)
+		return
+	}
+
+	if len(comments) == 0 {
+		return
+	}
+
+	go func() {
+		saved, err := g.VideoModel.SaveArchivedComments(context.Background(), videoID, originalSite, comments)
+		if err != nil {
+			log.Errorf("Could not save archived comments for video %d (saved %d of %d). Err: %s", videoID, saved, len(comments), err)
+			return
+		}
+
+		log.Infof("Imported %d archived comments for video %d", saved, videoID)
+	}()
+}
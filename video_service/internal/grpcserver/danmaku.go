@@ -0,0 +1,196 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/horahoradev/PrometheusTube/backend/video_service/danmaku"
+	"github.com/horahoradev/PrometheusTube/backend/video_service/internal/models"
+	"github.com/horahoradev/PrometheusTube/backend/video_service/internal/wordfilter"
+	proto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type DanmakuOptions struct {
+	// MaxPerImport is the most danmaku a single import can add
+	MaxPerImport int
+	// MaxPerUserPerDay is the most danmaku each user can import in a day
+	MaxPerUserPerDay int
+	// MaxPostsPerMinute is the most danmaku each user can post in a minute
+	MaxPostsPerMinute int
+	// BannedWords rejects posted danmaku, and drops imported ones, which contain any of its words
+	BannedWords *wordfilter.Filter
+}
+
+func (g GRPCServer) AddDanmaku(ctx context.Context, req *proto.Danmaku) (*proto.Danmaku, error) {
+	// GetDanmaku sorts by the timestamp as a number, so anything else would break the video's danmaku for everyone
+	t, err := strconv.ParseFloat(req.Timestamp, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid timestamp %s", req.Timestamp)
+	}
+
+	c := danmaku.Comment{Time: t, Text: req.Message, Position: req.Type, Color: req.Color, Size: req.FontSize}
+	if err := c.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid danmaku: %s", err)
+	}
+
+	if word, ok := g.DanmakuOptions.BannedWords.Match(req.Message); ok {
+		return nil, status.Errorf(codes.InvalidArgument, "message contains the banned word %q", word)
+	}
+
+	over, err := g.isOverDanmakuPostLimit(ctx, req.AuthorId)
+	if err != nil {
+		return nil, err
+	}
+	if over {
+		return nil, status.Errorf(codes.ResourceExhausted, "at most %d danmaku can be posted per minute", g.DanmakuOptions.MaxPostsPerMinute)
+	}
+
+	id, err := g.VideoModel.MakeDanmaku(int(req.VideoId), req.Timestamp, req.Message, int(req.AuthorId), req.Type, req.Color, req.FontSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.Danmaku{
+		Id:        id,
+		VideoId:   req.VideoId,
+		Timestamp: req.Timestamp,
+		Message:   req.Message,
+		AuthorId:  req.AuthorId,
+		Type:      req.Type,
+		Color:     req.Color,
+		FontSize:  req.FontSize,
+	}, nil
+}
+
+func (g GRPCServer) DeleteDanmaku(ctx context.Context, req *proto.DanmakuDeleteReq) (*proto.DanmakuList, error) {
+	var deleted []*proto.Danmaku
+	var err error
+	switch {
+	case len(req.Ids) > 0:
+		deleted, err = g.VideoModel.DeleteDanmaku(req.Ids)
+	case req.AuthorId != 0:
+		deleted, err = g.VideoModel.DeleteDanmakuByAuthor(req.AuthorId, req.VideoId)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "either danmaku IDs or an author is required")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.DanmakuList{Comments: deleted}, nil
+}
+
+// isOverDanmakuPostLimit counts a post against the user's limit for the current minute
+func (g GRPCServer) isOverDanmakuPostLimit(ctx context.Context, userID int64) (bool, error) {
+	key := fmt.Sprintf("danmaku-posts-%d-%d", userID, time.Now().Unix()/60)
+
+	count, err := g.RedisConn.Incr(ctx, key).Result()
+	if err != nil {
+		return false, fmt.Errorf("could not incr danmaku post limit. Err: %s", err)
+	}
+
+	if count == 1 {
+		err = g.RedisConn.Expire(ctx, key, time.Minute*2).Err()
+		if err != nil {
+			log.Errorf("Could not set expiry on %s. Err: %s", key, err)
+		}
+	}
+
+	return count > int64(g.DanmakuOptions.MaxPostsPerMinute), nil
+}
+
+func (g GRPCServer) ImportDanmaku(ctx context.Context, req *proto.DanmakuImportReq) (*proto.DanmakuImportResp, error) {
+	if len(req.Comments) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no danmaku to import")
+	}
+
+	if len(req.Comments) > g.DanmakuOptions.MaxPerImport {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d danmaku can be imported at once", g.DanmakuOptions.MaxPerImport)
+	}
+
+	for i, dn := range req.Comments {
+		t, err := strconv.ParseFloat(dn.Timestamp, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "danmaku %d has an invalid timestamp %s", i, dn.Timestamp)
+		}
+
+		c := danmaku.Comment{Time: t, Text: dn.Message, Position: dn.Type, Color: dn.Color, Size: dn.FontSize}
+		if err := c.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "danmaku %d is invalid: %s", i, err)
+		}
+	}
+
+	// Dropped rather than rejected, since files from elsewhere weren't written with our word list in mind
+	comments := make([]*proto.Danmaku, 0, len(req.Comments))
+	for _, dn := range req.Comments {
+		if _, ok := g.DanmakuOptions.BannedWords.Match(dn.Message); !ok {
+			comments = append(comments, dn)
+		}
+	}
+	if len(comments) == 0 {
+		return &proto.DanmakuImportResp{}, nil
+	}
+
+	over, err := g.isOverDailyDanmakuImportLimit(ctx, req.UserId, len(comments))
+	if err != nil {
+		return nil, err
+	}
+	if over {
+		return nil, status.Errorf(codes.ResourceExhausted, "at most %d danmaku can be imported per day", g.DanmakuOptions.MaxPerUserPerDay)
+	}
+
+	imported, err := g.VideoModel.ImportUserDanmaku(ctx, req.VideoId, req.UserId, req.Source, comments)
+	switch {
+	case errors.Is(err, models.ErrVideoNotFound):
+		g.refundDanmakuImport(req.UserId, len(comments))
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		g.refundDanmakuImport(req.UserId, len(comments))
+		return nil, err
+	}
+
+	log.Infof("User %d imported %d danmaku for video %d", req.UserId, imported, req.VideoId)
+	return &proto.DanmakuImportResp{Imported: int64(imported)}, nil
+}
+
+func danmakuImportKey(userID int64) string {
+	return fmt.Sprintf("danmaku-imports-%d-%s", userID, time.Now().Format("01-02-2006"))
+}
+
+// isOverDailyDanmakuImportLimit counts n danmaku against the user's daily limit. If they'd go over it, they aren't
+// counted.
+func (g GRPCServer) isOverDailyDanmakuImportLimit(ctx context.Context, userID int64, n int) (bool, error) {
+	key := danmakuImportKey(userID)
+
+	count, err := g.RedisConn.IncrBy(ctx, key, int64(n)).Result()
+	if err != nil {
+		return false, fmt.Errorf("could not incr danmaku import limit. Err: %s", err)
+	}
+
+	// Keys only need to outlive their day
+	err = g.RedisConn.Expire(ctx, key, time.Hour*48).Err()
+	if err != nil {
+		log.Errorf("Could not set expiry on %s. Err: %s", key, err)
+	}
+
+	if count > int64(g.DanmakuOptions.MaxPerUserPerDay) {
+		g.refundDanmakuImport(userID, n)
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// refundDanmakuImport takes back danmaku counted against the user's daily limit which weren't imported after all
+func (g GRPCServer) refundDanmakuImport(userID int64, n int) {
+	err := g.RedisConn.DecrBy(context.Background(), danmakuImportKey(userID), int64(n)).Err()
+	if err != nil {
+		log.Errorf("Could not refund %d danmaku imports for user %d. Err: %s", n, userID, err)
+	}
+}
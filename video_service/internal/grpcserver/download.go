@@ -0,0 +1,158 @@
+package grpcserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"strings"
+
+	proto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const downloadChunkSize = 1024 * 1024
+
+// originalUUID returns the storage key of the original upload, which is inferred from the manifest location
+// Need to fix the mpd storage for this stuff LOL
+func originalUUID(videoLoc string) string {
+	spl := strings.Split(videoLoc, "/")
+	r := spl[len(spl)-1]
+	return r[:len(r)-4]
+}
+
+// resolveRange converts a requested offset and length into the range to send, following the semantics of HTTP ranges:
+// a negative offset counts back from the end, and ranges which run past the end of the file are cut short.
+func resolveRange(size, offset, length int64) (int64, int64, error) {
+	if offset < 0 {
+		offset = size + offset
+		if offset < 0 {
+			offset = 0
+		}
+	}
+
+	if length < 0 || (offset >= size && size > 0) {
+		return 0, 0, status.Errorf(codes.OutOfRange, "range %d+%d is not satisfiable for a file of %d bytes", offset, length, size)
+	}
+
+	if length == 0 || offset+length > size {
+		length = size - offset
+	}
+
+	return offset, length, nil
+}
+
+// DownloadVideo streams the original upload of a video. The first message is the metadata, which includes the checksum
+// of the whole file and the range being sent, and the rest are the contents.
+func (g GRPCServer) DownloadVideo(req *proto.VideoRequest, outputStream proto.VideoService_DownloadVideoServer) error {
+	info, err := g.VideoModel.GetVideoInfo(req.VideoID)
+	if err != nil {
+		return LogAndRetErr("could not get video info. Err: %s", err)
+	}
+
+	key := originalUUID(info.VideoLoc)
+	stat, err := g.Storage.Stat(outputStream.Context(), key)
+	if err != nil {
+		return LogAndRetErr("could not stat original video. Err: %s", err)
+	}
+
+	checksum, err := g.originalChecksum(info.VideoID, key)
+	if err != nil {
+		return LogAndRetErr("could not get checksum of original video. Err: %s", err)
+	}
+
+	offset, length, err := resolveRange(stat.Size, req.Offset, req.Length)
+	if err != nil {
+		return err
+	}
+
+	err = outputStream.Send(&proto.ResponseVideoChunk{
+		Payload: &proto.ResponseVideoChunk_Meta{
+			Meta: &proto.ResponseFileMetadata{
+				Title:       info.VideoTitle,
+				Description: info.Description,
+				AuthorUID:   info.AuthorID,
+				Size:        stat.Size,
+				Sha256:      checksum,
+				Offset:      offset,
+				Length:      length,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Streamed straight from storage, rather than spooling the whole video to disk for a few bytes of it
+	vid, _, err := g.Storage.OpenRange(outputStream.Context(), key, offset, length)
+	if err != nil {
+		return LogAndRetErr("could not fetch original video from storage. Err: %s", err)
+	}
+	defer vid.Close()
+
+	r := io.LimitReader(vid, length)
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			sendErr := outputStream.Send(&proto.ResponseVideoChunk{
+				Payload: &proto.ResponseVideoChunk_Content{
+					Content: &proto.FileContent{
+						Data: buf[:n],
+					},
+				},
+			})
+			if sendErr != nil {
+				return sendErr
+			}
+		}
+
+		switch {
+		case err == io.EOF || err == io.ErrUnexpectedEOF:
+			log.Infof("Sent %d bytes of video %d", length, info.VideoID)
+			return nil
+		case err != nil:
+			return err
+		}
+	}
+}
+
+// originalChecksum returns the checksum of the original, which is saved along with the video. Videos saved before
+// checksums were have it computed in the background, once, and "" is returned until it's done.
+func (g GRPCServer) originalChecksum(videoID int64, key string) (string, error) {
+	checksum, err := g.VideoModel.GetOriginalChecksum(videoID)
+	if err != nil || checksum != "" {
+		return checksum, err
+	}
+
+	if _, inFlight := g.checksumsInFlight.LoadOrStore(videoID, struct{}{}); !inFlight {
+		go g.backfillChecksum(videoID, key)
+	}
+
+	return "", nil
+}
+
+func (g GRPCServer) backfillChecksum(videoID int64, key string) {
+	defer g.checksumsInFlight.Delete(videoID)
+
+	vid, _, err := g.Storage.Open(context.Background(), key)
+	if err != nil {
+		log.Errorf("could not open original of video %d to compute its checksum. Err: %s", videoID, err)
+		return
+	}
+	defer vid.Close()
+
+	h := sha256.New()
+	_, err = io.Copy(h, vid)
+	if err != nil {
+		log.Errorf("could not compute checksum for video %d. Err: %s", videoID, err)
+		return
+	}
+
+	err = g.VideoModel.SetOriginalChecksum(videoID, hex.EncodeToString(h.Sum(nil)))
+	if err != nil {
+		log.Errorf("could not save checksum for video %d. Err: %s", videoID, err)
+	}
+}
@@ -0,0 +1,46 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+
+	"github.com/horahoradev/PrometheusTube/backend/video_service/internal/models"
+	proto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (g GRPCServer) AddFavorite(ctx context.Context, req *proto.FavoriteReq) (*proto.FavoriteStatus, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "a user is required")
+	}
+
+	st, err := g.VideoModel.AddFavorite(ctx, req.UserId, req.VideoId)
+	if errors.Is(err, models.ErrVideoNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return st, err
+}
+
+func (g GRPCServer) RemoveFavorite(ctx context.Context, req *proto.FavoriteReq) (*proto.FavoriteStatus, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "a user is required")
+	}
+
+	return g.VideoModel.RemoveFavorite(ctx, req.UserId, req.VideoId)
+}
+
+func (g GRPCServer) GetFavoriteStatus(ctx context.Context, req *proto.FavoriteReq) (*proto.FavoriteStatus, error) {
+	return g.VideoModel.GetFavoriteStatus(ctx, req.UserId, req.VideoId)
+}
+
+func (g GRPCServer) GetFavorites(ctx context.Context, req *proto.FavoritesQuery) (*proto.VideoList, error) {
+	list, err := g.VideoModel.GetFavorites(ctx, req.UserId, req.PageNumber, req.ShowMature)
+	if err != nil {
+		return nil, err
+	}
+	g.signVideos(list.Videos)
+
+	return list, nil
+}
@@ -0,0 +1,46 @@
+package grpcserver
+
+import (
+	"path"
+	"time"
+
+	proto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/horahoradev/PrometheusTube/backend/video_service/storage"
+)
+
+// FilesystemOptions configures the "fs" storage backend, which keeps files on local disk and serves them from the
+// metrics port under /files/
+type FilesystemOptions struct {
+	Root string
+	// SigningKey is the HMAC key for the URLs files are served from
+	SigningKey string
+	// URLTTL is how long signed URLs are valid for
+	URLTTL time.Duration
+}
+
+// filesystem returns the filesystem storage which files are served from, if the primary backend is "fs"
+func (g GRPCServer) filesystem() (*storage.FilesystemStorage, bool) {
+	s := g.Storage
+	if r, ok := s.(*storage.Replicated); ok {
+		s = r.Primary
+	}
+
+	fs, ok := s.(*storage.FilesystemStorage)
+	return fs, ok
+}
+
+// signLoc turns a stored location (e.g. otomads/<uuid>.mpd) into a signed one if files are served by the video service.
+// Locations are stored unsigned, so the signature is added whenever they're handed out.
+func (g GRPCServer) signLoc(loc string) string {
+	if g.URLSigner == nil || loc == "" {
+		return loc
+	}
+
+	return g.URLSigner.Sign(path.Base(loc))
+}
+
+func (g GRPCServer) signVideos(videos []*proto.Video) {
+	for _, video := range videos {
+		video.ThumbnailLoc = g.signLoc(video.ThumbnailLoc)
+	}
+}
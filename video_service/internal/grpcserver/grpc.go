@@ -2,6 +2,9 @@ package grpcserver
 
 import (
 	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -14,6 +17,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -51,17 +55,55 @@ type GRPCServer struct {
 	VideoModel *models.VideoModel
 	Local      bool
 	OriginFQDN string
-	Storage    storage.Storage
+	Storage    storage.ObjectStorage
 	RedisConn  *redis.Client
 	proto.UnsafeVideoServiceServer
 	MaxDailyUploadMB int
+	Transcoder       dashutils.Transcoder
+	TranscodeQueue   *models.TranscodeQueue
+	UploadSessions   *models.UploadSessionModel
+	Subtitles        *models.SubtitleModel
+	Upstream         *models.UpstreamModel
+	Playlists        *models.PlaylistModel
+	UploadDir        string
+	// URLSigner signs the locations returned to clients, if files are served by the video service
+	URLSigner *storage.URLSigner
+
+	DanmakuOptions DanmakuOptions
+
+	// searchRefresh asks for videos_denormalized to be refreshed early, e.g. after tags are edited
+	searchRefresh chan struct{}
+	// checksumsInFlight has the IDs of videos whose original's checksum is being backfilled
+	checksumsInFlight *sync.Map
+}
+
+// SearchOptions picks the backend which video lists are searched with
+type SearchOptions struct {
+	// Backend is elasticsearch or postgres
+	Backend          string
+	ElasticsearchURL string
 }
 
 // TODO: API is getting bloated
-func NewGRPCServer(bucketName string, db *sqlx.DB, port int, originFQDN string, local bool,
+// NewGRPCServer serves until ctx is canceled, then waits for in-flight requests and transcodes to wind down
+func NewGRPCServer(ctx context.Context, bucketName string, db *sqlx.DB, port int, originFQDN string, local bool,
 	client userproto.UserServiceClient, tracer opentracing.Tracer, storageBackend, apiID,
-	apiKey string, approvalThreshold int, storageEndpoint string, MaxDLFileSize int64, redisConn *redis.Client, maxDailyUploadMB int) error {
-	g, err := initGRPCServer(bucketName, db, client, local, originFQDN, storageBackend, apiID, apiKey, approvalThreshold, storageEndpoint, MaxDLFileSize, redisConn, maxDailyUploadMB)
+	apiKey string, approvalThreshold int, storageEndpoint string, MaxDLFileSize int64, redisConn *redis.Client, maxDailyUploadMB int, transcodeOpts TranscodeOptions, uploadOpts UploadOptions, fsOpts FilesystemOptions, replica storage.Config, danmakuOpts DanmakuOptions, searchOpts SearchOptions) error {
+	g, err := initGRPCServer(bucketName, db, client, local, originFQDN, storageBackend, apiID, apiKey, approvalThreshold, storageEndpoint, MaxDLFileSize, redisConn, maxDailyUploadMB, fsOpts, replica, searchOpts)
+	if err != nil {
+		return err
+	}
+
+	g.Transcoder = dashutils.H264Transcoder{Ladder: transcodeOpts.Ladder}
+	g.DanmakuOptions = danmakuOpts
+	g.TranscodeQueue, err = models.NewTranscodeQueue(db, transcodeOpts.LeaseDuration, transcodeOpts.MaxAttempts)
+	if err != nil {
+		return err
+	}
+
+	g.UploadSessions = models.NewUploadSessionModel(db, uploadOpts.TTL)
+	g.UploadDir = uploadOpts.Dir
+	err = os.MkdirAll(g.UploadDir, 0755)
 	if err != nil {
 		return err
 	}
@@ -71,78 +113,145 @@ func NewGRPCServer(bucketName string, db *sqlx.DB, port int, originFQDN string,
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	// TODO: context and return
-	go g.transcodeAndUploadVideos(MaxDLFileSize)
+	var transcoders sync.WaitGroup
+	transcoders.Add(1)
+	go func() {
+		defer transcoders.Done()
+		g.transcodeAndUploadVideos(ctx, transcodeOpts.NumWorkers, MaxDLFileSize)
+	}()
 
 	go g.refreshMaterializedView()
 
+	go g.collectExpiredUploadSessions(ctx)
+
 	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 		otgrpc.OpenTracingServerInterceptor(tracer))))
 	proto.RegisterVideoServiceServer(grpcServer, g)
 
 	grpc_prometheus.Register(grpcServer)
 	http.Handle("/metrics", promhttp.Handler())
+	if fs, ok := g.filesystem(); ok {
+		http.Handle(storage.FilesPrefix, storage.FileServer{Storage: fs, Signer: g.URLSigner})
+	}
 	go http.ListenAndServe(":8080", nil)
 
-	return grpcServer.Serve(lis)
+	go func() {
+		<-ctx.Done()
+		log.Info("Shutting down the grpc server")
+		grpcServer.GracefulStop()
+	}()
+
+	err = grpcServer.Serve(lis)
+
+	log.Info("Waiting for transcoding workers to stop")
+	transcoders.Wait()
+
+	return err
 }
 
 func initGRPCServer(bucketName string, db *sqlx.DB, client userproto.UserServiceClient, local bool,
-	originFQDN, storageBackend, apiID, apiKey string, approvalThreshold int, storageEndpoint string, MaxDLFileSize int64, redisConn *redis.Client, maxDailyUploadMB int) (*GRPCServer, error) {
+	originFQDN, storageBackend, apiID, apiKey string, approvalThreshold int, storageEndpoint string, MaxDLFileSize int64, redisConn *redis.Client, maxDailyUploadMB int, fsOpts FilesystemOptions, replica storage.Config, searchOpts SearchOptions) (*GRPCServer, error) {
 
 	g := &GRPCServer{
-		Local:            local,
-		OriginFQDN:       originFQDN,
-		RedisConn:        redisConn,
-		MaxDailyUploadMB: maxDailyUploadMB,
+		Local:             local,
+		OriginFQDN:        originFQDN,
+		RedisConn:         redisConn,
+		MaxDailyUploadMB:  maxDailyUploadMB,
+		searchRefresh:     make(chan struct{}, 1),
+		checksumsInFlight: &sync.Map{},
 	}
 
 	var err error
 
-	switch storageBackend {
-	case "b2":
-		g.Storage, err = storage.NewB2(apiID, apiKey, bucketName)
+	g.Storage, err = storage.New(storage.Config{
+		Backend:  storageBackend,
+		Bucket:   bucketName,
+		Endpoint: storageEndpoint,
+		APIID:    apiID,
+		APIKey:   apiKey,
+		Root:     fsOpts.Root,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := g.Storage.(*storage.FilesystemStorage); ok {
+		g.URLSigner, err = storage.NewURLSigner(fsOpts.SigningKey, fsOpts.URLTTL)
 		if err != nil {
 			return nil, err
 		}
-	case "s3":
-		if storageEndpoint == "" {
-			g.Storage, err = storage.NewS3(bucketName)
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			g.Storage, err = storage.NewS3Endpoint(bucketName, storageEndpoint, apiID, apiKey)
-			if err != nil {
-				return nil, err
-			}
+	}
+
+	if replica.Backend != "" {
+		secondary, err := storage.New(replica)
+		if err != nil {
+			return nil, fmt.Errorf("could not initialize replica storage. Err: %s", err)
 		}
-	default:
-		return nil, fmt.Errorf("Unknown storage backend %s", storageBackend)
+
+		log.Infof("Replicating writes to %s storage", replica.Backend)
+		g.Storage = storage.NewReplicated(g.Storage, secondary)
+	}
+
+	search, err := models.NewSearchBackend(db, searchOpts.Backend, searchOpts.ElasticsearchURL)
+	if err != nil {
+		return nil, err
 	}
 
-	g.VideoModel, err = models.NewVideoModel(db, client, approvalThreshold)
+	g.VideoModel, err = models.NewVideoModel(db, client, approvalThreshold, search)
 	if err != nil {
 		return nil, err
 	}
 
+	g.Subtitles = models.NewSubtitleModel(db)
+	g.Upstream = models.NewUpstreamModel(db)
+	g.Playlists = models.NewPlaylistModel(db, g.VideoModel)
+
 	return g, nil
 }
 
 func (g GRPCServer) GetFollowFeed(ctx context.Context, req *proto.FeedReq) (*proto.VideoList, error) {
-	// TODO on pagination
 	// TODO on unapproved
 	// TODO on cardinality
-	videos, _, _, err := g.VideoModel.GetVideoList(proto.SortDirection_desc, 1, 0, "", true, false, proto.OrderCategory_upload_date, "", true, req.FollowedUsers, req.ShowMature)
+	q := models.VideoQuery{
+		Direction:      proto.SortDirection_desc,
+		OrderBy:        proto.OrderCategory_upload_date,
+		PageNum:        1,
+		ShowUnapproved: true,
+		FollowFeed:     true,
+		Following:      req.FollowedUsers,
+		ShowMature:     req.ShowMature,
+	}
+
+	if req.Cursor != "" {
+		var err error
+		q.Cursor, err = models.DecodeCursor(req.Cursor, q.OrderBy, q.Direction)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	list, err := g.VideoModel.GetVideoList(ctx, q)
+	if err != nil {
+		log.Errorf("Could not get follow feed. Err: %s", err)
+		return nil, err
+	}
+	g.signVideos(list.Videos)
+
 	return &proto.VideoList{
-		Videos: videos,
-	}, err
+		Videos:     list.Videos,
+		NextCursor: list.NextCursor,
+		PrevCursor: list.PrevCursor,
+	}, nil
 }
 
 type VideoUpload struct {
 	Meta         *proto.InputVideoChunk_Meta
 	FileData     *os.File
 	MetaFileData *os.File
+	// Danmaku archived from the original site
+	Danmaku []*proto.Danmaku
+	// Subtitle tracks archived from the original site
+	Subtitles []*proto.SubtitleUpload
 }
 
 var DailyUploadLimitError error = errors.New("the daily upload limit has been exceeded")
@@ -184,6 +293,10 @@ func (g GRPCServer) UploadVideo(inpStream proto.VideoService_UploadVideoServer)
 
 	video.FileData = tmpFile
 	video.MetaFileData = metaTmp
+
+	// The original's checksum is computed as it's received, rather than reading it all back later
+	videoHash := sha256.New()
+	videoWriter := io.MultiWriter(video.FileData, videoHash)
 loop:
 	for {
 		chunk, err := inpStream.Recv()
@@ -202,7 +315,7 @@ loop:
 				return DailyUploadLimitError
 			}
 
-			_, err := video.FileData.Write(r.Content.Data)
+			_, err := videoWriter.Write(r.Content.Data)
 			if err != nil {
 				err = fmt.Errorf("could not write video data to file, err: %s", err)
 				log.Error(err)
@@ -231,78 +344,104 @@ loop:
 
 			video.Meta = r
 			log.Infof("Received metadata for video %s, category: %s", video.Meta.Meta.Title, video.Meta.Meta.Category)
+
+		case *proto.InputVideoChunk_Danmaku:
+			video.Danmaku = append(video.Danmaku, r.Danmaku.Comments...)
+
+		case *proto.InputVideoChunk_Subtitle:
+			video.Subtitles = append(video.Subtitles, r.Subtitle)
+		}
+	}
+
+	if video.Meta == nil {
+		return LogAndRetErr("could not handle upload. Err: %s", errors.New("no metadata in stream"))
+	}
+
+	// processUpload rewrites the site for backwards compatibility
+	originalSite := video.Meta.Meta.OriginalSite
+
+	videoID, err := g.processUpload(video.Meta.Meta, video.FileData.Name(), video.MetaFileData.Name(), hex.EncodeToString(videoHash.Sum(nil)))
+	if err != nil {
+		return err
+	}
+
+	if len(video.Danmaku) > 0 {
+		saved, err := g.VideoModel.SaveImportedDanmaku(inpStream.Context(), videoID, originalSite, video.Danmaku)
+		if err != nil {
+			// The video's already been saved, so don't fail the upload over its danmaku
+			log.Errorf("Could not save imported danmaku for video %d. Err: %s", videoID, err)
+		} else {
+			log.Infof("Imported %d danmaku for video %d", saved, videoID)
 		}
 	}
 
-	log.Infof("Handling video upload %s from website %s", video.Meta.Meta.OriginalID, video.Meta.Meta.OriginalSite)
+	g.saveArchivedSubtitles(inpStream.Context(), videoID, video.Subtitles)
+
+	uploadResp := proto.UploadResponse{
+		VideoID: videoID,
+	}
+
+	log.Infof("Finished handling video %s", video.Meta.Meta.Title)
+	return inpStream.SendAndClose(&uploadResp)
+}
+
+// processUpload uploads a received video, and saves it to the database. The video's storage key is the base of videoPath.
+// rawMetaPath is the metadata.json for the video, and is optional. checksum is the hex SHA-256 of the video.
+func (g GRPCServer) processUpload(meta *proto.InputFileMetadata, videoPath, rawMetaPath, checksum string) (int64, error) {
+	log.Infof("Handling video upload %s from website %s", meta.OriginalID, meta.OriginalSite)
 
 	// Do some in-place edits for backwards compatibility...
 	// FIXME
-	switch {
-	case strings.Contains(video.Meta.Meta.OriginalSite, "nicovideo"):
-		video.Meta.Meta.OriginalSite = "0"
-	case strings.Contains(video.Meta.Meta.OriginalSite, "bilibili"):
-		video.Meta.Meta.OriginalSite = "1"
-	case strings.Contains(video.Meta.Meta.OriginalSite, "youtube"):
-		video.Meta.Meta.OriginalSite = "2"
-	}
+	meta.OriginalSite = storedWebsite(meta.OriginalSite)
 
-	err = ioutil.WriteFile(video.FileData.Name()+".thumb", video.Meta.Meta.Thumbnail, 0644)
+	err := ioutil.WriteFile(videoPath+".thumb", meta.Thumbnail, 0644)
 	if err != nil {
-		return LogAndRetErr("could not write thumbnail. Err: %s", err)
+		return 0, LogAndRetErr("could not write thumbnail. Err: %s", err)
 	}
+	defer os.Remove(videoPath + ".thumb")
 
-	log.Infof("Finished receiving file data for %s, uploading to %v", video.Meta.Meta.Title, g.OriginFQDN)
+	log.Infof("Finished receiving file data for %s, uploading to %v", meta.Title, g.OriginFQDN)
 
 	// If not local, upload the thumbnail and original video before returning
 	if !g.Local {
 		// FIXME did it again...
-		log.Infof("Uploading thumbnail: %s", video.FileData.Name()+".thumb")
-		err = g.Storage.Upload(video.FileData.Name()+".thumb", filepath.Base(video.FileData.Name()+".thumb"))
+		log.Infof("Uploading thumbnail: %s", videoPath+".thumb")
+		err = storage.PutFile(context.TODO(), g.Storage, videoPath+".thumb", filepath.Base(videoPath+".thumb"))
 		if err != nil {
-			return err
+			return 0, err
 		}
 
 		// Upload the raw metadata
-		log.Infof("Uploading metadata: %s", video.MetaFileData.Name())
-		err = g.Storage.Upload(video.MetaFileData.Name(), filepath.Base(video.MetaFileData.Name()))
-		if err != nil {
-			return err
+		if rawMetaPath != "" {
+			log.Infof("Uploading metadata: %s", rawMetaPath)
+			err = storage.PutFile(context.TODO(), g.Storage, rawMetaPath, filepath.Base(rawMetaPath))
+			if err != nil {
+				return 0, err
+			}
 		}
 
 		// Upload the original video
-		log.Infof("Uploading video: %s", video.FileData.Name())
-		err = g.Storage.Upload(video.FileData.Name(), filepath.Base(video.FileData.Name()))
+		log.Infof("Uploading video: %s", videoPath)
+		err = storage.PutFile(context.TODO(), g.Storage, videoPath, filepath.Base(videoPath))
 		if err != nil {
-			return err
+			return 0, err
 		}
 	}
 
-	f, err := g.getVideoDuration(video.FileData.Name())
+	f, err := g.getVideoDuration(videoPath)
 	if err != nil {
 		log.Errorf("Failed to get video duration, err: %v", err)
 	}
 
 	// TODO configurable
-	videoLoc := fmt.Sprintf("%s/%s", "otomads", filepath.Base(video.FileData.Name()))
-	u, err := url.Parse(fmt.Sprintf("%s/%s", g.OriginFQDN, filepath.Base(video.FileData.Name())))
-	if err != nil {
-		return err
-	}
-	// lol fixme
-	u.Host = fmt.Sprintf("%v:%v", "host.docker.internal", "9000")
+	videoLoc := fmt.Sprintf("%s/%s", "otomads", filepath.Base(videoPath))
 
-	log.Infof("Trying to reach origin file at %v", u.String())
-
-	// We've uploaded the video... can we reach it?
-	// If we can't reach it with a head request, don't commit it to the db
-	res, err := http.Head(u.String())
-	switch {
-	case err != nil:
-		log.Errorf("receied err trying to reach origin: %v", err)
-		return err
-	case res != nil && res.StatusCode >= 400:
-		return fmt.Errorf("recieved bad status code %v for request to %v", res.StatusCode, videoLoc)
+	// Files on local disk are always reachable once the upload has returned
+	if _, ok := g.filesystem(); !ok {
+		err = g.checkOriginReachable(videoPath, videoLoc)
+		if err != nil {
+			return 0, err
+		}
 	}
 
 	// This is MESSY
@@ -313,31 +452,71 @@ loop:
 	// (FIXME)
 	manifestLoc := videoLoc + ".mpd"
 
-	videoID, err := g.VideoModel.SaveForeignVideo(context.TODO(), video.Meta.Meta.Title, video.Meta.Meta.Description,
-		video.Meta.Meta.AuthorUsername, video.Meta.Meta.AuthorUID, video.Meta.Meta.OriginalSite,
-		video.Meta.Meta.OriginalVideoLink, video.Meta.Meta.OriginalID, manifestLoc, video.Meta.Meta.Tags, video.Meta.Meta.DomesticAuthorID, f, video.Meta.Meta.Category)
+	// Not worth failing the upload over
+	var originalUploadDate sql.NullTime
+	if meta.OriginalUploadDate != "" {
+		originalUploadDate.Time, err = parseDate(meta.OriginalUploadDate)
+		if err != nil {
+			log.Errorf("Invalid original upload date %s. Err: %s", meta.OriginalUploadDate, err)
+		}
+		originalUploadDate.Valid = err == nil
+	}
+
+	videoID, err := g.VideoModel.SaveForeignVideo(context.TODO(), meta.Title, meta.Description,
+		meta.AuthorUsername, meta.AuthorUID, meta.OriginalSite,
+		meta.OriginalVideoLink, meta.OriginalID, manifestLoc, meta.Tags, meta.DomesticAuthorID, f, meta.Category, originalUploadDate)
 	if err != nil {
-		return LogAndRetErr("failed to save video to postgres. Err: %s", err)
+		return 0, LogAndRetErr("failed to save video to postgres. Err: %s", err)
+	}
+
+	err = g.VideoModel.SetOriginalChecksum(videoID, checksum)
+	if err != nil {
+		// Not fatal, downloads backfill it
+		log.Errorf("could not save checksum for video %d. Err: %s", videoID, err)
+	}
+
+	if rawMetaPath != "" {
+		g.importArchivedComments(videoID, meta.OriginalSite, rawMetaPath)
 	}
 
 	gorse := client.NewGorseClient("http://gorse:8088", "api_key")
 	_, err = gorse.InsertItem(context.TODO(), client.Item{
 		ItemId:     fmt.Sprintf("%d", videoID),
 		IsHidden:   true,
-		Labels:     video.Meta.Meta.Tags,
-		Categories: []string{video.Meta.Meta.Category},
+		Labels:     meta.Tags,
+		Categories: []string{meta.Category},
 		Timestamp:  time.Now().String(),
 	})
 	if err != nil {
 		log.Errorf("failed to insert gorse item: %v", err)
 	}
 
-	uploadResp := proto.UploadResponse{
-		VideoID: videoID,
+	return videoID, nil
+}
+
+// checkOriginReachable makes sure that the uploaded video can be fetched from the origin
+func (g GRPCServer) checkOriginReachable(videoPath, videoLoc string) error {
+	u, err := url.Parse(fmt.Sprintf("%s/%s", g.OriginFQDN, filepath.Base(videoPath)))
+	if err != nil {
+		return err
 	}
+	// lol fixme
+	u.Host = fmt.Sprintf("%v:%v", "host.docker.internal", "9000")
 
-	log.Infof("Finished handling video %s", video.Meta.Meta.Title)
-	return inpStream.SendAndClose(&uploadResp)
+	log.Infof("Trying to reach origin file at %v", u.String())
+
+	// We've uploaded the video... can we reach it?
+	// If we can't reach it with a head request, don't commit it to the db
+	res, err := http.Head(u.String())
+	switch {
+	case err != nil:
+		log.Errorf("receied err trying to reach origin: %v", err)
+		return err
+	case res != nil && res.StatusCode >= 400:
+		return fmt.Errorf("recieved bad status code %v for request to %v", res.StatusCode, videoLoc)
+	}
+
+	return nil
 }
 
 func (g GRPCServer) getVideoDuration(path string) (float64, error) {
@@ -360,12 +539,15 @@ func (g GRPCServer) getVideoDuration(path string) (float64, error) {
 	return strconv.ParseFloat(strings.TrimSuffix(string(payload), "\n"), 10)
 }
 
+// dailyUploadKey is the key today's uploads are counted under
+func dailyUploadKey() string {
+	return time.Now().Format("01-02-2006")
+}
+
 func (g GRPCServer) isOverDailyUploadLimit(sizeBytes int) bool {
 	sizeMB := sizeBytes / 1024 / 1024
 
-	dateTS := time.Now().Format("01-02-2006")
-
-	ret, err := g.RedisConn.IncrBy(context.Background(), dateTS, int64(sizeMB)).Result()
+	ret, err := g.RedisConn.IncrBy(context.Background(), dailyUploadKey(), int64(sizeMB)).Result()
 	if err != nil {
 		log.Errorf("could not incr upload limit. Err: %v", err)
 		return true
@@ -376,23 +558,48 @@ func (g GRPCServer) isOverDailyUploadLimit(sizeBytes int) bool {
 	return ret > int64(g.MaxDailyUploadMB)
 }
 
+// uploadedTodayMB returns how much has been counted against the daily upload limit so far, without counting anything
+func (g GRPCServer) uploadedTodayMB(ctx context.Context) (int64, error) {
+	ret, err := g.RedisConn.Get(ctx, dailyUploadKey()).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	} else if err != nil {
+		return 0, LogAndRetErr("could not get upload limit. Err: %s", err)
+	}
+
+	return ret, nil
+}
+
+// countUpload counts data which has been stored for good against the daily upload limit
+func (g GRPCServer) countUpload(sizeBytes int64) {
+	err := g.RedisConn.IncrBy(context.Background(), dailyUploadKey(), sizeBytes/1024/1024).Err()
+	if err != nil {
+		log.Errorf("could not incr upload limit. Err: %v", err)
+	}
+}
+
 func LogAndRetErr(fmtStr string, err error) error {
 	errWithMsg := fmt.Errorf(fmtStr, err)
 	log.Error(errWithMsg)
 	return errWithMsg
 }
 
-func (g GRPCServer) ForeignVideoExists(ctx context.Context, foreignVideoCheck *proto.ForeignVideoCheck) (*proto.VideoExistenceResponse, error) {
+// storedWebsite returns how the website is stored with videos. The sites we started out with are stored by their old
+// enum values.
+func storedWebsite(website string) string {
 	switch {
-	case strings.Contains(foreignVideoCheck.ForeignWebsite, "nicovideo"):
-		foreignVideoCheck.ForeignWebsite = "0"
-	case strings.Contains(foreignVideoCheck.ForeignWebsite, "bilibili"):
-		foreignVideoCheck.ForeignWebsite = "1"
-	case strings.Contains(foreignVideoCheck.ForeignWebsite, "youtube"):
-		foreignVideoCheck.ForeignWebsite = "2"
-	}
+	case strings.Contains(website, "nicovideo"):
+		return "0"
+	case strings.Contains(website, "bilibili"):
+		return "1"
+	case strings.Contains(website, "youtube"):
+		return "2"
+	}
+	return website
+}
 
-	exists, err := g.VideoModel.ForeignVideoExists(foreignVideoCheck.ForeignVideoID, foreignVideoCheck.ForeignWebsite)
+func (g GRPCServer) ForeignVideoExists(ctx context.Context, foreignVideoCheck *proto.ForeignVideoCheck) (*proto.VideoExistenceResponse, error) {
+	exists, err := g.VideoModel.ForeignVideoExists(foreignVideoCheck.ForeignVideoID, storedWebsite(foreignVideoCheck.ForeignWebsite))
 	if err != nil {
 		return nil, err
 	}
@@ -403,131 +610,75 @@ func (g GRPCServer) ForeignVideoExists(ctx context.Context, foreignVideoCheck *p
 
 }
 
-func (g GRPCServer) refreshMaterializedView() {
-	for {
-		if err := g.VideoModel.RefreshMaterializedView(); err != nil {
-			log.Errorf("Refresh materialized view: err %v", err)
-		}
-		<-time.After(time.Second * 60)
+func (g GRPCServer) SetForeignVideoCategory(ctx context.Context, req *proto.ForeignVideoCategory) (*proto.Nothing, error) {
+	if req.Category == "" {
+		return nil, status.Error(codes.InvalidArgument, "category can't be empty")
 	}
-}
 
-const NUM_TRANSCODING_WORKERS = 1
+	err := g.VideoModel.SetForeignVideoCategory(req.ForeignVideoID, storedWebsite(req.ForeignWebsite), req.Category)
+	switch {
+	case errors.Is(err, models.ErrVideoNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, err
+	}
 
-// TODO: graceful shutdown or something, lock video acquisition
-func (g GRPCServer) transcodeAndUploadVideos(MaxDLFileSize int64) {
-	gorse := client.NewGorseClient("http://gorse:8088", "api_key")
+	return &proto.Nothing{}, nil
+}
 
+func (g GRPCServer) refreshMaterializedView() {
 	for {
-		<-time.After(time.Second * 10)
-		videos, err := g.VideoModel.GetUnencodedVideos()
-		if err != nil {
-			log.Errorf("could not fetch unencoded videos. Err: %s", err)
-			continue
+		if err := g.VideoModel.RefreshMaterializedView(); err != nil {
+			log.Errorf("Refresh materialized view: err %v", err)
 		}
 
-		if len(videos) == 0 {
-			log.Infof("Failed to fetch unencoded videos, sleeping for 15 seconds")
-			<-time.After(time.Second * 15)
-			continue
+		select {
+		case <-time.After(time.Second * 60):
+		case <-g.searchRefresh:
 		}
+	}
+}
 
-		for _, v := range videos {
-			<-time.After(time.Second * 10)
-
-			func(video models.UnencodedVideo) {
-				// TODO: distributed lock goes here
-
-				log.Infof("Transcoding/chunking video id %d uuid %s", video.ID, video.GetMPDUUID())
-
-				vid, err := g.Storage.Fetch(video.GetMPDUUID())
-
-				if err != nil {
-					log.Errorf("could not fetch unencoded video id %d from backend. Err: %s", video.ID, err)
-					return
-				} else {
-					defer func() {
-						vid.Close()
-						os.Remove(vid.Name())
-					}()
-				}
-
-				if MaxDLFileSize != 0 {
-					s, err := vid.Stat()
-					if err != nil {
-						log.Errorf("Could not stat video to encode. Err: %s", err)
-						return
-					}
-
-					if s.Size() >= 1024*1024*MaxDLFileSize {
-						err = g.VideoModel.MarkVideoAsTooBig(video)
-						if err != nil {
-							log.Errorf("failed to mark video as too big. Err: %s", err)
-							return
-						}
-
-						log.Errorf("Video %d greater than 300mb, skipping and marking as too big", v.ID)
-						return
-					}
-				}
-				_, err = vid.Seek(0, 0)
-				if err != nil {
-					log.Errorf("Could not seek to 0 for video to encode. Err: %s", err)
-					return
-				}
-
-				nullTranscoder := dashutils.H264Transcoder{}
-
-				transcodeResults, err := nullTranscoder.TranscodeAndGenerateManifest(vid.Name(), g.Local)
-				if err != nil {
-					err := fmt.Errorf("failed to transcode and chunk. Err: %s", err)
-					log.Error(err)
-					return
-				}
-
-				err = g.UploadMPDSet(transcodeResults)
-				if err != nil {
-					log.Errorf("failed to upload mpd set. Err: %s", err)
-					return
-				}
-
-				err = g.VideoModel.MarkVideoAsEncoded(video)
-				if err != nil {
-					log.Errorf("failed to mark video as encoded. Err: %s", err)
-					return
-				}
-
-				f := false
-				_, err = gorse.UpdateItem(context.TODO(), fmt.Sprintf("%d", video.ID), client.ItemPatch{
-					IsHidden: &f,
-				})
-				if err != nil {
-					log.Errorf("failed to update gorse item after transcoding: %v", err)
-				}
-
-				log.Infof("Video %d has been successfully encoded", video.ID)
-			}(v)
-		}
+// refreshSearchSoon has videos_denormalized refreshed without waiting for the next scheduled refresh. Requests made
+// while a refresh is pending are merged into it.
+func (g GRPCServer) refreshSearchSoon() {
+	select {
+	case g.searchRefresh <- struct{}{}:
+	default:
 	}
 }
 
 // UploadMPDSet uploads the files to S3. Files may be overwritten (but they're versioned so they're safe).
 // Need to ensure as a precondition that the video hasn't been uploaded before and the temp file ID hasn't been
 // used.
-func (g GRPCServer) UploadMPDSet(d *dashutils.DASHVideo) error {
+func (g GRPCServer) UploadMPDSet(ctx context.Context, d *dashutils.DASHVideo) error {
 	if d.ManifestPath == nil {
 		return nil
 	}
 
+	if len(d.TextTracks) > 0 {
+		err := dashutils.SetTextTracksInFile(*d.ManifestPath, d.TextTracks)
+		if err != nil {
+			return fmt.Errorf("could not add text tracks to manifest. Err: %s", err)
+		}
+	}
+
 	// send manifest to origin
-	err := g.Storage.Upload(*d.ManifestPath, filepath.Base(*d.ManifestPath))
+	err := storage.PutFile(ctx, g.Storage, *d.ManifestPath, filepath.Base(*d.ManifestPath))
 	if err != nil {
 		return err
 	}
 
-	// Send all of the chunked files
+	if d.HLSMasterPath != nil {
+		err = storage.PutFile(ctx, g.Storage, *d.HLSMasterPath, filepath.Base(*d.HLSMasterPath))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Send all of the chunked files and media playlists
 	for _, path := range d.QualityMap {
-		err = g.Storage.Upload(path, filepath.Base(path))
+		err = storage.PutFile(ctx, g.Storage, path, filepath.Base(path))
 		if err != nil {
 			return err
 		}
@@ -542,12 +693,6 @@ func (g GRPCServer) UploadMPDSet(d *dashutils.DASHVideo) error {
 	return nil
 }
 
-// Do we need this?
-func (g GRPCServer) DownloadVideo(req *proto.VideoRequest, outputStream proto.VideoService_DownloadVideoServer) error {
-	return nil
-	// TODO
-}
-
 func (g GRPCServer) GetCategories(context.Context, *proto.Nothing) (*proto.CategoryList, error) {
 	return g.VideoModel.GetCategories()
 }
@@ -555,18 +700,19 @@ func (g GRPCServer) GetCategories(context.Context, *proto.Nothing) (*proto.Categ
 func (g GRPCServer) GetVideoList(ctx context.Context, queryConfig *proto.VideoQueryConfig) (*proto.VideoList, error) {
 	switch queryConfig.OrderBy {
 	case proto.OrderCategory_rating, proto.OrderCategory_views, proto.OrderCategory_upload_date, proto.OrderCategory_my_ratings:
-		videos, n, categories, err := g.VideoModel.GetVideoList(queryConfig.Direction, queryConfig.PageNumber,
-			queryConfig.FromUserID, queryConfig.SearchVal, queryConfig.ShowUnapproved, queryConfig.UnapprovedOnly, queryConfig.OrderBy, queryConfig.Category, false, nil, queryConfig.ShowMature)
+		q, err := videoQuery(queryConfig)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		list, err := g.VideoModel.GetVideoList(ctx, q)
 		if err != nil {
 			log.Errorf("Could not get video list. Err: %s", err)
 			return nil, err
 		}
+		g.signVideos(list.Videos)
 
-		return &proto.VideoList{
-			Videos:         videos,
-			NumberOfVideos: int64(n),
-			Categories:     categories,
-		}, nil
+		return list, nil
 
 	default:
 		st := status.New(codes.InvalidArgument, "invalid order category")
@@ -598,6 +744,16 @@ func (g GRPCServer) GetVideo(ctx context.Context, req *proto.VideoRequest) (*pro
 		return nil, err
 	}
 
+	videoMetadata.VideoLoc = g.signLoc(videoMetadata.VideoLoc)
+	videoMetadata.HLSLoc = g.signLoc(videoMetadata.HLSLoc)
+	videoMetadata.Thumbnail = g.signLoc(videoMetadata.Thumbnail)
+
+	videoMetadata.Subtitles, err = g.listSubtitleTracks(videoMetadata.VideoID)
+	if err != nil {
+		// Not worth failing the whole page over
+		log.Errorf("Could not list subtitle tracks of video %d. Err: %s", videoMetadata.VideoID, err)
+	}
+
 	return videoMetadata, nil
 }
 
@@ -617,21 +773,18 @@ func (g GRPCServer) DeleteVideo(ctx context.Context, deleteReq *proto.VideoDelet
 		return nil, err
 	}
 
-	// Need to fix the mpd storage for this stuff LOL
-	spl := strings.Split(info.VideoLoc, "/")
-	r := spl[len(spl)-1]
-	uuid := r[:len(r)-4]
+	uuid := originalUUID(info.VideoLoc)
 
 	// Delete video from storage
 	log.Errorf("Deleting video %s", uuid)
-	err = g.Storage.Delete(uuid)
+	err = g.Storage.Delete(ctx, uuid)
 	if err != nil {
 		return nil, err
 	}
 
 	// Delete thumb from storage
 	log.Errorf("Deleting thumbnail %s", uuid+".thumb")
-	err = g.Storage.Delete(uuid + ".thumb")
+	err = g.Storage.Delete(ctx, uuid+".thumb")
 	if err != nil {
 		return nil, err
 	}
@@ -671,6 +824,7 @@ func (g GRPCServer) GetVideoRecommendations(ctx context.Context, req *proto.RecR
 	if err != nil {
 		return nil, err
 	}
+	g.signVideos(resp.Videos)
 
 	return resp, nil
 }
@@ -683,7 +837,3 @@ func (g GRPCServer) GetDanmaku(ctx context.Context, req *proto.DanmakuQueryReq)
 
 	return resp, nil
 }
-
-func (g GRPCServer) AddDanmaku(ctx context.Context, req *proto.Danmaku) (*proto.Nothing, error) {
-	return &proto.Nothing{}, g.VideoModel.MakeDanmaku(int(req.VideoId), req.Timestamp, req.Message, int(req.AuthorId), req.Type, req.Color, req.FontSize)
-}
@@ -0,0 +1,188 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/horahoradev/PrometheusTube/backend/video_service/internal/models"
+	proto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxPlaylistTitleLength       = 255
+	maxPlaylistDescriptionLength = 4096
+	maxPlaylistNoteLength        = 1024
+)
+
+func (g GRPCServer) CreatePlaylist(ctx context.Context, req *proto.Playlist) (*proto.Playlist, error) {
+	p, err := checkPlaylist(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := g.Playlists.Create(ctx, p)
+	if err != nil {
+		return nil, playlistErr(err)
+	}
+
+	return ret, nil
+}
+
+func (g GRPCServer) UpdatePlaylist(ctx context.Context, req *proto.Playlist) (*proto.Playlist, error) {
+	p, err := checkPlaylist(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := g.Playlists.Update(ctx, p)
+	if err != nil {
+		return nil, playlistErr(err)
+	}
+
+	return ret, nil
+}
+
+func (g GRPCServer) DeletePlaylist(ctx context.Context, req *proto.PlaylistReq) (*proto.Nothing, error) {
+	err := g.Playlists.Delete(ctx, req.Id, req.UserId)
+	if err != nil {
+		return nil, playlistErr(err)
+	}
+
+	return &proto.Nothing{}, nil
+}
+
+func (g GRPCServer) GetPlaylist(ctx context.Context, req *proto.PlaylistReq) (*proto.Playlist, error) {
+	p, err := g.Playlists.Get(ctx, req.Id, req.UserId)
+	if err != nil {
+		return nil, playlistErr(err)
+	}
+
+	for _, entry := range p.Entries {
+		g.signVideos([]*proto.Video{entry.Video})
+	}
+
+	return p, nil
+}
+
+func (g GRPCServer) ListPlaylists(ctx context.Context, req *proto.PlaylistListReq) (*proto.PlaylistList, error) {
+	playlists, err := g.Playlists.List(ctx, req.OwnerId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.PlaylistList{Playlists: playlists}, nil
+}
+
+func (g GRPCServer) AddPlaylistEntry(ctx context.Context, req *proto.PlaylistEntryReq) (*proto.PlaylistEntry, error) {
+	err := checkPlaylistEntry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := g.Playlists.AddEntry(ctx, req.PlaylistId, req.UserId, req.VideoId, req.Note, req.Position)
+	if err != nil {
+		return nil, playlistErr(err)
+	}
+	g.signVideos([]*proto.Video{entry.Video})
+
+	return entry, nil
+}
+
+func (g GRPCServer) UpdatePlaylistEntry(ctx context.Context, req *proto.PlaylistEntryReq) (*proto.PlaylistEntry, error) {
+	err := checkPlaylistEntry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := g.Playlists.UpdateEntry(ctx, req.PlaylistId, req.UserId, req.VideoId, req.Note, req.Position)
+	if err != nil {
+		return nil, playlistErr(err)
+	}
+	g.signVideos([]*proto.Video{entry.Video})
+
+	return entry, nil
+}
+
+func (g GRPCServer) RemovePlaylistEntry(ctx context.Context, req *proto.PlaylistEntryReq) (*proto.Nothing, error) {
+	err := g.Playlists.RemoveEntry(ctx, req.PlaylistId, req.UserId, req.VideoId)
+	if err != nil {
+		return nil, playlistErr(err)
+	}
+
+	return &proto.Nothing{}, nil
+}
+
+func (g GRPCServer) GetPlaylistNeighbours(ctx context.Context, req *proto.PlaylistPositionReq) (*proto.PlaylistNeighbours, error) {
+	res, err := g.Playlists.Neighbours(ctx, req.PlaylistId, req.UserId, req.VideoId, req.Loop)
+	if err != nil {
+		return nil, playlistErr(err)
+	}
+
+	for _, entry := range []*proto.PlaylistEntry{res.Previous, res.Next} {
+		if entry != nil {
+			g.signVideos([]*proto.Video{entry.Video})
+		}
+	}
+
+	return res, nil
+}
+
+// checkPlaylist validates the playlist's details. Playlists are private unless a visibility is given.
+func checkPlaylist(req *proto.Playlist) (models.Playlist, error) {
+	p := models.Playlist{
+		ID:          req.Id,
+		UserID:      req.UserId,
+		Title:       strings.TrimSpace(req.Title),
+		Description: req.Description,
+		Visibility:  req.Visibility,
+	}
+
+	if p.Visibility == "" {
+		p.Visibility = models.PlaylistPrivate
+	}
+
+	switch {
+	case p.UserID == 0:
+		return p, status.Error(codes.InvalidArgument, "a user is required")
+	case p.Title == "":
+		return p, status.Error(codes.InvalidArgument, "a title is required")
+	case utf8.RuneCountInString(p.Title) > maxPlaylistTitleLength:
+		return p, status.Errorf(codes.InvalidArgument, "titles can be at most %d characters", maxPlaylistTitleLength)
+	case utf8.RuneCountInString(p.Description) > maxPlaylistDescriptionLength:
+		return p, status.Errorf(codes.InvalidArgument, "descriptions can be at most %d characters", maxPlaylistDescriptionLength)
+	case !models.ValidPlaylistVisibility(p.Visibility):
+		return p, status.Errorf(codes.InvalidArgument, "invalid visibility %q", p.Visibility)
+	}
+
+	return p, nil
+}
+
+func checkPlaylistEntry(req *proto.PlaylistEntryReq) error {
+	switch {
+	case utf8.RuneCountInString(req.Note) > maxPlaylistNoteLength:
+		return status.Errorf(codes.InvalidArgument, "notes can be at most %d characters", maxPlaylistNoteLength)
+	case req.Position < 0:
+		return status.Error(codes.InvalidArgument, "positions start from 1")
+	}
+
+	return nil
+}
+
+// playlistErr maps model errors to status codes
+func playlistErr(err error) error {
+	switch {
+	case errors.Is(err, models.ErrPlaylistNotFound), errors.Is(err, models.ErrPlaylistEntryNotFound), errors.Is(err, models.ErrVideoNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, models.ErrPlaylistEntryExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, models.ErrTooManyPlaylists):
+		return status.Errorf(codes.ResourceExhausted, "users can have at most %d playlists", models.MaxPlaylistsPerUser)
+	case errors.Is(err, models.ErrPlaylistFull):
+		return status.Errorf(codes.ResourceExhausted, "playlists can have at most %d videos", models.MaxPlaylistEntries)
+	}
+	return err
+}
@@ -0,0 +1,97 @@
+package grpcserver
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/horahoradev/PrometheusTube/backend/video_service/internal/models"
+	proto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+)
+
+// videoQuery returns the query for a video list request, or an error describing the first invalid filter
+func videoQuery(config *proto.VideoQueryConfig) (models.VideoQuery, error) {
+	q := models.VideoQuery{
+		Direction:        config.Direction,
+		OrderBy:          config.OrderBy,
+		PageNum:          config.PageNumber,
+		FromUserID:       config.FromUserID,
+		Search:           config.SearchVal,
+		ShowUnapproved:   config.ShowUnapproved,
+		UnapprovedOnly:   config.UnapprovedOnly,
+		Category:         config.Category,
+		ShowMature:       config.ShowMature,
+		LostUpstreamOnly: config.LostUpstreamOnly,
+		Tags:             nonEmpty(config.Tags),
+		ExcludedTags:     nonEmpty(config.ExcludedTags),
+		AnyTags:          nonEmpty(config.AnyTags),
+		Durations:        nonEmpty(config.Durations),
+		MinRating:        config.MinRating,
+		MinViews:         int64(config.MinViews),
+		HasDanmaku:       config.HasDanmaku,
+	}
+
+	for _, duration := range q.Durations {
+		if !models.ValidDuration(duration) {
+			return q, fmt.Errorf("invalid duration %s", duration)
+		}
+	}
+
+	for _, site := range nonEmpty(config.Sites) {
+		q.Sites = append(q.Sites, strings.ToLower(site))
+	}
+
+	dates := []struct {
+		name  string
+		value string
+		dest  *time.Time
+	}{
+		{"uploadedSince", config.UploadedSince, &q.UploadedSince},
+		{"uploadedBefore", config.UploadedBefore, &q.UploadedBefore},
+		{"originalUploadedSince", config.OriginalUploadedSince, &q.OriginalUploadedSince},
+		{"originalUploadedBefore", config.OriginalUploadedBefore, &q.OriginalUploadedBefore},
+	}
+	for _, d := range dates {
+		if d.value == "" {
+			continue
+		}
+
+		var err error
+		*d.dest, err = parseDate(d.value)
+		if err != nil {
+			return q, fmt.Errorf("invalid %s. Err: %s", d.name, err)
+		}
+	}
+
+	if config.Cursor != "" {
+		var err error
+		q.Cursor, err = models.DecodeCursor(config.Cursor, q.OrderBy, q.Direction)
+		if err != nil {
+			return q, err
+		}
+	}
+
+	return q, nil
+}
+
+// parseDate parses a YYYY-MM-DD date, or an RFC3339 time
+func parseDate(s string) (time.Time, error) {
+	t, err := time.Parse("2006-01-02", s)
+	if err == nil {
+		return t, nil
+	}
+
+	t, err = time.Parse(time.RFC3339, s)
+	return t.UTC(), err
+}
+
+func nonEmpty(values []string) []string {
+	var res []string
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v != "" {
+			res = append(res, v)
+		}
+	}
+	return res
+}
@@ -0,0 +1,286 @@
+package grpcserver
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/horahoradev/PrometheusTube/backend/video_service/internal/dashutils"
+	"github.com/horahoradev/PrometheusTube/backend/video_service/internal/models"
+	"github.com/horahoradev/PrometheusTube/backend/video_service/internal/subtitles"
+	proto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/horahoradev/PrometheusTube/backend/video_service/storage"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxSubtitleLabelLength = 255
+	subtitleContentType    = "text/vtt; charset=utf-8"
+)
+
+func (g GRPCServer) UploadSubtitle(ctx context.Context, req *proto.SubtitleUpload) (*proto.SubtitleTrack, error) {
+	vtt, err := checkSubtitleUpload(req)
+	if err != nil {
+		return nil, err
+	}
+
+	manifestLoc, err := g.VideoModel.GetManifestLocation(req.VideoId)
+	if err != nil {
+		return nil, subtitleErr(err)
+	}
+
+	track := models.SubtitleTrack{
+		VideoID:  req.VideoId,
+		UserID:   sql.NullInt64{Int64: req.UserId, Valid: req.UserId != 0},
+		Language: req.Language,
+		Kind:     req.Kind,
+		Label:    req.Label,
+	}
+
+	err = g.saveSubtitleTrack(ctx, manifestLoc, &track, vtt)
+	if err != nil {
+		return nil, subtitleErr(err)
+	}
+
+	return g.subtitleTrackToProto(track), nil
+}
+
+func (g GRPCServer) GetSubtitles(ctx context.Context, req *proto.VideoRequest) (*proto.SubtitleTrackList, error) {
+	videoID, err := strconv.ParseInt(req.VideoID, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid video ID %q", req.VideoID)
+	}
+
+	tracks, err := g.listSubtitleTracks(videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.SubtitleTrackList{Tracks: tracks}, nil
+}
+
+func (g GRPCServer) DeleteSubtitle(ctx context.Context, req *proto.SubtitleDeleteReq) (*proto.Nothing, error) {
+	track, err := g.Subtitles.Delete(req.Id, req.VideoAuthorId)
+	if err != nil {
+		return nil, subtitleErr(err)
+	}
+
+	g.removeSubtitleFile(ctx, track.Location)
+
+	manifestLoc, err := g.VideoModel.GetManifestLocation(track.VideoID)
+	if err != nil {
+		log.Errorf("Could not get manifest location of video %d. Err: %s", track.VideoID, err)
+		return &proto.Nothing{}, nil
+	}
+
+	err = g.updateManifestTextTracks(ctx, track.VideoID, manifestLoc)
+	if err != nil {
+		log.Errorf("Could not update text tracks of video %d. Err: %s", track.VideoID, err)
+	}
+
+	return &proto.Nothing{}, nil
+}
+
+// checkSubtitleUpload validates the track, and converts it to WebVTT
+func checkSubtitleUpload(req *proto.SubtitleUpload) ([]byte, error) {
+	if req.Kind == "" {
+		req.Kind = subtitles.KindSubtitles
+	}
+
+	switch {
+	case !subtitles.ValidLanguage(req.Language):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid language %q", req.Language)
+	case !subtitles.ValidKind(req.Kind):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid kind %q", req.Kind)
+	case utf8.RuneCountInString(req.Label) > maxSubtitleLabelLength:
+		return nil, status.Errorf(codes.InvalidArgument, "labels can be at most %d characters", maxSubtitleLabelLength)
+	}
+
+	vtt, err := subtitles.ToWebVTT(req.Data)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not read subtitles. Err: %s", err)
+	}
+
+	return vtt, nil
+}
+
+// subtitleErr maps model errors to status codes
+func subtitleErr(err error) error {
+	switch {
+	case errors.Is(err, models.ErrVideoNotFound), errors.Is(err, models.ErrSubtitleTrackNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, models.ErrSubtitleDeleteNotAllowed):
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return err
+}
+
+// saveArchivedSubtitles saves the subtitle tracks archived from the original site. Tracks which can't be read are
+// skipped, since the video itself is fine.
+func (g GRPCServer) saveArchivedSubtitles(ctx context.Context, videoID int64, uploads []*proto.SubtitleUpload) {
+	if len(uploads) == 0 {
+		return
+	}
+
+	manifestLoc, err := g.VideoModel.GetManifestLocation(videoID)
+	if err != nil {
+		log.Errorf("Could not get manifest location of video %d. Err: %s", videoID, err)
+		return
+	}
+
+	for _, upload := range uploads {
+		vtt, err := checkSubtitleUpload(upload)
+		if err != nil {
+			log.Errorf("Skipping %s subtitles archived for video %d. Err: %s", upload.Language, videoID, err)
+			continue
+		}
+
+		track := models.SubtitleTrack{
+			VideoID:  videoID,
+			Language: upload.Language,
+			Kind:     upload.Kind,
+			Label:    upload.Label,
+			Imported: true,
+		}
+
+		err = g.saveSubtitleTrack(ctx, manifestLoc, &track, vtt)
+		if err != nil {
+			log.Errorf("Could not save %s subtitles archived for video %d. Err: %s", upload.Language, videoID, err)
+			continue
+		}
+
+		log.Infof("Saved %s subtitles archived for video %d", upload.Language, videoID)
+	}
+}
+
+// saveSubtitleTrack stores the WebVTT file next to the video's files, saves the track, and lists it in the video's
+// manifest. Any track with the same language and kind is replaced.
+func (g GRPCServer) saveSubtitleTrack(ctx context.Context, manifestLoc string, track *models.SubtitleTrack, vtt []byte) error {
+	// Every upload gets a new file, so that caches don't keep serving the track it replaced
+	track.Location = fmt.Sprintf("%s_subtitles_%s.vtt", strings.TrimSuffix(manifestLoc, ".mpd"), uuid.New().String())
+
+	err := g.Storage.Put(ctx, path.Base(track.Location), bytes.NewReader(vtt), int64(len(vtt)), subtitleContentType)
+	if err != nil {
+		return fmt.Errorf("could not upload subtitles. Err: %s", err)
+	}
+
+	previous, err := g.Subtitles.Save(ctx, track)
+	if err != nil {
+		g.removeSubtitleFile(ctx, track.Location)
+		return err
+	}
+
+	if previous != "" {
+		g.removeSubtitleFile(ctx, previous)
+	}
+
+	err = g.updateManifestTextTracks(ctx, track.VideoID, manifestLoc)
+	if err != nil {
+		// The track is still listed with the video, so players which don't go through the manifest can show it
+		log.Errorf("Could not update text tracks of video %d. Err: %s", track.VideoID, err)
+	}
+
+	return nil
+}
+
+func (g GRPCServer) removeSubtitleFile(ctx context.Context, loc string) {
+	err := g.Storage.Delete(ctx, path.Base(loc))
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		log.Errorf("Could not remove subtitle file %s. Err: %s", loc, err)
+	}
+}
+
+// updateManifestTextTracks lists the video's subtitle tracks in its DASH manifest. Videos which haven't been transcoded
+// yet don't have a manifest, and get their tracks when they are. The video is locked meanwhile, so that concurrent
+// updates can't drop each other's tracks.
+func (g GRPCServer) updateManifestTextTracks(ctx context.Context, videoID int64, manifestLoc string) error {
+	return g.Subtitles.LockManifest(ctx, videoID, func() error {
+		return g.setManifestTextTracks(ctx, videoID, manifestLoc)
+	})
+}
+
+func (g GRPCServer) setManifestTextTracks(ctx context.Context, videoID int64, manifestLoc string) error {
+	key := path.Base(manifestLoc)
+	r, _, err := g.Storage.Open(ctx, key)
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		return nil
+	case err != nil:
+		return err
+	}
+
+	mpd, err := io.ReadAll(r)
+	r.Close()
+	if err != nil {
+		return err
+	}
+
+	tracks, err := g.textTracks(videoID)
+	if err != nil {
+		return err
+	}
+
+	mpd, err = dashutils.SetTextTracks(mpd, tracks)
+	if err != nil {
+		return err
+	}
+
+	return g.Storage.Put(ctx, key, bytes.NewReader(mpd), int64(len(mpd)), "application/dash+xml")
+}
+
+// textTracks returns the video's subtitle tracks, as they're listed in its manifest
+func (g GRPCServer) textTracks(videoID int64) ([]dashutils.TextTrack, error) {
+	tracks, err := g.Subtitles.List(videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []dashutils.TextTrack
+	for _, t := range tracks {
+		ret = append(ret, dashutils.TextTrack{
+			ID:       t.ID,
+			Language: t.Language,
+			Kind:     t.Kind,
+			Label:    t.Label,
+			Key:      path.Base(t.Location),
+		})
+	}
+
+	return ret, nil
+}
+
+func (g GRPCServer) listSubtitleTracks(videoID int64) ([]*proto.SubtitleTrack, error) {
+	tracks, err := g.Subtitles.List(videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*proto.SubtitleTrack, 0, len(tracks))
+	for _, t := range tracks {
+		ret = append(ret, g.subtitleTrackToProto(t))
+	}
+
+	return ret, nil
+}
+
+func (g GRPCServer) subtitleTrackToProto(t models.SubtitleTrack) *proto.SubtitleTrack {
+	return &proto.SubtitleTrack{
+		Id:       t.ID,
+		VideoId:  t.VideoID,
+		Language: t.Language,
+		Kind:     t.Kind,
+		Label:    t.Label,
+		Location: g.signLoc(t.Location),
+		Imported: t.Imported,
+	}
+}
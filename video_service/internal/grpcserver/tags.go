@@ -0,0 +1,144 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/horahoradev/PrometheusTube/backend/video_service/internal/models"
+	proto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	log "github.com/sirupsen/logrus"
+	"github.com/zhenghaoz/gorse/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Tags are stored as VARCHAR(60)
+const maxTagLength = 60
+
+func (g GRPCServer) GetTags(ctx context.Context, req *proto.VideoTagsReq) (*proto.VideoTags, error) {
+	tags, err := g.VideoModel.GetTags(ctx, req.VideoId)
+	if err != nil {
+		return nil, err
+	}
+
+	return videoTags(req.VideoId, tags), nil
+}
+
+func (g GRPCServer) AddTag(ctx context.Context, req *proto.TagEditReq) (*proto.VideoTags, error) {
+	tag, err := checkTag(req.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := g.VideoModel.AddTag(ctx, req.VideoId, tagEditor(req.UserId, req.Moderator), tag)
+	if err != nil {
+		return nil, tagErr(err)
+	}
+
+	g.tagsChanged(req.VideoId, tags)
+	return videoTags(req.VideoId, tags), nil
+}
+
+func (g GRPCServer) RemoveTag(ctx context.Context, req *proto.TagEditReq) (*proto.VideoTags, error) {
+	tags, err := g.VideoModel.RemoveTag(ctx, req.VideoId, tagEditor(req.UserId, req.Moderator), strings.TrimSpace(req.Tag))
+	if err != nil {
+		return nil, tagErr(err)
+	}
+
+	g.tagsChanged(req.VideoId, tags)
+	return videoTags(req.VideoId, tags), nil
+}
+
+func (g GRPCServer) SetTagLock(ctx context.Context, req *proto.TagEditReq) (*proto.VideoTags, error) {
+	tags, err := g.VideoModel.SetTagLock(ctx, req.VideoId, tagEditor(req.UserId, req.Moderator), strings.TrimSpace(req.Tag), req.Locked)
+	if err != nil {
+		return nil, tagErr(err)
+	}
+
+	// Locks don't change what the video is tagged with, so there's nothing to update
+	return videoTags(req.VideoId, tags), nil
+}
+
+func (g GRPCServer) RevertTags(ctx context.Context, req *proto.TagRevertReq) (*proto.VideoTags, error) {
+	tags, err := g.VideoModel.RevertTags(ctx, req.VideoId, tagEditor(req.UserId, req.Moderator), req.EditId)
+	if err != nil {
+		return nil, tagErr(err)
+	}
+
+	g.tagsChanged(req.VideoId, tags)
+	return videoTags(req.VideoId, tags), nil
+}
+
+func (g GRPCServer) GetTagHistory(ctx context.Context, req *proto.TagHistoryReq) (*proto.TagHistory, error) {
+	edits, total, err := g.VideoModel.GetTagHistory(ctx, req.VideoId, req.PageNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.TagHistory{Edits: edits, Total: total}, nil
+}
+
+func tagEditor(userID int64, moderator bool) models.TagEditor {
+	return models.TagEditor{UserID: userID, Moderator: moderator}
+}
+
+// checkTag validates a tag which is being added, and trims it
+func checkTag(tag string) (string, error) {
+	tag = strings.TrimSpace(tag)
+	switch {
+	case tag == "":
+		return "", status.Error(codes.InvalidArgument, "empty tag")
+	case utf8.RuneCountInString(tag) > maxTagLength:
+		return "", status.Errorf(codes.InvalidArgument, "tags can be at most %d characters", maxTagLength)
+	case strings.IndexFunc(tag, unicode.IsControl) != -1:
+		return "", status.Error(codes.InvalidArgument, "tags can't contain control characters")
+	}
+
+	return tag, nil
+}
+
+// tagErr maps model errors to status codes
+func tagErr(err error) error {
+	switch {
+	case errors.Is(err, models.ErrVideoNotFound), errors.Is(err, models.ErrTagNotFound), errors.Is(err, models.ErrTagEditNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, models.ErrTagExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, models.ErrTooManyTags):
+		return status.Errorf(codes.ResourceExhausted, "videos can have at most %d tags", models.MaxTagsPerVideo)
+	case errors.Is(err, models.ErrTagLocked), errors.Is(err, models.ErrTagEditNotAllowed), errors.Is(err, models.ErrRevertNotAllowed):
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return err
+}
+
+// tagsChanged brings search and recommendations up to date with the video's new tags. Neither is worth failing the
+// edit over.
+func (g GRPCServer) tagsChanged(videoID int64, tags []models.VideoTag) {
+	g.refreshSearchSoon()
+
+	labels := make([]string, 0, len(tags))
+	for _, t := range tags {
+		labels = append(labels, t.Tag)
+	}
+
+	gorse := client.NewGorseClient("http://gorse:8088", "api_key")
+	_, err := gorse.UpdateItem(context.TODO(), fmt.Sprintf("%d", videoID), client.ItemPatch{
+		Labels: labels,
+	})
+	if err != nil {
+		log.Errorf("failed to update gorse item labels of video %d: %v", videoID, err)
+	}
+}
+
+func videoTags(videoID int64, tags []models.VideoTag) *proto.VideoTags {
+	ret := proto.VideoTags{VideoId: videoID}
+	for _, t := range tags {
+		ret.Tags = append(ret.Tags, &proto.VideoTag{Tag: t.Tag, Locked: t.Locked})
+	}
+	return &ret
+}
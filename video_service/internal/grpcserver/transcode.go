@@ -0,0 +1,185 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/horahoradev/PrometheusTube/backend/video_service/internal/dashutils"
+	"github.com/horahoradev/PrometheusTube/backend/video_service/internal/models"
+	"github.com/horahoradev/PrometheusTube/backend/video_service/storage"
+	log "github.com/sirupsen/logrus"
+	"github.com/zhenghaoz/gorse/client"
+)
+
+type TranscodeOptions struct {
+	Ladder     []dashutils.Rendition
+	NumWorkers int
+	// MaxAttempts is the number of times a video is tried before it's marked as failed
+	MaxAttempts int
+	// LeaseDuration is how long a video stays claimed by a worker without a heartbeat
+	LeaseDuration time.Duration
+}
+
+const (
+	// How long idle workers wait before checking for new videos
+	transcodePollDelay = time.Second * 15
+	// Failed videos are retried after TRANSCODE_RETRY_DELAY * the number of attempts so far
+	TRANSCODE_RETRY_DELAY = time.Minute * 5
+)
+
+// transcodeAndUploadVideos runs the transcoding workers until ctx is canceled
+func (g GRPCServer) transcodeAndUploadVideos(ctx context.Context, numWorkers int, MaxDLFileSize int64) {
+	gorse := client.NewGorseClient("http://gorse:8088", "api_key")
+
+	var wg sync.WaitGroup
+	for i := 0; i < numWorkers; i++ {
+		wg.Add(1)
+		go func(q *models.TranscodeQueue) {
+			defer wg.Done()
+			g.transcodeWorker(ctx, q, gorse, MaxDLFileSize)
+		}(g.TranscodeQueue.Worker(i))
+	}
+
+	wg.Wait()
+	log.Info("Transcoding workers have stopped")
+}
+
+func (g GRPCServer) transcodeWorker(ctx context.Context, q *models.TranscodeQueue, gorse *client.GorseClient, MaxDLFileSize int64) {
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		video, err := q.Claim()
+		if err != nil {
+			log.Errorf("could not claim unencoded video. Err: %s", err)
+		}
+
+		if video == nil {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(transcodePollDelay):
+			}
+			continue
+		}
+
+		g.processTranscode(ctx, q, video, gorse, MaxDLFileSize)
+	}
+}
+
+// processTranscode transcodes a claimed video, and records the outcome
+func (g GRPCServer) processTranscode(ctx context.Context, q *models.TranscodeQueue, video *models.UnencodedVideo, gorse *client.GorseClient, MaxDLFileSize int64) {
+	// Attempts are counted when a video is claimed, so this only happens if the video keeps killing its worker
+	if video.Attempts > q.MaxAttempts {
+		log.Errorf("Video %d has been claimed %d times without finishing, marking it as failed", video.ID, video.Attempts)
+		err := q.Fail(video, errors.New("worker died while transcoding"))
+		if err != nil {
+			log.Errorf("failed to mark video %d as failed. Err: %s", video.ID, err)
+		}
+		return
+	}
+
+	stopHeartbeat := q.KeepAlive(video)
+	defer stopHeartbeat()
+
+	err := g.transcodeVideo(ctx, q, video, gorse, MaxDLFileSize)
+	switch {
+	case err == nil:
+		return
+	case ctx.Err() != nil:
+		// We're shutting down, so let another worker have it without counting this attempt against the video
+		log.Infof("Abandoning transcode of video %d for shutdown", video.ID)
+		err = q.Abandon(video)
+		if err != nil {
+			log.Errorf("failed to abandon video %d. Err: %s", video.ID, err)
+		}
+	default:
+		log.Errorf("failed to transcode video %d (attempt %d/%d). Err: %s", video.ID, video.Attempts, q.MaxAttempts, err)
+		err = q.Release(video, TRANSCODE_RETRY_DELAY*time.Duration(video.Attempts), err)
+		if err != nil {
+			log.Errorf("failed to release video %d. Err: %s", video.ID, err)
+		}
+	}
+}
+
+func (g GRPCServer) transcodeVideo(ctx context.Context, q *models.TranscodeQueue, video *models.UnencodedVideo, gorse *client.GorseClient, MaxDLFileSize int64) error {
+	log.Infof("Transcoding/chunking video id %d uuid %s", video.ID, video.GetMPDUUID())
+
+	// Check the size before fetching, so that videos which are too big never touch the disk
+	if MaxDLFileSize != 0 {
+		info, err := g.Storage.Stat(ctx, video.GetMPDUUID())
+		if err != nil {
+			return fmt.Errorf("could not stat video to encode. Err: %s", err)
+		}
+
+		if info.Size >= 1024*1024*MaxDLFileSize {
+			log.Errorf("Video %d greater than %dmb, skipping and marking as too big", video.ID, MaxDLFileSize)
+			err = q.MarkTooBig(video)
+			if err != nil {
+				return fmt.Errorf("failed to mark video as too big. Err: %s", err)
+			}
+
+			return nil
+		}
+	}
+
+	vid, err := storage.FetchFile(ctx, g.Storage, video.GetMPDUUID())
+	if err != nil {
+		return fmt.Errorf("could not fetch unencoded video from backend. Err: %s", err)
+	}
+	defer func() {
+		vid.Close()
+		os.Remove(vid.Name())
+
+		err := dashutils.RemoveGeneratedFiles(vid.Name())
+		if err != nil {
+			log.Errorf("Could not remove generated files for video %d. Err: %s", video.ID, err)
+		}
+	}()
+
+	_, err = vid.Seek(0, 0)
+	if err != nil {
+		return fmt.Errorf("could not seek to 0 for video to encode. Err: %s", err)
+	}
+
+	transcodeResults, err := g.Transcoder.TranscodeAndGenerateManifest(ctx, vid.Name(), g.Local)
+	if err != nil {
+		return fmt.Errorf("failed to transcode and chunk. Err: %s", err)
+	}
+
+	transcodeResults.TextTracks, err = g.textTracks(int64(video.ID))
+	if err != nil {
+		// They're added to the manifest whenever a track is uploaded, so it can do without them for now
+		log.Errorf("Could not list subtitle tracks of video %d. Err: %s", video.ID, err)
+	}
+
+	err = g.UploadMPDSet(ctx, transcodeResults)
+	if err != nil {
+		return fmt.Errorf("failed to upload mpd set. Err: %s", err)
+	}
+
+	err = q.Complete(video, transcodeResults.HLSMasterPath != nil)
+	if errors.Is(err, models.ErrLeaseLost) {
+		// Someone else took over after our lease expired. The files are the same either way, so it's harmless.
+		log.Errorf("Lost the lease on video %d while transcoding it", video.ID)
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to mark video as encoded. Err: %s", err)
+	}
+
+	f := false
+	_, err = gorse.UpdateItem(context.TODO(), fmt.Sprintf("%d", video.ID), client.ItemPatch{
+		IsHidden: &f,
+	})
+	if err != nil {
+		log.Errorf("failed to update gorse item after transcoding: %v", err)
+	}
+
+	log.Infof("Video %d has been successfully encoded", video.ID)
+	return nil
+}
@@ -0,0 +1,342 @@
+package grpcserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/horahoradev/PrometheusTube/backend/video_service/internal/models"
+	proto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// Resumable uploads, loosely modeled after tus (https://tus.io/protocols/resumable-upload).
+// The partial video for each session is kept in UploadOptions.Dir until the session is finalized or expires, so that
+// a dropped connection only loses the chunk which was in flight.
+
+type UploadOptions struct {
+	// Dir is where partial uploads are kept. It should be on a volume which survives restarts.
+	Dir string
+	// TTL is how long a session lives after it was last written to
+	TTL time.Duration
+}
+
+const uploadSessionGCInterval = time.Minute * 10
+
+func (g GRPCServer) sessionPath(id string) string {
+	return filepath.Join(g.UploadDir, id)
+}
+
+func uploadSessionToProto(s *models.UploadSession) *proto.UploadSession {
+	return &proto.UploadSession{
+		SessionID: s.ID,
+		Offset:    s.Offset,
+		Size:      s.Size,
+		ExpiresAt: s.ExpiresAt.Format(time.RFC3339),
+	}
+}
+
+func uploadSessionErr(err error) error {
+	switch {
+	case errors.Is(err, models.ErrUploadSessionNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, models.ErrUploadSessionBusy), errors.Is(err, models.ErrUploadSessionLeaseLost):
+		return status.Error(codes.Aborted, err.Error())
+	}
+	return err
+}
+
+func (g GRPCServer) CreateUploadSession(ctx context.Context, req *proto.NewUploadSession) (*proto.UploadSession, error) {
+	if req.Meta == nil {
+		return nil, status.Error(codes.InvalidArgument, "metadata is required")
+	}
+
+	if req.Size <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "size must be positive")
+	}
+
+	if req.Sha256 != "" {
+		if _, err := hex.DecodeString(req.Sha256); err != nil || len(req.Sha256) != sha256.Size*2 {
+			return nil, status.Error(codes.InvalidArgument, "sha256 must be a hex encoded sha256 checksum")
+		}
+	}
+
+	// UUID for tmp filename and all uploads provides probabilistic guarantee of uniqueness
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	meta, err := protobuf.Marshal(req.Meta)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the file now, so that a full disk is noticed before anything is uploaded
+	f, err := os.Create(g.sessionPath(id.String()))
+	if err != nil {
+		return nil, LogAndRetErr("could not create upload session file. Err: %s", err)
+	}
+	f.Close()
+
+	session := models.UploadSession{
+		ID:       id.String(),
+		UserID:   req.UserID,
+		Size:     req.Size,
+		SHA256:   req.Sha256,
+		Metadata: meta,
+	}
+
+	err = g.UploadSessions.Create(&session)
+	if err != nil {
+		os.Remove(g.sessionPath(id.String()))
+		return nil, LogAndRetErr("could not create upload session. Err: %s", err)
+	}
+
+	log.Infof("Created upload session %s for user %d, size %d", session.ID, session.UserID, session.Size)
+	return uploadSessionToProto(&session), nil
+}
+
+func (g GRPCServer) GetUploadSession(ctx context.Context, req *proto.UploadSessionReq) (*proto.UploadSession, error) {
+	session, err := g.UploadSessions.Get(req.SessionID)
+	if err != nil {
+		return nil, uploadSessionErr(err)
+	}
+
+	if session.UserID != req.UserID {
+		return nil, status.Error(codes.NotFound, models.ErrUploadSessionNotFound.Error())
+	}
+
+	return uploadSessionToProto(session), nil
+}
+
+func (g GRPCServer) AppendUploadChunk(inpStream proto.VideoService_AppendUploadChunkServer) error {
+	first, err := inpStream.Recv()
+	if err != nil {
+		return LogAndRetErr("could not recv. Err: %s", err)
+	}
+
+	session, err := g.UploadSessions.Lock(inpStream.Context(), first.SessionID)
+	if err != nil {
+		return uploadSessionErr(err)
+	}
+	// Unlocking after Advance is a no-op
+	defer session.Unlock()
+	// The lease is short, so it's kept alive for as long as the chunk takes to arrive
+	stopHeartbeat := session.KeepAlive()
+	defer stopHeartbeat()
+
+	if session.UserID != first.UserID {
+		return status.Error(codes.NotFound, models.ErrUploadSessionNotFound.Error())
+	}
+
+	if first.Offset != session.Offset {
+		return status.Errorf(codes.FailedPrecondition, "offset %d does not match the session's offset %d", first.Offset, session.Offset)
+	}
+
+	fileHash, err := restoreHash(session.HashState)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(g.sessionPath(session.ID), os.O_WRONLY, 0644)
+	if err != nil {
+		return LogAndRetErr("could not open upload session file. Err: %s", err)
+	}
+	defer f.Close()
+
+	_, err = f.Seek(session.Offset, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	// Chunks only count against the daily limit once they're recorded, since rejected ones are thrown away
+	uploadedMB, err := g.uploadedTodayMB(inpStream.Context())
+	if err != nil {
+		return err
+	}
+
+	chunkHash := sha256.New()
+	w := io.MultiWriter(f, chunkHash, fileHash)
+	offset := session.Offset
+
+	// Anything written past the session's offset is discarded unless the whole chunk makes it
+	abort := func(err error) error {
+		truncErr := f.Truncate(session.Offset)
+		if truncErr != nil {
+			log.Errorf("could not truncate upload session %s. Err: %s", session.ID, truncErr)
+		}
+		return err
+	}
+
+	for chunk := first; ; {
+		if offset+int64(len(chunk.Data)) > session.Size {
+			return abort(status.Errorf(codes.OutOfRange, "upload would exceed the declared size of %d bytes", session.Size))
+		}
+
+		received := offset + int64(len(chunk.Data)) - session.Offset
+		if uploadedMB+received/1024/1024 > int64(g.MaxDailyUploadMB) {
+			return abort(DailyUploadLimitError)
+		}
+
+		_, err = w.Write(chunk.Data)
+		if err != nil {
+			return abort(LogAndRetErr("could not write video data to file, err: %s", err))
+		}
+		offset += int64(len(chunk.Data))
+
+		chunk, err = inpStream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return abort(LogAndRetErr("could not recv. Err: %s", err))
+		}
+	}
+
+	if first.Sha256 != "" && hex.EncodeToString(chunkHash.Sum(nil)) != first.Sha256 {
+		return abort(status.Error(codes.DataLoss, "checksum of the chunk does not match"))
+	}
+
+	// Leftovers from an earlier attempt which died before it could be recorded
+	err = f.Truncate(offset)
+	if err != nil {
+		return abort(err)
+	}
+
+	err = f.Sync()
+	if err != nil {
+		return abort(err)
+	}
+
+	hashState, err := fileHash.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		return abort(err)
+	}
+
+	err = session.Advance(offset, hashState, g.UploadSessions.TTL)
+	if errors.Is(err, models.ErrUploadSessionLeaseLost) {
+		// The file may belong to another request by now, so it's left alone
+		return uploadSessionErr(err)
+	} else if err != nil {
+		return abort(LogAndRetErr("could not record upload progress. Err: %s", err))
+	}
+
+	g.countUpload(offset - session.Offset)
+
+	return inpStream.SendAndClose(uploadSessionToProto(&session.UploadSession))
+}
+
+func (g GRPCServer) FinalizeUpload(ctx context.Context, req *proto.UploadSessionReq) (*proto.UploadResponse, error) {
+	session, err := g.UploadSessions.Lock(ctx, req.SessionID)
+	if err != nil {
+		return nil, uploadSessionErr(err)
+	}
+	defer session.Unlock()
+
+	if session.UserID != req.UserID {
+		return nil, status.Error(codes.NotFound, models.ErrUploadSessionNotFound.Error())
+	}
+
+	if session.Offset != session.Size {
+		return nil, status.Errorf(codes.FailedPrecondition, "upload is incomplete, received %d of %d bytes", session.Offset, session.Size)
+	}
+
+	fileHash, err := restoreHash(session.HashState)
+	if err != nil {
+		return nil, err
+	}
+
+	checksum := hex.EncodeToString(fileHash.Sum(nil))
+	if session.SHA256 != "" && checksum != session.SHA256 {
+		return nil, status.Error(codes.DataLoss, "checksum of the uploaded video does not match")
+	}
+
+	var meta proto.InputFileMetadata
+	err = protobuf.Unmarshal(session.Metadata, &meta)
+	if err != nil {
+		return nil, err
+	}
+
+	// Saving the video can take a while, so the session is marked rather than kept locked meanwhile
+	err = session.MarkFinalizing(g.UploadSessions.TTL)
+	if errors.Is(err, models.ErrUploadSessionLeaseLost) {
+		return nil, uploadSessionErr(err)
+	} else if err != nil {
+		return nil, LogAndRetErr("could not mark upload session as finalizing. Err: %s", err)
+	}
+
+	videoID, err := g.processUpload(&meta, g.sessionPath(session.ID), "", checksum)
+	if err != nil {
+		abortErr := g.UploadSessions.AbortFinalizing(session.ID)
+		if abortErr != nil {
+			log.Errorf("could not unmark upload session %s. Err: %s", session.ID, abortErr)
+		}
+		return nil, err
+	}
+
+	err = g.UploadSessions.FinishFinalizing(session.ID)
+	if err != nil {
+		log.Errorf("could not delete finalized upload session %s. Err: %s", session.ID, err)
+	}
+
+	os.Remove(g.sessionPath(session.ID))
+
+	log.Infof("Finalized upload session %s as video %d", session.ID, videoID)
+	return &proto.UploadResponse{
+		VideoID: videoID,
+	}, nil
+}
+
+// restoreHash returns a sha256 hash which has already consumed the data the state was marshaled from
+func restoreHash(state []byte) (hash.Hash, error) {
+	h := sha256.New()
+	if len(state) == 0 {
+		return h, nil
+	}
+
+	err := h.(encoding.BinaryUnmarshaler).UnmarshalBinary(state)
+	if err != nil {
+		return nil, fmt.Errorf("could not restore upload checksum. Err: %s", err)
+	}
+
+	return h, nil
+}
+
+// collectExpiredUploadSessions removes expired sessions and their partial files
+func (g GRPCServer) collectExpiredUploadSessions(ctx context.Context) {
+	for {
+		ids, err := g.UploadSessions.DeleteExpired()
+		if err != nil {
+			log.Errorf("could not delete expired upload sessions. Err: %s", err)
+		}
+
+		for _, id := range ids {
+			err = os.Remove(g.sessionPath(id))
+			if err != nil && !os.IsNotExist(err) {
+				log.Errorf("could not remove file for expired upload session %s. Err: %s", id, err)
+			}
+		}
+
+		if len(ids) > 0 {
+			log.Infof("Removed %d expired upload sessions", len(ids))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(uploadSessionGCInterval):
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/horahoradev/PrometheusTube/backend/video_service/internal/models"
+	proto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Most videos the scheduler claims in one go
+const maxUpstreamClaim = 100
+
+func (g GRPCServer) ClaimUpstreamChecks(ctx context.Context, req *proto.UpstreamCheckClaim) (*proto.UpstreamCheckList, error) {
+	switch {
+	case req.Limit < 1 || req.Limit > maxUpstreamClaim:
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", maxUpstreamClaim)
+	case req.LeaseSeconds < 1:
+		return nil, status.Error(codes.InvalidArgument, "lease must be positive")
+	case req.IntervalSeconds < 0:
+		return nil, status.Error(codes.InvalidArgument, "interval can't be negative")
+	}
+
+	checks, err := g.Upstream.Claim(ctx, int(req.Limit), time.Duration(req.LeaseSeconds)*time.Second, time.Duration(req.IntervalSeconds)*time.Second)
+	if err != nil {
+		log.Errorf("Could not claim upstream checks. Err: %s", err)
+		return nil, err
+	}
+
+	ret := proto.UpstreamCheckList{Checks: make([]*proto.UpstreamCheck, 0, len(checks))}
+	for _, check := range checks {
+		ret.Checks = append(ret.Checks, &proto.UpstreamCheck{VideoId: check.VideoID, Url: check.URL})
+	}
+
+	return &ret, nil
+}
+
+func (g GRPCServer) ReportUpstreamStatus(ctx context.Context, req *proto.UpstreamStatusReport) (*proto.Nothing, error) {
+	if req.RecheckAfterSeconds < 1 {
+		return nil, status.Error(codes.InvalidArgument, "recheck delay must be positive")
+	}
+
+	err := g.Upstream.Report(ctx, req.VideoId, req.Status, req.Message, time.Duration(req.RecheckAfterSeconds)*time.Second)
+	switch {
+	case errors.Is(err, models.ErrInvalidUpstreamStatus):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid upstream status %q", req.Status)
+	case errors.Is(err, models.ErrVideoNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		log.Errorf("Could not report upstream status of video %d. Err: %s", req.VideoId, err)
+		return nil, err
+	}
+
+	return &proto.Nothing{}, nil
+}
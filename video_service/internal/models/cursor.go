@@ -0,0 +1,93 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor marks where a page of a video list starts, so that pages follow on from each other even if the list changes
+// in between. It's handed to clients as an opaque token.
+type Cursor struct {
+	OrderBy   videoproto.OrderCategory `json:"o"`
+	Direction videoproto.SortDirection `json:"d"`
+	// Key and VideoID are the sort key and ID of the video next to the page. What the key is depends on the search
+	// backend, see SearchHit.
+	Key     int64 `json:"k"`
+	VideoID int64 `json:"id"`
+	// Before is set for cursors to the page before the video, rather than the one after it
+	Before bool `json:"b,omitempty"`
+}
+
+func (c Cursor) Encode() string {
+	// Can't fail
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor decodes a cursor for the query's order and direction
+func DecodeCursor(token string, orderBy videoproto.OrderCategory, direction videoproto.SortDirection) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c Cursor
+	err = json.Unmarshal(b, &c)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	if c.OrderBy != orderBy || c.Direction != direction || c.VideoID == 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	return &c, nil
+}
+
+// cursorFor returns the cursor to the page after the hit, or before it
+func cursorFor(q VideoQuery, hit SearchHit, before bool) string {
+	return Cursor{
+		OrderBy:   q.OrderBy,
+		Direction: q.Direction,
+		Key:       hit.SortKey,
+		VideoID:   hit.VideoID,
+		Before:    before,
+	}.Encode()
+}
+
+// page trims the hits which a backend returned for the query down to a page, in the list's order, and returns the
+// cursors to the pages on either side of it
+func page(q VideoQuery, hits []SearchHit) ([]SearchHit, string, string) {
+	more := len(hits) > NumResultsPerPage
+	if more {
+		hits = hits[:NumResultsPerPage]
+	}
+
+	before := q.Cursor != nil && q.Cursor.Before
+	if before {
+		for i, j := 0, len(hits)-1; i < j; i, j = i+1, j-1 {
+			hits[i], hits[j] = hits[j], hits[i]
+		}
+	}
+
+	if len(hits) == 0 {
+		return hits, "", ""
+	}
+
+	var next, prev string
+	// Paging back means coming from the next page
+	if more || before {
+		next = cursorFor(q, hits[len(hits)-1], false)
+	}
+
+	if (before && more) || (!before && (q.Cursor != nil || q.offset() > 0)) {
+		prev = cursorFor(q, hits[0], true)
+	}
+
+	return hits, next, prev
+}
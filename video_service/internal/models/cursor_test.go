@@ -0,0 +1,93 @@
+package models
+
+import (
+	"testing"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursorEncoding(t *testing.T) {
+	c := Cursor{
+		OrderBy:   videoproto.OrderCategory_views,
+		Direction: videoproto.SortDirection_asc,
+		Key:       100,
+		VideoID:   7,
+		Before:    true,
+	}
+
+	decoded, err := DecodeCursor(c.Encode(), videoproto.OrderCategory_views, videoproto.SortDirection_asc)
+	assert.NoError(t, err)
+	assert.Equal(t, c, *decoded)
+
+	// Cursors only work for the order they came from
+	_, err = DecodeCursor(c.Encode(), videoproto.OrderCategory_rating, videoproto.SortDirection_asc)
+	assert.Equal(t, ErrInvalidCursor, err)
+
+	_, err = DecodeCursor(c.Encode(), videoproto.OrderCategory_views, videoproto.SortDirection_desc)
+	assert.Equal(t, ErrInvalidCursor, err)
+
+	for _, token := range []string{"not a cursor", "bm90IGpzb24", "e30"} {
+		_, err = DecodeCursor(token, videoproto.OrderCategory_views, videoproto.SortDirection_asc)
+		assert.Equal(t, ErrInvalidCursor, err, token)
+	}
+}
+
+func hits(ids ...int64) []SearchHit {
+	var res []SearchHit
+	for _, id := range ids {
+		res = append(res, SearchHit{VideoID: id, SortKey: id * 10})
+	}
+	return res
+}
+
+func ids(hits []SearchHit) []int64 {
+	var res []int64
+	for _, hit := range hits {
+		res = append(res, hit.VideoID)
+	}
+	return res
+}
+
+func decode(t *testing.T, token string) Cursor {
+	c, err := DecodeCursor(token, videoproto.OrderCategory_views, videoproto.SortDirection_desc)
+	assert.NoError(t, err)
+	return *c
+}
+
+func TestPage(t *testing.T) {
+	q := VideoQuery{OrderBy: videoproto.OrderCategory_views, Direction: videoproto.SortDirection_desc, PageNum: 1}
+
+	all := make([]int64, 0, NumResultsPerPage+1)
+	for i := NumResultsPerPage + 1; i > 0; i-- {
+		all = append(all, int64(i))
+	}
+
+	// First page, with another after it
+	page1, next, prev := page(q, hits(all...))
+	assert.Equal(t, all[:NumResultsPerPage], ids(page1))
+	assert.Equal(t, Cursor{OrderBy: q.OrderBy, Direction: q.Direction, Key: 20, VideoID: 2}, decode(t, next))
+	assert.Empty(t, prev)
+
+	// Last page, reached with a cursor
+	q.Cursor = &Cursor{Key: 20, VideoID: 2}
+	last, next, prev := page(q, hits(1))
+	assert.Equal(t, []int64{1}, ids(last))
+	assert.Empty(t, next)
+	assert.Equal(t, Cursor{OrderBy: q.OrderBy, Direction: q.Direction, Key: 10, VideoID: 1, Before: true}, decode(t, prev))
+
+	// Paging back from the last page returns the hits in reverse, which are put back in order
+	q.Cursor = &Cursor{Key: 10, VideoID: 1, Before: true}
+	reversed := append([]int64{}, all[:NumResultsPerPage]...)
+	for i, j := 0, len(reversed)-1; i < j; i, j = i+1, j-1 {
+		reversed[i], reversed[j] = reversed[j], reversed[i]
+	}
+	back, next, prev := page(q, hits(reversed...))
+	assert.Equal(t, all[:NumResultsPerPage], ids(back))
+	assert.Equal(t, Cursor{OrderBy: q.OrderBy, Direction: q.Direction, Key: 20, VideoID: 2}, decode(t, next))
+	assert.Empty(t, prev)
+
+	_, next, prev = page(q, nil)
+	assert.Empty(t, next)
+	assert.Empty(t, prev)
+}
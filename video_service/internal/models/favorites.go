@@ -0,0 +1,84 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/lib/pq"
+)
+
+// videoHitColumns selects what's listed of each video from videos into a SearchHit. videos_denormalized would do, but
+// it's only refreshed every so often, and lists which users change themselves should show their changes straight away.
+const videoHitColumns = "videos.id AS videoid, COALESCE(videos.title, '') AS title, videos.userid, COALESCE(videos.newlink, '') AS newlink, " +
+	"COALESCE(videos.views, 0) AS views, COALESCE(videos.video_duration, 0) AS video_duration, COALESCE(videos.rating, 0) AS rating, videos.is_mature"
+
+// AddFavorite favorites the video for the user. Favoriting a video twice does nothing.
+func (v *VideoModel) AddFavorite(ctx context.Context, userID, videoID int64) (*videoproto.FavoriteStatus, error) {
+	_, err := v.db.ExecContext(ctx, "INSERT INTO favorites (user_id, video_id) VALUES ($1, $2) ON CONFLICT DO NOTHING", userID, videoID)
+	var pqErr *pq.Error
+	switch {
+	case errors.As(err, &pqErr) && pqErr.Code == pqForeignKeyViolation:
+		return nil, ErrVideoNotFound
+	case err != nil:
+		return nil, err
+	}
+
+	return v.GetFavoriteStatus(ctx, userID, videoID)
+}
+
+func (v *VideoModel) RemoveFavorite(ctx context.Context, userID, videoID int64) (*videoproto.FavoriteStatus, error) {
+	_, err := v.db.ExecContext(ctx, "DELETE FROM favorites WHERE user_id = $1 AND video_id = $2", userID, videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.GetFavoriteStatus(ctx, userID, videoID)
+}
+
+// GetFavoriteStatus returns whether the user has favorited the video, and how many users have. A user ID of 0 only
+// counts them.
+func (v *VideoModel) GetFavoriteStatus(ctx context.Context, userID, videoID int64) (*videoproto.FavoriteStatus, error) {
+	var status videoproto.FavoriteStatus
+	err := v.db.QueryRowContext(ctx, "SELECT count(*), COALESCE(bool_or(user_id = $1), false) FROM favorites WHERE video_id = $2", userID, videoID).
+		Scan(&status.Count, &status.Favorite)
+	if err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+// GetFavorites returns a page of the videos the user has favorited, most recently favorited first
+func (v *VideoModel) GetFavorites(ctx context.Context, userID, pageNum int64, showMature bool) (*videoproto.VideoList, error) {
+	if pageNum < 1 {
+		pageNum = 1
+	}
+
+	where := "WHERE favorites.user_id = $1 AND videos.transcoded = true AND videos.is_deleted = false"
+	if !showMature {
+		where += " AND videos.is_mature = false"
+	}
+
+	var list videoproto.VideoList
+	err := v.db.GetContext(ctx, &list.NumberOfVideos, "SELECT count(*) FROM favorites INNER JOIN videos ON favorites.video_id = videos.id "+where, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var hits []SearchHit
+	sql := "SELECT " + videoHitColumns + " FROM favorites INNER JOIN videos ON favorites.video_id = videos.id " + where +
+		fmt.Sprintf(" ORDER BY favorites.created_at DESC, videos.id DESC LIMIT %d OFFSET %d", NumResultsPerPage, (pageNum-1)*NumResultsPerPage)
+	err = v.db.SelectContext(ctx, &hits, sql, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	list.Videos, err = v.videosFromHits(hits)
+	if err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
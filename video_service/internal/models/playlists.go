@@ -0,0 +1,466 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+var (
+	ErrPlaylistNotFound      = errors.New("playlist not found")
+	ErrPlaylistEntryNotFound = errors.New("video is not in the playlist")
+	ErrPlaylistEntryExists   = errors.New("video is already in the playlist")
+	ErrTooManyPlaylists      = errors.New("too many playlists")
+	ErrPlaylistFull          = errors.New("playlist is full")
+)
+
+const (
+	PlaylistPublic   = "public"
+	PlaylistUnlisted = "unlisted"
+	PlaylistPrivate  = "private"
+
+	MaxPlaylistsPerUser = 100
+	MaxPlaylistEntries  = 500
+)
+
+const pqUniqueViolation = "23505"
+
+func ValidPlaylistVisibility(visibility string) bool {
+	switch visibility {
+	case PlaylistPublic, PlaylistUnlisted, PlaylistPrivate:
+		return true
+	}
+	return false
+}
+
+type Playlist struct {
+	ID          int64     `db:"id"`
+	UserID      int64     `db:"user_id"`
+	Title       string    `db:"title"`
+	Description string    `db:"description"`
+	Visibility  string    `db:"visibility"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+	EntryCount  int64     `db:"entry_count"`
+}
+
+// visibleTo returns whether the user can see the playlist. Unlisted playlists can be seen by anyone who has their ID.
+func (p Playlist) visibleTo(userID int64) bool {
+	return p.Visibility != PlaylistPrivate || p.UserID == userID
+}
+
+type PlaylistEntry struct {
+	PlaylistID int64     `db:"playlist_id"`
+	Position   int64     `db:"position"`
+	Note       string    `db:"note"`
+	AddedAt    time.Time `db:"added_at"`
+	SearchHit
+}
+
+// PlaylistModel stores users' playlists (mylists)
+type PlaylistModel struct {
+	db     *sqlx.DB
+	videos *VideoModel
+}
+
+func NewPlaylistModel(db *sqlx.DB, videos *VideoModel) *PlaylistModel {
+	return &PlaylistModel{db: db, videos: videos}
+}
+
+const playlistColumns = "id, user_id, title, description, visibility, created_at, updated_at, " +
+	"(SELECT count(*) FROM playlist_entries WHERE playlist_id = playlists.id) AS entry_count"
+
+// Entries of videos which have been deleted are left out
+const playlistEntrySelect = "SELECT playlist_entries.playlist_id, playlist_entries.position, playlist_entries.note, playlist_entries.added_at, " +
+	videoHitColumns + " FROM playlist_entries INNER JOIN videos ON playlist_entries.video_id = videos.id " +
+	"WHERE playlist_entries.playlist_id = $1 AND videos.is_deleted = false"
+
+// Only transcoded videos can be played
+const playableEntry = " AND videos.transcoded = true"
+
+func (m *PlaylistModel) Create(ctx context.Context, p Playlist) (*videoproto.Playlist, error) {
+	var count int
+	err := m.db.GetContext(ctx, &count, "SELECT count(*) FROM playlists WHERE user_id = $1", p.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if count >= MaxPlaylistsPerUser {
+		return nil, ErrTooManyPlaylists
+	}
+
+	err = m.db.GetContext(ctx, &p, "INSERT INTO playlists (user_id, title, description, visibility) VALUES ($1, $2, $3, $4) RETURNING "+playlistColumns,
+		p.UserID, p.Title, p.Description, p.Visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	return playlistToProto(p), nil
+}
+
+// Update changes the playlist's title, description and visibility. Only its owner can.
+func (m *PlaylistModel) Update(ctx context.Context, p Playlist) (*videoproto.Playlist, error) {
+	err := m.db.GetContext(ctx, &p, "UPDATE playlists SET title = $1, description = $2, visibility = $3, updated_at = Now() "+
+		"WHERE id = $4 AND user_id = $5 RETURNING "+playlistColumns, p.Title, p.Description, p.Visibility, p.ID, p.UserID)
+	if err == sql.ErrNoRows {
+		return nil, ErrPlaylistNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return playlistToProto(p), nil
+}
+
+// Delete deletes the playlist, along with its entries. Only its owner can.
+func (m *PlaylistModel) Delete(ctx context.Context, id, userID int64) error {
+	res, err := m.db.ExecContext(ctx, "DELETE FROM playlists WHERE id = $1 AND user_id = $2", id, userID)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return ErrPlaylistNotFound
+	}
+
+	return nil
+}
+
+// Get returns the playlist with its entries, in order, if the user can see it
+func (m *PlaylistModel) Get(ctx context.Context, id, userID int64) (*videoproto.Playlist, error) {
+	p, err := m.get(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []PlaylistEntry
+	err = m.db.SelectContext(ctx, &entries, playlistEntrySelect+" ORDER BY playlist_entries.position", id)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := playlistToProto(*p)
+	ret.Entries, err = m.entriesToProto(entries)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (m *PlaylistModel) get(ctx context.Context, id, userID int64) (*Playlist, error) {
+	var p Playlist
+	err := m.db.GetContext(ctx, &p, "SELECT "+playlistColumns+" FROM playlists WHERE id = $1", id)
+	if err == sql.ErrNoRows {
+		return nil, ErrPlaylistNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if !p.visibleTo(userID) {
+		return nil, ErrPlaylistNotFound
+	}
+
+	return &p, nil
+}
+
+// List returns the owner's playlists, most recently changed first. Other users only see the public ones.
+func (m *PlaylistModel) List(ctx context.Context, ownerID, userID int64) ([]*videoproto.Playlist, error) {
+	sql := "SELECT " + playlistColumns + " FROM playlists WHERE user_id = $1"
+	if ownerID != userID {
+		sql += " AND visibility = '" + PlaylistPublic + "'"
+	}
+
+	var playlists []Playlist
+	err := m.db.SelectContext(ctx, &playlists, sql+" ORDER BY updated_at DESC, id DESC", ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*videoproto.Playlist, 0, len(playlists))
+	for _, p := range playlists {
+		ret = append(ret, playlistToProto(p))
+	}
+
+	return ret, nil
+}
+
+// AddEntry adds the video to the playlist at the position, or at the end if it's 0. Only the playlist's owner can.
+func (m *PlaylistModel) AddEntry(ctx context.Context, playlistID, userID, videoID int64, note string, position int64) (*videoproto.PlaylistEntry, error) {
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	err = lockPlaylist(ctx, tx, playlistID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var count int64
+	err = tx.GetContext(ctx, &count, "SELECT count(*) FROM playlist_entries WHERE playlist_id = $1", playlistID)
+	if err != nil {
+		return nil, err
+	}
+
+	if count >= MaxPlaylistEntries {
+		return nil, ErrPlaylistFull
+	}
+
+	if position < 1 || position > count+1 {
+		position = count + 1
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE playlist_entries SET position = position + 1 WHERE playlist_id = $1 AND position >= $2", playlistID, position)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := tx.ExecContext(ctx, "INSERT INTO playlist_entries (playlist_id, video_id, position, note) "+
+		"SELECT $1, id, $3, $4 FROM videos WHERE id = $2 AND is_deleted = false", playlistID, videoID, position, note)
+	var pqErr *pq.Error
+	switch {
+	case errors.As(err, &pqErr) && pqErr.Code == pqUniqueViolation:
+		return nil, ErrPlaylistEntryExists
+	case err != nil:
+		return nil, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if n == 0 {
+		return nil, ErrVideoNotFound
+	}
+
+	err = touchPlaylist(ctx, tx, playlistID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return m.entry(ctx, playlistID, " AND playlist_entries.video_id = $2", videoID)
+}
+
+// UpdateEntry replaces the entry's note, and moves it to the position unless that's 0. Only the playlist's owner can.
+func (m *PlaylistModel) UpdateEntry(ctx context.Context, playlistID, userID, videoID int64, note string, position int64) (*videoproto.PlaylistEntry, error) {
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	err = lockPlaylist(ctx, tx, playlistID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var current int64
+	err = tx.GetContext(ctx, &current, "SELECT position FROM playlist_entries WHERE playlist_id = $1 AND video_id = $2", playlistID, videoID)
+	if err == sql.ErrNoRows {
+		return nil, ErrPlaylistEntryNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if position > 0 && position != current {
+		var count int64
+		err = tx.GetContext(ctx, &count, "SELECT count(*) FROM playlist_entries WHERE playlist_id = $1", playlistID)
+		if err != nil {
+			return nil, err
+		}
+
+		if position > count {
+			position = count
+		}
+
+		// Close the gap the entry leaves, then open one where it's going
+		_, err = tx.ExecContext(ctx, "UPDATE playlist_entries SET position = position - 1 WHERE playlist_id = $1 AND position > $2", playlistID, current)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = tx.ExecContext(ctx, "UPDATE playlist_entries SET position = position + 1 WHERE playlist_id = $1 AND position >= $2 AND video_id <> $3",
+			playlistID, position, videoID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		position = current
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE playlist_entries SET note = $1, position = $2 WHERE playlist_id = $3 AND video_id = $4",
+		note, position, playlistID, videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = touchPlaylist(ctx, tx, playlistID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return m.entry(ctx, playlistID, " AND playlist_entries.video_id = $2", videoID)
+}
+
+// RemoveEntry removes the video from the playlist. Only the playlist's owner can.
+func (m *PlaylistModel) RemoveEntry(ctx context.Context, playlistID, userID, videoID int64) error {
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = lockPlaylist(ctx, tx, playlistID, userID)
+	if err != nil {
+		return err
+	}
+
+	var position int64
+	err = tx.GetContext(ctx, &position, "DELETE FROM playlist_entries WHERE playlist_id = $1 AND video_id = $2 RETURNING position", playlistID, videoID)
+	if err == sql.ErrNoRows {
+		return ErrPlaylistEntryNotFound
+	} else if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE playlist_entries SET position = position - 1 WHERE playlist_id = $1 AND position > $2", playlistID, position)
+	if err != nil {
+		return err
+	}
+
+	err = touchPlaylist(ctx, tx, playlistID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Neighbours returns the entries before and after the video in the playlist, skipping videos which can't be played. At
+// either end there isn't one, unless the playlist loops.
+func (m *PlaylistModel) Neighbours(ctx context.Context, playlistID, userID, videoID int64, loop bool) (*videoproto.PlaylistNeighbours, error) {
+	_, err := m.get(ctx, playlistID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var position int64
+	err = m.db.GetContext(ctx, &position, "SELECT position FROM playlist_entries WHERE playlist_id = $1 AND video_id = $2", playlistID, videoID)
+	if err == sql.ErrNoRows {
+		return nil, ErrPlaylistEntryNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	var res videoproto.PlaylistNeighbours
+	res.Previous, err = m.entry(ctx, playlistID, playableEntry+" AND playlist_entries.position < $2 ORDER BY playlist_entries.position DESC LIMIT 1", position)
+	if err == ErrPlaylistEntryNotFound && loop {
+		res.Previous, err = m.entry(ctx, playlistID, playableEntry+" ORDER BY playlist_entries.position DESC LIMIT 1")
+	}
+	if err != nil && err != ErrPlaylistEntryNotFound {
+		return nil, err
+	}
+
+	res.Next, err = m.entry(ctx, playlistID, playableEntry+" AND playlist_entries.position > $2 ORDER BY playlist_entries.position LIMIT 1", position)
+	if err == ErrPlaylistEntryNotFound && loop {
+		res.Next, err = m.entry(ctx, playlistID, playableEntry+" ORDER BY playlist_entries.position LIMIT 1")
+	}
+	if err != nil && err != ErrPlaylistEntryNotFound {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// entry returns the first of the playlist's entries which matches the rest of the query
+func (m *PlaylistModel) entry(ctx context.Context, playlistID int64, rest string, args ...interface{}) (*videoproto.PlaylistEntry, error) {
+	var e PlaylistEntry
+	err := m.db.GetContext(ctx, &e, playlistEntrySelect+rest, append([]interface{}{playlistID}, args...)...)
+	if err == sql.ErrNoRows {
+		return nil, ErrPlaylistEntryNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	entries, err := m.entriesToProto([]PlaylistEntry{e})
+	if err != nil {
+		return nil, err
+	}
+
+	return entries[0], nil
+}
+
+func (m *PlaylistModel) entriesToProto(entries []PlaylistEntry) ([]*videoproto.PlaylistEntry, error) {
+	hits := make([]SearchHit, 0, len(entries))
+	for _, e := range entries {
+		hits = append(hits, e.SearchHit)
+	}
+
+	videos, err := m.videos.videosFromHits(hits)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*videoproto.PlaylistEntry, 0, len(entries))
+	for i, e := range entries {
+		ret = append(ret, &videoproto.PlaylistEntry{
+			PlaylistId: e.PlaylistID,
+			Video:      videos[i],
+			Position:   e.Position,
+			Note:       e.Note,
+			AddedAt:    e.AddedAt.Format(time.RFC3339),
+		})
+	}
+
+	return ret, nil
+}
+
+// lockPlaylist locks the playlist for changes to its entries, if it belongs to the user
+func lockPlaylist(ctx context.Context, tx *sqlx.Tx, id, userID int64) error {
+	var found bool
+	err := tx.GetContext(ctx, &found, "SELECT true FROM playlists WHERE id = $1 AND user_id = $2 FOR UPDATE", id, userID)
+	if err == sql.ErrNoRows {
+		return ErrPlaylistNotFound
+	}
+	return err
+}
+
+func touchPlaylist(ctx context.Context, tx *sqlx.Tx, id int64) error {
+	_, err := tx.ExecContext(ctx, "UPDATE playlists SET updated_at = Now() WHERE id = $1", id)
+	return err
+}
+
+func playlistToProto(p Playlist) *videoproto.Playlist {
+	return &videoproto.Playlist{
+		Id:          p.ID,
+		UserId:      p.UserID,
+		Title:       p.Title,
+		Description: p.Description,
+		Visibility:  p.Visibility,
+		CreatedAt:   p.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   p.UpdatedAt.Format(time.RFC3339),
+		EntryCount:  p.EntryCount,
+	}
+}
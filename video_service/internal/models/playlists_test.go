@@ -0,0 +1,24 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlaylistVisibility(t *testing.T) {
+	for _, visibility := range []string{PlaylistPublic, PlaylistUnlisted, PlaylistPrivate} {
+		assert.True(t, ValidPlaylistVisibility(visibility))
+	}
+	assert.False(t, ValidPlaylistVisibility(""))
+	assert.False(t, ValidPlaylistVisibility("Public"))
+
+	p := Playlist{UserID: 1, Visibility: PlaylistPrivate}
+	assert.True(t, p.visibleTo(1))
+	assert.False(t, p.visibleTo(2))
+	assert.False(t, p.visibleTo(0))
+
+	p.Visibility = PlaylistUnlisted
+	assert.True(t, p.visibleTo(2))
+	assert.True(t, p.visibleTo(0))
+}
@@ -0,0 +1,133 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/jmoiron/sqlx"
+)
+
+// Search backends which can be configured
+const (
+	SearchElasticsearch = "elasticsearch"
+	SearchPostgres      = "postgres"
+)
+
+// SearchBackend runs video list queries against videos_denormalized. Elasticsearch (through ZomboDB) is the default;
+// Postgres' own full text search is there for deployments which don't run Elasticsearch.
+//
+// Search returns the hits in the order they're paged through, which for cursors to the previous page is the reverse of
+// the list's order, so that the ones nearest the cursor come first. One more hit than fits on a page is returned if
+// there is one, so that callers know whether there's another page.
+type SearchBackend interface {
+	Search(ctx context.Context, q VideoQuery) (*SearchResult, error)
+}
+
+// NewSearchBackend returns the named backend. esURL is only used by Elasticsearch.
+func NewSearchBackend(db *sqlx.DB, backend, esURL string) (SearchBackend, error) {
+	switch backend {
+	case SearchElasticsearch, "":
+		return NewElasticsearchBackend(esURL)
+	case SearchPostgres:
+		return NewPostgresSearchBackend(db), nil
+	}
+
+	return nil, fmt.Errorf("unknown search backend %s", backend)
+}
+
+// VideoQuery is a page of a video list. Only transcoded videos which haven't been deleted are ever found.
+type VideoQuery struct {
+	Direction videoproto.SortDirection
+	OrderBy   videoproto.OrderCategory
+	// Pages start from 1, and are ignored if there's a cursor
+	PageNum    int64
+	Cursor     *Cursor
+	FromUserID int64
+	// Search is matched against titles and tags
+	Search         string
+	ShowUnapproved bool
+	UnapprovedOnly bool
+	Category       string
+	// FollowFeed limits the list to videos uploaded by the users in Following
+	FollowFeed       bool
+	Following        []int64
+	ShowMature       bool
+	LostUpstreamOnly bool
+	// Tags are matched ignoring case. Every one of Tags is required, none of ExcludedTags are allowed, and at least one
+	// of AnyTags is required.
+	Tags         []string
+	ExcludedTags []string
+	AnyTags      []string
+	// Durations are duration buckets, any of which match
+	Durations []string
+	// Ranges are from Since, inclusive, to Before, and zero times are open ended
+	UploadedSince          time.Time
+	UploadedBefore         time.Time
+	OriginalUploadedSince  time.Time
+	OriginalUploadedBefore time.Time
+	// Sites are as in videos_denormalized.site, any of which match
+	Sites      []string
+	MinRating  int64
+	MinViews   int64
+	HasDanmaku bool
+}
+
+func (q VideoQuery) offset() int64 {
+	if q.Cursor != nil || q.PageNum < 1 {
+		return 0
+	}
+	return (q.PageNum - 1) * NumResultsPerPage
+}
+
+// How many hits backends return, see SearchBackend
+const searchLimit = NumResultsPerPage + 1
+
+// SearchHit is a video in the results
+type SearchHit struct {
+	VideoID  int64   `db:"videoid"`
+	Title    string  `db:"title"`
+	AuthorID int64   `db:"userid"`
+	NewLink  string  `db:"newlink"`
+	Views    int64   `db:"views"`
+	Duration float64 `db:"video_duration"`
+	Rating   int64   `db:"rating"`
+	IsMature bool    `db:"is_mature"`
+	// SortKey is what the hit was sorted by, for cursors. Upload dates are in the backend's own precision.
+	SortKey int64 `db:"sort_key"`
+}
+
+type SearchResult struct {
+	// Hits is the requested page
+	Hits []SearchHit
+	// Total is the number of videos which matched, across all pages
+	Total int
+	// Categories counts the matches in the most common categories
+	Categories []*videoproto.Category
+	// Facets count the matches by the values they have for the other filters
+	Facets *videoproto.SearchFacets
+}
+
+const (
+	// How many categories are counted for the results
+	maxCategoryCounts = 10
+	// How many of the most common tags and sites are counted for the results
+	maxTagCounts  = 20
+	maxSiteCounts = 10
+)
+
+// Duration buckets, see videos_denormalized.duration_bucket
+const (
+	DurationShort  = "short"  // Under 4 minutes
+	DurationMedium = "medium" // 4 to 20 minutes
+	DurationLong   = "long"
+)
+
+func ValidDuration(duration string) bool {
+	switch duration {
+	case DurationShort, DurationMedium, DurationLong:
+		return true
+	}
+	return false
+}
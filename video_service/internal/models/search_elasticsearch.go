@@ -0,0 +1,359 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aquasecurity/esquery"
+	"github.com/elastic/go-elasticsearch/v7"
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	log "github.com/sirupsen/logrus"
+)
+
+// ElasticsearchBackend searches the Elasticsearch index which ZomboDB keeps of videos_denormalized
+type ElasticsearchBackend struct {
+	client *elasticsearch.Client
+}
+
+func NewElasticsearchBackend(url string) (*ElasticsearchBackend, error) {
+	c, err := elasticsearch.NewClient(elasticsearch.Config{
+		Addresses: []string{url},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ElasticsearchBackend{client: c}, nil
+}
+
+type ESVideoResp struct {
+	Took     int  `json:"took"`
+	TimedOut bool `json:"timed_out"`
+	Shards   struct {
+		Total      int `json:"total"`
+		Successful int `json:"successful"`
+		Skipped    int `json:"skipped"`
+		Failed     int `json:"failed"`
+	} `json:"_shards"`
+	Hits struct {
+		Total struct {
+			Value    int    `json:"value"`
+			Relation string `json:"relation"`
+		} `json:"total"`
+		MaxScore any `json:"max_score"`
+		Hits     []struct {
+			Index  string `json:"_index"`
+			Type   string `json:"_type"`
+			ID     string `json:"_id"`
+			Score  any    `json:"_score"`
+			Source struct {
+				Videoid       int      `json:"videoid"`
+				Title         string   `json:"title"`
+				Tags          []string `json:"tags"`
+				CommentCount  int      `json:"comment_count"`
+				Category      string   `json:"category"`
+				FavoriteArr   []any    `json:"favorite_arr"`
+				UploadDate    string   `json:"upload_date"`
+				Userid        int      `json:"userid"`
+				Newlink       string   `json:"newlink"`
+				VideoDuration float64  `json:"video_duration"`
+				Views         int      `json:"views"`
+				Rating        int      `json:"rating"`
+				IsDeleted     bool     `json:"is_deleted"`
+				Transcoded    bool     `json:"transcoded"`
+				TooBig        bool     `json:"too_big"`
+				IsApproved    bool     `json:"is_approved"`
+				IsMature      bool     `json:"is_mature"`
+				UpstreamLost  bool     `json:"upstream_lost"`
+				ZdbCtid       int64    `json:"zdb_ctid"`
+				ZdbCmin       int      `json:"zdb_cmin"`
+				ZdbCmax       int      `json:"zdb_cmax"`
+				ZdbXmin       int      `json:"zdb_xmin"`
+			} `json:"_source"`
+			Sort []json.Number `json:"sort"`
+		} `json:"hits"`
+	} `json:"hits"`
+	Aggregations struct {
+		Cardinalities esBuckets `json:"cardinalities"`
+		Tags          esBuckets `json:"tags"`
+		Durations     esBuckets `json:"durations"`
+		Sites         esBuckets `json:"sites"`
+		Danmaku       struct {
+			DocCount int `json:"doc_count"`
+		} `json:"danmaku"`
+	} `json:"aggregations"`
+}
+
+type esBuckets struct {
+	DocCountErrorUpperBound int `json:"doc_count_error_upper_bound"`
+	SumOtherDocCount        int `json:"sum_other_doc_count"`
+	Buckets                 []struct {
+		Key      string `json:"key"`
+		DocCount int    `json:"doc_count"`
+	} `json:"buckets"`
+}
+
+func (b esBuckets) counts() []*videoproto.FacetCount {
+	var counts []*videoproto.FacetCount
+	for _, bucket := range b.Buckets {
+		counts = append(counts, &videoproto.FacetCount{Value: bucket.Key, Count: uint64(bucket.DocCount)})
+	}
+	return counts
+}
+
+func (e *ElasticsearchBackend) Search(ctx context.Context, q VideoQuery) (*SearchResult, error) {
+	req := elasticsearchQuery(q)
+
+	if log.IsLevelEnabled(log.DebugLevel) {
+		pl, _ := req.MarshalJSON()
+		log.Debugf("Elasticsearch query: %s", pl)
+	}
+
+	resp, err := req.Run(e.client, e.client.Search.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("elasticsearch returned %s", resp.Status())
+	}
+
+	var st ESVideoResp
+	err = json.NewDecoder(resp.Body).Decode(&st)
+	if err != nil {
+		return nil, err
+	}
+
+	res := SearchResult{Total: st.Hits.Total.Value}
+	sorted := esSortField(q.OrderBy) != ""
+	for _, video := range st.Hits.Hits {
+		// Hits are sorted by the sort field, if there is one, and then by ID
+		var sortKey int64
+		if sorted && len(video.Sort) > 0 {
+			sortKey, err = esSortKey(video.Sort[0])
+			if err != nil {
+				return nil, fmt.Errorf("invalid sort key. Err: %s", err)
+			}
+		}
+
+		res.Hits = append(res.Hits, SearchHit{
+			VideoID:  int64(video.Source.Videoid),
+			Title:    video.Source.Title,
+			AuthorID: int64(video.Source.Userid),
+			NewLink:  video.Source.Newlink,
+			Views:    int64(video.Source.Views),
+			Duration: video.Source.VideoDuration,
+			Rating:   int64(video.Source.Rating),
+			IsMature: video.Source.IsMature,
+			SortKey:  sortKey,
+		})
+	}
+
+	for _, cat := range st.Aggregations.Cardinalities.Buckets {
+		res.Categories = append(res.Categories, &videoproto.Category{
+			Name:        cat.Key,
+			Cardinality: uint64(cat.DocCount),
+		})
+	}
+
+	res.Facets = &videoproto.SearchFacets{
+		Tags:        st.Aggregations.Tags.counts(),
+		Durations:   st.Aggregations.Durations.counts(),
+		Sites:       st.Aggregations.Sites.counts(),
+		WithDanmaku: uint64(st.Aggregations.Danmaku.DocCount),
+	}
+
+	return &res, nil
+}
+
+func elasticsearchQuery(q VideoQuery) *esquery.SearchRequest {
+	res := esquery.Search().Size(searchLimit).From(uint64(q.offset()))
+
+	var queries []esquery.Mappable
+
+	order := esquery.OrderDesc
+	asc := q.Direction == videoproto.SortDirection_asc
+	if q.Cursor != nil && q.Cursor.Before {
+		asc = !asc
+	}
+	if asc {
+		order = esquery.OrderAsc
+	}
+
+	// The ID breaks ties, so that pages don't overlap
+	field := esSortField(q.OrderBy)
+	if field != "" {
+		res = res.Sort(field, order)
+	}
+	res = res.Sort("videoid", order)
+
+	if q.Cursor != nil {
+		if field != "" {
+			res = res.SearchAfter(q.Cursor.Key, q.Cursor.VideoID)
+		} else {
+			res = res.SearchAfter(q.Cursor.VideoID)
+		}
+	}
+
+	if q.FollowFeed {
+		var follows []esquery.Mappable
+		for _, follow := range q.Following {
+			follows = append(follows, esquery.Term("userid", follow))
+		}
+		queries = append(queries, esquery.Bool().Should(follows...).MinimumShouldMatch(1))
+	}
+
+	if q.Category != "" {
+		queries = append(queries, esquery.Term("category", q.Category))
+	}
+
+	if q.FromUserID != 0 {
+		queries = append(queries, esquery.Term("userid", q.FromUserID))
+	}
+
+	if q.Search != "" {
+		// TODO: negative search terms
+		queries = append(queries,
+			esquery.MultiMatch(q.Search).Type(esquery.MatchTypeBoolPrefix).Fields("title", "description", "tags"))
+	}
+
+	if !q.ShowUnapproved {
+		// only show approved
+		queries = append(queries,
+			esquery.Term("is_approved", true))
+	} else if q.UnapprovedOnly {
+		queries = append(queries,
+			esquery.Term("is_approved", false))
+	}
+
+	if !q.ShowMature {
+		// only show suitable content
+		queries = append(queries,
+			esquery.Term("is_mature", false))
+	}
+
+	if q.LostUpstreamOnly {
+		// only show what's gone from the site it was archived from
+		queries = append(queries,
+			esquery.Term("upstream_lost", true))
+	}
+
+	// ZomboDB normalizes varchars to lower case
+	for _, tag := range q.Tags {
+		queries = append(queries, esquery.Term("tags", strings.ToLower(tag)))
+	}
+
+	var excluded []esquery.Mappable
+	if len(q.ExcludedTags) > 0 {
+		excluded = append(excluded, esquery.Terms("tags", esValues(lowerAll(q.ExcludedTags))...))
+	}
+
+	if len(q.AnyTags) > 0 {
+		queries = append(queries, esquery.Terms("tags", esValues(lowerAll(q.AnyTags))...))
+	}
+
+	if len(q.Durations) > 0 {
+		queries = append(queries, esquery.Terms("duration_bucket", esValues(q.Durations)...))
+	}
+
+	if r, ok := esRange("upload_date", q.UploadedSince, q.UploadedBefore); ok {
+		queries = append(queries, r)
+	}
+
+	if r, ok := esRange("original_upload_date", q.OriginalUploadedSince, q.OriginalUploadedBefore); ok {
+		queries = append(queries, r)
+	}
+
+	if len(q.Sites) > 0 {
+		queries = append(queries, esquery.Terms("site", esValues(q.Sites)...))
+	}
+
+	if q.MinRating != 0 {
+		queries = append(queries, esquery.Range("rating").Gte(q.MinRating))
+	}
+
+	if q.MinViews != 0 {
+		queries = append(queries, esquery.Range("views").Gte(q.MinViews))
+	}
+
+	if q.HasDanmaku {
+		queries = append(queries, esquery.Term("has_danmaku", true))
+	}
+
+	// Only show transcoded videos
+	queries = append(queries,
+		esquery.Term("transcoded", true))
+
+	// Do not show deleted videos
+	queries = append(queries,
+		esquery.Term("is_deleted", false))
+
+	query := esquery.Bool().Must(queries...)
+	if len(excluded) > 0 {
+		query = query.MustNot(excluded...)
+	}
+
+	return res.Query(query).Aggs(
+		esquery.TermsAgg("cardinalities", "category").Size(maxCategoryCounts),
+		esquery.TermsAgg("tags", "tags").Size(maxTagCounts),
+		esquery.TermsAgg("durations", "duration_bucket"),
+		esquery.TermsAgg("sites", "site").Size(maxSiteCounts),
+		esquery.FilterAgg("danmaku", esquery.Term("has_danmaku", true)),
+	)
+}
+
+// esSortField returns the field the list is sorted by before the ID, or "" if it's only sorted by ID. Upload dates
+// are sorted by their milliseconds since the epoch.
+func esSortField(orderBy videoproto.OrderCategory) string {
+	switch orderBy {
+	case videoproto.OrderCategory_upload_date:
+		return "upload_date"
+	case videoproto.OrderCategory_views:
+		return "views"
+	case videoproto.OrderCategory_rating:
+		return "rating"
+	}
+
+	return ""
+}
+
+func esSortKey(n json.Number) (int64, error) {
+	key, err := n.Int64()
+	if err == nil {
+		return key, nil
+	}
+
+	f, err := n.Float64()
+	return int64(f), err
+}
+
+// esRange returns a range query for the dates, if either is set
+func esRange(field string, since, before time.Time) (*esquery.RangeQuery, bool) {
+	if since.IsZero() && before.IsZero() {
+		return nil, false
+	}
+
+	r := esquery.Range(field)
+	if !since.IsZero() {
+		r = r.Gte(since.Format(esDateFormat))
+	}
+	if !before.IsZero() {
+		r = r.Lt(before.Format(esDateFormat))
+	}
+	return r, true
+}
+
+// ZomboDB indexes timestamps without a time zone
+const esDateFormat = "2006-01-02T15:04:05"
+
+func esValues(s []string) []interface{} {
+	values := make([]interface{}, 0, len(s))
+	for _, v := range s {
+		values = append(values, v)
+	}
+	return values
+}
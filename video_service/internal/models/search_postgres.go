@@ -0,0 +1,276 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// PostgresSearchBackend searches videos_denormalized with Postgres' own full text search. Every search term has to be
+// found in the video's title or tags, either as a word or, since Japanese titles aren't split into words, as part of
+// one, and terms starting with - mustn't be. Migration 034 indexes both ways of matching.
+type PostgresSearchBackend struct {
+	db *sqlx.DB
+}
+
+func NewPostgresSearchBackend(db *sqlx.DB) *PostgresSearchBackend {
+	return &PostgresSearchBackend{db: db}
+}
+
+func (p *PostgresSearchBackend) Search(ctx context.Context, q VideoQuery) (*SearchResult, error) {
+	where, args := postgresFilter(q)
+
+	res := SearchResult{Facets: &videoproto.SearchFacets{}}
+
+	hitArgs := pgArgs(append([]interface{}{}, args...))
+	order, cond := postgresPaging(q, &hitArgs)
+	hitWhere := where
+	if cond != "" {
+		hitWhere += " AND " + cond
+	}
+
+	sortKey := postgresSortKey(q.OrderBy)
+	if sortKey == "" {
+		sortKey = "0"
+	}
+
+	sql := "SELECT videoid, COALESCE(title, '') AS title, userid, COALESCE(newlink, '') AS newlink, COALESCE(views, 0) AS views, " +
+		"COALESCE(video_duration, 0) AS video_duration, COALESCE(rating, 0) AS rating, is_mature, " + sortKey + " AS sort_key " +
+		"FROM videos_denormalized " + hitWhere + " ORDER BY " + order + fmt.Sprintf(" LIMIT %d OFFSET %d", searchLimit, q.offset())
+	err := p.db.SelectContext(ctx, &res.Hits, sql, hitArgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	sql = "SELECT count(*), count(*) FILTER (WHERE has_danmaku) FROM videos_denormalized " + where
+	err = p.db.QueryRowContext(ctx, sql, args...).Scan(&res.Total, &res.Facets.WithDanmaku)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := p.countBy(ctx, "category", "videos_denormalized", where, args, maxCategoryCounts)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cat := range categories {
+		res.Categories = append(res.Categories, &videoproto.Category{Name: cat.Value, Cardinality: cat.Count})
+	}
+
+	res.Facets.Durations, err = p.countBy(ctx, "duration_bucket", "videos_denormalized", where, args, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Facets.Sites, err = p.countBy(ctx, "site", "videos_denormalized", where, args, maxSiteCounts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tags are counted per video, in case one has the same tag twice
+	res.Facets.Tags, err = p.countBy(ctx, "lower(tag)", "videos_denormalized, unnest(tags) AS tag", where+" AND tag IS NOT NULL", args, maxTagCounts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// countBy counts the matching videos by the value of expr, most common first. A limit of 0 counts every value.
+func (p *PostgresSearchBackend) countBy(ctx context.Context, expr, from, where string, args []interface{}, limit int) ([]*videoproto.FacetCount, error) {
+	sql := fmt.Sprintf("SELECT COALESCE(%s, ''), count(DISTINCT videoid) FROM %s %s GROUP BY 1 ORDER BY 2 DESC, 1", expr, from, where)
+	if limit > 0 {
+		sql += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	rows, err := p.db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var counts []*videoproto.FacetCount
+	for rows.Next() {
+		var count videoproto.FacetCount
+		err = rows.Scan(&count.Value, &count.Count)
+		if err != nil {
+			return nil, err
+		}
+		counts = append(counts, &count)
+	}
+
+	return counts, rows.Err()
+}
+
+// postgresFilter returns the WHERE clause for the query, along with its arguments
+func postgresFilter(q VideoQuery) (string, []interface{}) {
+	var conds []string
+	var args pgArgs
+	arg := args.add
+
+	// Only show transcoded videos which haven't been deleted
+	conds = append(conds, "transcoded = true", "is_deleted = false")
+
+	if q.FollowFeed {
+		conds = append(conds, "userid = ANY("+arg(pq.Array(q.Following))+")")
+	}
+
+	if q.Category != "" {
+		conds = append(conds, "category = "+arg(q.Category))
+	}
+
+	if q.FromUserID != 0 {
+		conds = append(conds, "userid = "+arg(q.FromUserID))
+	}
+
+	// Must match the expressions indexed in migration 034. Terms starting with - must not match.
+	for _, term := range strings.Fields(q.Search) {
+		not := ""
+		if strings.HasPrefix(term, "-") {
+			not = "NOT "
+			term = term[1:]
+			if term == "" {
+				continue
+			}
+		}
+
+		conds = append(conds, fmt.Sprintf("%s(to_tsvector('simple', video_search_text(title, tags)) @@ plainto_tsquery('simple', %s) "+
+			"OR video_search_text(title, tags) ILIKE %s)", not, arg(term), arg("%"+escapeLike(term)+"%")))
+	}
+
+	if !q.ShowUnapproved {
+		conds = append(conds, "is_approved = true")
+	} else if q.UnapprovedOnly {
+		conds = append(conds, "is_approved = false")
+	}
+
+	if !q.ShowMature {
+		conds = append(conds, "is_mature = false")
+	}
+
+	if q.LostUpstreamOnly {
+		conds = append(conds, "upstream_lost = true")
+	}
+
+	for _, tag := range q.Tags {
+		conds = append(conds, "EXISTS (SELECT 1 FROM unnest(tags) AS t WHERE lower(t) = "+arg(strings.ToLower(tag))+")")
+	}
+
+	if len(q.ExcludedTags) > 0 {
+		conds = append(conds, "NOT EXISTS (SELECT 1 FROM unnest(tags) AS t WHERE lower(t) = ANY("+arg(pq.Array(lowerAll(q.ExcludedTags)))+"))")
+	}
+
+	if len(q.AnyTags) > 0 {
+		conds = append(conds, "EXISTS (SELECT 1 FROM unnest(tags) AS t WHERE lower(t) = ANY("+arg(pq.Array(lowerAll(q.AnyTags)))+"))")
+	}
+
+	if len(q.Durations) > 0 {
+		conds = append(conds, "duration_bucket = ANY("+arg(pq.Array(q.Durations))+")")
+	}
+
+	if !q.UploadedSince.IsZero() {
+		conds = append(conds, "upload_date >= "+arg(q.UploadedSince))
+	}
+
+	if !q.UploadedBefore.IsZero() {
+		conds = append(conds, "upload_date < "+arg(q.UploadedBefore))
+	}
+
+	if !q.OriginalUploadedSince.IsZero() {
+		conds = append(conds, "original_upload_date >= "+arg(q.OriginalUploadedSince))
+	}
+
+	if !q.OriginalUploadedBefore.IsZero() {
+		conds = append(conds, "original_upload_date < "+arg(q.OriginalUploadedBefore))
+	}
+
+	if len(q.Sites) > 0 {
+		conds = append(conds, "site = ANY("+arg(pq.Array(q.Sites))+")")
+	}
+
+	if q.MinRating != 0 {
+		conds = append(conds, "rating >= "+arg(q.MinRating))
+	}
+
+	if q.MinViews != 0 {
+		conds = append(conds, "views >= "+arg(q.MinViews))
+	}
+
+	if q.HasDanmaku {
+		conds = append(conds, "has_danmaku = true")
+	}
+
+	return "WHERE " + strings.Join(conds, " AND "), args
+}
+
+// pgArgs are the arguments of a query
+type pgArgs []interface{}
+
+// add adds an argument, and returns its placeholder
+func (a *pgArgs) add(v interface{}) string {
+	*a = append(*a, v)
+	return fmt.Sprintf("$%d", len(*a))
+}
+
+// postgresSortKey returns what the list is sorted by before the ID, or "" if it's only sorted by ID. Upload dates are
+// sorted by their microseconds since the epoch, so that they fit in a cursor.
+func postgresSortKey(orderBy videoproto.OrderCategory) string {
+	switch orderBy {
+	case videoproto.OrderCategory_upload_date:
+		return "(extract(epoch FROM upload_date) * 1000000)::bigint"
+	case videoproto.OrderCategory_views:
+		return "COALESCE(views, 0)"
+	case videoproto.OrderCategory_rating:
+		return "COALESCE(rating, 0)"
+	}
+
+	return ""
+}
+
+// postgresPaging returns the ORDER BY clause for the query, and the condition for its cursor if it has one. The ID
+// breaks ties, so that pages don't overlap.
+func postgresPaging(q VideoQuery, args *pgArgs) (string, string) {
+	asc := q.Direction == videoproto.SortDirection_asc
+	if q.Cursor != nil && q.Cursor.Before {
+		asc = !asc
+	}
+
+	dir, cmp := "DESC", "<"
+	if asc {
+		dir, cmp = "ASC", ">"
+	}
+
+	key := postgresSortKey(q.OrderBy)
+
+	order := "videoid " + dir
+	if key != "" {
+		order = key + " " + dir + ", " + order
+	}
+
+	if q.Cursor == nil {
+		return order, ""
+	}
+
+	if key == "" {
+		return order, "videoid " + cmp + " " + args.add(q.Cursor.VideoID)
+	}
+
+	return order, fmt.Sprintf("(%s, videoid) %s (%s, %s)", key, cmp, args.add(q.Cursor.Key), args.add(q.Cursor.VideoID))
+}
+
+// escapeLike escapes the characters which LIKE treats specially
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func lowerAll(s []string) []string {
+	lower := make([]string, 0, len(s))
+	for _, v := range s {
+		lower = append(lower, strings.ToLower(v))
+	}
+	return lower
+}
@@ -0,0 +1,167 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostgresFilter(t *testing.T) {
+	where, args := postgresFilter(VideoQuery{ShowMature: true, ShowUnapproved: true})
+	assert.Equal(t, "WHERE transcoded = true AND is_deleted = false", where)
+	assert.Empty(t, args)
+
+	where, args = postgresFilter(VideoQuery{
+		FollowFeed:       true,
+		Following:        []int64{1, 2},
+		Category:         "Music",
+		Search:           "初音ミク  100%",
+		LostUpstreamOnly: true,
+	})
+	assert.Equal(t, "WHERE transcoded = true AND is_deleted = false AND userid = ANY($1) AND category = $2 AND "+
+		"(to_tsvector('simple', video_search_text(title, tags)) @@ plainto_tsquery('simple', $3) OR video_search_text(title, tags) ILIKE $4) AND "+
+		"(to_tsvector('simple', video_search_text(title, tags)) @@ plainto_tsquery('simple', $5) OR video_search_text(title, tags) ILIKE $6) AND "+
+		"is_approved = true AND is_mature = false AND upstream_lost = true", where)
+	assert.Equal(t, []interface{}{pq.Array([]int64{1, 2}), "Music", "初音ミク", "%初音ミク%", "100%", `%100\%%`}, args)
+
+	where, args = postgresFilter(VideoQuery{ShowUnapproved: true, ShowMature: true, Search: "miku -MMD -"})
+	assert.Equal(t, "WHERE transcoded = true AND is_deleted = false AND "+
+		"(to_tsvector('simple', video_search_text(title, tags)) @@ plainto_tsquery('simple', $1) OR video_search_text(title, tags) ILIKE $2) AND "+
+		"NOT (to_tsvector('simple', video_search_text(title, tags)) @@ plainto_tsquery('simple', $3) OR video_search_text(title, tags) ILIKE $4)", where)
+	assert.Equal(t, []interface{}{"miku", "%miku%", "MMD", "%MMD%"}, args)
+
+	where, _ = postgresFilter(VideoQuery{ShowUnapproved: true, UnapprovedOnly: true, ShowMature: true, FromUserID: 3})
+	assert.Equal(t, "WHERE transcoded = true AND is_deleted = false AND userid = $1 AND is_approved = false", where)
+}
+
+func TestPostgresFacetFilters(t *testing.T) {
+	since := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	where, args := postgresFilter(VideoQuery{
+		ShowUnapproved:         true,
+		ShowMature:             true,
+		Tags:                   []string{"VOCALOID"},
+		ExcludedTags:           []string{"MAD"},
+		AnyTags:                []string{"a", "B"},
+		Durations:              []string{DurationShort},
+		OriginalUploadedSince:  since,
+		OriginalUploadedBefore: since.AddDate(1, 0, 0),
+		Sites:                  []string{"nicovideo"},
+		MinRating:              5,
+		HasDanmaku:             true,
+	})
+	assert.Equal(t, "WHERE transcoded = true AND is_deleted = false AND "+
+		"EXISTS (SELECT 1 FROM unnest(tags) AS t WHERE lower(t) = $1) AND "+
+		"NOT EXISTS (SELECT 1 FROM unnest(tags) AS t WHERE lower(t) = ANY($2)) AND "+
+		"EXISTS (SELECT 1 FROM unnest(tags) AS t WHERE lower(t) = ANY($3)) AND "+
+		"duration_bucket = ANY($4) AND original_upload_date >= $5 AND original_upload_date < $6 AND "+
+		"site = ANY($7) AND rating >= $8 AND has_danmaku = true", where)
+	assert.Equal(t, []interface{}{"vocaloid", pq.Array([]string{"mad"}), pq.Array([]string{"a", "b"}), pq.Array([]string{"short"}),
+		since, since.AddDate(1, 0, 0), pq.Array([]string{"nicovideo"}), int64(5)}, args)
+}
+
+func TestPostgresPaging(t *testing.T) {
+	var args pgArgs
+	order, cond := postgresPaging(VideoQuery{OrderBy: videoproto.OrderCategory_views, Direction: videoproto.SortDirection_asc}, &args)
+	assert.Equal(t, "COALESCE(views, 0) ASC, videoid ASC", order)
+	assert.Empty(t, cond)
+	assert.Empty(t, args)
+
+	args = pgArgs{"Music"}
+	order, cond = postgresPaging(VideoQuery{
+		OrderBy:   videoproto.OrderCategory_upload_date,
+		Direction: videoproto.SortDirection_desc,
+		Cursor:    &Cursor{Key: 1600000000000000, VideoID: 7},
+	}, &args)
+	assert.Equal(t, "(extract(epoch FROM upload_date) * 1000000)::bigint DESC, videoid DESC", order)
+	assert.Equal(t, "((extract(epoch FROM upload_date) * 1000000)::bigint, videoid) < ($2, $3)", cond)
+	assert.Equal(t, pgArgs{"Music", int64(1600000000000000), int64(7)}, args)
+
+	// Paging back reverses the order
+	args = nil
+	order, cond = postgresPaging(VideoQuery{
+		OrderBy:   videoproto.OrderCategory_my_ratings,
+		Direction: videoproto.SortDirection_desc,
+		Cursor:    &Cursor{VideoID: 7, Before: true},
+	}, &args)
+	assert.Equal(t, "videoid ASC", order)
+	assert.Equal(t, "videoid > $1", cond)
+}
+
+func TestElasticsearchQuery(t *testing.T) {
+	pl, err := elasticsearchQuery(VideoQuery{
+		OrderBy:    videoproto.OrderCategory_rating,
+		Direction:  videoproto.SortDirection_desc,
+		PageNum:    3,
+		Category:   "Music",
+		ShowMature: true,
+	}).MarshalJSON()
+	assert.NoError(t, err)
+
+	var req map[string]interface{}
+	assert.NoError(t, json.Unmarshal(pl, &req))
+
+	assert.EqualValues(t, 2*NumResultsPerPage, req["from"])
+	assert.EqualValues(t, NumResultsPerPage+1, req["size"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"rating": map[string]interface{}{"order": "desc"}},
+		map[string]interface{}{"videoid": map[string]interface{}{"order": "desc"}},
+	}, req["sort"])
+	assert.NotContains(t, req, "search_after")
+
+	must := req["query"].(map[string]interface{})["bool"].(map[string]interface{})["must"].([]interface{})
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"term": map[string]interface{}{"category": map[string]interface{}{"value": "Music"}}},
+		map[string]interface{}{"term": map[string]interface{}{"is_approved": map[string]interface{}{"value": true}}},
+		map[string]interface{}{"term": map[string]interface{}{"transcoded": map[string]interface{}{"value": true}}},
+		map[string]interface{}{"term": map[string]interface{}{"is_deleted": map[string]interface{}{"value": false}}},
+	}, must)
+}
+
+func TestElasticsearchCursorQuery(t *testing.T) {
+	pl, err := elasticsearchQuery(VideoQuery{
+		OrderBy:   videoproto.OrderCategory_upload_date,
+		Direction: videoproto.SortDirection_desc,
+		PageNum:   3,
+		Cursor:    &Cursor{Key: 1600000000000, VideoID: 7, Before: true},
+	}).MarshalJSON()
+	assert.NoError(t, err)
+
+	var req map[string]interface{}
+	assert.NoError(t, json.Unmarshal(pl, &req))
+
+	// Cursors replace the page number, and paging back reverses the order
+	assert.EqualValues(t, 0, req["from"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"upload_date": map[string]interface{}{"order": "asc"}},
+		map[string]interface{}{"videoid": map[string]interface{}{"order": "asc"}},
+	}, req["sort"])
+	assert.Equal(t, []interface{}{float64(1600000000000), float64(7)}, req["search_after"])
+}
+
+func TestElasticsearchFacetQuery(t *testing.T) {
+	pl, err := elasticsearchQuery(VideoQuery{
+		ShowUnapproved: true,
+		ShowMature:     true,
+		ExcludedTags:   []string{"MAD"},
+		UploadedSince:  time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		MinViews:       100,
+	}).MarshalJSON()
+	assert.NoError(t, err)
+
+	var req map[string]interface{}
+	assert.NoError(t, json.Unmarshal(pl, &req))
+
+	query := req["query"].(map[string]interface{})["bool"].(map[string]interface{})
+	assert.Equal(t, []interface{}{map[string]interface{}{"terms": map[string]interface{}{"tags": []interface{}{"mad"}}}}, query["must_not"])
+	assert.Contains(t, query["must"], map[string]interface{}{"range": map[string]interface{}{"upload_date": map[string]interface{}{"gte": "2020-01-01T00:00:00"}}})
+	assert.Contains(t, query["must"], map[string]interface{}{"range": map[string]interface{}{"views": map[string]interface{}{"gte": float64(100)}}})
+
+	aggs := req["aggs"].(map[string]interface{})
+	for _, name := range []string{"cardinalities", "tags", "durations", "sites", "danmaku"} {
+		assert.Contains(t, aggs, name)
+	}
+}
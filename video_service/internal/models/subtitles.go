@@ -0,0 +1,133 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+var (
+	ErrSubtitleTrackNotFound    = errors.New("subtitle track not found")
+	ErrSubtitleDeleteNotAllowed = errors.New("only the video's uploader or a trusted user can delete its subtitles")
+)
+
+type SubtitleTrack struct {
+	ID      int64 `db:"id"`
+	VideoID int64 `db:"video_id"`
+	// UserID is who uploaded the track, and isn't set for tracks archived from the original site
+	UserID   sql.NullInt64 `db:"user_id"`
+	Language string        `db:"language"`
+	Kind     string        `db:"kind"`
+	Label    string        `db:"label"`
+	Location string        `db:"location"`
+	Imported bool          `db:"imported"`
+}
+
+// SubtitleModel stores the subtitle tracks of videos. The WebVTT files themselves are kept in object storage.
+type SubtitleModel struct {
+	db *sqlx.DB
+}
+
+func NewSubtitleModel(db *sqlx.DB) *SubtitleModel {
+	return &SubtitleModel{db: db}
+}
+
+// Save adds the track, replacing the video's track with the same language and kind. The location of the replaced track
+// is returned so that its file can be removed, or "" if there wasn't one.
+func (m *SubtitleModel) Save(ctx context.Context, t *SubtitleTrack) (string, error) {
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	var previous string
+	err = tx.GetContext(ctx, &previous, "SELECT location FROM subtitle_tracks WHERE video_id = $1 AND language = $2 AND kind = $3 FOR UPDATE",
+		t.VideoID, t.Language, t.Kind)
+	if err != nil && err != sql.ErrNoRows {
+		return "", err
+	}
+
+	sql := "INSERT INTO subtitle_tracks (video_id, user_id, language, kind, label, location, imported) VALUES ($1, $2, $3, $4, $5, $6, $7) " +
+		"ON CONFLICT (video_id, language, kind) DO UPDATE SET user_id = EXCLUDED.user_id, label = EXCLUDED.label, location = EXCLUDED.location, " +
+		"imported = EXCLUDED.imported, created_at = Now() RETURNING id"
+	err = tx.GetContext(ctx, &t.ID, sql, t.VideoID, t.UserID, t.Language, t.Kind, t.Label, t.Location, t.Imported)
+	var pqErr *pq.Error
+	switch {
+	case errors.As(err, &pqErr) && pqErr.Code == pqForeignKeyViolation:
+		return "", ErrVideoNotFound
+	case err != nil:
+		return "", err
+	}
+
+	return previous, tx.Commit()
+}
+
+// List returns the video's tracks, ordered by language
+func (m *SubtitleModel) List(videoID int64) ([]SubtitleTrack, error) {
+	tracks := make([]SubtitleTrack, 0)
+	err := m.db.Select(&tracks, "SELECT id, video_id, user_id, language, kind, label, location, imported FROM subtitle_tracks WHERE video_id = $1 "+
+		"ORDER BY language, kind", videoID)
+	return tracks, err
+}
+
+// Delete deletes the track, and returns it so that its file can be removed. If videoAuthorID isn't 0, the track is only
+// deleted if it's for one of their videos.
+func (m *SubtitleModel) Delete(id, videoAuthorID int64) (*SubtitleTrack, error) {
+	tx, err := m.db.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var t struct {
+		SubtitleTrack
+		VideoAuthorID int64 `db:"userid"`
+	}
+	err = tx.Get(&t, "SELECT t.id, t.video_id, t.user_id, t.language, t.kind, t.label, t.location, t.imported, v.userID FROM subtitle_tracks t "+
+		"INNER JOIN videos v ON v.id = t.video_id WHERE t.id = $1 FOR UPDATE OF t", id)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, ErrSubtitleTrackNotFound
+	case err != nil:
+		return nil, err
+	case videoAuthorID != 0 && t.VideoAuthorID != videoAuthorID:
+		return nil, ErrSubtitleDeleteNotAllowed
+	}
+
+	_, err = tx.Exec("DELETE FROM subtitle_tracks WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t.SubtitleTrack, tx.Commit()
+}
+
+// LockManifest calls fn while holding a row lock on the video, so that updates to its manifest don't overwrite each
+// other
+func (m *SubtitleModel) LockManifest(ctx context.Context, videoID int64, fn func() error) error {
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var id int64
+	err = tx.GetContext(ctx, &id, "SELECT id FROM videos WHERE id = $1 FOR UPDATE", videoID)
+	switch {
+	case err == sql.ErrNoRows:
+		return ErrVideoNotFound
+	case err != nil:
+		return err
+	}
+
+	err = fn()
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
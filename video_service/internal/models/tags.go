@@ -0,0 +1,280 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	ErrTagExists         = errors.New("the video already has that tag")
+	ErrTagNotFound       = errors.New("the video doesn't have that tag")
+	ErrTagLocked         = errors.New("the tag is locked")
+	ErrTooManyTags       = errors.New("the video has too many tags")
+	ErrTagEditNotAllowed = errors.New("only the uploader or a moderator can do that")
+	ErrTagEditNotFound   = errors.New("tag edit not found")
+	ErrRevertNotAllowed  = errors.New("only moderators can revert tags")
+)
+
+const (
+	// Videos can be archived with more tags than this, but users can't add more once they have this many
+	MaxTagsPerVideo = 32
+
+	TagEditAdd    = "add"
+	TagEditRemove = "remove"
+	TagEditLock   = "lock"
+	TagEditUnlock = "unlock"
+	TagEditRevert = "revert"
+)
+
+type VideoTag struct {
+	Tag    string `db:"tag"`
+	Locked bool   `db:"locked"`
+}
+
+// TagEditor is who's editing a video's tags
+type TagEditor struct {
+	UserID    int64
+	Moderator bool
+}
+
+type tagEdit struct {
+	ID             int64          `db:"id"`
+	VideoID        int64          `db:"video_id"`
+	UserID         int64          `db:"user_id"`
+	Action         string         `db:"action"`
+	Tag            string         `db:"tag"`
+	RevertedEditID sql.NullInt64  `db:"reverted_edit_id"`
+	TagsBefore     pq.StringArray `db:"tags_before"`
+	TagsAfter      pq.StringArray `db:"tags_after"`
+	CreatedAt      time.Time      `db:"created_at"`
+}
+
+// findTag returns the index of the tag, ignoring case, or -1 if it's not there
+func findTag(tags []VideoTag, tag string) int {
+	for i, t := range tags {
+		if strings.EqualFold(t.Tag, tag) {
+			return i
+		}
+	}
+	return -1
+}
+
+func tagNames(tags []VideoTag) []string {
+	names := make([]string, 0, len(tags))
+	for _, t := range tags {
+		names = append(names, t.Tag)
+	}
+	return names
+}
+
+// GetTags returns the video's tags, in the order they were added
+func (v *VideoModel) GetTags(ctx context.Context, videoID int64) ([]VideoTag, error) {
+	tags := make([]VideoTag, 0)
+	err := v.db.SelectContext(ctx, &tags, "SELECT tag, locked FROM tags WHERE video_id = $1 ORDER BY id", videoID)
+	return tags, err
+}
+
+// AddTag adds the tag to the video, unless it already has it
+func (v *VideoModel) AddTag(ctx context.Context, videoID int64, editor TagEditor, tag string) ([]VideoTag, error) {
+	return v.editTags(ctx, videoID, editor, func(tx *sqlx.Tx, tags []VideoTag, uploader bool) (tagEdit, error) {
+		switch {
+		case findTag(tags, tag) != -1:
+			return tagEdit{}, ErrTagExists
+		case len(tags) >= MaxTagsPerVideo:
+			return tagEdit{}, ErrTooManyTags
+		}
+
+		_, err := tx.ExecContext(ctx, "INSERT INTO tags (video_id, tag) VALUES ($1, $2)", videoID, tag)
+		return tagEdit{Action: TagEditAdd, Tag: tag}, err
+	})
+}
+
+// RemoveTag removes the tag from the video. Locked tags can only be removed by the uploader or a moderator.
+func (v *VideoModel) RemoveTag(ctx context.Context, videoID int64, editor TagEditor, tag string) ([]VideoTag, error) {
+	return v.editTags(ctx, videoID, editor, func(tx *sqlx.Tx, tags []VideoTag, uploader bool) (tagEdit, error) {
+		i := findTag(tags, tag)
+		switch {
+		case i == -1:
+			return tagEdit{}, ErrTagNotFound
+		case tags[i].Locked && !uploader && !editor.Moderator:
+			return tagEdit{}, ErrTagLocked
+		}
+
+		_, err := tx.ExecContext(ctx, "DELETE FROM tags WHERE video_id = $1 AND tag = $2", videoID, tags[i].Tag)
+		return tagEdit{Action: TagEditRemove, Tag: tags[i].Tag}, err
+	})
+}
+
+// SetTagLock locks or unlocks the tag. Only the uploader or a moderator can.
+func (v *VideoModel) SetTagLock(ctx context.Context, videoID int64, editor TagEditor, tag string, locked bool) ([]VideoTag, error) {
+	return v.editTags(ctx, videoID, editor, func(tx *sqlx.Tx, tags []VideoTag, uploader bool) (tagEdit, error) {
+		if !uploader && !editor.Moderator {
+			return tagEdit{}, ErrTagEditNotAllowed
+		}
+
+		i := findTag(tags, tag)
+		if i == -1 {
+			return tagEdit{}, ErrTagNotFound
+		}
+
+		action := TagEditLock
+		if !locked {
+			action = TagEditUnlock
+		}
+
+		_, err := tx.ExecContext(ctx, "UPDATE tags SET locked = $1 WHERE video_id = $2 AND tag = $3", locked, videoID, tags[i].Tag)
+		return tagEdit{Action: action, Tag: tags[i].Tag}, err
+	})
+}
+
+// RevertTags puts the video's tags back to how they were before the edit. Tags which are kept stay locked if they
+// were. Only moderators can.
+func (v *VideoModel) RevertTags(ctx context.Context, videoID int64, editor TagEditor, editID int64) ([]VideoTag, error) {
+	if !editor.Moderator {
+		return nil, ErrRevertNotAllowed
+	}
+
+	return v.editTags(ctx, videoID, editor, func(tx *sqlx.Tx, tags []VideoTag, uploader bool) (tagEdit, error) {
+		var target pq.StringArray
+		err := tx.GetContext(ctx, &target, "SELECT tags_before FROM tag_edits WHERE id = $1 AND video_id = $2", editID, videoID)
+		if err == sql.ErrNoRows {
+			return tagEdit{}, ErrTagEditNotFound
+		} else if err != nil {
+			return tagEdit{}, err
+		}
+
+		targetTags := toVideoTags(target)
+		for _, t := range tags {
+			if findTag(targetTags, t.Tag) == -1 {
+				_, err = tx.ExecContext(ctx, "DELETE FROM tags WHERE video_id = $1 AND tag = $2", videoID, t.Tag)
+				if err != nil {
+					return tagEdit{}, err
+				}
+			}
+		}
+
+		for _, t := range target {
+			if findTag(tags, t) == -1 {
+				_, err = tx.ExecContext(ctx, "INSERT INTO tags (video_id, tag) VALUES ($1, $2)", videoID, t)
+				if err != nil {
+					return tagEdit{}, err
+				}
+			}
+		}
+
+		return tagEdit{Action: TagEditRevert, RevertedEditID: sql.NullInt64{Int64: editID, Valid: true}}, nil
+	})
+}
+
+func toVideoTags(names []string) []VideoTag {
+	tags := make([]VideoTag, 0, len(names))
+	for _, name := range names {
+		tags = append(tags, VideoTag{Tag: name})
+	}
+	return tags
+}
+
+// editTags makes a change to the video's tags, and records it in the video's tag history. The video is locked while
+// it's changed, so that edits to the same video happen one at a time.
+func (v *VideoModel) editTags(ctx context.Context, videoID int64, editor TagEditor,
+	edit func(tx *sqlx.Tx, tags []VideoTag, uploader bool) (tagEdit, error)) ([]VideoTag, error) {
+	tx, err := v.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var authorID int64
+	err = tx.GetContext(ctx, &authorID, "SELECT userid FROM videos WHERE id = $1 AND is_deleted = false FOR UPDATE", videoID)
+	if err == sql.ErrNoRows {
+		return nil, ErrVideoNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	before := make([]VideoTag, 0)
+	err = tx.SelectContext(ctx, &before, "SELECT tag, locked FROM tags WHERE video_id = $1 ORDER BY id", videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := edit(tx, before, authorID == editor.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	after := make([]VideoTag, 0)
+	err = tx.SelectContext(ctx, &after, "SELECT tag, locked FROM tags WHERE video_id = $1 ORDER BY id", videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, "INSERT INTO tag_edits (video_id, user_id, action, tag, reverted_edit_id, tags_before, tags_after) "+
+		"VALUES ($1, $2, $3, $4, $5, $6, $7)", videoID, editor.UserID, e.Action, e.Tag, e.RevertedEditID,
+		pq.StringArray(tagNames(before)), pq.StringArray(tagNames(after)))
+	if err != nil {
+		return nil, fmt.Errorf("could not record tag edit. Err: %s", err)
+	}
+
+	return after, tx.Commit()
+}
+
+// GetTagHistory returns a page of the changes to the video's tags, newest first, and how many there are in all
+func (v *VideoModel) GetTagHistory(ctx context.Context, videoID, pageNum int64) ([]*videoproto.TagEdit, int64, error) {
+	if pageNum < 1 {
+		pageNum = 1
+	}
+
+	var total int64
+	err := v.db.GetContext(ctx, &total, "SELECT count(*) FROM tag_edits WHERE video_id = $1", videoID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var edits []tagEdit
+	err = v.db.SelectContext(ctx, &edits, "SELECT id, video_id, user_id, action, tag, reverted_edit_id, tags_before, tags_after, created_at "+
+		fmt.Sprintf("FROM tag_edits WHERE video_id = $1 ORDER BY id DESC LIMIT %d OFFSET %d", NumResultsPerPage, (pageNum-1)*NumResultsPerPage), videoID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	usernames := make(map[int64]string)
+	ret := make([]*videoproto.TagEdit, 0, len(edits))
+	for _, e := range edits {
+		username, ok := usernames[e.UserID]
+		if !ok {
+			resp, err := v.getUserInfo(e.UserID)
+			if err != nil {
+				// The history is still worth showing
+				log.Errorf("Could not look up user %d. Err: %s", e.UserID, err)
+			} else {
+				username = resp.Username
+			}
+			usernames[e.UserID] = username
+		}
+
+		ret = append(ret, &videoproto.TagEdit{
+			Id:             e.ID,
+			VideoId:        e.VideoID,
+			UserId:         e.UserID,
+			Username:       username,
+			Action:         e.Action,
+			Tag:            e.Tag,
+			RevertedEditId: e.RevertedEditID.Int64,
+			TagsBefore:     e.TagsBefore,
+			TagsAfter:      e.TagsAfter,
+			CreatedAt:      e.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return ret, total, nil
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindTag(t *testing.T) {
+	tags := []VideoTag{{Tag: "VOCALOID", Locked: true}, {Tag: "音MAD"}}
+
+	assert.Equal(t, 0, findTag(tags, "vocaloid"))
+	assert.Equal(t, 1, findTag(tags, "音mad"))
+	assert.Equal(t, -1, findTag(tags, "MAD"))
+	assert.Equal(t, -1, findTag(nil, "MAD"))
+
+	assert.Equal(t, []string{"VOCALOID", "音MAD"}, tagNames(tags))
+	assert.Equal(t, []string{}, tagNames(nil))
+	assert.Equal(t, []VideoTag{{Tag: "a"}, {Tag: "b"}}, toVideoTags([]string{"a", "b"}))
+}
@@ -0,0 +1,195 @@
+package models
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	log "github.com/sirupsen/logrus"
+)
+
+var ErrLeaseLost = errors.New("transcode lease is no longer held by this worker")
+
+type UnencodedVideo struct {
+	ID       uint32 `db:"id"`
+	NewLink  string `db:"newlink"`
+	Attempts int    `db:"transcode_attempts"`
+}
+
+func (v UnencodedVideo) GetMPDUUID() string {
+	spl := strings.Split(v.NewLink, "/")
+	r := spl[len(spl)-1]
+	return r[:len(r)-4]
+}
+
+// TranscodeQueue hands out untranscoded videos to transcoding workers.
+// Each video is claimed with a row lock and a lease, so that every worker (on this replica or any other) gets a different
+// video. Workers keep the lease alive while they work, and if a worker dies its lease expires and the video becomes
+// available again.
+type TranscodeQueue struct {
+	db            *sqlx.DB
+	Owner         string
+	LeaseDuration time.Duration
+	// MaxAttempts is the number of times a video can be claimed before it's marked as failed
+	MaxAttempts int
+}
+
+func NewTranscodeQueue(db *sqlx.DB, leaseDuration time.Duration, maxAttempts int) (*TranscodeQueue, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+
+	return &TranscodeQueue{
+		db:            db,
+		Owner:         fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+		LeaseDuration: leaseDuration,
+		MaxAttempts:   maxAttempts,
+	}, nil
+}
+
+// Worker returns a copy of the queue whose leases are owned by the nth worker of this replica
+func (q *TranscodeQueue) Worker(n int) *TranscodeQueue {
+	return &TranscodeQueue{
+		db:            q.db,
+		Owner:         fmt.Sprintf("%s/%d", q.Owner, n),
+		LeaseDuration: q.LeaseDuration,
+		MaxAttempts:   q.MaxAttempts,
+	}
+}
+
+// Claim leases the newest video which needs to be transcoded. Returns nil if there's nothing to do.
+func (q *TranscodeQueue) Claim() (*UnencodedVideo, error) {
+	claimSQL := "UPDATE videos SET transcode_lock_owner = $1, transcode_locked_until = Now() + $2 * interval '1 second', " +
+		"transcode_attempts = transcode_attempts + 1 WHERE id = (SELECT id FROM videos WHERE transcoded = false AND too_big = false " +
+		"AND transcode_failed = false AND is_deleted = false AND (transcode_locked_until IS NULL OR transcode_locked_until < Now()) " +
+		"ORDER BY upload_date DESC LIMIT 1 FOR UPDATE SKIP LOCKED) RETURNING id, newLink, transcode_attempts"
+
+	var video UnencodedVideo
+	err := q.db.Get(&video, claimSQL, q.Owner, q.LeaseDuration.Seconds())
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+
+	return &video, nil
+}
+
+// Heartbeat extends the lease on a video. ErrLeaseLost is returned if the lease expired and another worker claimed it.
+func (q *TranscodeQueue) Heartbeat(video *UnencodedVideo) error {
+	sql := "UPDATE videos SET transcode_locked_until = Now() + $1 * interval '1 second' WHERE id = $2 AND transcode_lock_owner = $3 AND transcoded = false"
+	res, err := q.db.Exec(sql, q.LeaseDuration.Seconds(), video.ID, q.Owner)
+	if err != nil {
+		return err
+	}
+
+	return checkLeaseHeld(res)
+}
+
+// KeepAlive heartbeats the video in the background until the returned function is called
+func (q *TranscodeQueue) KeepAlive(video *UnencodedVideo) func() {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(q.LeaseDuration / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				err := q.Heartbeat(video)
+				if err != nil {
+					log.Errorf("Could not heartbeat transcode of video %d. Err: %s", video.ID, err)
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+	}
+}
+
+// Complete marks the video as transcoded and gives up the lease
+func (q *TranscodeQueue) Complete(video *UnencodedVideo, hasHLSMaster bool) error {
+	sql := "UPDATE videos SET transcoded = true, has_hls_master = $2, transcode_lock_owner = NULL, transcode_locked_until = NULL, " +
+		"transcode_last_error = NULL WHERE id = $1 AND transcode_lock_owner = $3"
+	res, err := q.db.Exec(sql, video.ID, hasHLSMaster, q.Owner)
+	if err != nil {
+		return err
+	}
+
+	return checkLeaseHeld(res)
+}
+
+// Release gives up the lease after a failed attempt, and makes the video available again after the given delay.
+// If the video has used up its attempts, it's marked as failed instead.
+func (q *TranscodeQueue) Release(video *UnencodedVideo, delay time.Duration, reason error) error {
+	if video.Attempts >= q.MaxAttempts {
+		return q.Fail(video, reason)
+	}
+
+	sql := "UPDATE videos SET transcode_lock_owner = NULL, transcode_locked_until = Now() + $1 * interval '1 second', transcode_last_error = $2 " +
+		"WHERE id = $3 AND transcode_lock_owner = $4"
+	res, err := q.db.Exec(sql, delay.Seconds(), reason.Error(), video.ID, q.Owner)
+	if err != nil {
+		return err
+	}
+
+	return checkLeaseHeld(res)
+}
+
+// Abandon gives up the lease without counting the attempt, e.g. because the worker is shutting down
+func (q *TranscodeQueue) Abandon(video *UnencodedVideo) error {
+	sql := "UPDATE videos SET transcode_lock_owner = NULL, transcode_locked_until = NULL, transcode_attempts = GREATEST(transcode_attempts - 1, 0) " +
+		"WHERE id = $1 AND transcode_lock_owner = $2"
+	res, err := q.db.Exec(sql, video.ID, q.Owner)
+	if err != nil {
+		return err
+	}
+
+	return checkLeaseHeld(res)
+}
+
+// Fail marks the video as failed, so that it won't be retried
+func (q *TranscodeQueue) Fail(video *UnencodedVideo, reason error) error {
+	sql := "UPDATE videos SET transcode_failed = true, transcode_lock_owner = NULL, transcode_locked_until = NULL, transcode_last_error = $1 " +
+		"WHERE id = $2 AND transcode_lock_owner = $3"
+	res, err := q.db.Exec(sql, reason.Error(), video.ID, q.Owner)
+	if err != nil {
+		return err
+	}
+
+	return checkLeaseHeld(res)
+}
+
+// MarkTooBig marks the video as too big to transcode, which is never retried
+func (q *TranscodeQueue) MarkTooBig(video *UnencodedVideo) error {
+	sql := "UPDATE videos SET too_big = true, transcode_lock_owner = NULL, transcode_locked_until = NULL WHERE id = $1 AND transcode_lock_owner = $2"
+	res, err := q.db.Exec(sql, video.ID, q.Owner)
+	if err != nil {
+		return err
+	}
+
+	return checkLeaseHeld(res)
+}
+
+func checkLeaseHeld(res sql.Result) error {
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return ErrLeaseLost
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	ErrUploadSessionNotFound = errors.New("upload session not found or expired")
+	// ErrUploadSessionBusy is returned if another request is already appending to or finalizing the session
+	ErrUploadSessionBusy = errors.New("upload session is in use by another request")
+	// ErrUploadSessionLeaseLost is returned if the session's lease expired while it was locked, so another request may
+	// have locked it since
+	ErrUploadSessionLeaseLost = errors.New("upload session lease is no longer held by this request")
+)
+
+const (
+	// How long a session stays locked without a heartbeat, i.e. how long a session is stuck if its request dies
+	uploadSessionLease = time.Second * 30
+
+	// A session which has been finalizing for longer than this is assumed to have been abandoned, e.g. because the
+	// video service restarted, and can be finalized again
+	finalizeTimeout = time.Hour
+
+	uploadSessionColumns = "id, user_id, size, upload_offset, sha256, hash_state, metadata, expires_at, " +
+		"COALESCE(finalizing_at > Now() - $2 * interval '1 second', false) AS finalizing"
+)
+
+type UploadSession struct {
+	ID        string    `db:"id"`
+	UserID    int64     `db:"user_id"`
+	Size      int64     `db:"size"`
+	Offset    int64     `db:"upload_offset"`
+	SHA256    string    `db:"sha256"`
+	HashState []byte    `db:"hash_state"`
+	Metadata  []byte    `db:"metadata"`
+	ExpiresAt time.Time `db:"expires_at"`
+	// Finalizing is true while the session's video is being saved
+	Finalizing bool `db:"finalizing"`
+}
+
+// UploadSessionModel stores the state of resumable uploads. The partial files themselves are kept on disk by the caller.
+type UploadSessionModel struct {
+	db *sqlx.DB
+	// TTL is how long a session lives after it was last written to
+	TTL time.Duration
+}
+
+func NewUploadSessionModel(db *sqlx.DB, ttl time.Duration) *UploadSessionModel {
+	return &UploadSessionModel{
+		db:  db,
+		TTL: ttl,
+	}
+}
+
+func (m *UploadSessionModel) Create(s *UploadSession) error {
+	sql := "INSERT INTO upload_sessions (id, user_id, size, sha256, metadata, expires_at) VALUES ($1, $2, $3, $4, $5, Now() + $6 * interval '1 second') " +
+		"RETURNING expires_at"
+	return m.db.Get(&s.ExpiresAt, sql, s.ID, s.UserID, s.Size, s.SHA256, s.Metadata, m.TTL.Seconds())
+}
+
+func (m *UploadSessionModel) Get(id string) (*UploadSession, error) {
+	var s UploadSession
+	err := m.db.Get(&s, "SELECT "+uploadSessionColumns+" FROM upload_sessions WHERE id = $1 AND expires_at > Now()", id, finalizeTimeout.Seconds())
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, ErrUploadSessionNotFound
+	case err != nil:
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// LockedUploadSession is a session which is locked with a lease, which KeepAlive keeps from expiring.
+// Exactly one of Advance or MarkFinalizing should be called, Unlock gives up the lease if neither was.
+type LockedUploadSession struct {
+	UploadSession
+	db    *sqlx.DB
+	owner string
+}
+
+// Lock locks the session so that only one request can write to it at a time. The lock is a lease rather than a row
+// lock, so that no transaction is held open while a chunk is being received.
+// ErrUploadSessionBusy is returned immediately if it's already locked, or if it's being finalized.
+func (m *UploadSessionModel) Lock(ctx context.Context, id string) (*LockedUploadSession, error) {
+	l := LockedUploadSession{db: m.db, owner: uuid.NewString()}
+
+	lockSQL := "UPDATE upload_sessions SET lock_owner = $3, locked_until = Now() + $4 * interval '1 second' " +
+		"WHERE id = $1 AND expires_at > Now() AND (locked_until IS NULL OR locked_until < Now()) " +
+		"AND NOT COALESCE(finalizing_at > Now() - $2 * interval '1 second', false) RETURNING " + uploadSessionColumns
+	err := m.db.GetContext(ctx, &l.UploadSession, lockSQL, id, finalizeTimeout.Seconds(), l.owner, uploadSessionLease.Seconds())
+	switch {
+	case err == sql.ErrNoRows:
+		// Either there's no such session, or someone else has it
+		_, err = m.Get(id)
+		if err != nil {
+			return nil, err
+		}
+		return nil, ErrUploadSessionBusy
+	case err != nil:
+		return nil, err
+	}
+
+	return &l, nil
+}
+
+// Heartbeat extends the session's lease. ErrUploadSessionLeaseLost is returned if it had already expired and another
+// request locked the session.
+func (l *LockedUploadSession) Heartbeat() error {
+	sql := "UPDATE upload_sessions SET locked_until = Now() + $1 * interval '1 second' WHERE id = $2 AND lock_owner = $3"
+	res, err := l.db.Exec(sql, uploadSessionLease.Seconds(), l.ID, l.owner)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrUploadSessionLeaseLost
+	}
+
+	return nil
+}
+
+// KeepAlive heartbeats the session in the background until the returned function is called
+func (l *LockedUploadSession) KeepAlive() func() {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(uploadSessionLease / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				err := l.Heartbeat()
+				if err != nil {
+					log.Errorf("Could not heartbeat upload session %s. Err: %s", l.ID, err)
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+	}
+}
+
+// Advance records the data which has been written, extends the session's expiry, and unlocks it.
+// ErrUploadSessionLeaseLost is returned if the lease expired meanwhile, in which case nothing is recorded.
+func (l *LockedUploadSession) Advance(offset int64, hashState []byte, ttl time.Duration) error {
+	advanceSQL := "UPDATE upload_sessions SET upload_offset = $1, hash_state = $2, expires_at = Now() + $3 * interval '1 second', " +
+		"lock_owner = NULL, locked_until = NULL WHERE id = $4 AND lock_owner = $5 RETURNING expires_at"
+	err := l.db.Get(&l.ExpiresAt, advanceSQL, offset, hashState, ttl.Seconds(), l.ID, l.owner)
+	switch {
+	case err == sql.ErrNoRows:
+		return ErrUploadSessionLeaseLost
+	case err != nil:
+		return err
+	}
+
+	l.Offset = offset
+	l.HashState = hashState
+	return nil
+}
+
+// MarkFinalizing marks the session as being finalized, extends its expiry so that it isn't collected meanwhile, and
+// unlocks it. Until FinishFinalizing or AbortFinalizing is called, or finalizeTimeout passes, Lock returns
+// ErrUploadSessionBusy for it.
+func (l *LockedUploadSession) MarkFinalizing(ttl time.Duration) error {
+	sql := "UPDATE upload_sessions SET finalizing_at = Now(), expires_at = Now() + $1 * interval '1 second', " +
+		"lock_owner = NULL, locked_until = NULL WHERE id = $2 AND lock_owner = $3"
+	res, err := l.db.Exec(sql, ttl.Seconds(), l.ID, l.owner)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrUploadSessionLeaseLost
+	}
+
+	l.Finalizing = true
+	return nil
+}
+
+// FinishFinalizing removes the session once its video has been saved
+func (m *UploadSessionModel) FinishFinalizing(id string) error {
+	_, err := m.db.Exec("DELETE FROM upload_sessions WHERE id = $1", id)
+	return err
+}
+
+// AbortFinalizing unmarks the session if its video couldn't be saved, so that finalizing can be retried
+func (m *UploadSessionModel) AbortFinalizing(id string) error {
+	_, err := m.db.Exec("UPDATE upload_sessions SET finalizing_at = NULL WHERE id = $1", id)
+	return err
+}
+
+// Unlock gives up the session's lease without changing it. Unlocking after Advance or MarkFinalizing is a no-op.
+func (l *LockedUploadSession) Unlock() error {
+	_, err := l.db.Exec("UPDATE upload_sessions SET lock_owner = NULL, locked_until = NULL WHERE id = $1 AND lock_owner = $2", l.ID, l.owner)
+	return err
+}
+
+// DeleteExpired removes the expired sessions which aren't in use, and returns their IDs so that their files can be removed
+func (m *UploadSessionModel) DeleteExpired() ([]string, error) {
+	sql := "DELETE FROM upload_sessions WHERE id IN (SELECT id FROM upload_sessions WHERE expires_at < Now() " +
+		"AND (locked_until IS NULL OR locked_until < Now()) FOR UPDATE SKIP LOCKED) RETURNING id"
+	var ids []string
+	err := m.db.Select(&ids, sql)
+	return ids, err
+}
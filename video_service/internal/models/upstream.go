@@ -0,0 +1,109 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Statuses of a video's original, as classified by the scheduler
+const (
+	UpstreamOnline       = "online"
+	UpstreamRemoved      = "removed"
+	UpstreamPrivate      = "private"
+	UpstreamGeoBlocked   = "geo_blocked"
+	UpstreamAuthRequired = "auth_required"
+)
+
+var ErrInvalidUpstreamStatus = errors.New("invalid upstream status")
+
+// ValidUpstreamStatus checks that the status is one we know
+func ValidUpstreamStatus(status string) bool {
+	switch status {
+	case UpstreamOnline, UpstreamRemoved, UpstreamPrivate, UpstreamGeoBlocked, UpstreamAuthRequired:
+		return true
+	}
+	return false
+}
+
+// UpstreamCheck is an archived video whose original is due to be checked
+type UpstreamCheck struct {
+	VideoID int64  `db:"id"`
+	URL     string `db:"originallink"`
+}
+
+// UpstreamModel keeps track of whether archived videos are still available on the site they were archived from
+type UpstreamModel struct {
+	db *sqlx.DB
+}
+
+func NewUpstreamModel(db *sqlx.DB) *UpstreamModel {
+	return &UpstreamModel{db: db}
+}
+
+// Claim returns up to limit archived videos which are due to be checked, and holds them for the lease so that other
+// schedulers don't claim them too. Videos which have never been checked are due interval after they were archived.
+func (m *UpstreamModel) Claim(ctx context.Context, limit int, lease, interval time.Duration) ([]UpstreamCheck, error) {
+	// Domestic uploads have no original to check
+	sql := "UPDATE videos SET upstream_next_check = Now() + $2 * interval '1 second' WHERE id IN (" +
+		"SELECT id FROM videos WHERE is_deleted = false AND originalSite <> 'blank' AND originalLink NOT IN ('', '0') " +
+		"AND COALESCE(upstream_next_check, upload_date + $3 * interval '1 second') <= Now() " +
+		"ORDER BY COALESCE(upstream_next_check, upload_date + $3 * interval '1 second') LIMIT $1 FOR UPDATE SKIP LOCKED) " +
+		"RETURNING id, originalLink"
+
+	checks := make([]UpstreamCheck, 0)
+	err := m.db.SelectContext(ctx, &checks, sql, limit, int64(lease.Seconds()), int64(interval.Seconds()))
+	return checks, err
+}
+
+// Report records the result of checking the video's original, and schedules the next check. An empty status means the
+// check was inconclusive, and only reschedules the video. Changes of status are kept in the video's history.
+func (m *UpstreamModel) Report(ctx context.Context, videoID int64, status, message string, recheckAfter time.Duration) error {
+	if status != "" && !ValidUpstreamStatus(status) {
+		return ErrInvalidUpstreamStatus
+	}
+
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var previous sql.NullString
+	err = tx.GetContext(ctx, &previous, "SELECT upstream_status FROM videos WHERE id = $1 FOR UPDATE", videoID)
+	switch {
+	case err == sql.ErrNoRows:
+		return ErrVideoNotFound
+	case err != nil:
+		return err
+	}
+
+	recheck := int64(recheckAfter.Seconds())
+	if status == "" {
+		_, err = tx.ExecContext(ctx, "UPDATE videos SET upstream_next_check = Now() + $2 * interval '1 second' WHERE id = $1", videoID, recheck)
+		if err != nil {
+			return err
+		}
+		return tx.Commit()
+	}
+
+	sql := "UPDATE videos SET upstream_status = $2, upstream_checked_at = Now(), upstream_next_check = Now() + $3 * interval '1 second', " +
+		"upstream_status_since = CASE WHEN upstream_status IS DISTINCT FROM $2 THEN Now() ELSE upstream_status_since END WHERE id = $1"
+	_, err = tx.ExecContext(ctx, sql, videoID, status, recheck)
+	if err != nil {
+		return err
+	}
+
+	if previous.String != status {
+		_, err = tx.ExecContext(ctx, "INSERT INTO upstream_status_history (video_id, status, message) VALUES ($1, $2, $3)",
+			videoID, status, message)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
@@ -3,22 +3,21 @@ package models
 import (
 	"context"
 	sql2 "database/sql"
-	"encoding/json"
 	"fmt"
-	"io"
 	"strings"
+	"time"
 
 	serror "errors"
 
-	"github.com/aquasecurity/esquery"
 	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
-	"github.com/elastic/go-elasticsearch/v7"
+	"github.com/horahoradev/PrometheusTube/backend/video_service/internal/archivedcomments"
 	videoproto "github.com/horahoradev/PrometheusTube/backend/video_service/protocol"
 	"github.com/horahoradev/horahora/user_service/errors"
 	_ "github.com/horahoradev/horahora/user_service/protocol"
 	proto "github.com/horahoradev/horahora/user_service/protocol"
 	userproto "github.com/horahoradev/horahora/user_service/protocol"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/status"
 )
@@ -35,20 +34,13 @@ type VideoModel struct {
 	grpcClient        proto.UserServiceClient
 	ApprovalThreshold int
 	r                 Recommender
-	esClient          *elasticsearch.Client
+	search            SearchBackend
 }
 
-func NewVideoModel(db *sqlx.DB, client proto.UserServiceClient, approvalThreshold int) (*VideoModel, error) {
-	c, err := elasticsearch.NewClient(elasticsearch.Config{
-		Addresses: []string{"http://elasticsearch:9200"},
-	})
-	if err != nil {
-		return nil, err
-	}
-
+func NewVideoModel(db *sqlx.DB, client proto.UserServiceClient, approvalThreshold int, search SearchBackend) (*VideoModel, error) {
 	v := &VideoModel{db: db,
 		grpcClient: client,
-		esClient:   c,
+		search:     search,
 	}
 
 	rec := NewBayesianTagSum(db, v)
@@ -62,7 +54,8 @@ func NewVideoModel(db *sqlx.DB, client proto.UserServiceClient, approvalThreshol
 // FIXME this signature is too long lol
 // If domesticAuthorID is 0, will interpret as foreign video from foreign user
 func (v *VideoModel) SaveForeignVideo(ctx context.Context, title, description string, foreignAuthorUsername string, foreignAuthorID string,
-	originalSite string, originalVideoLink, originalVideoID, newURI string, tags []string, domesticAuthorID int64, videoDuration float64, category string) (int64, error) {
+	originalSite string, originalVideoLink, originalVideoID, newURI string, tags []string, domesticAuthorID int64, videoDuration float64, category string,
+	originalUploadDate sql2.NullTime) (int64, error) {
 	tx, err := v.db.BeginTx(ctx, nil)
 	if err != nil {
 		return 0, err
@@ -76,65 +69,19 @@ func (v *VideoModel) SaveForeignVideo(ctx context.Context, title, description st
 		return 0, serror.New("original video info cannot be blank")
 	}
 
-	req := proto.GetForeignUserRequest{
-		OriginalWebsite: originalSite,
-		ForeignUserID:   foreignAuthorID,
-	}
-
 	horahoraUID := domesticAuthorID
 
 	if horahoraUID == 0 {
-		resp, err := v.grpcClient.GetUserForForeignUID(ctx, &req)
-		grpcErr, ok := status.FromError(err)
-		if !ok {
-			return 0, fmt.Errorf("could not parse gRPC err")
-		}
-		switch {
-		case grpcErr.Message() == errors.UserDoesNotExistMessage:
-			// Create the user
-			log.Infof("User %s does not exist for video %s, creating...", foreignAuthorUsername, originalVideoID)
-
-			regReq := proto.RegisterRequest{
-				Email:          "fake@user.com", // NO!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
-				Username:       foreignAuthorUsername,
-				Password:       "",
-				ForeignUser:    true,
-				ForeignUserID:  foreignAuthorID,
-				ForeignWebsite: originalSite,
-			}
-			regResp, err := v.grpcClient.Register(ctx, &regReq)
-			if err != nil {
-				return 0, err
-			}
-
-			validateReq := proto.ValidateJWTRequest{
-				Jwt: regResp.Jwt,
-			}
-
-			// The validation is superfluous, but we need the claims
-			// FIXME: can probably optimize
-			validateResp, err := v.grpcClient.ValidateJWT(ctx, &validateReq)
-			if err != nil {
-				return 0, err
-			}
-
-			if !validateResp.IsValid {
-				return 0, fmt.Errorf("jwt invalid (this should never happen!)")
-			}
-
-			horahoraUID = validateResp.Uid
-
-		case err != nil:
+		horahoraUID, err = v.getOrCreateForeignUser(ctx, originalSite, foreignAuthorID, foreignAuthorUsername)
+		if err != nil {
+			tx.Rollback()
 			return 0, err
-
-		case err == nil:
-			horahoraUID = resp.NewUID
 		}
 	}
 
 	sql := "INSERT INTO videos (title, description, userID, originalSite, " +
-		"originalLink, newLink, originalID, upload_date, video_duration, category) " +
-		"VALUES ($1, $2, $3, $4, $5, $6, $7, Now(), $8, $9) " +
+		"originalLink, newLink, originalID, upload_date, video_duration, category, original_upload_date) " +
+		"VALUES ($1, $2, $3, $4, $5, $6, $7, Now(), $8, $9, $10) " +
 		"returning id"
 
 	// By this point the user should exist
@@ -142,7 +89,7 @@ func (v *VideoModel) SaveForeignVideo(ctx context.Context, title, description st
 	// FIXME: there might be some issues with error handling here. Should test to make sure scan returns ErrNoRows if insertion fail.
 	// maybe switch to: https://github.com/jmoiron/sqlx/issues/154#issuecomment-148216948
 	var videoID int64
-	res := tx.QueryRow(sql, title, description, horahoraUID, originalSite, originalVideoLink, newURI, originalVideoID, videoDuration, category)
+	res := tx.QueryRow(sql, title, description, horahoraUID, originalSite, originalVideoLink, newURI, originalVideoID, videoDuration, category, originalUploadDate)
 
 	err = res.Scan(&videoID)
 	if err != nil {
@@ -168,6 +115,81 @@ func (v *VideoModel) SaveForeignVideo(ctx context.Context, title, description st
 	return videoID, nil
 }
 
+// getOrCreateForeignUser returns the domestic user ID for a user from another site, registering them if they don't
+// have an account yet
+func (v *VideoModel) getOrCreateForeignUser(ctx context.Context, originalSite, foreignUserID, foreignUsername string) (int64, error) {
+	req := proto.GetForeignUserRequest{
+		OriginalWebsite: originalSite,
+		ForeignUserID:   foreignUserID,
+	}
+
+	resp, err := v.grpcClient.GetUserForForeignUID(ctx, &req)
+	grpcErr, ok := status.FromError(err)
+	if !ok {
+		return 0, fmt.Errorf("could not parse gRPC err")
+	}
+	switch {
+	case grpcErr.Message() == errors.UserDoesNotExistMessage:
+		// Create the user
+		log.Infof("Foreign user %s does not exist, creating...", foreignUsername)
+
+		regReq := proto.RegisterRequest{
+			Email:          "fake@user.com", // NO!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+			Username:       foreignUsername,
+			Password:       "",
+			ForeignUser:    true,
+			ForeignUserID:  foreignUserID,
+			ForeignWebsite: originalSite,
+		}
+		regResp, err := v.grpcClient.Register(ctx, &regReq)
+		if err != nil {
+			return 0, err
+		}
+
+		validateReq := proto.ValidateJWTRequest{
+			Jwt: regResp.Jwt,
+		}
+
+		// The validation is superfluous, but we need the claims
+		// FIXME: can probably optimize
+		validateResp, err := v.grpcClient.ValidateJWT(ctx, &validateReq)
+		if err != nil {
+			return 0, err
+		}
+
+		if !validateResp.IsValid {
+			return 0, fmt.Errorf("jwt invalid (this should never happen!)")
+		}
+
+		return validateResp.Uid, nil
+
+	case err != nil:
+		return 0, err
+
+	default:
+		return resp.NewUID, nil
+	}
+}
+
+// SetForeignVideoCategory changes the category of the video archived from the website
+func (v *VideoModel) SetForeignVideoCategory(foreignVideoID, website, category string) error {
+	sql := "UPDATE videos SET category = $3 WHERE originalSite = $1 AND originalID = $2"
+	res, err := v.db.Exec(sql, website, foreignVideoID, category)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return ErrVideoNotFound
+	}
+
+	return nil
+}
+
 func (v *VideoModel) ForeignVideoExists(foreignVideoID, website string) (bool, error) {
 	sql := "SELECT id FROM videos WHERE originalSite=$1 AND originalID=$2"
 	var videoID int64
@@ -193,6 +215,34 @@ func (v *VideoModel) IncrementViewsForVideo(videoID int64) error {
 	return nil
 }
 
+// GetOriginalChecksum returns the checksum of the video's original upload, or "" if it hasn't been computed yet
+func (v *VideoModel) GetOriginalChecksum(videoID int64) (string, error) {
+	var checksum sql2.NullString
+	err := v.db.Get(&checksum, "SELECT original_sha256 FROM videos WHERE id = $1", videoID)
+	if err != nil {
+		return "", err
+	}
+
+	return checksum.String, nil
+}
+
+func (v *VideoModel) SetOriginalChecksum(videoID int64, checksum string) error {
+	_, err := v.db.Exec("UPDATE videos SET original_sha256 = $1 WHERE id = $2", checksum, videoID)
+	return err
+}
+
+// GetManifestLocation returns where the video's DASH manifest is (or will be, if it hasn't been transcoded yet). The
+// rest of the video's files are named after it.
+func (v *VideoModel) GetManifestLocation(videoID int64) (string, error) {
+	var loc string
+	err := v.db.Get(&loc, "SELECT newlink FROM videos WHERE id = $1 AND is_deleted = false", videoID)
+	if err == sql2.ErrNoRows {
+		return "", ErrVideoNotFound
+	}
+
+	return loc, err
+}
+
 func (v *VideoModel) AddRatingToVideoID(ratingUID, videoID int64, ratingValue float64) error {
 	sql := "INSERT INTO ratings (user_id, video_id, thumbs) VALUES ($1, $2, $3)" +
 		"ON CONFLICT (user_id, video_id) DO update SET thumbs = $4"
@@ -240,219 +290,57 @@ func (v *VideoModel) GetCategories() (*videoproto.CategoryList, error) {
 	return &videoproto.CategoryList{Categories: categories}, nil
 }
 
-type ESVideoResp struct {
-	Took     int  `json:"took"`
-	TimedOut bool `json:"timed_out"`
-	Shards   struct {
-		Total      int `json:"total"`
-		Successful int `json:"successful"`
-		Skipped    int `json:"skipped"`
-		Failed     int `json:"failed"`
-	} `json:"_shards"`
-	Hits struct {
-		Total struct {
-			Value    int    `json:"value"`
-			Relation string `json:"relation"`
-		} `json:"total"`
-		MaxScore any `json:"max_score"`
-		Hits     []struct {
-			Index  string `json:"_index"`
-			Type   string `json:"_type"`
-			ID     string `json:"_id"`
-			Score  any    `json:"_score"`
-			Source struct {
-				Videoid       int      `json:"videoid"`
-				Title         string   `json:"title"`
-				Tags          []string `json:"tags"`
-				CommentCount  int      `json:"comment_count"`
-				Category      string   `json:"category"`
-				FavoriteArr   []any    `json:"favorite_arr"`
-				UploadDate    string   `json:"upload_date"`
-				Userid        int      `json:"userid"`
-				Newlink       string   `json:"newlink"`
-				VideoDuration float64  `json:"video_duration"`
-				Views         int      `json:"views"`
-				Rating        int      `json:"rating"`
-				IsDeleted     bool     `json:"is_deleted"`
-				Transcoded    bool     `json:"transcoded"`
-				TooBig        bool     `json:"too_big"`
-				IsApproved    bool     `json:"is_approved"`
-				IsMature      bool     `json:"is_mature"`
-				ZdbCtid       int64    `json:"zdb_ctid"`
-				ZdbCmin       int      `json:"zdb_cmin"`
-				ZdbCmax       int      `json:"zdb_cmax"`
-				ZdbXmin       int      `json:"zdb_xmin"`
-			} `json:"_source"`
-			// Sort []string `json:"sort"`
-		} `json:"hits"`
-	} `json:"hits"`
-	Aggregations struct {
-		Cardinalities struct {
-			DocCountErrorUpperBound int `json:"doc_count_error_upper_bound"`
-			SumOtherDocCount        int `json:"sum_other_doc_count"`
-			Buckets                 []struct {
-				Key      string `json:"key"`
-				DocCount int    `json:"doc_count"`
-			} `json:"buckets"`
-		} `json:"cardinalities"`
-	} `json:"aggregations"`
-}
-
-// For now, this only supports either fromUserID or withTag. Can support both in future, need to switch to
-// goqu and write better tests
-func (v *VideoModel) GetVideoList(direction videoproto.SortDirection, pageNum int64, fromUserID int64, searchVal string, showUnapproved, unapprovedOnly bool,
-	orderCategory videoproto.OrderCategory, category string, followFeed bool, following []int64, showMature bool) ([]*videoproto.Video, int, *videoproto.CategoryList, error) {
-	sql, err := v.generateVideoListSQL(direction, pageNum, fromUserID, searchVal, showUnapproved, unapprovedOnly, orderCategory, category, followFeed, following, showMature)
-	if err != nil {
-		return nil, 0, nil, err
-	}
-
-	var st ESVideoResp
-	err = json.Unmarshal([]byte(sql), &st)
-	if err != nil {
-		return nil, 0, nil, err
-	}
-
-	var results []*videoproto.Video
-	for _, video := range st.Hits.Hits {
-		vid := videoproto.Video{
-			VideoID:       int64(video.Source.Videoid),
-			VideoTitle:    video.Source.Title,
-			AuthorID:      int64(video.Source.Userid),
-			ThumbnailLoc:  strings.Replace(video.Source.Newlink, ".mpd", ".thumb", 1),
-			Views:         uint64(video.Source.Views),
-			VideoDuration: float32(video.Source.VideoDuration),
-			Rating:        int64(video.Source.Rating),
-			IsMature:      video.Source.IsMature,
-		}
+// GetVideoList returns a page of the videos which match the query, with cursors to the pages on either side, the total
+// number of matches, the number in each category and the other facets
+func (v *VideoModel) GetVideoList(ctx context.Context, q VideoQuery) (*videoproto.VideoList, error) {
+	res, err := v.search.Search(ctx, q)
+	if err != nil {
+		return nil, err
+	}
 
-		resp, err := v.getUserInfo(int64(video.Source.Userid))
-		if err != nil {
-			return nil, 0, nil, err
-		}
+	hits, next, prev := page(q, res.Hits)
 
-		vid.AuthorName = resp.Username
-		results = append(results, &vid)
+	list := videoproto.VideoList{
+		NumberOfVideos: int64(res.Total),
+		Categories:     &videoproto.CategoryList{Categories: res.Categories},
+		Facets:         res.Facets,
+		NextCursor:     next,
+		PrevCursor:     prev,
 	}
 
-	var cl videoproto.CategoryList
-	for _, cat := range st.Aggregations.Cardinalities.Buckets {
-		cl.Categories = append(cl.Categories, &videoproto.Category{
-			Name:        cat.Key,
-			Cardinality: uint64(cat.DocCount),
-		})
+	list.Videos, err = v.videosFromHits(hits)
+	if err != nil {
+		return nil, err
 	}
 
-	return results, st.Hits.Total.Value, &cl, nil
+	return &list, nil
 }
 
-func (v *VideoModel) generateVideoListSQL(direction videoproto.SortDirection, pageNum, fromUserID int64, searchVal string, showUnapproved, unapprovedOnly bool, orderCategory videoproto.OrderCategory, category string, followFeed bool, following []int64, showMature bool) (string, error) {
-	minResultNum := (pageNum - 1) * NumResultsPerPage
-	res := esquery.Search().Size(NumResultsPerPage).From(uint64(minResultNum))
-
-	var queries []esquery.Mappable
-	var searchQueries []esquery.Mappable
-
-	switch orderCategory {
-	case videoproto.OrderCategory_upload_date:
-		switch direction {
-		case videoproto.SortDirection_asc:
-			res = res.Sort("upload_date", esquery.OrderAsc)
-		case videoproto.SortDirection_desc:
-			res = res.Sort("upload_date", esquery.OrderDesc)
-		}
-
-	case videoproto.OrderCategory_views:
-		switch direction {
-		case videoproto.SortDirection_asc:
-			res = res.Sort("views", esquery.OrderAsc)
-		case videoproto.SortDirection_desc:
-			res = res.Sort("views", esquery.OrderDesc)
-		}
-
-	case videoproto.OrderCategory_rating:
-		switch direction {
-		case videoproto.SortDirection_asc:
-			res = res.Sort("rating", esquery.OrderAsc)
-		case videoproto.SortDirection_desc:
-			res = res.Sort("rating", esquery.OrderDesc)
+// videosFromHits looks up the authors of the videos
+func (v *VideoModel) videosFromHits(hits []SearchHit) ([]*videoproto.Video, error) {
+	var videos []*videoproto.Video
+	for _, video := range hits {
+		vid := videoproto.Video{
+			VideoID:       video.VideoID,
+			VideoTitle:    video.Title,
+			AuthorID:      video.AuthorID,
+			ThumbnailLoc:  strings.Replace(video.NewLink, ".mpd", ".thumb", 1),
+			Views:         uint64(video.Views),
+			VideoDuration: float32(video.Duration),
+			Rating:        video.Rating,
+			IsMature:      video.IsMature,
 		}
 
-		// case videoproto.OrderCategory_my_ratings:
-		// 	ds = ds.LeftJoin(
-		// 		goqu.T("ratings"),
-		// 		goqu.On(goqu.Ex{"videos.id": goqu.I("ratings.video_id")})).
-		// 		Where(goqu.I("user_id").Eq(fromUserID))
-		// 	switch direction {
-		// 	case videoproto.SortDirection_asc:
-		// 		ds = ds.Order(goqu.I("ratings.rating").Asc())
-		// 	case videoproto.SortDirection_desc:
-		// 		ds = ds.Order(goqu.I("ratings.rating").Desc())
-		// 	}
-	}
-
-	if followFeed {
-		var q []esquery.Mappable
-		for _, follow := range following {
-			q = append(q, esquery.Term("userid", follow))
+		resp, err := v.getUserInfo(video.AuthorID)
+		if err != nil {
+			return nil, err
 		}
-		queries = append(queries, esquery.Bool().Should(q...).MinimumShouldMatch(1))
-	}
-
-	if category != "" {
-		queries = append(queries, esquery.Term("category", category))
-	}
-
-	if fromUserID != 0 {
-		queries = append(queries, esquery.Term("userid", fromUserID))
-	}
 
-	if searchVal != "" {
-		// TODO: negative search terms
-		queries = append(queries,
-			esquery.MultiMatch(searchVal).Type(esquery.MatchTypeBoolPrefix).Fields("title", "description", "tags"))
-	}
-
-	if !showUnapproved {
-		// only show approved
-		queries = append(queries,
-			esquery.Term("is_approved", true))
-	} else if unapprovedOnly {
-		queries = append(queries,
-			esquery.Term("is_approved", false))
-	}
-
-	if !showMature {
-		// only show suitable content
-		queries = append(queries,
-			esquery.Term("is_mature", false))
-	}
-
-	// Only show transcoded videos
-	queries = append(queries,
-		esquery.Term("transcoded", true))
-
-	// Do not show deleted videos
-	queries = append(queries,
-		esquery.Term("is_deleted", false))
-
-	pl, _ := res.Query(esquery.Bool().Must(queries...).Should(searchQueries...)).MarshalJSON()
-	log.Errorf("pl: %v", string(pl))
-
-	resp, err := res.Query(esquery.Bool().Must(queries...).Should(searchQueries...)).Aggs(esquery.TermsAgg("cardinalities", "category")).Run(v.esClient, v.esClient.Search.WithContext(context.TODO()))
-	if err != nil {
-		return "", err
-	}
-	defer resp.Body.Close()
-
-	payload, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return "", err
+		vid.AuthorName = resp.Username
+		videos = append(videos, &vid)
 	}
 
-	// log.Errorf(string(payload))
-
-	return string(payload), err
+	return videos, nil
 }
 
 func (v *VideoModel) RefreshMaterializedView() error {
@@ -465,22 +353,6 @@ func (v *VideoModel) RefreshMaterializedView() error {
 	return nil
 }
 
-// TODO: might want to switch to some domain-specific information retrieval language in the future
-// One of the log query languages, like Lucene, could work
-func extractSearchTerms(search string) (includeTerms, excludeTerms []string) {
-	var exclude, include []string
-	spl := strings.Split(search, " ")
-	for _, term := range spl {
-		switch {
-		case strings.HasPrefix(term, "-"):
-			exclude = append(exclude, term[1:])
-		default:
-			include = append(include, term)
-		}
-	}
-	return include, exclude
-}
-
 type basicVideoInfo struct {
 	authorName string
 	authorID   int64
@@ -489,17 +361,36 @@ type basicVideoInfo struct {
 
 // Information that isn't super straightforward to query for
 func (v *VideoModel) GetVideoInfo(videoID string) (*videoproto.VideoMetadata, error) {
-	sql := "SELECT id, title, description, upload_date, userID, newLink, views, video_duration, is_mature FROM videos WHERE id=$1 AND is_deleted=false"
+	sql := "SELECT id, title, description, upload_date, userID, newLink, views, video_duration, is_mature, has_hls_master, " +
+		"upstream_status, upstream_status_since, upstream_checked_at FROM videos WHERE id=$1 AND is_deleted=false"
 	var video videoproto.VideoMetadata
 	var authorID, views int64
+	var hasHLSMaster bool
+	var upstreamStatus sql2.NullString
+	var upstreamSince, upstreamChecked sql2.NullTime
 
 	row := v.db.QueryRow(sql, videoID)
 
-	err := row.Scan(&video.VideoID, &video.VideoTitle, &video.Description, &video.UploadDate, &authorID, &video.VideoLoc, &views, &video.VideoDuration, &video.IsMature)
+	err := row.Scan(&video.VideoID, &video.VideoTitle, &video.Description, &video.UploadDate, &authorID, &video.VideoLoc, &views, &video.VideoDuration, &video.IsMature, &hasHLSMaster,
+		&upstreamStatus, &upstreamSince, &upstreamChecked)
 	if err != nil {
 		return nil, err
 	}
 
+	video.UpstreamStatus = upstreamStatus.String
+	if upstreamSince.Valid {
+		video.UpstreamStatusSince = upstreamSince.Time.Format(time.RFC3339)
+	}
+	if upstreamChecked.Valid {
+		video.UpstreamCheckedAt = upstreamChecked.Time.Format(time.RFC3339)
+	}
+
+	// Older videos only have an HLS master playlist, which lives where the DASH manifest should be
+	video.HLSLoc = video.VideoLoc
+	if hasHLSMaster {
+		video.HLSLoc = strings.Replace(video.VideoLoc, ".mpd", ".m3u8", 1)
+	}
+
 	basicInfo, err := v.getBasicVideoInfo(authorID, video.VideoID)
 	if err != nil {
 		return nil, err
@@ -677,47 +568,61 @@ func (v *VideoModel) MakeComment(userID, videoID, parentID int64, content string
 	return nil
 }
 
-type UnencodedVideo struct {
-	ID      uint32 `db:"id"`
-	NewLink string `db:"newlink"`
-}
+// SaveArchivedComments saves comments archived from the video's original site, mapping their authors to foreign users.
+// Comments which were already saved are skipped, so it's safe to call again for the same video. Returns the number of
+// comments which were saved.
+func (v *VideoModel) SaveArchivedComments(ctx context.Context, videoID int64, originalSite string, comments []archivedcomments.Comment) (int, error) {
+	// Foreign comment ID -> domestic comment ID, for threading replies
+	commentIDs := make(map[string]int64)
+	// Foreign user ID -> domestic user ID
+	userIDs := make(map[string]int64)
 
-func (v UnencodedVideo) GetMPDUUID() string {
-	spl := strings.Split(v.NewLink, "/")
-	r := spl[len(spl)-1]
-	return r[:len(r)-4]
-}
+	var saved int
+	for _, comment := range comments {
+		userID, ok := userIDs[comment.AuthorID]
+		if !ok {
+			var err error
+			userID, err = v.getOrCreateForeignUser(ctx, originalSite, comment.AuthorID, comment.AuthorUsername)
+			if err != nil {
+				// One bad author shouldn't lose the rest of the comments
+				log.Errorf("Could not get user for comment author %s. Err: %s", comment.AuthorID, err)
+				continue
+			}
+			userIDs[comment.AuthorID] = userID
+		}
 
-func (v *VideoModel) GetUnencodedVideos() ([]UnencodedVideo, error) {
-	// Newest videos first
-	sql := "SELECT id, newLink FROM videos WHERE transcoded = false AND too_big = false ORDER BY upload_date desc LIMIT 100"
-	var videos []UnencodedVideo
-	err := v.db.Select(&videos, sql)
-	if err != nil {
-		return nil, err
-	}
+		var parentID sql2.NullInt64
+		if comment.ParentID != "" {
+			parentID.Int64, parentID.Valid = commentIDs[comment.ParentID]
+		}
 
-	return videos, nil
-}
+		createdAt := comment.CreatedAt
+		if createdAt.IsZero() {
+			createdAt = time.Now()
+		}
 
-func (v *VideoModel) MarkVideoAsEncoded(uv UnencodedVideo) error {
-	sql := "UPDATE videos SET transcoded = true WHERE id = $1"
-	_, err := v.db.Exec(sql, uv.ID)
-	if err != nil {
-		return err
-	}
+		sql := "INSERT INTO comments (user_id, video_id, parent_comment, comment, creation_date, is_archived, foreign_id, foreign_like_count) " +
+			"VALUES ($1, $2, $3, $4, $5, true, $6, $7) ON CONFLICT (video_id, foreign_id) WHERE foreign_id IS NOT NULL DO NOTHING RETURNING id"
 
-	return nil
-}
+		var commentID int64
+		err := v.db.QueryRowContext(ctx, sql, userID, videoID, parentID, comment.Text, createdAt, comment.ID, comment.LikeCount).Scan(&commentID)
+		switch {
+		case err == sql2.ErrNoRows:
+			// Saved by an earlier import
+			err = v.db.GetContext(ctx, &commentID, "SELECT id FROM comments WHERE video_id = $1 AND foreign_id = $2", videoID, comment.ID)
+			if err != nil {
+				return saved, err
+			}
+		case err != nil:
+			return saved, err
+		default:
+			saved++
+		}
 
-func (v *VideoModel) MarkVideoAsTooBig(uv UnencodedVideo) error {
-	sql := "UPDATE videos SET too_big = true WHERE id = $1"
-	_, err := v.db.Exec(sql, uv.ID)
-	if err != nil {
-		return err
+		commentIDs[comment.ID] = commentID
 	}
 
-	return nil
+	return saved, nil
 }
 
 func (v *VideoModel) MakeUpvote(userID, commentID int64, voteScore int) error {
@@ -743,18 +648,150 @@ func (v *VideoModel) MakeUpvote(userID, commentID int64, voteScore int) error {
    font_size varchar(255)
 */
 
-func (v *VideoModel) MakeDanmaku(video_id int, timestamp, message string, authorID int, inpType, color, fontSize string) error {
-	sql := "INSERT INTO danmaku (video_id, timestamp, message, author_id, type, color, creation_date, font_size) VALUES ($1, $2, $3, $4, $5, $6, now(), $7)"
-	_, err := v.db.Exec(sql, video_id, timestamp, message, authorID, inpType, color, fontSize)
+// MakeDanmaku saves a danmaku and returns its ID
+func (v *VideoModel) MakeDanmaku(video_id int, timestamp, message string, authorID int, inpType, color, fontSize string) (int64, error) {
+	sql := "INSERT INTO danmaku (video_id, timestamp, message, author_id, type, color, creation_date, font_size) VALUES ($1, $2, $3, $4, $5, $6, now(), $7) RETURNING id"
+	var id int64
+	err := v.db.QueryRow(sql, video_id, timestamp, message, authorID, inpType, color, fontSize).Scan(&id)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	return nil
+	return id, nil
+}
+
+// DeleteDanmaku deletes the danmaku with the given IDs, and returns the ID and video of each one which was deleted
+func (v *VideoModel) DeleteDanmaku(ids []int64) ([]*videoproto.Danmaku, error) {
+	sql := "DELETE FROM danmaku WHERE id = ANY($1) RETURNING id, video_id"
+	return v.deleteDanmaku(sql, pq.Array(ids))
+}
+
+// DeleteDanmakuByAuthor deletes all of the author's danmaku, or only those on the given video if videoID isn't 0. The
+// ID and video of each deleted danmaku are returned.
+func (v *VideoModel) DeleteDanmakuByAuthor(authorID, videoID int64) ([]*videoproto.Danmaku, error) {
+	if videoID == 0 {
+		sql := "DELETE FROM danmaku WHERE author_id = $1 RETURNING id, video_id"
+		return v.deleteDanmaku(sql, authorID)
+	}
+
+	sql := "DELETE FROM danmaku WHERE author_id = $1 AND video_id = $2 RETURNING id, video_id"
+	return v.deleteDanmaku(sql, authorID, videoID)
+}
+
+func (v *VideoModel) deleteDanmaku(sql string, args ...interface{}) ([]*videoproto.Danmaku, error) {
+	rows, err := v.db.Query(sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deleted := make([]*videoproto.Danmaku, 0)
+	for rows.Next() {
+		var dn videoproto.Danmaku
+		err = rows.Scan(&dn.Id, &dn.VideoId)
+		if err != nil {
+			return nil, err
+		}
+		deleted = append(deleted, &dn)
+	}
+
+	return deleted, rows.Err()
+}
+
+// ErrVideoNotFound is returned when danmaku are imported for a video which doesn't exist
+var ErrVideoNotFound = serror.New("video not found")
+
+const pqForeignKeyViolation = "23503"
+
+// SaveImportedDanmaku saves danmaku archived from the original site. Any danmaku previously archived for the video are
+// replaced, so that archiving a video again doesn't duplicate them. Returns the number saved.
+func (v *VideoModel) SaveImportedDanmaku(ctx context.Context, videoID int64, source string, danmaku []*videoproto.Danmaku) (int, error) {
+	tx, err := v.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// Danmaku imported by users have an author, and are kept
+	_, err = tx.ExecContext(ctx, "DELETE FROM danmaku WHERE video_id = $1 AND imported AND author_id IS NULL", videoID)
+	if err != nil {
+		return 0, err
+	}
+
+	saved, err := copyDanmaku(ctx, tx, videoID, sql2.NullInt64{}, source, danmaku)
+	if err != nil {
+		return 0, err
+	}
+
+	return saved, tx.Commit()
+}
+
+// ImportUserDanmaku saves danmaku a user imported from a file, e.g. one made with another player. Returns the number
+// saved.
+func (v *VideoModel) ImportUserDanmaku(ctx context.Context, videoID, userID int64, source string, danmaku []*videoproto.Danmaku) (int, error) {
+	tx, err := v.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	saved, err := copyDanmaku(ctx, tx, videoID, sql2.NullInt64{Int64: userID, Valid: true}, source, danmaku)
+	if err != nil {
+		return 0, err
+	}
+
+	return saved, tx.Commit()
+}
+
+// copyDanmaku bulk inserts imported danmaku. Empty messages are skipped, and long ones are truncated.
+func copyDanmaku(ctx context.Context, tx *sql2.Tx, videoID int64, authorID sql2.NullInt64, source string, danmaku []*videoproto.Danmaku) (int, error) {
+	stmt, err := tx.PrepareContext(ctx, pq.CopyIn("danmaku", "video_id", "timestamp", "message", "author_id", "type", "color", "creation_date", "font_size", "imported", "source"))
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+
+	now := time.Now()
+	var saved int
+	for _, dn := range danmaku {
+		message := truncateRunes(dn.Message, maxDanmakuLength)
+		if strings.TrimSpace(message) == "" {
+			continue
+		}
+
+		_, err = stmt.ExecContext(ctx, videoID, dn.Timestamp, message, authorID, dn.Type, dn.Color, now, dn.FontSize, true, source)
+		if err != nil {
+			return 0, err
+		}
+		saved++
+	}
+
+	// Flush the copy
+	_, err = stmt.ExecContext(ctx)
+	var pqErr *pq.Error
+	switch {
+	case serror.As(err, &pqErr) && pqErr.Code == pqForeignKeyViolation:
+		return 0, ErrVideoNotFound
+	case err != nil:
+		return 0, err
+	}
+
+	return saved, stmt.Close()
+}
+
+// The danmaku table's message column is a varchar(255)
+const maxDanmakuLength = 255
+
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n])
 }
 
 func (v *VideoModel) GetDanmaku(videoID int) (*videoproto.DanmakuList, error) {
-	sql := "SELECT id, timestamp, message, author_id, type, color, font_size from danmaku WHERE video_id = $1 ORDER BY timestamp::float asc"
+	sql := "SELECT id, timestamp, message, COALESCE(author_id, 0), type, color, font_size, imported, COALESCE(source, '') from danmaku WHERE video_id = $1 ORDER BY timestamp::float asc"
 	var categories videoproto.DanmakuList
 	categories.Comments = make([]*videoproto.Danmaku, 0)
 	rows, err := v.db.Query(sql, videoID)
@@ -765,7 +802,7 @@ func (v *VideoModel) GetDanmaku(videoID int) (*videoproto.DanmakuList, error) {
 
 	for rows.Next() {
 		var dn videoproto.Danmaku
-		err = rows.Scan(&dn.Id, &dn.Timestamp, &dn.Message, &dn.AuthorId, &dn.Type, &dn.Color, &dn.FontSize)
+		err = rows.Scan(&dn.Id, &dn.Timestamp, &dn.Message, &dn.AuthorId, &dn.Type, &dn.Color, &dn.FontSize, &dn.Imported, &dn.Source)
 		if err != nil {
 			return nil, err
 		}
@@ -794,7 +831,7 @@ func (v *VideoModel) DeleteVideo(videoID string) error {
 func (v *VideoModel) GetComments(videoID, currUserID int64) ([]*videoproto.Comment, error) {
 	var comments []*videoproto.Comment
 	sql := "SELECT id, sum(COALESCE(vote_score, 0)) as upvote_score, comments.user_id," +
-		" creation_date, comment, COALESCE(parent_comment, 0) " +
+		" creation_date, comment, COALESCE(parent_comment, 0), is_archived, foreign_like_count " +
 		"FROM comments LEFT JOIN comment_upvotes ON id = comment_id GROUP BY id,comment_upvotes.comment_id HAVING video_id = $1"
 	rows, err := v.db.Query(sql, videoID)
 	if err != nil {
@@ -806,7 +843,7 @@ func (v *VideoModel) GetComments(videoID, currUserID int64) ([]*videoproto.Comme
 		var comment videoproto.Comment
 
 		err = rows.Scan(&comment.CommentId, &comment.VoteScore, &comment.AuthorId,
-			&comment.CreationDate, &comment.Content, &comment.ParentId)
+			&comment.CreationDate, &comment.Content, &comment.ParentId, &comment.Archived, &comment.LikeCount)
 		if err != nil {
 			log.Errorf("Failed to scan. Err: %s", err)
 			continue
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"path"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type VideoLocation struct {
+	ID      int64  `db:"id"`
+	NewLink string `db:"newlink"`
+}
+
+// StorageKey returns the UUID which all of the video's objects (original, thumbnail, manifests, segments) start with
+func (v VideoLocation) StorageKey() string {
+	if v.NewLink == "" {
+		return ""
+	}
+	return strings.TrimSuffix(path.Base(v.NewLink), ".mpd")
+}
+
+// VideoLocationModel lists where every video is stored, for tools which need to walk all of the objects
+type VideoLocationModel struct {
+	db *sqlx.DB
+}
+
+func NewVideoLocationModel(db *sqlx.DB) *VideoLocationModel {
+	return &VideoLocationModel{db: db}
+}
+
+// List returns up to limit videos with IDs greater than afterID, in order of ID
+func (m *VideoLocationModel) List(afterID int64, limit int) ([]VideoLocation, error) {
+	var ret []VideoLocation
+	err := m.db.Select(&ret, "SELECT id, newlink FROM videos WHERE id > $1 ORDER BY id LIMIT $2", afterID, limit)
+	return ret, err
+}
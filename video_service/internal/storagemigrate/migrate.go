@@ -0,0 +1,211 @@
+package storagemigrate
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/horahoradev/PrometheusTube/backend/video_service/internal/models"
+	"github.com/horahoradev/PrometheusTube/backend/video_service/storage"
+	log "github.com/sirupsen/logrus"
+)
+
+const batchSize = 100
+
+type VideoSource interface {
+	List(afterID int64, limit int) ([]models.VideoLocation, error)
+}
+
+// Migrator copies every video's objects from one backend to another
+type Migrator struct {
+	Videos VideoSource
+	Src    storage.ObjectStorage
+	Dst    storage.ObjectStorage
+	// DryRun only logs what would be copied
+	DryRun bool
+	// StatePath is where the ID of the last video which was fully copied is kept, so that an interrupted run can pick
+	// up where it left off. Optional.
+	StatePath string
+}
+
+type Stats struct {
+	Videos  int
+	Copied  int
+	Skipped int
+	Bytes   int64
+}
+
+func (m *Migrator) Run(ctx context.Context) (Stats, error) {
+	var stats Stats
+
+	afterID, err := m.loadState()
+	if err != nil {
+		return stats, err
+	}
+
+	if afterID > 0 {
+		log.Infof("Resuming after video %d", afterID)
+	}
+
+	for {
+		videos, err := m.Videos.List(afterID, batchSize)
+		if err != nil {
+			return stats, fmt.Errorf("could not list videos. Err: %s", err)
+		}
+
+		if len(videos) == 0 {
+			return stats, nil
+		}
+
+		for _, video := range videos {
+			if ctx.Err() != nil {
+				return stats, ctx.Err()
+			}
+
+			err = m.migrateVideo(ctx, video, &stats)
+			if err != nil {
+				return stats, fmt.Errorf("could not migrate video %d. Err: %s", video.ID, err)
+			}
+
+			afterID = video.ID
+			err = m.saveState(afterID)
+			if err != nil {
+				return stats, err
+			}
+		}
+	}
+}
+
+func (m *Migrator) migrateVideo(ctx context.Context, video models.VideoLocation, stats *Stats) error {
+	key := video.StorageKey()
+	// An empty prefix would match the whole bucket
+	if key == "" {
+		log.Warnf("Video %d has no location, skipping", video.ID)
+		return nil
+	}
+
+	objs, err := m.Src.List(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	for _, obj := range objs {
+		copied, err := m.copyObject(ctx, obj)
+		if err != nil {
+			return fmt.Errorf("could not copy %s. Err: %s", obj.Key, err)
+		}
+
+		if copied {
+			stats.Copied++
+			stats.Bytes += obj.Size
+		} else {
+			stats.Skipped++
+		}
+	}
+
+	stats.Videos++
+	return nil
+}
+
+// copyObject copies the object unless it's already in the destination, and verifies the copy's checksum
+func (m *Migrator) copyObject(ctx context.Context, obj storage.ObjectInfo) (bool, error) {
+	// Keys are never reused, so an object of the same size was copied by an earlier run
+	existing, err := m.Dst.Stat(ctx, obj.Key)
+	switch {
+	case err == nil && existing.Size == obj.Size:
+		return false, nil
+	case err != nil && !errors.Is(err, storage.ErrNotFound):
+		return false, err
+	}
+
+	if m.DryRun {
+		log.Infof("Would copy %s (%d bytes)", obj.Key, obj.Size)
+		return true, nil
+	}
+
+	r, info, err := m.Src.Open(ctx, obj.Key)
+	if err != nil {
+		return false, err
+	}
+	defer r.Close()
+
+	srcHash := sha256.New()
+	err = m.Dst.Put(ctx, obj.Key, io.TeeReader(r, srcHash), info.Size, info.ContentType)
+	if err != nil {
+		return false, err
+	}
+
+	dstChecksum, err := checksum(ctx, m.Dst, obj.Key)
+	if err != nil {
+		return false, fmt.Errorf("could not verify copy. Err: %s", err)
+	}
+
+	if srcChecksum := hex.EncodeToString(srcHash.Sum(nil)); srcChecksum != dstChecksum {
+		// Don't leave it behind, or the next run would think it's done
+		delErr := m.Dst.Delete(ctx, obj.Key)
+		if delErr != nil {
+			log.Errorf("Could not delete bad copy of %s. Err: %s", obj.Key, delErr)
+		}
+		return false, fmt.Errorf("checksum of the copy %s does not match the original %s", dstChecksum, srcChecksum)
+	}
+
+	log.Infof("Copied %s (%d bytes)", obj.Key, info.Size)
+	return true, nil
+}
+
+func checksum(ctx context.Context, s storage.ObjectStorage, key string) (string, error) {
+	r, _, err := s.Open(ctx, key)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	h := sha256.New()
+	_, err = io.Copy(h, r)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func (m *Migrator) loadState() (int64, error) {
+	if m.StatePath == "" {
+		return 0, nil
+	}
+
+	data, err := os.ReadFile(m.StatePath)
+	if os.IsNotExist(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+
+	id, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse state file %s. Err: %s", m.StatePath, err)
+	}
+
+	return id, nil
+}
+
+func (m *Migrator) saveState(id int64) error {
+	if m.StatePath == "" || m.DryRun {
+		return nil
+	}
+
+	// Renamed into place so that a crash can't leave a truncated state file behind
+	tmp := m.StatePath + ".tmp"
+	err := os.WriteFile(tmp, []byte(strconv.FormatInt(id, 10)), 0644)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, m.StatePath)
+}
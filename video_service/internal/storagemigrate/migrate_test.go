@@ -0,0 +1,80 @@
+package storagemigrate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/horahoradev/PrometheusTube/backend/video_service/internal/models"
+	"github.com/horahoradev/PrometheusTube/backend/video_service/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeVideos []models.VideoLocation
+
+func (f fakeVideos) List(afterID int64, limit int) ([]models.VideoLocation, error) {
+	var ret []models.VideoLocation
+	for _, v := range f {
+		if v.ID > afterID && len(ret) < limit {
+			ret = append(ret, v)
+		}
+	}
+	return ret, nil
+}
+
+func put(t *testing.T, s storage.ObjectStorage, key, data string) {
+	assert.NoError(t, s.Put(context.Background(), key, strings.NewReader(data), int64(len(data)), ""))
+}
+
+func TestMigrator(t *testing.T) {
+	ctx := context.Background()
+	src, err := storage.NewFilesystem(t.TempDir())
+	assert.NoError(t, err)
+	dst, err := storage.NewFilesystem(t.TempDir())
+	assert.NoError(t, err)
+
+	for _, key := range []string{"aaaa", "aaaa.thumb", "aaaa.json", "aaaa.mpd", "aaaa_720p.mp4", "bbbb", "bbbb.mpd", "unrelated"} {
+		put(t, src, key, "data for "+key)
+	}
+	// Left over from an earlier run
+	put(t, dst, "aaaa", "data for aaaa")
+
+	videos := fakeVideos{
+		{ID: 1, NewLink: "otomads/aaaa.mpd"},
+		{ID: 2, NewLink: ""},
+		{ID: 3, NewLink: "otomads/bbbb.mpd"},
+	}
+	statePath := filepath.Join(t.TempDir(), "state")
+
+	m := Migrator{Videos: videos, Src: src, Dst: dst, DryRun: true, StatePath: statePath}
+	stats, err := m.Run(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, Stats{Videos: 2, Copied: 6, Skipped: 1, Bytes: stats.Bytes}, stats)
+	_, err = dst.Stat(ctx, "aaaa.mpd")
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+	_, err = os.Stat(statePath)
+	assert.True(t, os.IsNotExist(err))
+
+	m.DryRun = false
+	stats, err = m.Run(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 6, stats.Copied)
+
+	objs, err := dst.List(ctx, "")
+	assert.NoError(t, err)
+	assert.Len(t, objs, 7)
+	_, err = dst.Stat(ctx, "unrelated")
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+
+	state, err := os.ReadFile(statePath)
+	assert.NoError(t, err)
+	assert.Equal(t, "3", string(state))
+
+	// Resumed runs start after the last video
+	put(t, src, "aaaa.m3u8", "added later")
+	stats, err = m.Run(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, Stats{}, stats)
+}
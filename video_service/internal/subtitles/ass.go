@@ -0,0 +1,78 @@
+package subtitles
+
+import (
+	"regexp"
+	"strings"
+)
+
+// The columns of the events section, if it doesn't say
+var defaultASSFormat = []string{"Layer", "Start", "End", "Style", "Name", "MarginL", "MarginR", "MarginV", "Effect", "Text"}
+
+// parseASS reads the dialogue of Advanced SubStation Alpha (and SubStation Alpha) subtitles. Styling and positioning are
+// dropped, since WebVTT can't express most of them.
+func parseASS(data []byte) ([]Cue, error) {
+	var cues []Cue
+	var section string
+	format := defaultASSFormat
+
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "["):
+			section = strings.ToLower(line)
+			continue
+		case section != "[events]":
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+
+		switch strings.TrimSpace(key) {
+		case "Format":
+			format = strings.Split(value, ",")
+			for i := range format {
+				format[i] = strings.TrimSpace(format[i])
+			}
+
+		case "Dialogue":
+			// The text is last, and may contain commas
+			fields := strings.SplitN(strings.TrimSpace(value), ",", len(format))
+			if len(fields) != len(format) {
+				continue
+			}
+
+			var cue Cue
+			var err error
+			for i, name := range format {
+				switch name {
+				case "Start":
+					cue.Start, err = parseTimestamp(fields[i])
+				case "End":
+					cue.End, err = parseTimestamp(fields[i])
+				case "Text":
+					cue.Text = assText(fields[i])
+				}
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			cues = appendCue(cues, cue)
+		}
+	}
+
+	return cues, nil
+}
+
+// Override tags, e.g. {\an8} or {\pos(10,20)\c&H00FFFF&}
+var assTagRe = regexp.MustCompile(`\{\\[^}]*\}`)
+
+var assEscaper = strings.NewReplacer(`\N`, "\n", `\n`, "\n", `\h`, " ")
+
+func assText(text string) string {
+	text = assTagRe.ReplaceAllString(text, "")
+	return assEscaper.Replace(escapeText(text))
+}
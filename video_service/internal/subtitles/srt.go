@@ -0,0 +1,64 @@
+package subtitles
+
+import (
+	"regexp"
+	"strings"
+)
+
+// parseSRT reads SubRip subtitles. Each cue is an optional number, the timings, then the text.
+func parseSRT(data []byte) ([]Cue, error) {
+	var cues []Cue
+	for _, block := range splitBlocks(data) {
+		lines := strings.Split(block, "\n")
+		for i, line := range lines {
+			if !strings.Contains(line, "-->") {
+				continue
+			}
+
+			cue, err := parseTimings(line)
+			if err != nil {
+				return nil, err
+			}
+
+			// Some SRT files have coordinates after the timings, which don't mean anything to WebVTT
+			cue.Settings = ""
+			cue.Text = srtText(strings.Join(lines[i+1:], "\n"))
+			cues = appendCue(cues, cue)
+			break
+		}
+	}
+
+	return cues, nil
+}
+
+var srtTagRe = regexp.MustCompile(`<[^>]*>`)
+
+// srtText converts SRT's HTML-ish text to WebVTT's. Italic, bold and underline tags are kept, font tags are dropped,
+// and anything else is escaped so that it shows up as it did in the SRT.
+func srtText(text string) string {
+	var b strings.Builder
+	last := 0
+	for _, loc := range srtTagRe.FindAllStringIndex(text, -1) {
+		b.WriteString(escapeText(text[last:loc[0]]))
+		last = loc[1]
+
+		tag := strings.ToLower(text[loc[0]:loc[1]])
+		switch {
+		case tag == "<i>" || tag == "</i>" || tag == "<b>" || tag == "</b>" || tag == "<u>" || tag == "</u>":
+			b.WriteString(tag)
+		case strings.HasPrefix(tag, "<font") || tag == "</font>":
+		default:
+			b.WriteString(escapeText(text[loc[0]:loc[1]]))
+		}
+	}
+	b.WriteString(escapeText(text[last:]))
+
+	return assTagRe.ReplaceAllString(b.String(), "")
+}
+
+var textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// escapeText escapes plain text for use in WebVTT cue text
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
@@ -0,0 +1,218 @@
+package subtitles
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// This package converts the subtitle formats people have lying around (SRT, ASS and WebVTT) to WebVTT, which is what
+// browsers and DASH players understand.
+
+// Kinds of track, as for the HTML track element
+const (
+	KindSubtitles    = "subtitles"
+	KindCaptions     = "captions"
+	KindDescriptions = "descriptions"
+)
+
+var (
+	ErrUnknownFormat = errors.New("unrecognized subtitle format")
+	ErrNoCues        = errors.New("subtitles don't contain any cues")
+)
+
+// Cue is a piece of text shown between two times
+type Cue struct {
+	Start time.Duration
+	End   time.Duration
+	// Settings are WebVTT's cue settings, e.g. "line:0 align:start"
+	Settings string
+	// Text is WebVTT cue text, which may contain lines and <i>, <b> and <u> tags
+	Text string
+}
+
+type Format string
+
+const (
+	SRT    Format = "srt"
+	ASS    Format = "ass"
+	WebVTT Format = "vtt"
+)
+
+// DetectFormat returns the format of a subtitle file, or "" if it isn't one we know
+func DetectFormat(data []byte) Format {
+	trimmed := trimFile(data)
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("WEBVTT")):
+		return WebVTT
+	case bytes.HasPrefix(trimmed, []byte("[Script Info]")) || bytes.Contains(trimmed, []byte("[Events]")):
+		return ASS
+	case bytes.Contains(trimmed, []byte("-->")):
+		return SRT
+	}
+
+	return ""
+}
+
+// Parse reads subtitles in any of the formats we know, which it detects from the contents
+func Parse(data []byte) ([]Cue, error) {
+	var cues []Cue
+	var err error
+
+	trimmed := trimFile(data)
+	switch DetectFormat(trimmed) {
+	case WebVTT:
+		cues, err = parseVTT(trimmed)
+	case ASS:
+		cues, err = parseASS(trimmed)
+	case SRT:
+		cues, err = parseSRT(trimmed)
+	default:
+		err = ErrUnknownFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cues) == 0 {
+		return nil, ErrNoCues
+	}
+
+	// WebVTT requires cues to be in order, which ASS doesn't
+	sort.SliceStable(cues, func(i, j int) bool {
+		return cues[i].Start < cues[j].Start
+	})
+
+	return cues, nil
+}
+
+// ToWebVTT converts subtitles in any of the formats we know to WebVTT
+func ToWebVTT(data []byte) ([]byte, error) {
+	cues, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return WriteVTT(cues), nil
+}
+
+// WriteVTT writes the cues as a WebVTT file
+func WriteVTT(cues []Cue) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("WEBVTT\n")
+	for _, cue := range cues {
+		fmt.Fprintf(&buf, "\n%s --> %s", formatTimestamp(cue.Start), formatTimestamp(cue.End))
+		if cue.Settings != "" {
+			buf.WriteString(" " + cue.Settings)
+		}
+		buf.WriteString("\n" + cue.Text + "\n")
+	}
+
+	return buf.Bytes()
+}
+
+// formatTimestamp formats d as WebVTT's hh:mm:ss.ttt
+func formatTimestamp(d time.Duration) string {
+	ms := d.Milliseconds()
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", ms/3600000, ms/60000%60, ms/1000%60, ms%1000)
+}
+
+var timestampRe = regexp.MustCompile(`^(?:(\d+):)?(\d{1,2}):(\d{1,2})[.,](\d{1,3})$`)
+
+// parseTimestamp parses the timestamps of SRT (00:01:02,500), WebVTT (00:01:02.500 or 01:02.500) and ASS (0:01:02.50)
+func parseTimestamp(s string) (time.Duration, error) {
+	m := timestampRe.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return 0, fmt.Errorf("invalid timestamp %q", s)
+	}
+
+	// The regexp only matches digits, so these can't fail
+	var hours int
+	if m[1] != "" {
+		hours, _ = strconv.Atoi(m[1])
+	}
+	minutes, _ := strconv.Atoi(m[2])
+	seconds, _ := strconv.Atoi(m[3])
+	frac, _ := strconv.Atoi(m[4])
+
+	// The fraction is hundredths in ASS, and thousandths everywhere else
+	for i := len(m[4]); i < 3; i++ {
+		frac *= 10
+	}
+
+	return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second +
+		time.Duration(frac)*time.Millisecond, nil
+}
+
+// parseTimings parses a cue timing line, "start --> end" followed by any settings
+func parseTimings(line string) (Cue, error) {
+	spl := strings.SplitN(line, "-->", 2)
+	if len(spl) != 2 {
+		return Cue{}, fmt.Errorf("invalid cue timings %q", line)
+	}
+
+	start, err := parseTimestamp(spl[0])
+	if err != nil {
+		return Cue{}, err
+	}
+
+	rest := strings.Fields(spl[1])
+	if len(rest) == 0 {
+		return Cue{}, fmt.Errorf("invalid cue timings %q", line)
+	}
+
+	end, err := parseTimestamp(rest[0])
+	if err != nil {
+		return Cue{}, err
+	}
+
+	return Cue{Start: start, End: end, Settings: strings.Join(rest[1:], " ")}, nil
+}
+
+func appendCue(cues []Cue, cue Cue) []Cue {
+	cue.Text = strings.TrimSpace(cue.Text)
+	// A blank line would end the cue, and --> would start another one
+	cue.Text = blankLinesRe.ReplaceAllString(cue.Text, "\n")
+	cue.Text = strings.ReplaceAll(cue.Text, "-->", "--&gt;")
+	if cue.Text == "" || cue.End <= cue.Start {
+		return cues
+	}
+
+	return append(cues, cue)
+}
+
+var blankLinesRe = regexp.MustCompile(`\n\s*\n`)
+
+// trimFile removes the byte order mark and surrounding whitespace, and normalizes line endings
+func trimFile(data []byte) []byte {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	data = bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n"))
+	data = bytes.ReplaceAll(data, []byte("\r"), []byte("\n"))
+	return bytes.TrimSpace(data)
+}
+
+// splitBlocks splits a file into blocks separated by blank lines
+func splitBlocks(data []byte) []string {
+	return blankLinesRe.Split(string(data), -1)
+}
+
+var languageRe = regexp.MustCompile(`^[a-zA-Z]{2,3}(-[a-zA-Z0-9]{1,8})*$`)
+
+// ValidLanguage checks that the language looks like a BCP 47 tag, e.g. en, ja-JP or zh-Hans
+func ValidLanguage(lang string) bool {
+	return len(lang) <= 35 && languageRe.MatchString(lang)
+}
+
+// ValidKind checks that the kind is one we support
+func ValidKind(kind string) bool {
+	switch kind {
+	case KindSubtitles, KindCaptions, KindDescriptions:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+package subtitles
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSRTToWebVTT(t *testing.T) {
+	srt := "\xef\xbb\xbf1\r\n00:00:01,500 --> 00:00:03,000\r\n<i>Hello</i> <font color=\"#ff0000\">world</font>\r\n\r\n" +
+		"2\r\n00:00:04,000 --> 00:00:05,250 X1:10 X2:20\r\n{\\an8}1 < 2 & 3\r\nsecond line\r\n\r\n" +
+		"3\r\n00:00:06,000 --> 00:00:06,000\r\nzero length\r\n"
+
+	vtt, err := ToWebVTT([]byte(srt))
+	assert.NoError(t, err)
+	assert.Equal(t, "WEBVTT\n\n00:00:01.500 --> 00:00:03.000\n<i>Hello</i> world\n\n"+
+		"00:00:04.000 --> 00:00:05.250\n1 &lt; 2 &amp; 3\nsecond line\n", string(vtt))
+}
+
+func TestASSToWebVTT(t *testing.T) {
+	ass := `[Script Info]
+ScriptType: v4.00+
+
+[V4+ Styles]
+Format: Name, Fontname, Fontsize
+Style: Default,Arial,20
+
+[Events]
+Format: Layer, Start, End, Style, Name, MarginL, MarginR, MarginV, Effect, Text
+Dialogue: 0,0:00:10.00,0:00:12.50,Default,,0,0,0,,Later, with a comma
+Dialogue: 0,0:00:01.00,0:00:02.00,Default,,0,0,0,,{\pos(10,20)\c&H00FFFF&}First\Nline
+Comment: 0,0:00:03.00,0:00:04.00,Default,,0,0,0,,not shown
+`
+
+	cues, err := Parse([]byte(ass))
+	assert.NoError(t, err)
+	assert.Equal(t, []Cue{
+		{Start: time.Second, End: 2 * time.Second, Text: "First\nline"},
+		{Start: 10 * time.Second, End: 12500 * time.Millisecond, Text: "Later, with a comma"},
+	}, cues)
+}
+
+func TestWebVTTRoundTrip(t *testing.T) {
+	vtt := "WEBVTT - from youtube\nKind: captions\nLanguage: en\n\nNOTE a comment\n\nSTYLE\n::cue { color: red }\n\n" +
+		"intro\n01:02.000 --> 01:03.500 line:0 align:start\n<c>Hi</c>\n\n00:01:04.000 --> 00:01:05.000\n\n"
+
+	cues, err := Parse([]byte(vtt))
+	assert.NoError(t, err)
+	assert.Equal(t, []Cue{
+		{Start: 62 * time.Second, End: 63500 * time.Millisecond, Settings: "line:0 align:start", Text: "<c>Hi</c>"},
+	}, cues)
+
+	assert.Equal(t, "WEBVTT\n\n00:01:02.000 --> 00:01:03.500 line:0 align:start\n<c>Hi</c>\n", string(WriteVTT(cues)))
+}
+
+func TestParseErrors(t *testing.T) {
+	_, err := Parse([]byte("just some text"))
+	assert.ErrorIs(t, err, ErrUnknownFormat)
+
+	_, err = Parse([]byte("WEBVTT\n"))
+	assert.ErrorIs(t, err, ErrNoCues)
+
+	_, err = Parse([]byte("1\n00:00:01 --> 00:00:02\ntext\n"))
+	assert.Error(t, err)
+}
+
+func TestFormatTimestamp(t *testing.T) {
+	assert.Equal(t, "00:00:00.000", formatTimestamp(0))
+	assert.Equal(t, "01:02:03.045", formatTimestamp(time.Hour+2*time.Minute+3*time.Second+45*time.Millisecond))
+}
+
+func TestValidLanguage(t *testing.T) {
+	for _, lang := range []string{"en", "ja-JP", "zh-Hans", "pt-BR", "en-nP7-2PuUl7o"} {
+		assert.True(t, ValidLanguage(lang), lang)
+	}
+
+	for _, lang := range []string{"", "e", "english!", "live_chat", "../en"} {
+		assert.False(t, ValidLanguage(lang), lang)
+	}
+}
@@ -0,0 +1,40 @@
+package subtitles
+
+import (
+	"strings"
+)
+
+// parseVTT reads WebVTT, so that it can be checked and written back out without anything a player might choke on
+func parseVTT(data []byte) ([]Cue, error) {
+	var cues []Cue
+
+	// The first block is the header
+	blocks := splitBlocks(data)
+	for _, block := range blocks[1:] {
+		if strings.HasPrefix(block, "NOTE") || strings.HasPrefix(block, "STYLE") || strings.HasPrefix(block, "REGION") {
+			continue
+		}
+
+		// Cues may have an identifier before the timings
+		lines := strings.Split(block, "\n")
+		for i, line := range lines {
+			if i > 1 {
+				break
+			}
+			if !strings.Contains(line, "-->") {
+				continue
+			}
+
+			cue, err := parseTimings(line)
+			if err != nil {
+				return nil, err
+			}
+
+			cue.Text = strings.Join(lines[i+1:], "\n")
+			cues = appendCue(cues, cue)
+			break
+		}
+	}
+
+	return cues, nil
+}
@@ -0,0 +1,61 @@
+package wordfilter
+
+import (
+	"strings"
+	"unicode"
+)
+
+// This package finds banned words in user posted text. Matching ignores case and fullwidth forms, since danmaku are
+// often typed with a Japanese or Chinese IME.
+
+type Filter struct {
+	words []string
+}
+
+// New creates a filter for the given words. Blank words are ignored.
+func New(words []string) *Filter {
+	f := &Filter{}
+	for _, word := range words {
+		word = normalize(strings.TrimSpace(word))
+		if word != "" {
+			f.words = append(f.words, word)
+		}
+	}
+
+	return f
+}
+
+// Parse creates a filter from a comma separated list of words
+func Parse(list string) *Filter {
+	return New(strings.Split(list, ","))
+}
+
+// Match returns the first banned word found in text, if any
+func (f *Filter) Match(text string) (string, bool) {
+	if f == nil || len(f.words) == 0 {
+		return "", false
+	}
+
+	text = normalize(text)
+	for _, word := range f.words {
+		if strings.Contains(text, word) {
+			return word, true
+		}
+	}
+
+	return "", false
+}
+
+func normalize(s string) string {
+	return strings.Map(func(r rune) rune {
+		// Fullwidth ASCII, e.g. Ａ
+		if r >= 0xff01 && r <= 0xff5e {
+			r -= 0xfee0
+		}
+		// Ideographic space
+		if r == 0x3000 {
+			r = ' '
+		}
+		return unicode.ToLower(r)
+	}, s)
+}
@@ -0,0 +1,28 @@
+package wordfilter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch(t *testing.T) {
+	f := Parse("spoiler, Bad Word,,  ")
+
+	word, ok := f.Match("no SPOILERS please")
+	assert.True(t, ok)
+	assert.Equal(t, "spoiler", word)
+
+	_, ok = f.Match("ｂａｄ　ｗｏｒｄ")
+	assert.True(t, ok)
+
+	_, ok = f.Match("perfectly fine")
+	assert.False(t, ok)
+
+	_, ok = Parse("").Match("anything")
+	assert.False(t, ok)
+
+	var nilFilter *Filter
+	_, ok = nilFilter.Match("anything")
+	assert.False(t, ok)
+}
@@ -1,10 +1,16 @@
 package main
 
 import (
+	"context"
 	"embed"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/horahoradev/PrometheusTube/backend/video_service/internal/config"
 	"github.com/horahoradev/PrometheusTube/backend/video_service/internal/grpcserver"
+	"github.com/horahoradev/PrometheusTube/backend/video_service/internal/wordfilter"
+	"github.com/horahoradev/PrometheusTube/backend/video_service/storage"
 	_ "github.com/lib/pq"
 	log "github.com/sirupsen/logrus"
 
@@ -30,9 +36,40 @@ func main() {
 		log.Fatal(err)
 	}
 
-	err = grpcserver.NewGRPCServer(conf.BucketName, conf.SqlClient, conf.GRPCPort, conf.OriginFQDN, conf.Local,
+	// Stop taking on new work and wind down on SIGTERM, so that deploys don't kill transcodes halfway through
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stop()
+
+	err = grpcserver.NewGRPCServer(ctx, conf.BucketName, conf.SqlClient, conf.GRPCPort, conf.OriginFQDN, conf.Local,
 		conf.UserClient, conf.Tracer, conf.StorageBackend, conf.StorageAPIID, conf.StorageAPIKey,
-		conf.ApprovalThreshold, conf.StorageEndpoint, conf.MaxDLFileSize, conf.RedisConn, conf.MaxDailyUploadMB)
+		conf.ApprovalThreshold, conf.StorageEndpoint, conf.MaxDLFileSize, conf.RedisConn, conf.MaxDailyUploadMB, grpcserver.TranscodeOptions{
+			Ladder:        conf.TranscodeLadder,
+			NumWorkers:    conf.NumTranscodingWorkers,
+			MaxAttempts:   conf.MaxTranscodeAttempts,
+			LeaseDuration: conf.TranscodeLeaseDuration,
+		}, grpcserver.UploadOptions{
+			Dir: conf.UploadSessionDir,
+			TTL: conf.UploadSessionTTL,
+		}, grpcserver.FilesystemOptions{
+			Root:       conf.StorageRoot,
+			SigningKey: conf.FileSigningKey,
+			URLTTL:     conf.SignedURLTTL,
+		}, storage.Config{
+			Backend:  conf.ReplicaStorageBackend,
+			Bucket:   conf.ReplicaBucketName,
+			Endpoint: conf.ReplicaStorageEndpoint,
+			APIID:    conf.ReplicaStorageAPIID,
+			APIKey:   conf.ReplicaStorageAPIKey,
+			Root:     conf.ReplicaStorageRoot,
+		}, grpcserver.DanmakuOptions{
+			MaxPerImport:      conf.MaxDanmakuPerImport,
+			MaxPerUserPerDay:  conf.MaxDailyDanmakuImports,
+			MaxPostsPerMinute: conf.DanmakuPostsPerMinute,
+			BannedWords:       wordfilter.Parse(conf.DanmakuBannedWords),
+		}, grpcserver.SearchOptions{
+			Backend:          conf.SearchBackend,
+			ElasticsearchURL: conf.ElasticsearchURL,
+		})
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -1,7 +1,18 @@
 -- +goose Up
-CREATE EXTENSION zombodb;
+-- ZomboDB is only needed by the elasticsearch search backend, so databases without it can use the postgres one
+-- +goose StatementBegin
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM pg_available_extensions WHERE name = 'zombodb') THEN
+        CREATE EXTENSION zombodb;
+    END IF;
 
-CREATE INDEX videos_denormalized_idxx
-    ON videos_denormalized
-    USING zombodb ((videos_denormalized.*))
-    WITH (url='http://elasticsearch:9200/');
+    IF EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'zombodb') THEN
+        CREATE INDEX videos_denormalized_idxx
+            ON videos_denormalized
+            USING zombodb ((videos_denormalized.*))
+            WITH (url='http://elasticsearch:9200/');
+    END IF;
+END
+$$;
+-- +goose StatementEnd
@@ -0,0 +1,3 @@
+-- +goose Up
+-- Videos transcoded before this have an HLS master playlist where the DASH manifest should be
+ALTER TABLE videos ADD COLUMN has_hls_master BOOLEAN NOT NULL DEFAULT false;
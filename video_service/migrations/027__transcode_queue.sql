@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE videos ADD COLUMN transcode_attempts INTEGER NOT NULL DEFAULT 0;
+-- Videos which failed to transcode too many times. Separate from too_big, which is never retried.
+ALTER TABLE videos ADD COLUMN transcode_failed BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE videos ADD COLUMN transcode_last_error TEXT;
+ALTER TABLE videos ADD COLUMN transcode_lock_owner VARCHAR(255);
+-- Also used to delay retries after a failure
+ALTER TABLE videos ADD COLUMN transcode_locked_until TIMESTAMP;
+
+CREATE INDEX idx_videos_untranscoded ON videos (upload_date DESC) WHERE transcoded = false AND too_big = false AND transcode_failed = false;
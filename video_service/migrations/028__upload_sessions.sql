@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE upload_sessions (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    size BIGINT NOT NULL,
+    upload_offset BIGINT NOT NULL DEFAULT 0,
+    -- Checksum of the whole video provided by the uploader, if any
+    sha256 VARCHAR(64) NOT NULL DEFAULT '',
+    -- Serialized sha256 state for the data received so far, so that the checksum doesn't have to be recomputed from disk
+    hash_state BYTEA,
+    -- Marshaled InputFileMetadata
+    metadata BYTEA NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT Now(),
+    expires_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_upload_sessions_expires_at ON upload_sessions (expires_at);
@@ -0,0 +1,3 @@
+-- +goose Up
+-- Checksum of the original upload, computed the first time it's downloaded
+ALTER TABLE videos ADD COLUMN original_sha256 VARCHAR(64);
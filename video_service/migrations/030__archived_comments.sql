@@ -0,0 +1,7 @@
+-- +goose Up
+-- Comments imported from the original site, rather than made here
+ALTER TABLE comments ADD COLUMN is_archived boolean NOT NULL DEFAULT false;
+ALTER TABLE comments ADD COLUMN foreign_id varchar(255); /* the comment's ID on the original site */
+ALTER TABLE comments ADD COLUMN foreign_like_count int NOT NULL DEFAULT 0; /* likes on the original site when it was archived */
+
+CREATE UNIQUE INDEX comments_foreign_id_idx ON comments (video_id, foreign_id) WHERE foreign_id IS NOT NULL;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Danmaku archived from the original site, rather than posted here
+ALTER TABLE danmaku ADD COLUMN imported boolean NOT NULL DEFAULT false;
+ALTER TABLE danmaku ADD COLUMN source varchar(255); /* the site imported danmaku came from */
+
+CREATE INDEX danmaku_video_id_idx ON danmaku (video_id);
@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE subtitle_tracks (
+    id SERIAL PRIMARY KEY,
+    video_id INT NOT NULL REFERENCES videos(id),
+    -- Who uploaded the track, NULL if it was archived from the original site
+    user_id BIGINT,
+    language VARCHAR(35) NOT NULL,
+    kind VARCHAR(32) NOT NULL,
+    label VARCHAR(255) NOT NULL DEFAULT '',
+    -- Where the WebVTT file is, like videos.newlink
+    location VARCHAR(255) NOT NULL,
+    imported BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP NOT NULL DEFAULT Now(),
+    UNIQUE (video_id, language, kind)
+);
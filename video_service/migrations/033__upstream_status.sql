@@ -0,0 +1,45 @@
+-- +goose Up
+-- Whether archived videos are still available on the site they were archived from. The status is NULL until the video
+-- has been checked, and is otherwise online, removed, private, geo_blocked or auth_required (as classified by the scheduler).
+ALTER TABLE videos ADD COLUMN upstream_status VARCHAR(32);
+-- When the current status was first seen
+ALTER TABLE videos ADD COLUMN upstream_status_since TIMESTAMP;
+ALTER TABLE videos ADD COLUMN upstream_checked_at TIMESTAMP;
+-- When the scheduler should check the video next, NULL if it never has
+ALTER TABLE videos ADD COLUMN upstream_next_check TIMESTAMP;
+
+CREATE INDEX videos_upstream_next_check_idx ON videos (upstream_next_check NULLS FIRST);
+
+-- Every change of a video's upstream status
+CREATE TABLE upstream_status_history (
+    id SERIAL PRIMARY KEY,
+    video_id INT NOT NULL REFERENCES videos(id),
+    status VARCHAR(32) NOT NULL,
+    -- What the site said, e.g. yt-dlp's error
+    message TEXT NOT NULL DEFAULT '',
+    observed_at TIMESTAMP NOT NULL DEFAULT Now()
+);
+
+CREATE INDEX upstream_status_history_video_id_idx ON upstream_status_history (video_id, observed_at);
+
+-- Recreated with upstream_lost, so that video lists can be filtered by it. Dropping the view drops its index.
+DROP MATERIALIZED VIEW videos_denormalized;
+
+CREATE MATERIALIZED VIEW videos_denormalized AS
+WITH tags_arr as (select videos.id, array_agg(tags.tag) as tag_arr from videos LEFT JOIN tags on videos.id = tags.video_id GROUP BY videos.id),
+favorites_arr as (select videos.id, array_agg(favorites.user_id) as favorite_arr from videos LEFT JOIN favorites on videos.id = favorites.video_id GROUP BY videos.id),
+comments_count as (select videos.id, count(comments.*) as comment_count from videos LEFT JOIN comments on videos.id = comments.video_id GROUP BY videos.id)
+select videos.id as videoid, videos.title::text, tags_arr.tag_arr as tags, comments_count.comment_count, category, favorites_arr.favorite_arr, upload_date, userID, newLink, video_duration, views, rating, is_deleted, transcoded, too_big, is_approved, is_mature, COALESCE(upstream_status <> 'online', false) as upstream_lost from videos INNER JOIN favorites_arr ON videos.id = favorites_arr.id INNER JOIN comments_count on videos.id = comments_count.id INNER JOIN tags_arr on videos.id = tags_arr.id;
+
+-- +goose StatementBegin
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'zombodb') THEN
+        CREATE INDEX videos_denormalized_idxx
+            ON videos_denormalized
+            USING zombodb ((videos_denormalized.*))
+            WITH (url='http://elasticsearch:9200/');
+    END IF;
+END
+$$;
+-- +goose StatementEnd
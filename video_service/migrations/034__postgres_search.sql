@@ -0,0 +1,21 @@
+-- +goose Up
+-- Indexes for the postgres search backend. Search terms are matched as words through the full text index, and as part
+-- of the title or tags through the trigram index, which is what finds Japanese titles since they aren't split into
+-- words. pg_bigm can be swapped in for pg_trgm where it's installed, it's better at one and two character terms.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- array_to_string isn't immutable, so it can't be indexed directly
+-- +goose StatementBegin
+CREATE FUNCTION video_search_text(title text, tags varchar[]) RETURNS text
+    LANGUAGE sql IMMUTABLE PARALLEL SAFE AS $$
+    SELECT COALESCE(title, '') || ' ' || COALESCE(array_to_string(tags, ' '), '')
+$$;
+-- +goose StatementEnd
+
+CREATE INDEX videos_denormalized_search_tsv_idx
+    ON videos_denormalized
+    USING gin (to_tsvector('simple', video_search_text(title, tags)));
+
+CREATE INDEX videos_denormalized_search_trgm_idx
+    ON videos_denormalized
+    USING gin (video_search_text(title, tags) gin_trgm_ops);
@@ -0,0 +1,39 @@
+-- +goose Up
+-- When archived videos were uploaded to the site they came from
+ALTER TABLE videos ADD COLUMN original_upload_date DATE;
+
+-- Recreated with the columns video lists are filtered and faceted by. Sites are named rather than stored by their old
+-- enum values, and videos which weren't archived are from "domestic". Dropping the view drops its indexes.
+DROP MATERIALIZED VIEW videos_denormalized;
+
+CREATE MATERIALIZED VIEW videos_denormalized AS
+WITH tags_arr as (select videos.id, array_agg(tags.tag) as tag_arr from videos LEFT JOIN tags on videos.id = tags.video_id GROUP BY videos.id),
+favorites_arr as (select videos.id, array_agg(favorites.user_id) as favorite_arr from videos LEFT JOIN favorites on videos.id = favorites.video_id GROUP BY videos.id),
+comments_count as (select videos.id, count(comments.*) as comment_count from videos LEFT JOIN comments on videos.id = comments.video_id GROUP BY videos.id)
+select videos.id as videoid, videos.title::text, tags_arr.tag_arr as tags, comments_count.comment_count, category, favorites_arr.favorite_arr, upload_date, userID, newLink, video_duration, views, rating, is_deleted, transcoded, too_big, is_approved, is_mature, COALESCE(upstream_status <> 'online', false) as upstream_lost,
+    (CASE originalSite WHEN '0' THEN 'nicovideo' WHEN '1' THEN 'bilibili' WHEN '2' THEN 'youtube' WHEN 'blank' THEN 'domestic' ELSE COALESCE(originalSite, 'domestic') END)::varchar(255) as site,
+    (CASE WHEN COALESCE(video_duration, 0) < 240 THEN 'short' WHEN video_duration < 1200 THEN 'medium' ELSE 'long' END)::varchar(16) as duration_bucket,
+    original_upload_date,
+    EXISTS (SELECT 1 FROM danmaku WHERE danmaku.video_id = videos.id) as has_danmaku
+from videos INNER JOIN favorites_arr ON videos.id = favorites_arr.id INNER JOIN comments_count on videos.id = comments_count.id INNER JOIN tags_arr on videos.id = tags_arr.id;
+
+-- +goose StatementBegin
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'zombodb') THEN
+        CREATE INDEX videos_denormalized_idxx
+            ON videos_denormalized
+            USING zombodb ((videos_denormalized.*))
+            WITH (url='http://elasticsearch:9200/');
+    END IF;
+END
+$$;
+-- +goose StatementEnd
+
+CREATE INDEX videos_denormalized_search_tsv_idx
+    ON videos_denormalized
+    USING gin (to_tsvector('simple', video_search_text(title, tags)));
+
+CREATE INDEX videos_denormalized_search_trgm_idx
+    ON videos_denormalized
+    USING gin (video_search_text(title, tags) gin_trgm_ops);
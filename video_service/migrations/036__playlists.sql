@@ -0,0 +1,29 @@
+-- +goose Up
+ALTER TABLE favorites ADD COLUMN created_at TIMESTAMP NOT NULL DEFAULT Now();
+CREATE INDEX favorites_user_idx ON favorites (user_id, created_at);
+
+-- Named, ordered lists of videos, like niconico's mylists
+CREATE TABLE playlists (
+    id SERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description VARCHAR(4096) NOT NULL DEFAULT '',
+    -- public playlists are listed on their owner's profile, unlisted ones can be seen by anyone with the link, and
+    -- private ones only by their owner
+    visibility VARCHAR(16) NOT NULL DEFAULT 'private' CHECK (visibility IN ('public', 'unlisted', 'private')),
+    created_at TIMESTAMP NOT NULL DEFAULT Now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT Now()
+);
+CREATE INDEX playlists_user_idx ON playlists (user_id);
+
+CREATE TABLE playlist_entries (
+    playlist_id INT NOT NULL REFERENCES playlists(id) ON DELETE CASCADE,
+    video_id INT NOT NULL REFERENCES videos(id),
+    -- Positions run from 1 without gaps. The constraint is deferred so that entries can be moved within a transaction.
+    position INT NOT NULL,
+    note VARCHAR(1024) NOT NULL DEFAULT '',
+    added_at TIMESTAMP NOT NULL DEFAULT Now(),
+    PRIMARY KEY (playlist_id, video_id),
+    UNIQUE (playlist_id, position) DEFERRABLE INITIALLY DEFERRED
+);
+CREATE INDEX playlist_entries_video_idx ON playlist_entries (video_id);
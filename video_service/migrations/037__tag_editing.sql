@@ -0,0 +1,19 @@
+-- +goose Up
+-- Locked tags can only be removed by the video's uploader or a moderator
+ALTER TABLE tags ADD COLUMN locked BOOLEAN NOT NULL DEFAULT false;
+
+-- Every change to a video's tags, with the tags before and after it so that changes can be reverted
+CREATE TABLE tag_edits (
+    id SERIAL PRIMARY KEY,
+    video_id INT NOT NULL REFERENCES videos(id),
+    user_id BIGINT NOT NULL,
+    action VARCHAR(16) NOT NULL CHECK (action IN ('add', 'remove', 'lock', 'unlock', 'revert')),
+    -- The tag which was added, removed, locked or unlocked. Empty for reverts.
+    tag VARCHAR(60) NOT NULL DEFAULT '',
+    -- For reverts, the edit the tags were reverted to before
+    reverted_edit_id INT REFERENCES tag_edits(id),
+    tags_before VARCHAR(60)[] NOT NULL,
+    tags_after VARCHAR(60)[] NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT Now()
+);
+CREATE INDEX tag_edits_video_idx ON tag_edits (video_id, id);
@@ -0,0 +1,3 @@
+-- +goose Up
+-- Set while a session's video is being saved, so that the session doesn't have to stay locked meanwhile
+ALTER TABLE upload_sessions ADD COLUMN finalizing_at TIMESTAMP;
@@ -0,0 +1,5 @@
+-- +goose Up
+-- Sessions are locked with a short lease, kept alive while a chunk is being received, instead of a row lock held by a
+-- transaction for the whole request
+ALTER TABLE upload_sessions ADD COLUMN lock_owner VARCHAR(36);
+ALTER TABLE upload_sessions ADD COLUMN locked_until TIMESTAMP;
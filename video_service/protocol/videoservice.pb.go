@@ -225,6 +225,8 @@ type Danmaku struct {
 	Color     string `protobuf:"bytes,6,opt,name=color,proto3" json:"color,omitempty"`
 	FontSize  string `protobuf:"bytes,7,opt,name=font_size,json=fontSize,proto3" json:"font_size,omitempty"`
 	Id        int64  `protobuf:"varint,8,opt,name=id,proto3" json:"id,omitempty"`
+	Imported  bool   `protobuf:"varint,9,opt,name=imported,proto3" json:"imported,omitempty"` // Archived from the original site rather than posted here
+	Source    string `protobuf:"bytes,10,opt,name=source,proto3" json:"source,omitempty"`     // The site imported danmaku came from
 }
 
 func (x *Danmaku) Reset() {
@@ -315,17 +317,34 @@ func (x *Danmaku) GetId() int64 {
 	return 0
 }
 
-type FavoriteReq struct {
+func (x *Danmaku) GetImported() bool {
+	if x != nil {
+		return x.Imported
+	}
+	return false
+}
+
+func (x *Danmaku) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// Deletes the danmaku with the given IDs, or if there aren't any, all of the author's danmaku (only on the given video
+// if video_id is set). The deleted danmaku are returned.
+type DanmakuDeleteReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId  int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	VideoId int64 `protobuf:"varint,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Ids      []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	AuthorId int64   `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	VideoId  int64   `protobuf:"varint,3,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
 }
 
-func (x *FavoriteReq) Reset() {
-	*x = FavoriteReq{}
+func (x *DanmakuDeleteReq) Reset() {
+	*x = DanmakuDeleteReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_videoservice_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -333,13 +352,13 @@ func (x *FavoriteReq) Reset() {
 	}
 }
 
-func (x *FavoriteReq) String() string {
+func (x *DanmakuDeleteReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FavoriteReq) ProtoMessage() {}
+func (*DanmakuDeleteReq) ProtoMessage() {}
 
-func (x *FavoriteReq) ProtoReflect() protoreflect.Message {
+func (x *DanmakuDeleteReq) ProtoReflect() protoreflect.Message {
 	mi := &file_videoservice_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -351,36 +370,45 @@ func (x *FavoriteReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FavoriteReq.ProtoReflect.Descriptor instead.
-func (*FavoriteReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DanmakuDeleteReq.ProtoReflect.Descriptor instead.
+func (*DanmakuDeleteReq) Descriptor() ([]byte, []int) {
 	return file_videoservice_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *FavoriteReq) GetUserId() int64 {
+func (x *DanmakuDeleteReq) GetIds() []int64 {
 	if x != nil {
-		return x.UserId
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *DanmakuDeleteReq) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
 	}
 	return 0
 }
 
-func (x *FavoriteReq) GetVideoId() int64 {
+func (x *DanmakuDeleteReq) GetVideoId() int64 {
 	if x != nil {
 		return x.VideoId
 	}
 	return 0
 }
 
-type FeedReq struct {
+type DanmakuImportReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FollowedUsers []int64 `protobuf:"varint,1,rep,packed,name=followed_users,json=followedUsers,proto3" json:"followed_users,omitempty"`
-	ShowMature    bool    `protobuf:"varint,2,opt,name=showMature,proto3" json:"showMature,omitempty"`
+	VideoId  int64      `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	UserId   int64      `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Source   string     `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"` // The format the danmaku were imported from
+	Comments []*Danmaku `protobuf:"bytes,4,rep,name=comments,proto3" json:"comments,omitempty"`
 }
 
-func (x *FeedReq) Reset() {
-	*x = FeedReq{}
+func (x *DanmakuImportReq) Reset() {
+	*x = DanmakuImportReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_videoservice_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -388,13 +416,13 @@ func (x *FeedReq) Reset() {
 	}
 }
 
-func (x *FeedReq) String() string {
+func (x *DanmakuImportReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeedReq) ProtoMessage() {}
+func (*DanmakuImportReq) ProtoMessage() {}
 
-func (x *FeedReq) ProtoReflect() protoreflect.Message {
+func (x *DanmakuImportReq) ProtoReflect() protoreflect.Message {
 	mi := &file_videoservice_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -406,35 +434,49 @@ func (x *FeedReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FeedReq.ProtoReflect.Descriptor instead.
-func (*FeedReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DanmakuImportReq.ProtoReflect.Descriptor instead.
+func (*DanmakuImportReq) Descriptor() ([]byte, []int) {
 	return file_videoservice_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *FeedReq) GetFollowedUsers() []int64 {
+func (x *DanmakuImportReq) GetVideoId() int64 {
 	if x != nil {
-		return x.FollowedUsers
+		return x.VideoId
 	}
-	return nil
+	return 0
 }
 
-func (x *FeedReq) GetShowMature() bool {
+func (x *DanmakuImportReq) GetUserId() int64 {
 	if x != nil {
-		return x.ShowMature
+		return x.UserId
 	}
-	return false
+	return 0
 }
 
-type CategoryList struct {
+func (x *DanmakuImportReq) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *DanmakuImportReq) GetComments() []*Danmaku {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+type DanmakuImportResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Categories []*Category `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	Imported int64 `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
 }
 
-func (x *CategoryList) Reset() {
-	*x = CategoryList{}
+func (x *DanmakuImportResp) Reset() {
+	*x = DanmakuImportResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_videoservice_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -442,13 +484,13 @@ func (x *CategoryList) Reset() {
 	}
 }
 
-func (x *CategoryList) String() string {
+func (x *DanmakuImportResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoryList) ProtoMessage() {}
+func (*DanmakuImportResp) ProtoMessage() {}
 
-func (x *CategoryList) ProtoReflect() protoreflect.Message {
+func (x *DanmakuImportResp) ProtoReflect() protoreflect.Message {
 	mi := &file_videoservice_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -460,29 +502,34 @@ func (x *CategoryList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoryList.ProtoReflect.Descriptor instead.
-func (*CategoryList) Descriptor() ([]byte, []int) {
+// Deprecated: Use DanmakuImportResp.ProtoReflect.Descriptor instead.
+func (*DanmakuImportResp) Descriptor() ([]byte, []int) {
 	return file_videoservice_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CategoryList) GetCategories() []*Category {
+func (x *DanmakuImportResp) GetImported() int64 {
 	if x != nil {
-		return x.Categories
+		return x.Imported
 	}
-	return nil
+	return 0
 }
 
-type Category struct {
+type SubtitleTrack struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Cardinality uint64 `protobuf:"varint,2,opt,name=cardinality,proto3" json:"cardinality,omitempty"`
+	Id       int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	VideoId  int64  `protobuf:"varint,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Language string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"` // BCP 47, e.g. en or ja-JP
+	Kind     string `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`         // subtitles, captions or descriptions, as for the HTML track element
+	Label    string `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
+	Location string `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`  // The WebVTT file
+	Imported bool   `protobuf:"varint,7,opt,name=imported,proto3" json:"imported,omitempty"` // Whether the track was archived from the original site
 }
 
-func (x *Category) Reset() {
-	*x = Category{}
+func (x *SubtitleTrack) Reset() {
+	*x = SubtitleTrack{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_videoservice_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -490,13 +537,13 @@ func (x *Category) Reset() {
 	}
 }
 
-func (x *Category) String() string {
+func (x *SubtitleTrack) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Category) ProtoMessage() {}
+func (*SubtitleTrack) ProtoMessage() {}
 
-func (x *Category) ProtoReflect() protoreflect.Message {
+func (x *SubtitleTrack) ProtoReflect() protoreflect.Message {
 	mi := &file_videoservice_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -508,35 +555,70 @@ func (x *Category) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Category.ProtoReflect.Descriptor instead.
-func (*Category) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubtitleTrack.ProtoReflect.Descriptor instead.
+func (*SubtitleTrack) Descriptor() ([]byte, []int) {
 	return file_videoservice_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Category) GetName() string {
+func (x *SubtitleTrack) GetId() int64 {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *Category) GetCardinality() uint64 {
+func (x *SubtitleTrack) GetVideoId() int64 {
 	if x != nil {
-		return x.Cardinality
+		return x.VideoId
 	}
 	return 0
 }
 
-type VideoDeletionReq struct {
+func (x *SubtitleTrack) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *SubtitleTrack) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SubtitleTrack) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SubtitleTrack) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *SubtitleTrack) GetImported() bool {
+	if x != nil {
+		return x.Imported
+	}
+	return false
+}
+
+type SubtitleTrackList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VideoID string `protobuf:"bytes,1,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	Tracks []*SubtitleTrack `protobuf:"bytes,1,rep,name=tracks,proto3" json:"tracks,omitempty"`
 }
 
-func (x *VideoDeletionReq) Reset() {
-	*x = VideoDeletionReq{}
+func (x *SubtitleTrackList) Reset() {
+	*x = SubtitleTrackList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_videoservice_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -544,13 +626,13 @@ func (x *VideoDeletionReq) Reset() {
 	}
 }
 
-func (x *VideoDeletionReq) String() string {
+func (x *SubtitleTrackList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VideoDeletionReq) ProtoMessage() {}
+func (*SubtitleTrackList) ProtoMessage() {}
 
-func (x *VideoDeletionReq) ProtoReflect() protoreflect.Message {
+func (x *SubtitleTrackList) ProtoReflect() protoreflect.Message {
 	mi := &file_videoservice_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -562,26 +644,33 @@ func (x *VideoDeletionReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VideoDeletionReq.ProtoReflect.Descriptor instead.
-func (*VideoDeletionReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubtitleTrackList.ProtoReflect.Descriptor instead.
+func (*SubtitleTrackList) Descriptor() ([]byte, []int) {
 	return file_videoservice_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *VideoDeletionReq) GetVideoID() string {
+func (x *SubtitleTrackList) GetTracks() []*SubtitleTrack {
 	if x != nil {
-		return x.VideoID
+		return x.Tracks
 	}
-	return ""
+	return nil
 }
 
-type Nothing struct {
+type SubtitleUpload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	VideoId  int64  `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	UserId   int64  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Language string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	Kind     string `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"` // subtitles if empty
+	Label    string `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
+	Data     []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"` // SRT, ASS or WebVTT
 }
 
-func (x *Nothing) Reset() {
-	*x = Nothing{}
+func (x *SubtitleUpload) Reset() {
+	*x = SubtitleUpload{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_videoservice_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -589,13 +678,13 @@ func (x *Nothing) Reset() {
 	}
 }
 
-func (x *Nothing) String() string {
+func (x *SubtitleUpload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Nothing) ProtoMessage() {}
+func (*SubtitleUpload) ProtoMessage() {}
 
-func (x *Nothing) ProtoReflect() protoreflect.Message {
+func (x *SubtitleUpload) ProtoReflect() protoreflect.Message {
 	mi := &file_videoservice_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -607,23 +696,65 @@ func (x *Nothing) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Nothing.ProtoReflect.Descriptor instead.
-func (*Nothing) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubtitleUpload.ProtoReflect.Descriptor instead.
+func (*SubtitleUpload) Descriptor() ([]byte, []int) {
 	return file_videoservice_proto_rawDescGZIP(), []int{8}
 }
 
-type RecReq struct {
+func (x *SubtitleUpload) GetVideoId() int64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *SubtitleUpload) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SubtitleUpload) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *SubtitleUpload) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SubtitleUpload) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SubtitleUpload) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SubtitleDeleteReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId     int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	VideoId    int64 `protobuf:"varint,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
-	ShowMature bool  `protobuf:"varint,3,opt,name=showMature,proto3" json:"showMature,omitempty"`
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// If set, the track is only deleted if it's for one of this user's videos
+	VideoAuthorId int64 `protobuf:"varint,2,opt,name=video_author_id,json=videoAuthorId,proto3" json:"video_author_id,omitempty"`
 }
 
-func (x *RecReq) Reset() {
-	*x = RecReq{}
+func (x *SubtitleDeleteReq) Reset() {
+	*x = SubtitleDeleteReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_videoservice_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -631,13 +762,13 @@ func (x *RecReq) Reset() {
 	}
 }
 
-func (x *RecReq) String() string {
+func (x *SubtitleDeleteReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecReq) ProtoMessage() {}
+func (*SubtitleDeleteReq) ProtoMessage() {}
 
-func (x *RecReq) ProtoReflect() protoreflect.Message {
+func (x *SubtitleDeleteReq) ProtoReflect() protoreflect.Message {
 	mi := &file_videoservice_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -649,42 +780,39 @@ func (x *RecReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecReq.ProtoReflect.Descriptor instead.
-func (*RecReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubtitleDeleteReq.ProtoReflect.Descriptor instead.
+func (*SubtitleDeleteReq) Descriptor() ([]byte, []int) {
 	return file_videoservice_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *RecReq) GetUserId() int64 {
+func (x *SubtitleDeleteReq) GetId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *RecReq) GetVideoId() int64 {
+func (x *SubtitleDeleteReq) GetVideoAuthorId() int64 {
 	if x != nil {
-		return x.VideoId
+		return x.VideoAuthorId
 	}
 	return 0
 }
 
-func (x *RecReq) GetShowMature() bool {
-	if x != nil {
-		return x.ShowMature
-	}
-	return false
-}
-
-type RecResp struct {
+type UpstreamCheckClaim struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Videos []*Video `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// How long the claimed videos are held for before they can be claimed again
+	LeaseSeconds int64 `protobuf:"varint,2,opt,name=lease_seconds,json=leaseSeconds,proto3" json:"lease_seconds,omitempty"`
+	// Videos which have never been checked are due this long after they were archived
+	IntervalSeconds int64 `protobuf:"varint,3,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
 }
 
-func (x *RecResp) Reset() {
-	*x = RecResp{}
+func (x *UpstreamCheckClaim) Reset() {
+	*x = UpstreamCheckClaim{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_videoservice_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -692,13 +820,13 @@ func (x *RecResp) Reset() {
 	}
 }
 
-func (x *RecResp) String() string {
+func (x *UpstreamCheckClaim) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecResp) ProtoMessage() {}
+func (*UpstreamCheckClaim) ProtoMessage() {}
 
-func (x *RecResp) ProtoReflect() protoreflect.Message {
+func (x *UpstreamCheckClaim) ProtoReflect() protoreflect.Message {
 	mi := &file_videoservice_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -710,30 +838,43 @@ func (x *RecResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecResp.ProtoReflect.Descriptor instead.
-func (*RecResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpstreamCheckClaim.ProtoReflect.Descriptor instead.
+func (*UpstreamCheckClaim) Descriptor() ([]byte, []int) {
 	return file_videoservice_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *RecResp) GetVideos() []*Video {
+func (x *UpstreamCheckClaim) GetLimit() int64 {
 	if x != nil {
-		return x.Videos
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-type VideoRec struct {
+func (x *UpstreamCheckClaim) GetLeaseSeconds() int64 {
+	if x != nil {
+		return x.LeaseSeconds
+	}
+	return 0
+}
+
+func (x *UpstreamCheckClaim) GetIntervalSeconds() int64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+type UpstreamCheck struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ThumbnailLoc string `protobuf:"bytes,1,opt,name=thumbnailLoc,proto3" json:"thumbnailLoc,omitempty"`
-	VideoTitle   string `protobuf:"bytes,2,opt,name=videoTitle,proto3" json:"videoTitle,omitempty"`
-	VideoID      int64  `protobuf:"varint,3,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	VideoId int64  `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Url     string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"` // The original video link
 }
 
-func (x *VideoRec) Reset() {
-	*x = VideoRec{}
+func (x *UpstreamCheck) Reset() {
+	*x = UpstreamCheck{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_videoservice_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -741,13 +882,13 @@ func (x *VideoRec) Reset() {
 	}
 }
 
-func (x *VideoRec) String() string {
+func (x *UpstreamCheck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VideoRec) ProtoMessage() {}
+func (*UpstreamCheck) ProtoMessage() {}
 
-func (x *VideoRec) ProtoReflect() protoreflect.Message {
+func (x *UpstreamCheck) ProtoReflect() protoreflect.Message {
 	mi := &file_videoservice_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -759,45 +900,35 @@ func (x *VideoRec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VideoRec.ProtoReflect.Descriptor instead.
-func (*VideoRec) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpstreamCheck.ProtoReflect.Descriptor instead.
+func (*UpstreamCheck) Descriptor() ([]byte, []int) {
 	return file_videoservice_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *VideoRec) GetThumbnailLoc() string {
+func (x *UpstreamCheck) GetVideoId() int64 {
 	if x != nil {
-		return x.ThumbnailLoc
+		return x.VideoId
 	}
-	return ""
+	return 0
 }
 
-func (x *VideoRec) GetVideoTitle() string {
+func (x *UpstreamCheck) GetUrl() string {
 	if x != nil {
-		return x.VideoTitle
+		return x.Url
 	}
 	return ""
 }
 
-func (x *VideoRec) GetVideoID() int64 {
-	if x != nil {
-		return x.VideoID
-	}
-	return 0
-}
-
-type VideoComment struct {
+type UpstreamCheckList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId        int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	VideoId       int64  `protobuf:"varint,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
-	Comment       string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
-	ParentComment int64  `protobuf:"varint,4,opt,name=parent_comment,json=parentComment,proto3" json:"parent_comment,omitempty"` // 0 if root
+	Checks []*UpstreamCheck `protobuf:"bytes,1,rep,name=checks,proto3" json:"checks,omitempty"`
 }
 
-func (x *VideoComment) Reset() {
-	*x = VideoComment{}
+func (x *UpstreamCheckList) Reset() {
+	*x = UpstreamCheckList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_videoservice_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -805,13 +936,13 @@ func (x *VideoComment) Reset() {
 	}
 }
 
-func (x *VideoComment) String() string {
+func (x *UpstreamCheckList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VideoComment) ProtoMessage() {}
+func (*UpstreamCheckList) ProtoMessage() {}
 
-func (x *VideoComment) ProtoReflect() protoreflect.Message {
+func (x *UpstreamCheckList) ProtoReflect() protoreflect.Message {
 	mi := &file_videoservice_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -823,51 +954,33 @@ func (x *VideoComment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VideoComment.ProtoReflect.Descriptor instead.
-func (*VideoComment) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpstreamCheckList.ProtoReflect.Descriptor instead.
+func (*UpstreamCheckList) Descriptor() ([]byte, []int) {
 	return file_videoservice_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *VideoComment) GetUserId() int64 {
+func (x *UpstreamCheckList) GetChecks() []*UpstreamCheck {
 	if x != nil {
-		return x.UserId
+		return x.Checks
 	}
-	return 0
-}
-
-func (x *VideoComment) GetVideoId() int64 {
-	if x != nil {
-		return x.VideoId
-	}
-	return 0
-}
-
-func (x *VideoComment) GetComment() string {
-	if x != nil {
-		return x.Comment
-	}
-	return ""
-}
-
-func (x *VideoComment) GetParentComment() int64 {
-	if x != nil {
-		return x.ParentComment
-	}
-	return 0
+	return nil
 }
 
-// Can add pagination in the future if needed (probably fine without it)
-type CommentRequest struct {
+type UpstreamStatusReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VideoID    int64 `protobuf:"varint,1,opt,name=videoID,proto3" json:"videoID,omitempty"`
-	CurrUserID int64 `protobuf:"varint,2,opt,name=currUserID,proto3" json:"currUserID,omitempty"`
+	VideoId int64 `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	// online, removed, private, geo_blocked or auth_required. Empty if the check was inconclusive, in which case the
+	// video is only rescheduled.
+	Status              string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Message             string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"` // What the site said
+	RecheckAfterSeconds int64  `protobuf:"varint,4,opt,name=recheck_after_seconds,json=recheckAfterSeconds,proto3" json:"recheck_after_seconds,omitempty"`
 }
 
-func (x *CommentRequest) Reset() {
-	*x = CommentRequest{}
+func (x *UpstreamStatusReport) Reset() {
+	*x = UpstreamStatusReport{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_videoservice_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -875,13 +988,13 @@ func (x *CommentRequest) Reset() {
 	}
 }
 
-func (x *CommentRequest) String() string {
+func (x *UpstreamStatusReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommentRequest) ProtoMessage() {}
+func (*UpstreamStatusReport) ProtoMessage() {}
 
-func (x *CommentRequest) ProtoReflect() protoreflect.Message {
+func (x *UpstreamStatusReport) ProtoReflect() protoreflect.Message {
 	mi := &file_videoservice_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -893,37 +1006,49 @@ func (x *CommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommentRequest.ProtoReflect.Descriptor instead.
-func (*CommentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpstreamStatusReport.ProtoReflect.Descriptor instead.
+func (*UpstreamStatusReport) Descriptor() ([]byte, []int) {
 	return file_videoservice_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CommentRequest) GetVideoID() int64 {
+func (x *UpstreamStatusReport) GetVideoId() int64 {
 	if x != nil {
-		return x.VideoID
+		return x.VideoId
 	}
 	return 0
 }
 
-func (x *CommentRequest) GetCurrUserID() int64 {
+func (x *UpstreamStatusReport) GetStatus() string {
 	if x != nil {
-		return x.CurrUserID
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpstreamStatusReport) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpstreamStatusReport) GetRecheckAfterSeconds() int64 {
+	if x != nil {
+		return x.RecheckAfterSeconds
 	}
 	return 0
 }
 
-type CommentUpvote struct {
+type VideoTagsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CommentId int64 `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	Score     int64 `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"` // -1, 0, 1
+	VideoId int64 `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
 }
 
-func (x *CommentUpvote) Reset() {
-	*x = CommentUpvote{}
+func (x *VideoTagsReq) Reset() {
+	*x = VideoTagsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_videoservice_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -931,13 +1056,13 @@ func (x *CommentUpvote) Reset() {
 	}
 }
 
-func (x *CommentUpvote) String() string {
+func (x *VideoTagsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommentUpvote) ProtoMessage() {}
+func (*VideoTagsReq) ProtoMessage() {}
 
-func (x *CommentUpvote) ProtoReflect() protoreflect.Message {
+func (x *VideoTagsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_videoservice_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -949,42 +1074,29 @@ func (x *CommentUpvote) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommentUpvote.ProtoReflect.Descriptor instead.
-func (*CommentUpvote) Descriptor() ([]byte, []int) {
+// Deprecated: Use VideoTagsReq.ProtoReflect.Descriptor instead.
+func (*VideoTagsReq) Descriptor() ([]byte, []int) {
 	return file_videoservice_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CommentUpvote) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *CommentUpvote) GetCommentId() int64 {
-	if x != nil {
-		return x.CommentId
-	}
-	return 0
-}
-
-func (x *CommentUpvote) GetScore() int64 {
+func (x *VideoTagsReq) GetVideoId() int64 {
 	if x != nil {
-		return x.Score
+		return x.VideoId
 	}
 	return 0
 }
 
-type CommentListResponse struct {
+type VideoTag struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Comments []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	Tag    string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Locked bool   `protobuf:"varint,2,opt,name=locked,proto3" json:"locked,omitempty"`
 }
 
-func (x *CommentListResponse) Reset() {
-	*x = CommentListResponse{}
+func (x *VideoTag) Reset() {
+	*x = VideoTag{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_videoservice_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -992,13 +1104,13 @@ func (x *CommentListResponse) Reset() {
 	}
 }
 
-func (x *CommentListResponse) String() string {
+func (x *VideoTag) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommentListResponse) ProtoMessage() {}
+func (*VideoTag) ProtoMessage() {}
 
-func (x *CommentListResponse) ProtoReflect() protoreflect.Message {
+func (x *VideoTag) ProtoReflect() protoreflect.Message {
 	mi := &file_videoservice_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1010,37 +1122,36 @@ func (x *CommentListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommentListResponse.ProtoReflect.Descriptor instead.
-func (*CommentListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use VideoTag.ProtoReflect.Descriptor instead.
+func (*VideoTag) Descriptor() ([]byte, []int) {
 	return file_videoservice_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CommentListResponse) GetComments() []*Comment {
+func (x *VideoTag) GetTag() string {
 	if x != nil {
-		return x.Comments
+		return x.Tag
 	}
-	return nil
+	return ""
 }
 
-type Comment struct {
+func (x *VideoTag) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+type VideoTags struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CommentId               int64  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	CreationDate            string `protobuf:"bytes,2,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
-	Content                 string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	AuthorUsername          string `protobuf:"bytes,4,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"`
-	AuthorProfileImageUrl   string `protobuf:"bytes,5,opt,name=author_profile_image_url,json=authorProfileImageUrl,proto3" json:"author_profile_image_url,omitempty"`
-	VoteScore               int64  `protobuf:"varint,6,opt,name=vote_score,json=voteScore,proto3" json:"vote_score,omitempty"`
-	CurrentUserHasUpvoted   bool   `protobuf:"varint,7,opt,name=current_user_has_upvoted,json=currentUserHasUpvoted,proto3" json:"current_user_has_upvoted,omitempty"`
-	CurrentUserHasDownvoted bool   `protobuf:"varint,8,opt,name=current_user_has_downvoted,json=currentUserHasDownvoted,proto3" json:"current_user_has_downvoted,omitempty"`
-	AuthorId                int64  `protobuf:"varint,9,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	ParentId                int64  `protobuf:"varint,10,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	VideoId int64       `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Tags    []*VideoTag `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
-func (x *Comment) Reset() {
-	*x = Comment{}
+func (x *VideoTags) Reset() {
+	*x = VideoTags{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_videoservice_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1048,13 +1159,13 @@ func (x *Comment) Reset() {
 	}
 }
 
-func (x *Comment) String() string {
+func (x *VideoTags) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Comment) ProtoMessage() {}
+func (*VideoTags) ProtoMessage() {}
 
-func (x *Comment) ProtoReflect() protoreflect.Message {
+func (x *VideoTags) ProtoReflect() protoreflect.Message {
 	mi := &file_videoservice_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1066,119 +1177,132 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
-func (*Comment) Descriptor() ([]byte, []int) {
+// Deprecated: Use VideoTags.ProtoReflect.Descriptor instead.
+func (*VideoTags) Descriptor() ([]byte, []int) {
 	return file_videoservice_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Comment) GetCommentId() int64 {
+func (x *VideoTags) GetVideoId() int64 {
 	if x != nil {
-		return x.CommentId
+		return x.VideoId
 	}
 	return 0
 }
 
-func (x *Comment) GetCreationDate() string {
+func (x *VideoTags) GetTags() []*VideoTag {
 	if x != nil {
-		return x.CreationDate
+		return x.Tags
 	}
-	return ""
+	return nil
 }
 
-func (x *Comment) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
+type TagEditReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VideoId   int64  `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	UserId    int64  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Moderator bool   `protobuf:"varint,3,opt,name=moderator,proto3" json:"moderator,omitempty"` // Whether the user is a moderator
+	Tag       string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	Locked    bool   `protobuf:"varint,5,opt,name=locked,proto3" json:"locked,omitempty"` // For setTagLock
 }
 
-func (x *Comment) GetAuthorUsername() string {
-	if x != nil {
-		return x.AuthorUsername
+func (x *TagEditReq) Reset() {
+	*x = TagEditReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Comment) GetAuthorProfileImageUrl() string {
-	if x != nil {
-		return x.AuthorProfileImageUrl
+func (x *TagEditReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagEditReq) ProtoMessage() {}
+
+func (x *TagEditReq) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Comment) GetVoteScore() int64 {
+// Deprecated: Use TagEditReq.ProtoReflect.Descriptor instead.
+func (*TagEditReq) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TagEditReq) GetVideoId() int64 {
 	if x != nil {
-		return x.VoteScore
+		return x.VideoId
 	}
 	return 0
 }
 
-func (x *Comment) GetCurrentUserHasUpvoted() bool {
+func (x *TagEditReq) GetUserId() int64 {
 	if x != nil {
-		return x.CurrentUserHasUpvoted
+		return x.UserId
 	}
-	return false
+	return 0
 }
 
-func (x *Comment) GetCurrentUserHasDownvoted() bool {
+func (x *TagEditReq) GetModerator() bool {
 	if x != nil {
-		return x.CurrentUserHasDownvoted
+		return x.Moderator
 	}
 	return false
 }
 
-func (x *Comment) GetAuthorId() int64 {
+func (x *TagEditReq) GetTag() string {
 	if x != nil {
-		return x.AuthorId
+		return x.Tag
 	}
-	return 0
+	return ""
 }
 
-func (x *Comment) GetParentId() int64 {
+func (x *TagEditReq) GetLocked() bool {
 	if x != nil {
-		return x.ParentId
+		return x.Locked
 	}
-	return 0
+	return false
 }
 
-type VideoMetadata struct {
+type TagRevertReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VideoLoc      string   `protobuf:"bytes,1,opt,name=videoLoc,proto3" json:"videoLoc,omitempty"` // The location of the DASH manifest
-	VideoTitle    string   `protobuf:"bytes,2,opt,name=videoTitle,proto3" json:"videoTitle,omitempty"`
-	Rating        int64    `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
-	AuthorName    string   `protobuf:"bytes,4,opt,name=authorName,proto3" json:"authorName,omitempty"` // Do I need this? probably not
-	Views         uint64   `protobuf:"varint,5,opt,name=views,proto3" json:"views,omitempty"`
-	VideoID       int64    `protobuf:"varint,6,opt,name=videoID,proto3" json:"videoID,omitempty"`
-	UploadDate    string   `protobuf:"bytes,7,opt,name=uploadDate,proto3" json:"uploadDate,omitempty"`
-	Description   string   `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
-	AuthorID      int64    `protobuf:"varint,9,opt,name=authorID,proto3" json:"authorID,omitempty"`
-	Tags          []string `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
-	VideoDuration float32  `protobuf:"fixed32,11,opt,name=videoDuration,proto3" json:"videoDuration,omitempty"`
-	Category      string   `protobuf:"bytes,12,opt,name=category,proto3" json:"category,omitempty"`
-	Thumbnail     string   `protobuf:"bytes,13,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
-	IsMature      bool     `protobuf:"varint,14,opt,name=isMature,proto3" json:"isMature,omitempty"`
+	VideoId   int64 `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	UserId    int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Moderator bool  `protobuf:"varint,3,opt,name=moderator,proto3" json:"moderator,omitempty"`
+	EditId    int64 `protobuf:"varint,4,opt,name=edit_id,json=editId,proto3" json:"edit_id,omitempty"` // The tags are put back to how they were before this edit
 }
 
-func (x *VideoMetadata) Reset() {
-	*x = VideoMetadata{}
+func (x *TagRevertReq) Reset() {
+	*x = TagRevertReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_videoservice_proto_msgTypes[17]
+		mi := &file_videoservice_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VideoMetadata) String() string {
+func (x *TagRevertReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VideoMetadata) ProtoMessage() {}
+func (*TagRevertReq) ProtoMessage() {}
 
-func (x *VideoMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_videoservice_proto_msgTypes[17]
+func (x *TagRevertReq) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1189,136 +1313,128 @@ func (x *VideoMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VideoMetadata.ProtoReflect.Descriptor instead.
-func (*VideoMetadata) Descriptor() ([]byte, []int) {
-	return file_videoservice_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *VideoMetadata) GetVideoLoc() string {
-	if x != nil {
-		return x.VideoLoc
-	}
-	return ""
+// Deprecated: Use TagRevertReq.ProtoReflect.Descriptor instead.
+func (*TagRevertReq) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *VideoMetadata) GetVideoTitle() string {
+func (x *TagRevertReq) GetVideoId() int64 {
 	if x != nil {
-		return x.VideoTitle
+		return x.VideoId
 	}
-	return ""
+	return 0
 }
 
-func (x *VideoMetadata) GetRating() int64 {
+func (x *TagRevertReq) GetUserId() int64 {
 	if x != nil {
-		return x.Rating
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *VideoMetadata) GetAuthorName() string {
+func (x *TagRevertReq) GetModerator() bool {
 	if x != nil {
-		return x.AuthorName
+		return x.Moderator
 	}
-	return ""
+	return false
 }
 
-func (x *VideoMetadata) GetViews() uint64 {
+func (x *TagRevertReq) GetEditId() int64 {
 	if x != nil {
-		return x.Views
+		return x.EditId
 	}
 	return 0
 }
 
-func (x *VideoMetadata) GetVideoID() int64 {
-	if x != nil {
-		return x.VideoID
-	}
-	return 0
+type TagHistoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VideoId    int64 `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	PageNumber int64 `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"` // From 1
 }
 
-func (x *VideoMetadata) GetUploadDate() string {
-	if x != nil {
-		return x.UploadDate
+func (x *TagHistoryReq) Reset() {
+	*x = TagHistoryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *VideoMetadata) GetDescription() string {
-	if x != nil {
-		return x.Description
+func (x *TagHistoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagHistoryReq) ProtoMessage() {}
+
+func (x *TagHistoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *VideoMetadata) GetAuthorID() int64 {
+// Deprecated: Use TagHistoryReq.ProtoReflect.Descriptor instead.
+func (*TagHistoryReq) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TagHistoryReq) GetVideoId() int64 {
 	if x != nil {
-		return x.AuthorID
+		return x.VideoId
 	}
 	return 0
 }
 
-func (x *VideoMetadata) GetTags() []string {
+func (x *TagHistoryReq) GetPageNumber() int64 {
 	if x != nil {
-		return x.Tags
-	}
-	return nil
-}
-
-func (x *VideoMetadata) GetVideoDuration() float32 {
-	if x != nil {
-		return x.VideoDuration
+		return x.PageNumber
 	}
 	return 0
 }
 
-func (x *VideoMetadata) GetCategory() string {
-	if x != nil {
-		return x.Category
-	}
-	return ""
-}
-
-func (x *VideoMetadata) GetThumbnail() string {
-	if x != nil {
-		return x.Thumbnail
-	}
-	return ""
-}
-
-func (x *VideoMetadata) GetIsMature() bool {
-	if x != nil {
-		return x.IsMature
-	}
-	return false
-}
-
-type VideoList struct {
+type TagEdit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Videos         []*Video      `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
-	NumberOfVideos int64         `protobuf:"varint,2,opt,name=numberOfVideos,proto3" json:"numberOfVideos,omitempty"`
-	Categories     *CategoryList `protobuf:"bytes,3,opt,name=categories,proto3" json:"categories,omitempty"`
+	Id             int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	VideoId        int64    `protobuf:"varint,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	UserId         int64    `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username       string   `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Action         string   `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`                                          // add, remove, lock, unlock or revert
+	Tag            string   `protobuf:"bytes,6,opt,name=tag,proto3" json:"tag,omitempty"`                                                // Empty for reverts
+	RevertedEditId int64    `protobuf:"varint,7,opt,name=reverted_edit_id,json=revertedEditId,proto3" json:"reverted_edit_id,omitempty"` // For reverts, the edit the tags were put back to before
+	TagsBefore     []string `protobuf:"bytes,8,rep,name=tags_before,json=tagsBefore,proto3" json:"tags_before,omitempty"`
+	TagsAfter      []string `protobuf:"bytes,9,rep,name=tags_after,json=tagsAfter,proto3" json:"tags_after,omitempty"`
+	CreatedAt      string   `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *VideoList) Reset() {
-	*x = VideoList{}
+func (x *TagEdit) Reset() {
+	*x = TagEdit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_videoservice_proto_msgTypes[18]
+		mi := &file_videoservice_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VideoList) String() string {
+func (x *TagEdit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VideoList) ProtoMessage() {}
+func (*TagEdit) ProtoMessage() {}
 
-func (x *VideoList) ProtoReflect() protoreflect.Message {
-	mi := &file_videoservice_proto_msgTypes[18]
+func (x *TagEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1329,178 +1445,162 @@ func (x *VideoList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VideoList.ProtoReflect.Descriptor instead.
-func (*VideoList) Descriptor() ([]byte, []int) {
-	return file_videoservice_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use TagEdit.ProtoReflect.Descriptor instead.
+func (*TagEdit) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *VideoList) GetVideos() []*Video {
+func (x *TagEdit) GetId() int64 {
 	if x != nil {
-		return x.Videos
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *VideoList) GetNumberOfVideos() int64 {
+func (x *TagEdit) GetVideoId() int64 {
 	if x != nil {
-		return x.NumberOfVideos
+		return x.VideoId
 	}
 	return 0
 }
 
-func (x *VideoList) GetCategories() *CategoryList {
+func (x *TagEdit) GetUserId() int64 {
 	if x != nil {
-		return x.Categories
-	}
-	return nil
-}
-
-type Video struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	VideoTitle    string  `protobuf:"bytes,1,opt,name=videoTitle,proto3" json:"videoTitle,omitempty"`
-	Views         uint64  `protobuf:"varint,2,opt,name=views,proto3" json:"views,omitempty"`
-	Rating        int64   `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
-	ThumbnailLoc  string  `protobuf:"bytes,4,opt,name=thumbnailLoc,proto3" json:"thumbnailLoc,omitempty"`
-	VideoID       int64   `protobuf:"varint,5,opt,name=videoID,proto3" json:"videoID,omitempty"`
-	AuthorName    string  `protobuf:"bytes,6,opt,name=authorName,proto3" json:"authorName,omitempty"`
-	UploadDate    string  `protobuf:"bytes,7,opt,name=uploadDate,proto3" json:"uploadDate,omitempty"`
-	AuthorID      int64   `protobuf:"varint,8,opt,name=authorID,proto3" json:"authorID,omitempty"`
-	VideoDuration float32 `protobuf:"fixed32,9,opt,name=videoDuration,proto3" json:"videoDuration,omitempty"`
-	IsMature      bool    `protobuf:"varint,10,opt,name=isMature,proto3" json:"isMature,omitempty"`
-}
-
-func (x *Video) Reset() {
-	*x = Video{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_videoservice_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		return x.UserId
 	}
+	return 0
 }
 
-func (x *Video) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Video) ProtoMessage() {}
-
-func (x *Video) ProtoReflect() protoreflect.Message {
-	mi := &file_videoservice_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TagEdit) GetUsername() string {
+	if x != nil {
+		return x.Username
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Video.ProtoReflect.Descriptor instead.
-func (*Video) Descriptor() ([]byte, []int) {
-	return file_videoservice_proto_rawDescGZIP(), []int{19}
+	return ""
 }
 
-func (x *Video) GetVideoTitle() string {
+func (x *TagEdit) GetAction() string {
 	if x != nil {
-		return x.VideoTitle
+		return x.Action
 	}
 	return ""
 }
 
-func (x *Video) GetViews() uint64 {
+func (x *TagEdit) GetTag() string {
 	if x != nil {
-		return x.Views
+		return x.Tag
 	}
-	return 0
+	return ""
 }
 
-func (x *Video) GetRating() int64 {
+func (x *TagEdit) GetRevertedEditId() int64 {
 	if x != nil {
-		return x.Rating
+		return x.RevertedEditId
 	}
 	return 0
 }
 
-func (x *Video) GetThumbnailLoc() string {
+func (x *TagEdit) GetTagsBefore() []string {
 	if x != nil {
-		return x.ThumbnailLoc
+		return x.TagsBefore
 	}
-	return ""
+	return nil
 }
 
-func (x *Video) GetVideoID() int64 {
+func (x *TagEdit) GetTagsAfter() []string {
 	if x != nil {
-		return x.VideoID
+		return x.TagsAfter
 	}
-	return 0
+	return nil
 }
 
-func (x *Video) GetAuthorName() string {
+func (x *TagEdit) GetCreatedAt() string {
 	if x != nil {
-		return x.AuthorName
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *Video) GetUploadDate() string {
-	if x != nil {
-		return x.UploadDate
+type TagHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Edits []*TagEdit `protobuf:"bytes,1,rep,name=edits,proto3" json:"edits,omitempty"` // Newest first
+	Total int64      `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *TagHistory) Reset() {
+	*x = TagHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Video) GetAuthorID() int64 {
-	if x != nil {
-		return x.AuthorID
+func (x *TagHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagHistory) ProtoMessage() {}
+
+func (x *TagHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Video) GetVideoDuration() float32 {
+// Deprecated: Use TagHistory.ProtoReflect.Descriptor instead.
+func (*TagHistory) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TagHistory) GetEdits() []*TagEdit {
 	if x != nil {
-		return x.VideoDuration
+		return x.Edits
 	}
-	return 0
+	return nil
 }
 
-func (x *Video) GetIsMature() bool {
+func (x *TagHistory) GetTotal() int64 {
 	if x != nil {
-		return x.IsMature
+		return x.Total
 	}
-	return false
+	return 0
 }
 
-type VideoRating struct {
+type FavoriteReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID  int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	VideoID int64 `protobuf:"varint,2,opt,name=videoID,proto3" json:"videoID,omitempty"`
-	Rating  int64 `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	UserId  int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VideoId int64 `protobuf:"varint,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
 }
 
-func (x *VideoRating) Reset() {
-	*x = VideoRating{}
+func (x *FavoriteReq) Reset() {
+	*x = FavoriteReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_videoservice_proto_msgTypes[20]
+		mi := &file_videoservice_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VideoRating) String() string {
+func (x *FavoriteReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VideoRating) ProtoMessage() {}
+func (*FavoriteReq) ProtoMessage() {}
 
-func (x *VideoRating) ProtoReflect() protoreflect.Message {
-	mi := &file_videoservice_proto_msgTypes[20]
+func (x *FavoriteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1511,57 +1611,51 @@ func (x *VideoRating) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VideoRating.ProtoReflect.Descriptor instead.
-func (*VideoRating) Descriptor() ([]byte, []int) {
-	return file_videoservice_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *VideoRating) GetUserID() int64 {
-	if x != nil {
-		return x.UserID
-	}
-	return 0
+// Deprecated: Use FavoriteReq.ProtoReflect.Descriptor instead.
+func (*FavoriteReq) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *VideoRating) GetVideoID() int64 {
+func (x *FavoriteReq) GetUserId() int64 {
 	if x != nil {
-		return x.VideoID
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *VideoRating) GetRating() int64 {
+func (x *FavoriteReq) GetVideoId() int64 {
 	if x != nil {
-		return x.Rating
+		return x.VideoId
 	}
 	return 0
 }
 
-type VideoViewing struct {
+type FavoriteStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VideoID int64 `protobuf:"varint,1,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	Favorite bool   `protobuf:"varint,1,opt,name=favorite,proto3" json:"favorite,omitempty"` // Whether the user has favorited the video
+	Count    uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`       // How many users have
 }
 
-func (x *VideoViewing) Reset() {
-	*x = VideoViewing{}
+func (x *FavoriteStatus) Reset() {
+	*x = FavoriteStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_videoservice_proto_msgTypes[21]
+		mi := &file_videoservice_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VideoViewing) String() string {
+func (x *FavoriteStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VideoViewing) ProtoMessage() {}
+func (*FavoriteStatus) ProtoMessage() {}
 
-func (x *VideoViewing) ProtoReflect() protoreflect.Message {
-	mi := &file_videoservice_proto_msgTypes[21]
+func (x *FavoriteStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1572,45 +1666,52 @@ func (x *VideoViewing) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VideoViewing.ProtoReflect.Descriptor instead.
-func (*VideoViewing) Descriptor() ([]byte, []int) {
-	return file_videoservice_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use FavoriteStatus.ProtoReflect.Descriptor instead.
+func (*FavoriteStatus) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *VideoViewing) GetVideoID() int64 {
+func (x *FavoriteStatus) GetFavorite() bool {
 	if x != nil {
-		return x.VideoID
+		return x.Favorite
+	}
+	return false
+}
+
+func (x *FavoriteStatus) GetCount() uint64 {
+	if x != nil {
+		return x.Count
 	}
 	return 0
 }
 
-type VideoApproval struct {
+type FavoritesQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID  int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	VideoID int64 `protobuf:"varint,2,opt,name=videoID,proto3" json:"videoID,omitempty"`
-	Mature  bool  `protobuf:"varint,3,opt,name=mature,proto3" json:"mature,omitempty"`
+	UserId     int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageNumber int64 `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"` // From 1
+	ShowMature bool  `protobuf:"varint,3,opt,name=show_mature,json=showMature,proto3" json:"show_mature,omitempty"`
 }
 
-func (x *VideoApproval) Reset() {
-	*x = VideoApproval{}
+func (x *FavoritesQuery) Reset() {
+	*x = FavoritesQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_videoservice_proto_msgTypes[22]
+		mi := &file_videoservice_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VideoApproval) String() string {
+func (x *FavoritesQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VideoApproval) ProtoMessage() {}
+func (*FavoritesQuery) ProtoMessage() {}
 
-func (x *VideoApproval) ProtoReflect() protoreflect.Message {
-	mi := &file_videoservice_proto_msgTypes[22]
+func (x *FavoritesQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1621,66 +1722,65 @@ func (x *VideoApproval) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VideoApproval.ProtoReflect.Descriptor instead.
-func (*VideoApproval) Descriptor() ([]byte, []int) {
-	return file_videoservice_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use FavoritesQuery.ProtoReflect.Descriptor instead.
+func (*FavoritesQuery) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *VideoApproval) GetUserID() int64 {
+func (x *FavoritesQuery) GetUserId() int64 {
 	if x != nil {
-		return x.UserID
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *VideoApproval) GetVideoID() int64 {
+func (x *FavoritesQuery) GetPageNumber() int64 {
 	if x != nil {
-		return x.VideoID
+		return x.PageNumber
 	}
 	return 0
 }
 
-func (x *VideoApproval) GetMature() bool {
+func (x *FavoritesQuery) GetShowMature() bool {
 	if x != nil {
-		return x.Mature
+		return x.ShowMature
 	}
 	return false
 }
 
-type VideoQueryConfig struct {
+type Playlist struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderBy        OrderCategory `protobuf:"varint,1,opt,name=orderBy,proto3,enum=proto.OrderCategory" json:"orderBy,omitempty"`
-	Direction      SortDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=proto.SortDirection" json:"direction,omitempty"`
-	PageNumber     int64         `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
-	SearchVal      string        `protobuf:"bytes,4,opt,name=SearchVal,proto3" json:"SearchVal,omitempty"`
-	FromUserID     int64         `protobuf:"varint,5,opt,name=fromUserID,proto3" json:"fromUserID,omitempty"` // domestic user ID
-	ShowUnapproved bool          `protobuf:"varint,6,opt,name=showUnapproved,proto3" json:"showUnapproved,omitempty"`
-	UnapprovedOnly bool          `protobuf:"varint,7,opt,name=unapprovedOnly,proto3" json:"unapprovedOnly,omitempty"`
-	Category       string        `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
-	// bool favoritesOnly = 9;
-	ShowMature bool `protobuf:"varint,10,opt,name=showMature,proto3" json:"showMature,omitempty"`
+	Id          int64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId      int64            `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // The owner
+	Title       string           `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string           `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Visibility  string           `protobuf:"bytes,5,opt,name=visibility,proto3" json:"visibility,omitempty"` // public, unlisted or private
+	CreatedAt   string           `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   string           `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	EntryCount  int64            `protobuf:"varint,8,opt,name=entry_count,json=entryCount,proto3" json:"entry_count,omitempty"`
+	Entries     []*PlaylistEntry `protobuf:"bytes,9,rep,name=entries,proto3" json:"entries,omitempty"` // Only returned by getPlaylist
 }
 
-func (x *VideoQueryConfig) Reset() {
-	*x = VideoQueryConfig{}
+func (x *Playlist) Reset() {
+	*x = Playlist{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_videoservice_proto_msgTypes[23]
+		mi := &file_videoservice_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VideoQueryConfig) String() string {
+func (x *Playlist) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VideoQueryConfig) ProtoMessage() {}
+func (*Playlist) ProtoMessage() {}
 
-func (x *VideoQueryConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_videoservice_proto_msgTypes[23]
+func (x *Playlist) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1691,99 +1791,103 @@ func (x *VideoQueryConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VideoQueryConfig.ProtoReflect.Descriptor instead.
-func (*VideoQueryConfig) Descriptor() ([]byte, []int) {
-	return file_videoservice_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use Playlist.ProtoReflect.Descriptor instead.
+func (*Playlist) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *VideoQueryConfig) GetOrderBy() OrderCategory {
+func (x *Playlist) GetId() int64 {
 	if x != nil {
-		return x.OrderBy
+		return x.Id
 	}
-	return OrderCategory_views
+	return 0
 }
 
-func (x *VideoQueryConfig) GetDirection() SortDirection {
+func (x *Playlist) GetUserId() int64 {
 	if x != nil {
-		return x.Direction
+		return x.UserId
 	}
-	return SortDirection_asc
+	return 0
 }
 
-func (x *VideoQueryConfig) GetPageNumber() int64 {
+func (x *Playlist) GetTitle() string {
 	if x != nil {
-		return x.PageNumber
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *VideoQueryConfig) GetSearchVal() string {
+func (x *Playlist) GetDescription() string {
 	if x != nil {
-		return x.SearchVal
+		return x.Description
 	}
 	return ""
 }
 
-func (x *VideoQueryConfig) GetFromUserID() int64 {
+func (x *Playlist) GetVisibility() string {
 	if x != nil {
-		return x.FromUserID
+		return x.Visibility
 	}
-	return 0
+	return ""
 }
 
-func (x *VideoQueryConfig) GetShowUnapproved() bool {
+func (x *Playlist) GetCreatedAt() string {
 	if x != nil {
-		return x.ShowUnapproved
+		return x.CreatedAt
 	}
-	return false
+	return ""
 }
 
-func (x *VideoQueryConfig) GetUnapprovedOnly() bool {
+func (x *Playlist) GetUpdatedAt() string {
 	if x != nil {
-		return x.UnapprovedOnly
+		return x.UpdatedAt
 	}
-	return false
+	return ""
 }
 
-func (x *VideoQueryConfig) GetCategory() string {
+func (x *Playlist) GetEntryCount() int64 {
 	if x != nil {
-		return x.Category
+		return x.EntryCount
 	}
-	return ""
+	return 0
 }
 
-func (x *VideoQueryConfig) GetShowMature() bool {
+func (x *Playlist) GetEntries() []*PlaylistEntry {
 	if x != nil {
-		return x.ShowMature
+		return x.Entries
 	}
-	return false
+	return nil
 }
 
-type VideoExistenceResponse struct {
+type PlaylistEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Exists bool `protobuf:"varint,1,opt,name=Exists,proto3" json:"Exists,omitempty"`
+	PlaylistId int64  `protobuf:"varint,1,opt,name=playlist_id,json=playlistId,proto3" json:"playlist_id,omitempty"`
+	Video      *Video `protobuf:"bytes,2,opt,name=video,proto3" json:"video,omitempty"`
+	Position   int64  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"` // From 1
+	Note       string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	AddedAt    string `protobuf:"bytes,5,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
 }
 
-func (x *VideoExistenceResponse) Reset() {
-	*x = VideoExistenceResponse{}
+func (x *PlaylistEntry) Reset() {
+	*x = PlaylistEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_videoservice_proto_msgTypes[24]
+		mi := &file_videoservice_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VideoExistenceResponse) String() string {
+func (x *PlaylistEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VideoExistenceResponse) ProtoMessage() {}
+func (*PlaylistEntry) ProtoMessage() {}
 
-func (x *VideoExistenceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_videoservice_proto_msgTypes[24]
+func (x *PlaylistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1794,44 +1898,72 @@ func (x *VideoExistenceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VideoExistenceResponse.ProtoReflect.Descriptor instead.
-func (*VideoExistenceResponse) Descriptor() ([]byte, []int) {
-	return file_videoservice_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use PlaylistEntry.ProtoReflect.Descriptor instead.
+func (*PlaylistEntry) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *VideoExistenceResponse) GetExists() bool {
+func (x *PlaylistEntry) GetPlaylistId() int64 {
 	if x != nil {
-		return x.Exists
+		return x.PlaylistId
 	}
-	return false
+	return 0
 }
 
-type ForeignVideoCheck struct {
+func (x *PlaylistEntry) GetVideo() *Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+func (x *PlaylistEntry) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *PlaylistEntry) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *PlaylistEntry) GetAddedAt() string {
+	if x != nil {
+		return x.AddedAt
+	}
+	return ""
+}
+
+type PlaylistReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ForeignVideoID string `protobuf:"bytes,1,opt,name=ForeignVideoID,proto3" json:"ForeignVideoID,omitempty"`
-	ForeignWebsite string `protobuf:"bytes,2,opt,name=ForeignWebsite,proto3" json:"ForeignWebsite,omitempty"`
+	Id     int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Who's asking, 0 if nobody's logged in
 }
 
-func (x *ForeignVideoCheck) Reset() {
-	*x = ForeignVideoCheck{}
+func (x *PlaylistReq) Reset() {
+	*x = PlaylistReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_videoservice_proto_msgTypes[25]
+		mi := &file_videoservice_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ForeignVideoCheck) String() string {
+func (x *PlaylistReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForeignVideoCheck) ProtoMessage() {}
+func (*PlaylistReq) ProtoMessage() {}
 
-func (x *ForeignVideoCheck) ProtoReflect() protoreflect.Message {
-	mi := &file_videoservice_proto_msgTypes[25]
+func (x *PlaylistReq) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1842,50 +1974,51 @@ func (x *ForeignVideoCheck) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForeignVideoCheck.ProtoReflect.Descriptor instead.
-func (*ForeignVideoCheck) Descriptor() ([]byte, []int) {
-	return file_videoservice_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use PlaylistReq.ProtoReflect.Descriptor instead.
+func (*PlaylistReq) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ForeignVideoCheck) GetForeignVideoID() string {
+func (x *PlaylistReq) GetId() int64 {
 	if x != nil {
-		return x.ForeignVideoID
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *ForeignVideoCheck) GetForeignWebsite() string {
+func (x *PlaylistReq) GetUserId() int64 {
 	if x != nil {
-		return x.ForeignWebsite
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-type VideoRequest struct {
+type PlaylistListReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VideoID string `protobuf:"bytes,1,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	OwnerId int64 `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	UserId  int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Who's asking, 0 if nobody's logged in
 }
 
-func (x *VideoRequest) Reset() {
-	*x = VideoRequest{}
+func (x *PlaylistListReq) Reset() {
+	*x = PlaylistListReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_videoservice_proto_msgTypes[26]
+		mi := &file_videoservice_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VideoRequest) String() string {
+func (x *PlaylistListReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VideoRequest) ProtoMessage() {}
+func (*PlaylistListReq) ProtoMessage() {}
 
-func (x *VideoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_videoservice_proto_msgTypes[26]
+func (x *PlaylistListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1896,47 +2029,50 @@ func (x *VideoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VideoRequest.ProtoReflect.Descriptor instead.
-func (*VideoRequest) Descriptor() ([]byte, []int) {
-	return file_videoservice_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use PlaylistListReq.ProtoReflect.Descriptor instead.
+func (*PlaylistListReq) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *VideoRequest) GetVideoID() string {
+func (x *PlaylistListReq) GetOwnerId() int64 {
 	if x != nil {
-		return x.VideoID
+		return x.OwnerId
 	}
-	return ""
+	return 0
 }
 
-type InputVideoChunk struct {
+func (x *PlaylistListReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type PlaylistList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Payload:
-	//	*InputVideoChunk_Content
-	//	*InputVideoChunk_Meta
-	//	*InputVideoChunk_Rawmeta
-	Payload isInputVideoChunk_Payload `protobuf_oneof:"Payload"`
+	Playlists []*Playlist `protobuf:"bytes,1,rep,name=playlists,proto3" json:"playlists,omitempty"`
 }
 
-func (x *InputVideoChunk) Reset() {
-	*x = InputVideoChunk{}
+func (x *PlaylistList) Reset() {
+	*x = PlaylistList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_videoservice_proto_msgTypes[27]
+		mi := &file_videoservice_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InputVideoChunk) String() string {
+func (x *PlaylistList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InputVideoChunk) ProtoMessage() {}
+func (*PlaylistList) ProtoMessage() {}
 
-func (x *InputVideoChunk) ProtoReflect() protoreflect.Message {
-	mi := &file_videoservice_proto_msgTypes[27]
+func (x *PlaylistList) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1947,89 +2083,126 @@ func (x *InputVideoChunk) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InputVideoChunk.ProtoReflect.Descriptor instead.
-func (*InputVideoChunk) Descriptor() ([]byte, []int) {
-	return file_videoservice_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use PlaylistList.ProtoReflect.Descriptor instead.
+func (*PlaylistList) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{29}
 }
 
-func (m *InputVideoChunk) GetPayload() isInputVideoChunk_Payload {
-	if m != nil {
-		return m.Payload
+func (x *PlaylistList) GetPlaylists() []*Playlist {
+	if x != nil {
+		return x.Playlists
 	}
 	return nil
 }
 
-func (x *InputVideoChunk) GetContent() *FileContent {
-	if x, ok := x.GetPayload().(*InputVideoChunk_Content); ok {
-		return x.Content
-	}
-	return nil
+type PlaylistEntryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlaylistId int64  `protobuf:"varint,1,opt,name=playlist_id,json=playlistId,proto3" json:"playlist_id,omitempty"`
+	UserId     int64  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Who's asking, who has to own the playlist
+	VideoId    int64  `protobuf:"varint,3,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Note       string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	// Where to put the entry, from 1. When adding, 0 appends it, and when updating, 0 leaves it where it is.
+	Position int64 `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
 }
 
-func (x *InputVideoChunk) GetMeta() *InputFileMetadata {
-	if x, ok := x.GetPayload().(*InputVideoChunk_Meta); ok {
-		return x.Meta
+func (x *PlaylistEntryReq) Reset() {
+	*x = PlaylistEntryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *InputVideoChunk) GetRawmeta() *RawMetadata {
-	if x, ok := x.GetPayload().(*InputVideoChunk_Rawmeta); ok {
-		return x.Rawmeta
-	}
-	return nil
+func (x *PlaylistEntryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type isInputVideoChunk_Payload interface {
-	isInputVideoChunk_Payload()
+func (*PlaylistEntryReq) ProtoMessage() {}
+
+func (x *PlaylistEntryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type InputVideoChunk_Content struct {
-	Content *FileContent `protobuf:"bytes,1,opt,name=content,proto3,oneof"`
+// Deprecated: Use PlaylistEntryReq.ProtoReflect.Descriptor instead.
+func (*PlaylistEntryReq) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{30}
 }
 
-type InputVideoChunk_Meta struct {
-	Meta *InputFileMetadata `protobuf:"bytes,2,opt,name=meta,proto3,oneof"`
+func (x *PlaylistEntryReq) GetPlaylistId() int64 {
+	if x != nil {
+		return x.PlaylistId
+	}
+	return 0
 }
 
-type InputVideoChunk_Rawmeta struct {
-	Rawmeta *RawMetadata `protobuf:"bytes,3,opt,name=rawmeta,proto3,oneof"` // This was added after the above two fields, and is used for the metadata.json file for the video
+func (x *PlaylistEntryReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
 }
 
-func (*InputVideoChunk_Content) isInputVideoChunk_Payload() {}
+func (x *PlaylistEntryReq) GetVideoId() int64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
 
-func (*InputVideoChunk_Meta) isInputVideoChunk_Payload() {}
+func (x *PlaylistEntryReq) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
 
-func (*InputVideoChunk_Rawmeta) isInputVideoChunk_Payload() {}
+func (x *PlaylistEntryReq) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
 
-type ResponseVideoChunk struct {
+type PlaylistPositionReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Payload:
-	//	*ResponseVideoChunk_Content
-	//	*ResponseVideoChunk_Meta
-	Payload isResponseVideoChunk_Payload `protobuf_oneof:"Payload"`
+	PlaylistId int64 `protobuf:"varint,1,opt,name=playlist_id,json=playlistId,proto3" json:"playlist_id,omitempty"`
+	UserId     int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`    // Who's asking, 0 if nobody's logged in
+	VideoId    int64 `protobuf:"varint,3,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"` // The video being played
+	Loop       bool  `protobuf:"varint,4,opt,name=loop,proto3" json:"loop,omitempty"`                      // Go from the last entry back to the first, and the other way
 }
 
-func (x *ResponseVideoChunk) Reset() {
-	*x = ResponseVideoChunk{}
+func (x *PlaylistPositionReq) Reset() {
+	*x = PlaylistPositionReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_videoservice_proto_msgTypes[28]
+		mi := &file_videoservice_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResponseVideoChunk) String() string {
+func (x *PlaylistPositionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseVideoChunk) ProtoMessage() {}
+func (*PlaylistPositionReq) ProtoMessage() {}
 
-func (x *ResponseVideoChunk) ProtoReflect() protoreflect.Message {
-	mi := &file_videoservice_proto_msgTypes[28]
+func (x *PlaylistPositionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2040,9 +2213,2210 @@ func (x *ResponseVideoChunk) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseVideoChunk.ProtoReflect.Descriptor instead.
-func (*ResponseVideoChunk) Descriptor() ([]byte, []int) {
-	return file_videoservice_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use PlaylistPositionReq.ProtoReflect.Descriptor instead.
+func (*PlaylistPositionReq) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PlaylistPositionReq) GetPlaylistId() int64 {
+	if x != nil {
+		return x.PlaylistId
+	}
+	return 0
+}
+
+func (x *PlaylistPositionReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PlaylistPositionReq) GetVideoId() int64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *PlaylistPositionReq) GetLoop() bool {
+	if x != nil {
+		return x.Loop
+	}
+	return false
+}
+
+type PlaylistNeighbours struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unset at either end of the playlist, unless looping
+	Previous *PlaylistEntry `protobuf:"bytes,1,opt,name=previous,proto3" json:"previous,omitempty"`
+	Next     *PlaylistEntry `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *PlaylistNeighbours) Reset() {
+	*x = PlaylistNeighbours{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaylistNeighbours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaylistNeighbours) ProtoMessage() {}
+
+func (x *PlaylistNeighbours) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaylistNeighbours.ProtoReflect.Descriptor instead.
+func (*PlaylistNeighbours) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PlaylistNeighbours) GetPrevious() *PlaylistEntry {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *PlaylistNeighbours) GetNext() *PlaylistEntry {
+	if x != nil {
+		return x.Next
+	}
+	return nil
+}
+
+type FeedReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FollowedUsers []int64 `protobuf:"varint,1,rep,packed,name=followed_users,json=followedUsers,proto3" json:"followed_users,omitempty"`
+	ShowMature    bool    `protobuf:"varint,2,opt,name=showMature,proto3" json:"showMature,omitempty"`
+	Cursor        string  `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"` // From a previous VideoList
+}
+
+func (x *FeedReq) Reset() {
+	*x = FeedReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedReq) ProtoMessage() {}
+
+func (x *FeedReq) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedReq.ProtoReflect.Descriptor instead.
+func (*FeedReq) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FeedReq) GetFollowedUsers() []int64 {
+	if x != nil {
+		return x.FollowedUsers
+	}
+	return nil
+}
+
+func (x *FeedReq) GetShowMature() bool {
+	if x != nil {
+		return x.ShowMature
+	}
+	return false
+}
+
+func (x *FeedReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type CategoryList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Categories []*Category `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+}
+
+func (x *CategoryList) Reset() {
+	*x = CategoryList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CategoryList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryList) ProtoMessage() {}
+
+func (x *CategoryList) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryList.ProtoReflect.Descriptor instead.
+func (*CategoryList) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CategoryList) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type Category struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cardinality uint64 `protobuf:"varint,2,opt,name=cardinality,proto3" json:"cardinality,omitempty"`
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetCardinality() uint64 {
+	if x != nil {
+		return x.Cardinality
+	}
+	return 0
+}
+
+type VideoDeletionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VideoID string `protobuf:"bytes,1,opt,name=videoID,proto3" json:"videoID,omitempty"`
+}
+
+func (x *VideoDeletionReq) Reset() {
+	*x = VideoDeletionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VideoDeletionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoDeletionReq) ProtoMessage() {}
+
+func (x *VideoDeletionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoDeletionReq.ProtoReflect.Descriptor instead.
+func (*VideoDeletionReq) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *VideoDeletionReq) GetVideoID() string {
+	if x != nil {
+		return x.VideoID
+	}
+	return ""
+}
+
+type Nothing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Nothing) Reset() {
+	*x = Nothing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Nothing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nothing) ProtoMessage() {}
+
+func (x *Nothing) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nothing.ProtoReflect.Descriptor instead.
+func (*Nothing) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{37}
+}
+
+type RecReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VideoId    int64 `protobuf:"varint,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	ShowMature bool  `protobuf:"varint,3,opt,name=showMature,proto3" json:"showMature,omitempty"`
+}
+
+func (x *RecReq) Reset() {
+	*x = RecReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecReq) ProtoMessage() {}
+
+func (x *RecReq) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecReq.ProtoReflect.Descriptor instead.
+func (*RecReq) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RecReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RecReq) GetVideoId() int64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *RecReq) GetShowMature() bool {
+	if x != nil {
+		return x.ShowMature
+	}
+	return false
+}
+
+type RecResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Videos []*Video `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+}
+
+func (x *RecResp) Reset() {
+	*x = RecResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecResp) ProtoMessage() {}
+
+func (x *RecResp) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecResp.ProtoReflect.Descriptor instead.
+func (*RecResp) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RecResp) GetVideos() []*Video {
+	if x != nil {
+		return x.Videos
+	}
+	return nil
+}
+
+type VideoRec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThumbnailLoc string `protobuf:"bytes,1,opt,name=thumbnailLoc,proto3" json:"thumbnailLoc,omitempty"`
+	VideoTitle   string `protobuf:"bytes,2,opt,name=videoTitle,proto3" json:"videoTitle,omitempty"`
+	VideoID      int64  `protobuf:"varint,3,opt,name=videoID,proto3" json:"videoID,omitempty"`
+}
+
+func (x *VideoRec) Reset() {
+	*x = VideoRec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VideoRec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoRec) ProtoMessage() {}
+
+func (x *VideoRec) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoRec.ProtoReflect.Descriptor instead.
+func (*VideoRec) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *VideoRec) GetThumbnailLoc() string {
+	if x != nil {
+		return x.ThumbnailLoc
+	}
+	return ""
+}
+
+func (x *VideoRec) GetVideoTitle() string {
+	if x != nil {
+		return x.VideoTitle
+	}
+	return ""
+}
+
+func (x *VideoRec) GetVideoID() int64 {
+	if x != nil {
+		return x.VideoID
+	}
+	return 0
+}
+
+type VideoComment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId        int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	VideoId       int64  `protobuf:"varint,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Comment       string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	ParentComment int64  `protobuf:"varint,4,opt,name=parent_comment,json=parentComment,proto3" json:"parent_comment,omitempty"` // 0 if root
+}
+
+func (x *VideoComment) Reset() {
+	*x = VideoComment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VideoComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoComment) ProtoMessage() {}
+
+func (x *VideoComment) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoComment.ProtoReflect.Descriptor instead.
+func (*VideoComment) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *VideoComment) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *VideoComment) GetVideoId() int64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *VideoComment) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *VideoComment) GetParentComment() int64 {
+	if x != nil {
+		return x.ParentComment
+	}
+	return 0
+}
+
+// Can add pagination in the future if needed (probably fine without it)
+type CommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VideoID    int64 `protobuf:"varint,1,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	CurrUserID int64 `protobuf:"varint,2,opt,name=currUserID,proto3" json:"currUserID,omitempty"`
+}
+
+func (x *CommentRequest) Reset() {
+	*x = CommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentRequest) ProtoMessage() {}
+
+func (x *CommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentRequest.ProtoReflect.Descriptor instead.
+func (*CommentRequest) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CommentRequest) GetVideoID() int64 {
+	if x != nil {
+		return x.VideoID
+	}
+	return 0
+}
+
+func (x *CommentRequest) GetCurrUserID() int64 {
+	if x != nil {
+		return x.CurrUserID
+	}
+	return 0
+}
+
+type CommentUpvote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CommentId int64 `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Score     int64 `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"` // -1, 0, 1
+}
+
+func (x *CommentUpvote) Reset() {
+	*x = CommentUpvote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentUpvote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentUpvote) ProtoMessage() {}
+
+func (x *CommentUpvote) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentUpvote.ProtoReflect.Descriptor instead.
+func (*CommentUpvote) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CommentUpvote) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CommentUpvote) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *CommentUpvote) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type CommentListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comments []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+}
+
+func (x *CommentListResponse) Reset() {
+	*x = CommentListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentListResponse) ProtoMessage() {}
+
+func (x *CommentListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentListResponse.ProtoReflect.Descriptor instead.
+func (*CommentListResponse) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CommentListResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+type Comment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId               int64  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	CreationDate            string `protobuf:"bytes,2,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+	Content                 string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	AuthorUsername          string `protobuf:"bytes,4,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"`
+	AuthorProfileImageUrl   string `protobuf:"bytes,5,opt,name=author_profile_image_url,json=authorProfileImageUrl,proto3" json:"author_profile_image_url,omitempty"`
+	VoteScore               int64  `protobuf:"varint,6,opt,name=vote_score,json=voteScore,proto3" json:"vote_score,omitempty"`
+	CurrentUserHasUpvoted   bool   `protobuf:"varint,7,opt,name=current_user_has_upvoted,json=currentUserHasUpvoted,proto3" json:"current_user_has_upvoted,omitempty"`
+	CurrentUserHasDownvoted bool   `protobuf:"varint,8,opt,name=current_user_has_downvoted,json=currentUserHasDownvoted,proto3" json:"current_user_has_downvoted,omitempty"`
+	AuthorId                int64  `protobuf:"varint,9,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	ParentId                int64  `protobuf:"varint,10,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Archived                bool   `protobuf:"varint,11,opt,name=archived,proto3" json:"archived,omitempty"`                    // imported from the original site
+	LikeCount               int64  `protobuf:"varint,12,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"` // likes on the original site, for archived comments
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Comment) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *Comment) GetCreationDate() string {
+	if x != nil {
+		return x.CreationDate
+	}
+	return ""
+}
+
+func (x *Comment) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Comment) GetAuthorUsername() string {
+	if x != nil {
+		return x.AuthorUsername
+	}
+	return ""
+}
+
+func (x *Comment) GetAuthorProfileImageUrl() string {
+	if x != nil {
+		return x.AuthorProfileImageUrl
+	}
+	return ""
+}
+
+func (x *Comment) GetVoteScore() int64 {
+	if x != nil {
+		return x.VoteScore
+	}
+	return 0
+}
+
+func (x *Comment) GetCurrentUserHasUpvoted() bool {
+	if x != nil {
+		return x.CurrentUserHasUpvoted
+	}
+	return false
+}
+
+func (x *Comment) GetCurrentUserHasDownvoted() bool {
+	if x != nil {
+		return x.CurrentUserHasDownvoted
+	}
+	return false
+}
+
+func (x *Comment) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *Comment) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Comment) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *Comment) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+type VideoMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VideoLoc      string           `protobuf:"bytes,1,opt,name=videoLoc,proto3" json:"videoLoc,omitempty"` // The location of the DASH manifest
+	VideoTitle    string           `protobuf:"bytes,2,opt,name=videoTitle,proto3" json:"videoTitle,omitempty"`
+	Rating        int64            `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	AuthorName    string           `protobuf:"bytes,4,opt,name=authorName,proto3" json:"authorName,omitempty"` // Do I need this? probably not
+	Views         uint64           `protobuf:"varint,5,opt,name=views,proto3" json:"views,omitempty"`
+	VideoID       int64            `protobuf:"varint,6,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	UploadDate    string           `protobuf:"bytes,7,opt,name=uploadDate,proto3" json:"uploadDate,omitempty"`
+	Description   string           `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	AuthorID      int64            `protobuf:"varint,9,opt,name=authorID,proto3" json:"authorID,omitempty"`
+	Tags          []string         `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	VideoDuration float32          `protobuf:"fixed32,11,opt,name=videoDuration,proto3" json:"videoDuration,omitempty"`
+	Category      string           `protobuf:"bytes,12,opt,name=category,proto3" json:"category,omitempty"`
+	Thumbnail     string           `protobuf:"bytes,13,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	IsMature      bool             `protobuf:"varint,14,opt,name=isMature,proto3" json:"isMature,omitempty"`
+	HLSLoc        string           `protobuf:"bytes,15,opt,name=HLSLoc,proto3" json:"HLSLoc,omitempty"` // The location of the HLS master playlist
+	Subtitles     []*SubtitleTrack `protobuf:"bytes,16,rep,name=subtitles,proto3" json:"subtitles,omitempty"`
+	// Whether the original is still available: online, removed, private, geo_blocked or auth_required, or empty if it
+	// hasn't been checked (or the video wasn't archived)
+	UpstreamStatus      string `protobuf:"bytes,17,opt,name=upstreamStatus,proto3" json:"upstreamStatus,omitempty"`
+	UpstreamStatusSince string `protobuf:"bytes,18,opt,name=upstreamStatusSince,proto3" json:"upstreamStatusSince,omitempty"` // When the original was first seen with its current status
+	UpstreamCheckedAt   string `protobuf:"bytes,19,opt,name=upstreamCheckedAt,proto3" json:"upstreamCheckedAt,omitempty"`
+}
+
+func (x *VideoMetadata) Reset() {
+	*x = VideoMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VideoMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoMetadata) ProtoMessage() {}
+
+func (x *VideoMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoMetadata.ProtoReflect.Descriptor instead.
+func (*VideoMetadata) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *VideoMetadata) GetVideoLoc() string {
+	if x != nil {
+		return x.VideoLoc
+	}
+	return ""
+}
+
+func (x *VideoMetadata) GetVideoTitle() string {
+	if x != nil {
+		return x.VideoTitle
+	}
+	return ""
+}
+
+func (x *VideoMetadata) GetRating() int64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *VideoMetadata) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *VideoMetadata) GetViews() uint64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *VideoMetadata) GetVideoID() int64 {
+	if x != nil {
+		return x.VideoID
+	}
+	return 0
+}
+
+func (x *VideoMetadata) GetUploadDate() string {
+	if x != nil {
+		return x.UploadDate
+	}
+	return ""
+}
+
+func (x *VideoMetadata) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *VideoMetadata) GetAuthorID() int64 {
+	if x != nil {
+		return x.AuthorID
+	}
+	return 0
+}
+
+func (x *VideoMetadata) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *VideoMetadata) GetVideoDuration() float32 {
+	if x != nil {
+		return x.VideoDuration
+	}
+	return 0
+}
+
+func (x *VideoMetadata) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *VideoMetadata) GetThumbnail() string {
+	if x != nil {
+		return x.Thumbnail
+	}
+	return ""
+}
+
+func (x *VideoMetadata) GetIsMature() bool {
+	if x != nil {
+		return x.IsMature
+	}
+	return false
+}
+
+func (x *VideoMetadata) GetHLSLoc() string {
+	if x != nil {
+		return x.HLSLoc
+	}
+	return ""
+}
+
+func (x *VideoMetadata) GetSubtitles() []*SubtitleTrack {
+	if x != nil {
+		return x.Subtitles
+	}
+	return nil
+}
+
+func (x *VideoMetadata) GetUpstreamStatus() string {
+	if x != nil {
+		return x.UpstreamStatus
+	}
+	return ""
+}
+
+func (x *VideoMetadata) GetUpstreamStatusSince() string {
+	if x != nil {
+		return x.UpstreamStatusSince
+	}
+	return ""
+}
+
+func (x *VideoMetadata) GetUpstreamCheckedAt() string {
+	if x != nil {
+		return x.UpstreamCheckedAt
+	}
+	return ""
+}
+
+type VideoList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Videos         []*Video      `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	NumberOfVideos int64         `protobuf:"varint,2,opt,name=numberOfVideos,proto3" json:"numberOfVideos,omitempty"`
+	Categories     *CategoryList `protobuf:"bytes,3,opt,name=categories,proto3" json:"categories,omitempty"`
+	Facets         *SearchFacets `protobuf:"bytes,4,opt,name=facets,proto3" json:"facets,omitempty"`
+	// Cursors to the pages on either side of this one, empty if there aren't any. Unlike page numbers, they don't skip
+	// or repeat videos when the list changes between pages.
+	NextCursor string `protobuf:"bytes,5,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	PrevCursor string `protobuf:"bytes,6,opt,name=prevCursor,proto3" json:"prevCursor,omitempty"`
+}
+
+func (x *VideoList) Reset() {
+	*x = VideoList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VideoList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoList) ProtoMessage() {}
+
+func (x *VideoList) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoList.ProtoReflect.Descriptor instead.
+func (*VideoList) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *VideoList) GetVideos() []*Video {
+	if x != nil {
+		return x.Videos
+	}
+	return nil
+}
+
+func (x *VideoList) GetNumberOfVideos() int64 {
+	if x != nil {
+		return x.NumberOfVideos
+	}
+	return 0
+}
+
+func (x *VideoList) GetCategories() *CategoryList {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *VideoList) GetFacets() *SearchFacets {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+func (x *VideoList) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *VideoList) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
+type Video struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VideoTitle    string  `protobuf:"bytes,1,opt,name=videoTitle,proto3" json:"videoTitle,omitempty"`
+	Views         uint64  `protobuf:"varint,2,opt,name=views,proto3" json:"views,omitempty"`
+	Rating        int64   `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	ThumbnailLoc  string  `protobuf:"bytes,4,opt,name=thumbnailLoc,proto3" json:"thumbnailLoc,omitempty"`
+	VideoID       int64   `protobuf:"varint,5,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	AuthorName    string  `protobuf:"bytes,6,opt,name=authorName,proto3" json:"authorName,omitempty"`
+	UploadDate    string  `protobuf:"bytes,7,opt,name=uploadDate,proto3" json:"uploadDate,omitempty"`
+	AuthorID      int64   `protobuf:"varint,8,opt,name=authorID,proto3" json:"authorID,omitempty"`
+	VideoDuration float32 `protobuf:"fixed32,9,opt,name=videoDuration,proto3" json:"videoDuration,omitempty"`
+	IsMature      bool    `protobuf:"varint,10,opt,name=isMature,proto3" json:"isMature,omitempty"`
+}
+
+func (x *Video) Reset() {
+	*x = Video{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Video) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Video) ProtoMessage() {}
+
+func (x *Video) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Video.ProtoReflect.Descriptor instead.
+func (*Video) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Video) GetVideoTitle() string {
+	if x != nil {
+		return x.VideoTitle
+	}
+	return ""
+}
+
+func (x *Video) GetViews() uint64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *Video) GetRating() int64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Video) GetThumbnailLoc() string {
+	if x != nil {
+		return x.ThumbnailLoc
+	}
+	return ""
+}
+
+func (x *Video) GetVideoID() int64 {
+	if x != nil {
+		return x.VideoID
+	}
+	return 0
+}
+
+func (x *Video) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *Video) GetUploadDate() string {
+	if x != nil {
+		return x.UploadDate
+	}
+	return ""
+}
+
+func (x *Video) GetAuthorID() int64 {
+	if x != nil {
+		return x.AuthorID
+	}
+	return 0
+}
+
+func (x *Video) GetVideoDuration() float32 {
+	if x != nil {
+		return x.VideoDuration
+	}
+	return 0
+}
+
+func (x *Video) GetIsMature() bool {
+	if x != nil {
+		return x.IsMature
+	}
+	return false
+}
+
+type VideoRating struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID  int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	VideoID int64 `protobuf:"varint,2,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	Rating  int64 `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+}
+
+func (x *VideoRating) Reset() {
+	*x = VideoRating{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VideoRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoRating) ProtoMessage() {}
+
+func (x *VideoRating) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoRating.ProtoReflect.Descriptor instead.
+func (*VideoRating) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *VideoRating) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *VideoRating) GetVideoID() int64 {
+	if x != nil {
+		return x.VideoID
+	}
+	return 0
+}
+
+func (x *VideoRating) GetRating() int64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+type VideoViewing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VideoID int64 `protobuf:"varint,1,opt,name=videoID,proto3" json:"videoID,omitempty"`
+}
+
+func (x *VideoViewing) Reset() {
+	*x = VideoViewing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VideoViewing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoViewing) ProtoMessage() {}
+
+func (x *VideoViewing) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoViewing.ProtoReflect.Descriptor instead.
+func (*VideoViewing) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *VideoViewing) GetVideoID() int64 {
+	if x != nil {
+		return x.VideoID
+	}
+	return 0
+}
+
+type VideoApproval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID  int64 `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	VideoID int64 `protobuf:"varint,2,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	Mature  bool  `protobuf:"varint,3,opt,name=mature,proto3" json:"mature,omitempty"`
+}
+
+func (x *VideoApproval) Reset() {
+	*x = VideoApproval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VideoApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoApproval) ProtoMessage() {}
+
+func (x *VideoApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoApproval.ProtoReflect.Descriptor instead.
+func (*VideoApproval) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *VideoApproval) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *VideoApproval) GetVideoID() int64 {
+	if x != nil {
+		return x.VideoID
+	}
+	return 0
+}
+
+func (x *VideoApproval) GetMature() bool {
+	if x != nil {
+		return x.Mature
+	}
+	return false
+}
+
+type VideoQueryConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderBy        OrderCategory `protobuf:"varint,1,opt,name=orderBy,proto3,enum=proto.OrderCategory" json:"orderBy,omitempty"`
+	Direction      SortDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=proto.SortDirection" json:"direction,omitempty"`
+	PageNumber     int64         `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	SearchVal      string        `protobuf:"bytes,4,opt,name=SearchVal,proto3" json:"SearchVal,omitempty"`
+	FromUserID     int64         `protobuf:"varint,5,opt,name=fromUserID,proto3" json:"fromUserID,omitempty"` // domestic user ID
+	ShowUnapproved bool          `protobuf:"varint,6,opt,name=showUnapproved,proto3" json:"showUnapproved,omitempty"`
+	UnapprovedOnly bool          `protobuf:"varint,7,opt,name=unapprovedOnly,proto3" json:"unapprovedOnly,omitempty"`
+	Category       string        `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
+	// bool favoritesOnly = 9;
+	ShowMature       bool `protobuf:"varint,10,opt,name=showMature,proto3" json:"showMature,omitempty"`
+	LostUpstreamOnly bool `protobuf:"varint,11,opt,name=lostUpstreamOnly,proto3" json:"lostUpstreamOnly,omitempty"` // Only archived videos which are no longer available where they came from
+	// Tag filters ignore case. Every one of tags is required, none of excludedTags are allowed, and at least one of anyTags is required
+	Tags         []string `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
+	ExcludedTags []string `protobuf:"bytes,13,rep,name=excludedTags,proto3" json:"excludedTags,omitempty"`
+	AnyTags      []string `protobuf:"bytes,14,rep,name=anyTags,proto3" json:"anyTags,omitempty"`
+	Durations    []string `protobuf:"bytes,15,rep,name=durations,proto3" json:"durations,omitempty"` // Any of short (under 4 minutes), medium (4 to 20 minutes) or long
+	// Dates are YYYY-MM-DD or RFC3339. Since is inclusive, before isn't.
+	UploadedSince          string   `protobuf:"bytes,16,opt,name=uploadedSince,proto3" json:"uploadedSince,omitempty"`
+	UploadedBefore         string   `protobuf:"bytes,17,opt,name=uploadedBefore,proto3" json:"uploadedBefore,omitempty"`
+	OriginalUploadedSince  string   `protobuf:"bytes,18,opt,name=originalUploadedSince,proto3" json:"originalUploadedSince,omitempty"` // When archived videos were uploaded to the site they came from
+	OriginalUploadedBefore string   `protobuf:"bytes,19,opt,name=originalUploadedBefore,proto3" json:"originalUploadedBefore,omitempty"`
+	Sites                  []string `protobuf:"bytes,20,rep,name=sites,proto3" json:"sites,omitempty"` // Any of nicovideo, bilibili, youtube, domestic (not archived) or the host of another site
+	MinRating              int64    `protobuf:"varint,21,opt,name=minRating,proto3" json:"minRating,omitempty"`
+	MinViews               uint64   `protobuf:"varint,22,opt,name=minViews,proto3" json:"minViews,omitempty"`
+	HasDanmaku             bool     `protobuf:"varint,23,opt,name=hasDanmaku,proto3" json:"hasDanmaku,omitempty"`
+	// From a previous VideoList, with the same order and direction. pageNumber is ignored if it's set.
+	Cursor string `protobuf:"bytes,24,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *VideoQueryConfig) Reset() {
+	*x = VideoQueryConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VideoQueryConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoQueryConfig) ProtoMessage() {}
+
+func (x *VideoQueryConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoQueryConfig.ProtoReflect.Descriptor instead.
+func (*VideoQueryConfig) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *VideoQueryConfig) GetOrderBy() OrderCategory {
+	if x != nil {
+		return x.OrderBy
+	}
+	return OrderCategory_views
+}
+
+func (x *VideoQueryConfig) GetDirection() SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return SortDirection_asc
+}
+
+func (x *VideoQueryConfig) GetPageNumber() int64 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *VideoQueryConfig) GetSearchVal() string {
+	if x != nil {
+		return x.SearchVal
+	}
+	return ""
+}
+
+func (x *VideoQueryConfig) GetFromUserID() int64 {
+	if x != nil {
+		return x.FromUserID
+	}
+	return 0
+}
+
+func (x *VideoQueryConfig) GetShowUnapproved() bool {
+	if x != nil {
+		return x.ShowUnapproved
+	}
+	return false
+}
+
+func (x *VideoQueryConfig) GetUnapprovedOnly() bool {
+	if x != nil {
+		return x.UnapprovedOnly
+	}
+	return false
+}
+
+func (x *VideoQueryConfig) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *VideoQueryConfig) GetShowMature() bool {
+	if x != nil {
+		return x.ShowMature
+	}
+	return false
+}
+
+func (x *VideoQueryConfig) GetLostUpstreamOnly() bool {
+	if x != nil {
+		return x.LostUpstreamOnly
+	}
+	return false
+}
+
+func (x *VideoQueryConfig) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *VideoQueryConfig) GetExcludedTags() []string {
+	if x != nil {
+		return x.ExcludedTags
+	}
+	return nil
+}
+
+func (x *VideoQueryConfig) GetAnyTags() []string {
+	if x != nil {
+		return x.AnyTags
+	}
+	return nil
+}
+
+func (x *VideoQueryConfig) GetDurations() []string {
+	if x != nil {
+		return x.Durations
+	}
+	return nil
+}
+
+func (x *VideoQueryConfig) GetUploadedSince() string {
+	if x != nil {
+		return x.UploadedSince
+	}
+	return ""
+}
+
+func (x *VideoQueryConfig) GetUploadedBefore() string {
+	if x != nil {
+		return x.UploadedBefore
+	}
+	return ""
+}
+
+func (x *VideoQueryConfig) GetOriginalUploadedSince() string {
+	if x != nil {
+		return x.OriginalUploadedSince
+	}
+	return ""
+}
+
+func (x *VideoQueryConfig) GetOriginalUploadedBefore() string {
+	if x != nil {
+		return x.OriginalUploadedBefore
+	}
+	return ""
+}
+
+func (x *VideoQueryConfig) GetSites() []string {
+	if x != nil {
+		return x.Sites
+	}
+	return nil
+}
+
+func (x *VideoQueryConfig) GetMinRating() int64 {
+	if x != nil {
+		return x.MinRating
+	}
+	return 0
+}
+
+func (x *VideoQueryConfig) GetMinViews() uint64 {
+	if x != nil {
+		return x.MinViews
+	}
+	return 0
+}
+
+func (x *VideoQueryConfig) GetHasDanmaku() bool {
+	if x != nil {
+		return x.HasDanmaku
+	}
+	return false
+}
+
+func (x *VideoQueryConfig) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type FacetCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *FacetCount) Reset() {
+	*x = FacetCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FacetCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetCount) ProtoMessage() {}
+
+func (x *FacetCount) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetCount.ProtoReflect.Descriptor instead.
+func (*FacetCount) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *FacetCount) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FacetCount) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Counts of the videos which matched a query, by the values they have for each filter
+type SearchFacets struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags        []*FacetCount `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"` // The most common tags
+	Durations   []*FacetCount `protobuf:"bytes,2,rep,name=durations,proto3" json:"durations,omitempty"`
+	Sites       []*FacetCount `protobuf:"bytes,3,rep,name=sites,proto3" json:"sites,omitempty"`
+	WithDanmaku uint64        `protobuf:"varint,4,opt,name=withDanmaku,proto3" json:"withDanmaku,omitempty"`
+}
+
+func (x *SearchFacets) Reset() {
+	*x = SearchFacets{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchFacets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFacets) ProtoMessage() {}
+
+func (x *SearchFacets) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFacets.ProtoReflect.Descriptor instead.
+func (*SearchFacets) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SearchFacets) GetTags() []*FacetCount {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SearchFacets) GetDurations() []*FacetCount {
+	if x != nil {
+		return x.Durations
+	}
+	return nil
+}
+
+func (x *SearchFacets) GetSites() []*FacetCount {
+	if x != nil {
+		return x.Sites
+	}
+	return nil
+}
+
+func (x *SearchFacets) GetWithDanmaku() uint64 {
+	if x != nil {
+		return x.WithDanmaku
+	}
+	return 0
+}
+
+type ForeignVideoCategory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ForeignVideoID string `protobuf:"bytes,1,opt,name=ForeignVideoID,proto3" json:"ForeignVideoID,omitempty"`
+	ForeignWebsite string `protobuf:"bytes,2,opt,name=ForeignWebsite,proto3" json:"ForeignWebsite,omitempty"`
+	Category       string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (x *ForeignVideoCategory) Reset() {
+	*x = ForeignVideoCategory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForeignVideoCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForeignVideoCategory) ProtoMessage() {}
+
+func (x *ForeignVideoCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForeignVideoCategory.ProtoReflect.Descriptor instead.
+func (*ForeignVideoCategory) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ForeignVideoCategory) GetForeignVideoID() string {
+	if x != nil {
+		return x.ForeignVideoID
+	}
+	return ""
+}
+
+func (x *ForeignVideoCategory) GetForeignWebsite() string {
+	if x != nil {
+		return x.ForeignWebsite
+	}
+	return ""
+}
+
+func (x *ForeignVideoCategory) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type VideoExistenceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exists bool `protobuf:"varint,1,opt,name=Exists,proto3" json:"Exists,omitempty"`
+}
+
+func (x *VideoExistenceResponse) Reset() {
+	*x = VideoExistenceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VideoExistenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoExistenceResponse) ProtoMessage() {}
+
+func (x *VideoExistenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoExistenceResponse.ProtoReflect.Descriptor instead.
+func (*VideoExistenceResponse) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *VideoExistenceResponse) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+type ForeignVideoCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ForeignVideoID string `protobuf:"bytes,1,opt,name=ForeignVideoID,proto3" json:"ForeignVideoID,omitempty"`
+	ForeignWebsite string `protobuf:"bytes,2,opt,name=ForeignWebsite,proto3" json:"ForeignWebsite,omitempty"`
+}
+
+func (x *ForeignVideoCheck) Reset() {
+	*x = ForeignVideoCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForeignVideoCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForeignVideoCheck) ProtoMessage() {}
+
+func (x *ForeignVideoCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForeignVideoCheck.ProtoReflect.Descriptor instead.
+func (*ForeignVideoCheck) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ForeignVideoCheck) GetForeignVideoID() string {
+	if x != nil {
+		return x.ForeignVideoID
+	}
+	return ""
+}
+
+func (x *ForeignVideoCheck) GetForeignWebsite() string {
+	if x != nil {
+		return x.ForeignWebsite
+	}
+	return ""
+}
+
+type VideoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VideoID string `protobuf:"bytes,1,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	// The rest are only used by downloadVideo
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"` // Where to start reading the original from. Negative values count back from the end of the file.
+	Length int64 `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"` // Number of bytes to read, 0 reads to the end of the file
+}
+
+func (x *VideoRequest) Reset() {
+	*x = VideoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VideoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoRequest) ProtoMessage() {}
+
+func (x *VideoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoRequest.ProtoReflect.Descriptor instead.
+func (*VideoRequest) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *VideoRequest) GetVideoID() string {
+	if x != nil {
+		return x.VideoID
+	}
+	return ""
+}
+
+func (x *VideoRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *VideoRequest) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type InputVideoChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Payload:
+	//	*InputVideoChunk_Content
+	//	*InputVideoChunk_Meta
+	//	*InputVideoChunk_Rawmeta
+	//	*InputVideoChunk_Danmaku
+	//	*InputVideoChunk_Subtitle
+	Payload isInputVideoChunk_Payload `protobuf_oneof:"Payload"`
+}
+
+func (x *InputVideoChunk) Reset() {
+	*x = InputVideoChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InputVideoChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputVideoChunk) ProtoMessage() {}
+
+func (x *InputVideoChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputVideoChunk.ProtoReflect.Descriptor instead.
+func (*InputVideoChunk) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{59}
+}
+
+func (m *InputVideoChunk) GetPayload() isInputVideoChunk_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *InputVideoChunk) GetContent() *FileContent {
+	if x, ok := x.GetPayload().(*InputVideoChunk_Content); ok {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *InputVideoChunk) GetMeta() *InputFileMetadata {
+	if x, ok := x.GetPayload().(*InputVideoChunk_Meta); ok {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *InputVideoChunk) GetRawmeta() *RawMetadata {
+	if x, ok := x.GetPayload().(*InputVideoChunk_Rawmeta); ok {
+		return x.Rawmeta
+	}
+	return nil
+}
+
+func (x *InputVideoChunk) GetDanmaku() *DanmakuList {
+	if x, ok := x.GetPayload().(*InputVideoChunk_Danmaku); ok {
+		return x.Danmaku
+	}
+	return nil
+}
+
+func (x *InputVideoChunk) GetSubtitle() *SubtitleUpload {
+	if x, ok := x.GetPayload().(*InputVideoChunk_Subtitle); ok {
+		return x.Subtitle
+	}
+	return nil
+}
+
+type isInputVideoChunk_Payload interface {
+	isInputVideoChunk_Payload()
+}
+
+type InputVideoChunk_Content struct {
+	Content *FileContent `protobuf:"bytes,1,opt,name=content,proto3,oneof"`
+}
+
+type InputVideoChunk_Meta struct {
+	Meta *InputFileMetadata `protobuf:"bytes,2,opt,name=meta,proto3,oneof"`
+}
+
+type InputVideoChunk_Rawmeta struct {
+	Rawmeta *RawMetadata `protobuf:"bytes,3,opt,name=rawmeta,proto3,oneof"` // This was added after the above two fields, and is used for the metadata.json file for the video
+}
+
+type InputVideoChunk_Danmaku struct {
+	Danmaku *DanmakuList `protobuf:"bytes,4,opt,name=danmaku,proto3,oneof"` // Danmaku archived from the original site, sent in batches
+}
+
+type InputVideoChunk_Subtitle struct {
+	Subtitle *SubtitleUpload `protobuf:"bytes,5,opt,name=subtitle,proto3,oneof"` // A subtitle track archived from the original site, one per message
+}
+
+func (*InputVideoChunk_Content) isInputVideoChunk_Payload() {}
+
+func (*InputVideoChunk_Meta) isInputVideoChunk_Payload() {}
+
+func (*InputVideoChunk_Rawmeta) isInputVideoChunk_Payload() {}
+
+func (*InputVideoChunk_Danmaku) isInputVideoChunk_Payload() {}
+
+func (*InputVideoChunk_Subtitle) isInputVideoChunk_Payload() {}
+
+type ResponseVideoChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Payload:
+	//	*ResponseVideoChunk_Content
+	//	*ResponseVideoChunk_Meta
+	Payload isResponseVideoChunk_Payload `protobuf_oneof:"Payload"`
+}
+
+func (x *ResponseVideoChunk) Reset() {
+	*x = ResponseVideoChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseVideoChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseVideoChunk) ProtoMessage() {}
+
+func (x *ResponseVideoChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseVideoChunk.ProtoReflect.Descriptor instead.
+func (*ResponseVideoChunk) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{60}
 }
 
 func (m *ResponseVideoChunk) GetPayload() isResponseVideoChunk_Payload {
@@ -2093,7 +4467,7 @@ type FileContent struct {
 func (x *FileContent) Reset() {
 	*x = FileContent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_videoservice_proto_msgTypes[29]
+		mi := &file_videoservice_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2106,7 +4480,7 @@ func (x *FileContent) String() string {
 func (*FileContent) ProtoMessage() {}
 
 func (x *FileContent) ProtoReflect() protoreflect.Message {
-	mi := &file_videoservice_proto_msgTypes[29]
+	mi := &file_videoservice_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2119,7 +4493,7 @@ func (x *FileContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileContent.ProtoReflect.Descriptor instead.
 func (*FileContent) Descriptor() ([]byte, []int) {
-	return file_videoservice_proto_rawDescGZIP(), []int{29}
+	return file_videoservice_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *FileContent) GetData() []byte {
@@ -2140,7 +4514,7 @@ type RawMetadata struct {
 func (x *RawMetadata) Reset() {
 	*x = RawMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_videoservice_proto_msgTypes[30]
+		mi := &file_videoservice_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2153,7 +4527,7 @@ func (x *RawMetadata) String() string {
 func (*RawMetadata) ProtoMessage() {}
 
 func (x *RawMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_videoservice_proto_msgTypes[30]
+	mi := &file_videoservice_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2166,7 +4540,7 @@ func (x *RawMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RawMetadata.ProtoReflect.Descriptor instead.
 func (*RawMetadata) Descriptor() ([]byte, []int) {
-	return file_videoservice_proto_rawDescGZIP(), []int{30}
+	return file_videoservice_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *RawMetadata) GetData() []byte {
@@ -2182,23 +4556,24 @@ type InputFileMetadata struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title             string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description       string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	AuthorUID         string   `protobuf:"bytes,3,opt,name=authorUID,proto3" json:"authorUID,omitempty"`                 // 0 if reupload
-	OriginalVideoLink string   `protobuf:"bytes,4,opt,name=originalVideoLink,proto3" json:"originalVideoLink,omitempty"` // If reupload
-	AuthorUsername    string   `protobuf:"bytes,5,opt,name=authorUsername,proto3" json:"authorUsername,omitempty"`       // If reupload
-	OriginalSite      string   `protobuf:"bytes,6,opt,name=originalSite,proto3" json:"originalSite,omitempty"`           // If reupload
-	OriginalID        string   `protobuf:"bytes,7,opt,name=originalID,proto3" json:"originalID,omitempty"`               // If reupload // this is a little dumb
-	DomesticAuthorID  int64    `protobuf:"varint,8,opt,name=domesticAuthorID,proto3" json:"domesticAuthorID,omitempty"`
-	Tags              []string `protobuf:"bytes,9,rep,name=Tags,proto3" json:"Tags,omitempty"`
-	Thumbnail         []byte   `protobuf:"bytes,10,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"` // lol good enough, I could stream this but this is easier
-	Category          string   `protobuf:"bytes,11,opt,name=category,proto3" json:"category,omitempty"`
+	Title              string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description        string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	AuthorUID          string   `protobuf:"bytes,3,opt,name=authorUID,proto3" json:"authorUID,omitempty"`                 // 0 if reupload
+	OriginalVideoLink  string   `protobuf:"bytes,4,opt,name=originalVideoLink,proto3" json:"originalVideoLink,omitempty"` // If reupload
+	AuthorUsername     string   `protobuf:"bytes,5,opt,name=authorUsername,proto3" json:"authorUsername,omitempty"`       // If reupload
+	OriginalSite       string   `protobuf:"bytes,6,opt,name=originalSite,proto3" json:"originalSite,omitempty"`           // If reupload
+	OriginalID         string   `protobuf:"bytes,7,opt,name=originalID,proto3" json:"originalID,omitempty"`               // If reupload // this is a little dumb
+	DomesticAuthorID   int64    `protobuf:"varint,8,opt,name=domesticAuthorID,proto3" json:"domesticAuthorID,omitempty"`
+	Tags               []string `protobuf:"bytes,9,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	Thumbnail          []byte   `protobuf:"bytes,10,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"` // lol good enough, I could stream this but this is easier
+	Category           string   `protobuf:"bytes,11,opt,name=category,proto3" json:"category,omitempty"`
+	OriginalUploadDate string   `protobuf:"bytes,12,opt,name=originalUploadDate,proto3" json:"originalUploadDate,omitempty"` // YYYY-MM-DD, if reupload and the site says
 }
 
 func (x *InputFileMetadata) Reset() {
 	*x = InputFileMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_videoservice_proto_msgTypes[31]
+		mi := &file_videoservice_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2211,7 +4586,7 @@ func (x *InputFileMetadata) String() string {
 func (*InputFileMetadata) ProtoMessage() {}
 
 func (x *InputFileMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_videoservice_proto_msgTypes[31]
+	mi := &file_videoservice_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2224,7 +4599,7 @@ func (x *InputFileMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InputFileMetadata.ProtoReflect.Descriptor instead.
 func (*InputFileMetadata) Descriptor() ([]byte, []int) {
-	return file_videoservice_proto_rawDescGZIP(), []int{31}
+	return file_videoservice_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *InputFileMetadata) GetTitle() string {
@@ -2304,6 +4679,13 @@ func (x *InputFileMetadata) GetCategory() string {
 	return ""
 }
 
+func (x *InputFileMetadata) GetOriginalUploadDate() string {
+	if x != nil {
+		return x.OriginalUploadDate
+	}
+	return ""
+}
+
 // For now, these two are the same, but may deviate in future
 type ResponseFileMetadata struct {
 	state         protoimpl.MessageState
@@ -2314,25 +4696,301 @@ type ResponseFileMetadata struct {
 	Description       string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	AuthorUID         int64  `protobuf:"varint,3,opt,name=authorUID,proto3" json:"authorUID,omitempty"`                // 0 if reupload
 	OriginalVideoLink string `protobuf:"bytes,4,opt,name=originalVideoLink,proto3" json:"originalVideoLink,omitempty"` // If reupload
+	Size              int64  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`                          // Size of the whole original
+	Sha256            string `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"`                       // Hex encoded checksum of the whole original, empty while it is being computed for older videos
+	Offset            int64  `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`                      // Offset of the first byte which is sent
+	Length            int64  `protobuf:"varint,8,opt,name=length,proto3" json:"length,omitempty"`                      // Number of bytes which are sent
+}
+
+func (x *ResponseFileMetadata) Reset() {
+	*x = ResponseFileMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseFileMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseFileMetadata) ProtoMessage() {}
+
+func (x *ResponseFileMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseFileMetadata.ProtoReflect.Descriptor instead.
+func (*ResponseFileMetadata) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ResponseFileMetadata) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ResponseFileMetadata) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ResponseFileMetadata) GetAuthorUID() int64 {
+	if x != nil {
+		return x.AuthorUID
+	}
+	return 0
+}
+
+func (x *ResponseFileMetadata) GetOriginalVideoLink() string {
+	if x != nil {
+		return x.OriginalVideoLink
+	}
+	return ""
+}
+
+func (x *ResponseFileMetadata) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ResponseFileMetadata) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *ResponseFileMetadata) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ResponseFileMetadata) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type UploadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VideoID int64 `protobuf:"varint,1,opt,name=videoID,proto3" json:"videoID,omitempty"`
+}
+
+func (x *UploadResponse) Reset() {
+	*x = UploadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadResponse) ProtoMessage() {}
+
+func (x *UploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadResponse.ProtoReflect.Descriptor instead.
+func (*UploadResponse) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UploadResponse) GetVideoID() int64 {
+	if x != nil {
+		return x.VideoID
+	}
+	return 0
+}
+
+type CommentDeletionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentID int64 `protobuf:"varint,1,opt,name=commentID,proto3" json:"commentID,omitempty"`
+	UserID    int64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *CommentDeletionReq) Reset() {
+	*x = CommentDeletionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentDeletionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentDeletionReq) ProtoMessage() {}
+
+func (x *CommentDeletionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentDeletionReq.ProtoReflect.Descriptor instead.
+func (*CommentDeletionReq) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CommentDeletionReq) GetCommentID() int64 {
+	if x != nil {
+		return x.CommentID
+	}
+	return 0
+}
+
+func (x *CommentDeletionReq) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type NewUploadSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meta   *InputFileMetadata `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Size   int64              `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`    // Size of the whole video in bytes
+	Sha256 string             `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"` // Hex encoded checksum of the whole video, optional
+	UserID int64              `protobuf:"varint,4,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *NewUploadSession) Reset() {
+	*x = NewUploadSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_videoservice_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewUploadSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewUploadSession) ProtoMessage() {}
+
+func (x *NewUploadSession) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewUploadSession.ProtoReflect.Descriptor instead.
+func (*NewUploadSession) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *NewUploadSession) GetMeta() *InputFileMetadata {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *NewUploadSession) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *NewUploadSession) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *NewUploadSession) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type UploadSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Offset    int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"` // Number of bytes received so far
+	Size      int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	ExpiresAt string `protobuf:"bytes,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
 }
 
-func (x *ResponseFileMetadata) Reset() {
-	*x = ResponseFileMetadata{}
+func (x *UploadSession) Reset() {
+	*x = UploadSession{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_videoservice_proto_msgTypes[32]
+		mi := &file_videoservice_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResponseFileMetadata) String() string {
+func (x *UploadSession) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseFileMetadata) ProtoMessage() {}
+func (*UploadSession) ProtoMessage() {}
 
-func (x *ResponseFileMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_videoservice_proto_msgTypes[32]
+func (x *UploadSession) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2343,64 +5001,69 @@ func (x *ResponseFileMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseFileMetadata.ProtoReflect.Descriptor instead.
-func (*ResponseFileMetadata) Descriptor() ([]byte, []int) {
-	return file_videoservice_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use UploadSession.ProtoReflect.Descriptor instead.
+func (*UploadSession) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *ResponseFileMetadata) GetTitle() string {
+func (x *UploadSession) GetSessionID() string {
 	if x != nil {
-		return x.Title
+		return x.SessionID
 	}
 	return ""
 }
 
-func (x *ResponseFileMetadata) GetDescription() string {
+func (x *UploadSession) GetOffset() int64 {
 	if x != nil {
-		return x.Description
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-func (x *ResponseFileMetadata) GetAuthorUID() int64 {
+func (x *UploadSession) GetSize() int64 {
 	if x != nil {
-		return x.AuthorUID
+		return x.Size
 	}
 	return 0
 }
 
-func (x *ResponseFileMetadata) GetOriginalVideoLink() string {
+func (x *UploadSession) GetExpiresAt() string {
 	if x != nil {
-		return x.OriginalVideoLink
+		return x.ExpiresAt
 	}
 	return ""
 }
 
-type UploadResponse struct {
+// The first chunk in the stream identifies the session and where the data starts. Data can be split across any number of chunks.
+type UploadChunk struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VideoID int64 `protobuf:"varint,1,opt,name=videoID,proto3" json:"videoID,omitempty"`
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	UserID    int64  `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Offset    int64  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"` // Must be the session's current offset
+	Sha256    string `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"`  // Hex encoded checksum of all of the data in the stream, optional
+	Data      []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *UploadResponse) Reset() {
-	*x = UploadResponse{}
+func (x *UploadChunk) Reset() {
+	*x = UploadChunk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_videoservice_proto_msgTypes[33]
+		mi := &file_videoservice_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UploadResponse) String() string {
+func (x *UploadChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadResponse) ProtoMessage() {}
+func (*UploadChunk) ProtoMessage() {}
 
-func (x *UploadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_videoservice_proto_msgTypes[33]
+func (x *UploadChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2411,44 +5074,72 @@ func (x *UploadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadResponse.ProtoReflect.Descriptor instead.
-func (*UploadResponse) Descriptor() ([]byte, []int) {
-	return file_videoservice_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use UploadChunk.ProtoReflect.Descriptor instead.
+func (*UploadChunk) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *UploadResponse) GetVideoID() int64 {
+func (x *UploadChunk) GetSessionID() string {
 	if x != nil {
-		return x.VideoID
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *UploadChunk) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
 	}
 	return 0
 }
 
-type CommentDeletionReq struct {
+func (x *UploadChunk) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *UploadChunk) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *UploadChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UploadSessionReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CommentID int64 `protobuf:"varint,1,opt,name=commentID,proto3" json:"commentID,omitempty"`
-	UserID    int64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	SessionID string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	UserID    int64  `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
 }
 
-func (x *CommentDeletionReq) Reset() {
-	*x = CommentDeletionReq{}
+func (x *UploadSessionReq) Reset() {
+	*x = UploadSessionReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_videoservice_proto_msgTypes[34]
+		mi := &file_videoservice_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CommentDeletionReq) String() string {
+func (x *UploadSessionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommentDeletionReq) ProtoMessage() {}
+func (*UploadSessionReq) ProtoMessage() {}
 
-func (x *CommentDeletionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_videoservice_proto_msgTypes[34]
+func (x *UploadSessionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_videoservice_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2459,19 +5150,19 @@ func (x *CommentDeletionReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommentDeletionReq.ProtoReflect.Descriptor instead.
-func (*CommentDeletionReq) Descriptor() ([]byte, []int) {
-	return file_videoservice_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use UploadSessionReq.ProtoReflect.Descriptor instead.
+func (*UploadSessionReq) Descriptor() ([]byte, []int) {
+	return file_videoservice_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *CommentDeletionReq) GetCommentID() int64 {
+func (x *UploadSessionReq) GetSessionID() string {
 	if x != nil {
-		return x.CommentID
+		return x.SessionID
 	}
-	return 0
+	return ""
 }
 
-func (x *CommentDeletionReq) GetUserID() int64 {
+func (x *UploadSessionReq) GetUserID() int64 {
 	if x != nil {
 		return x.UserID
 	}
@@ -2489,7 +5180,7 @@ var file_videoservice_proto_rawDesc = []byte{
 	0x6d, 0x61, 0x6b, 0x75, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
 	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2e, 0x64, 0x61, 0x6e, 0x6d, 0x61, 0x6b, 0x75, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x07, 0x64, 0x61, 0x6e, 0x6d, 0x61, 0x6b, 0x75,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x84, 0x02, 0x0a, 0x07, 0x64, 0x61, 0x6e, 0x6d, 0x61, 0x6b, 0x75,
 	0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x03, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74,
 	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
@@ -2502,332 +5193,788 @@ var file_videoservice_proto_rawDesc = []byte{
 	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6f,
 	0x6e, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
 	0x6f, 0x6e, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x41, 0x0a, 0x0b, 0x66, 0x61, 0x76, 0x6f, 0x72,
-	0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x19, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x07, 0x66, 0x65,
-	0x65, 0x64, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
-	0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x66,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
-	0x73, 0x68, 0x6f, 0x77, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x3f, 0x0a, 0x0c,
-	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0a,
-	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
-	0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x40, 0x0a,
-	0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x22,
-	0x2c, 0x0a, 0x10, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x22, 0x09, 0x0a,
-	0x07, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x5c, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x52,
-	0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x76,
-	0x69, 0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76,
-	0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x4d, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x77,
-	0x4d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x24, 0x0a, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52,
-	0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x22, 0x68, 0x0a, 0x08, 0x56, 0x69, 0x64, 0x65, 0x6f,
-	0x52, 0x65, 0x63, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c,
-	0x4c, 0x6f, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x68, 0x75, 0x6d, 0x62,
-	0x6e, 0x61, 0x69, 0x6c, 0x4c, 0x6f, 0x63, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f,
-	0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x64,
-	0x65, 0x6f, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f,
-	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49,
-	0x44, 0x22, 0x83, 0x01, 0x0a, 0x0c, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x76,
-	0x69, 0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76,
-	0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x4a, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64,
-	0x65, 0x6f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65,
-	0x6f, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x22, 0x5d, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70,
-	0x76, 0x6f, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a,
-	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x22, 0x41, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x98, 0x03, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
-	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
-	0x27, 0x0a, 0x0f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72,
-	0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65,
-	0x12, 0x37, 0x0a, 0x18, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x68, 0x61, 0x73, 0x5f, 0x75, 0x70, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x15, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x48,
-	0x61, 0x73, 0x55, 0x70, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x1a, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x64, 0x6f,
-	0x77, 0x6e, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x48, 0x61, 0x73, 0x44, 0x6f, 0x77,
-	0x6e, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64,
-	0x22, 0xa1, 0x03, 0x0a, 0x0d, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x4c, 0x6f, 0x63, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x4c, 0x6f, 0x63, 0x12, 0x1e,
-	0x0a, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76,
-	0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x5c, 0x0a, 0x10, 0x64,
+	0x61, 0x6e, 0x6d, 0x61, 0x6b, 0x75, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x22, 0x8a, 0x01, 0x0a, 0x10, 0x64, 0x61,
+	0x6e, 0x6d, 0x61, 0x6b, 0x75, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x19,
+	0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x64, 0x61, 0x6e, 0x6d, 0x61, 0x6b, 0x75, 0x52, 0x08, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x2f, 0x0a, 0x11, 0x64, 0x61, 0x6e, 0x6d, 0x61, 0x6b,
+	0x75, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0xb8, 0x01, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x22, 0x41, 0x0a, 0x11, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x06, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4b, 0x0a, 0x11, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x49, 0x64, 0x22, 0x7a, 0x0a, 0x12, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22,
+	0x3c, 0x0a, 0x0d, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x41, 0x0a,
+	0x11, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x75, 0x70, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x22, 0x97, 0x01, 0x0a, 0x14, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x72, 0x65, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x66,
+	0x74, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x29, 0x0a, 0x0c, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x49, 0x64, 0x22, 0x34, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x61,
+	0x67, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x74, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x4b, 0x0a, 0x09, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x54, 0x61, 0x67, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54,
+	0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x0a, 0x74, 0x61, 0x67,
+	0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6d,
+	0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x22, 0x79, 0x0a, 0x0c, 0x74, 0x61, 0x67, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x64, 0x69, 0x74, 0x49, 0x64, 0x22, 0x4b,
+	0x0a, 0x0d, 0x74, 0x61, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12,
+	0x19, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x9c, 0x02, 0x0a, 0x07,
+	0x74, 0x61, 0x67, 0x45, 0x64, 0x69, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61,
+	0x67, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x64,
+	0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x64, 0x45, 0x64, 0x69, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x61, 0x67, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x61, 0x67, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x74, 0x61, 0x67, 0x73, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x74, 0x61, 0x67, 0x73, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x48, 0x0a, 0x0a, 0x74, 0x61,
+	0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x64, 0x69, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x74, 0x61, 0x67, 0x45, 0x64, 0x69, 0x74, 0x52, 0x05, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x22, 0x41, 0x0a, 0x0b, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x0e, 0x66, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x76,
+	0x6f, 0x72, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x61, 0x76,
+	0x6f, 0x72, 0x69, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6b, 0x0a, 0x0e, 0x66,
+	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x5f,
+	0x6d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x68,
+	0x6f, 0x77, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x9a, 0x02, 0x0a, 0x08, 0x70, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70,
+	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x65, 0x64, 0x41, 0x74, 0x22, 0x36, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x45, 0x0a, 0x0f, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3d, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x10, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x6f, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x7e, 0x0a, 0x13, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6c,
+	0x6f, 0x6f, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x6f, 0x6f, 0x70, 0x22,
+	0x70, 0x0a, 0x12, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x4e, 0x65, 0x69, 0x67, 0x68,
+	0x62, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x6e, 0x65, 0x78,
+	0x74, 0x22, 0x68, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0e,
+	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x4d, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x4d, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x3f, 0x0a, 0x0c, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0a, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x40, 0x0a, 0x08,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x2c,
+	0x0a, 0x10, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x22, 0x09, 0x0a, 0x07,
+	0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x5c, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x52, 0x65,
+	0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x4d, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x4d,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x24, 0x0a, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x06,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x22, 0x68, 0x0a, 0x08, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52,
+	0x65, 0x63, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x4c,
+	0x6f, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e,
+	0x61, 0x69, 0x6c, 0x4c, 0x6f, 0x63, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44,
+	0x22, 0x83, 0x01, 0x0a, 0x0c, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x4a, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x22, 0x5d, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x76,
+	0x6f, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x22, 0x41, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0xd3, 0x03, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c,
+	0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x37, 0x0a, 0x18, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x68, 0x61, 0x73, 0x5f, 0x75, 0x70, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x15, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x48, 0x61,
+	0x73, 0x55, 0x70, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x1a, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x64, 0x6f, 0x77,
+	0x6e, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x48, 0x61, 0x73, 0x44, 0x6f, 0x77, 0x6e,
+	0x76, 0x6f, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c,
+	0x69, 0x6b, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf5, 0x04, 0x0a, 0x0d, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x4c, 0x6f, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x4c, 0x6f, 0x63, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49,
+	0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44,
+	0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69,
+	0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61,
+	0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x48, 0x4c, 0x53, 0x4c, 0x6f, 0x63, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x48, 0x4c, 0x53, 0x4c, 0x6f, 0x63, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52,
+	0x09, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x75, 0x70,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53,
+	0x69, 0x6e, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0xfb, 0x01, 0x0a, 0x09, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x24, 0x0a, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x06,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x4f, 0x66, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x12, 0x33,
+	0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x52, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x22, 0xb1, 0x02, 0x0a, 0x05, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x68, 0x75, 0x6d,
+	0x62, 0x6e, 0x61, 0x69, 0x6c, 0x4c, 0x6f, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x4c, 0x6f, 0x63, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
 	0x44, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0a, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x76, 0x69, 0x64, 0x65,
-	0x6f, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x0d, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
-	0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68,
-	0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
-	0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x4d, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4d, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x09, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f,
-	0x52, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x4f, 0x66, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73,
-	0x12, 0x33, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0xb1, 0x02, 0x0a, 0x05, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12,
-	0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
-	0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a,
-	0x0c, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x4c, 0x6f, 0x63, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x4c, 0x6f,
-	0x63, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x76, 0x69, 0x64, 0x65, 0x6f,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d,
-	0x76, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
-	0x08, 0x69, 0x73, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x69, 0x73, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x57, 0x0a, 0x0b, 0x76, 0x69, 0x64,
-	0x65, 0x6f, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x61, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x4d, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4d, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x22, 0x57, 0x0a, 0x0b, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x28, 0x0a,
+	0x0c, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x56, 0x69, 0x65, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x22, 0x59, 0x0a, 0x0d, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
 	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
 	0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61,
-	0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69,
-	0x6e, 0x67, 0x22, 0x28, 0x0a, 0x0c, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x56, 0x69, 0x65, 0x77, 0x69,
-	0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x22, 0x59, 0x0a, 0x0d,
-	0x76, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x16, 0x0a,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x12,
-	0x16, 0x0a, 0x06, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xe0, 0x02, 0x0a, 0x10, 0x56, 0x69, 0x64, 0x65,
-	0x6f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x07,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x79, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x32, 0x0a, 0x09,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x12, 0x1e,
-	0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26,
-	0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x77, 0x55, 0x6e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x68, 0x6f, 0x77, 0x55, 0x6e, 0x61, 0x70,
-	0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x75, 0x6e, 0x61, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
-	0x75, 0x6e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1a,
-	0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x68,
-	0x6f, 0x77, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x73, 0x68, 0x6f, 0x77, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x30, 0x0a, 0x16, 0x56, 0x69,
-	0x64, 0x65, 0x6f, 0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x63, 0x0a, 0x11,
-	0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x56, 0x69, 0x64, 0x65,
-	0x6f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x46, 0x6f, 0x72, 0x65, 0x69,
-	0x67, 0x6e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x6f, 0x72,
-	0x65, 0x69, 0x67, 0x6e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74,
-	0x65, 0x22, 0x28, 0x0a, 0x0c, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x22, 0xac, 0x01, 0x0a, 0x0f,
-	0x49, 0x6e, 0x70, 0x75, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12,
-	0x2e, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
-	0x2e, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12,
-	0x2e, 0x0a, 0x07, 0x72, 0x61, 0x77, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x61, 0x77, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x07, 0x72, 0x61, 0x77, 0x6d, 0x65, 0x74, 0x61, 0x42,
-	0x09, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x12, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x68, 0x75, 0x6e,
-	0x6b, 0x12, 0x2e, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x31, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x04,
-	0x6d, 0x65, 0x74, 0x61, 0x42, 0x09, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22,
-	0x21, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61,
-	0x74, 0x61, 0x22, 0x21, 0x0a, 0x0b, 0x52, 0x61, 0x77, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0xfd, 0x02, 0x0a, 0x11, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x46,
-	0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x55, 0x49, 0x44,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x55, 0x49,
-	0x44, 0x12, 0x2c, 0x0a, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x56, 0x69, 0x64,
-	0x65, 0x6f, 0x4c, 0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x72,
-	0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
-	0x26, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x55,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x61, 0x6c, 0x53, 0x69, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x64,
-	0x6f, 0x6d, 0x65, 0x73, 0x74, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x44, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x64, 0x6f, 0x6d, 0x65, 0x73, 0x74, 0x69, 0x63, 0x41,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18,
-	0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
-	0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x9a, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14,
+	0x03, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0xc0, 0x06, 0x0a, 0x10, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x32, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x72, 0x6f,
+	0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66,
+	0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x68, 0x6f,
+	0x77, 0x55, 0x6e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x73, 0x68, 0x6f, 0x77, 0x55, 0x6e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x64, 0x12, 0x26, 0x0a, 0x0e, 0x75, 0x6e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x4f,
+	0x6e, 0x6c, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x75, 0x6e, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x4d, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x77, 0x4d,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x6f, 0x73, 0x74, 0x55, 0x70, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x6c, 0x6f, 0x73, 0x74, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x6e, 0x6c,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x64, 0x54, 0x61, 0x67, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x64, 0x54, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x79,
+	0x54, 0x61, 0x67, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6e, 0x79, 0x54,
+	0x61, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x53, 0x69, 0x6e,
+	0x63, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x64, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x75, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12,
+	0x34, 0x0a, 0x15, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64,
+	0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x16, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x69, 0x74, 0x65, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x73, 0x69,
+	0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x65, 0x77, 0x73, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x56, 0x69, 0x65, 0x77, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x68, 0x61, 0x73, 0x44, 0x61, 0x6e, 0x6d, 0x61, 0x6b, 0x75, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x44, 0x61, 0x6e, 0x6d, 0x61, 0x6b, 0x75, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x38, 0x0a, 0x0a, 0x66, 0x61, 0x63, 0x65, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0xb1, 0x01, 0x0a, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73,
+	0x12, 0x25, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x63, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x66, 0x61, 0x63, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x09, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x69, 0x74, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x66, 0x61, 0x63, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x05, 0x73, 0x69, 0x74, 0x65,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x44, 0x61, 0x6e, 0x6d, 0x61, 0x6b, 0x75,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x44, 0x61, 0x6e, 0x6d,
+	0x61, 0x6b, 0x75, 0x22, 0x82, 0x01, 0x0a, 0x14, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x0e,
+	0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x57,
+	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x46, 0x6f,
+	0x72, 0x65, 0x69, 0x67, 0x6e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x30, 0x0a, 0x16, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x63, 0x0a, 0x11, 0x46, 0x6f,
+	0x72, 0x65, 0x69, 0x67, 0x6e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12,
+	0x26, 0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e,
+	0x56, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x65, 0x69,
+	0x67, 0x6e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x22,
+	0x58, 0x0a, 0x0c, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x91, 0x02, 0x0a, 0x0f, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x2e, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a,
+	0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x2e, 0x0a,
+	0x07, 0x72, 0x61, 0x77, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x61, 0x77, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x48, 0x00, 0x52, 0x07, 0x72, 0x61, 0x77, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x2e, 0x0a,
+	0x07, 0x64, 0x61, 0x6e, 0x6d, 0x61, 0x6b, 0x75, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x64, 0x61, 0x6e, 0x6d, 0x61, 0x6b, 0x75, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x64, 0x61, 0x6e, 0x6d, 0x61, 0x6b, 0x75, 0x12, 0x33, 0x0a,
+	0x08, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x08, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x82, 0x01,
+	0x0a, 0x12, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x12, 0x2e, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48,
+	0x00, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x42, 0x09, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x22, 0x21, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x21, 0x0a, 0x0b, 0x52, 0x61, 0x77, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0xad, 0x03, 0x0a, 0x11, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14,
 	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
 	0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
 	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
 	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x55, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x55, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
 	0x72, 0x55, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
 	0x56, 0x69, 0x64, 0x65, 0x6f, 0x4c, 0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4c, 0x69,
-	0x6e, 0x6b, 0x22, 0x2a, 0x0a, 0x0e, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x22, 0x4a,
-	0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x2a, 0x47, 0x0a, 0x0d, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x09, 0x0a, 0x05, 0x76,
-	0x69, 0x65, 0x77, 0x73, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
-	0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x64, 0x61, 0x74,
-	0x65, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x6d, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
-	0x73, 0x10, 0x03, 0x2a, 0x22, 0x0a, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x07, 0x0a, 0x03, 0x61, 0x73, 0x63, 0x10, 0x00, 0x12, 0x08, 0x0a,
-	0x04, 0x64, 0x65, 0x73, 0x63, 0x10, 0x02, 0x32, 0x8f, 0x08, 0x0a, 0x0c, 0x56, 0x69, 0x64, 0x65,
-	0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x75, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x49, 0x6e, 0x70, 0x75, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x1a,
-	0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x43, 0x0a, 0x0d, 0x64, 0x6f,
-	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x13, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x00, 0x30, 0x01, 0x12,
-	0x4f, 0x0a, 0x12, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x45,
-	0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f,
-	0x72, 0x65, 0x69, 0x67, 0x6e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x1a,
-	0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x45, 0x78, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x3b, 0x0a, 0x0c, 0x67, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x37, 0x0a,
-	0x08, 0x67, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x09, 0x72, 0x61, 0x74, 0x65, 0x56, 0x69,
-	0x64, 0x65, 0x6f, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x69, 0x64, 0x65,
-	0x6f, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x09, 0x76, 0x69, 0x65,
-	0x77, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
-	0x69, 0x64, 0x65, 0x6f, 0x56, 0x69, 0x65, 0x77, 0x69, 0x6e, 0x67, 0x1a, 0x0e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x34, 0x0a,
-	0x0b, 0x4d, 0x61, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x13, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e,
-	0x67, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x11, 0x4d, 0x61, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x55, 0x70, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x76, 0x6f, 0x74, 0x65, 0x1a, 0x0e,
+	0x6e, 0x6b, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x74, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x12, 0x2a,
+	0x0a, 0x10, 0x64, 0x6f, 0x6d, 0x65, 0x73, 0x74, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x64, 0x6f, 0x6d, 0x65, 0x73, 0x74,
+	0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61,
+	0x67, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x12, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x6c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0xf6, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x55, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x55, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x61, 0x6c, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4c, 0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35,
+	0x36, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x22, 0x2a, 0x0a, 0x0e, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x44, 0x22, 0x4a, 0x0a,
+	0x12, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x84, 0x01, 0x0a, 0x10, 0x6e, 0x65,
+	0x77, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c,
+	0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x22, 0x77, 0x0a, 0x0d, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x0b, 0x75, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35,
+	0x36, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x48, 0x0a, 0x10, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x2a, 0x47, 0x0a,
+	0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x09,
+	0x0a, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x6d, 0x79, 0x5f, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x10, 0x03, 0x2a, 0x22, 0x0a, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x07, 0x0a, 0x03, 0x61, 0x73, 0x63, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x10, 0x02, 0x32, 0xbc, 0x17, 0x0a, 0x0c, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x75,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x75, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x43, 0x0a,
+	0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x13,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x4f, 0x0a, 0x12, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f,
+	0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x17, 0x73, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67,
+	0x6e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x56, 0x69,
+	0x64, 0x65, 0x6f, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x1a, 0x0e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x3b, 0x0a,
+	0x0c, 0x67, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x67, 0x65,
+	0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x09, 0x72, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f,
+	0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x74,
+	0x68, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x09, 0x76, 0x69, 0x65, 0x77, 0x56, 0x69,
+	0x64, 0x65, 0x6f, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x56, 0x69, 0x65, 0x77, 0x69, 0x6e, 0x67, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0b, 0x4d, 0x61,
+	0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x0e,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x00,
-	0x12, 0x4a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x46,
-	0x6f, 0x72, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x17,
-	0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x72, 0x65, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72,
-	0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x1a, 0x0e,
+	0x12, 0x3b, 0x0a, 0x11, 0x4d, 0x61, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55,
+	0x70, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x76, 0x6f, 0x74, 0x65, 0x1a, 0x0e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x4a, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x65, 0x63,
+	0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x72, 0x65, 0x63, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x1a, 0x0e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x38, 0x0a,
+	0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x17, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f,
+	0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x74, 0x68,
+	0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x46, 0x65, 0x65, 0x64, 0x12, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66,
+	0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x61, 0x64,
+	0x64, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x11, 0x67, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f,
+	0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x15,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0c, 0x67, 0x65, 0x74, 0x46, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x10,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4c, 0x69, 0x73, 0x74,
+	0x22, 0x00, 0x12, 0x34, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0e, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x36,
+	0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x74,
+	0x68, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0d,
+	0x6c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x16, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x6c,
+	0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x10,
+	0x61, 0x64, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22,
+	0x00, 0x12, 0x46, 0x0a, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69,
+	0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x13, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x15, 0x67,
+	0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62,
+	0x6f, 0x75, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x6c, 0x61,
+	0x79, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
+	0x74, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x75, 0x72, 0x73, 0x22, 0x00, 0x12, 0x32, 0x0a,
+	0x07, 0x67, 0x65, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x61, 0x67, 0x73, 0x22,
+	0x00, 0x12, 0x2f, 0x0a, 0x06, 0x61, 0x64, 0x64, 0x54, 0x61, 0x67, 0x12, 0x11, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x61, 0x67, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x10,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x61, 0x67, 0x73,
+	0x22, 0x00, 0x12, 0x32, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x67, 0x12,
+	0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x61, 0x67, 0x45, 0x64, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x54, 0x61, 0x67, 0x73, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x54, 0x61, 0x67,
+	0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x61, 0x67,
+	0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x61, 0x67, 0x73, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0a, 0x72,
+	0x65, 0x76, 0x65, 0x72, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x74, 0x61, 0x67, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x10,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x61, 0x67, 0x73,
+	0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0d, 0x67, 0x65, 0x74, 0x54, 0x61, 0x67, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x74, 0x61, 0x67, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x74, 0x61, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x00, 0x12, 0x3a,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x44, 0x61, 0x6e, 0x6d, 0x61, 0x6b, 0x75, 0x12, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x64, 0x61, 0x6e, 0x6d, 0x61, 0x6b, 0x75, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x64, 0x61, 0x6e,
+	0x6d, 0x61, 0x6b, 0x75, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x0a, 0x61, 0x64,
+	0x64, 0x44, 0x61, 0x6e, 0x6d, 0x61, 0x6b, 0x75, 0x12, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x64, 0x61, 0x6e, 0x6d, 0x61, 0x6b, 0x75, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x64, 0x61, 0x6e, 0x6d, 0x61, 0x6b, 0x75, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0d, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x6e, 0x6d, 0x61, 0x6b, 0x75, 0x12, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x64, 0x61, 0x6e, 0x6d, 0x61, 0x6b, 0x75, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x64, 0x61, 0x6e,
+	0x6d, 0x61, 0x6b, 0x75, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0d, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x6e, 0x6d, 0x61, 0x6b, 0x75, 0x12, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x64, 0x61, 0x6e, 0x6d, 0x61, 0x6b, 0x75, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x64, 0x61, 0x6e,
+	0x6d, 0x61, 0x6b, 0x75, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x3f, 0x0a, 0x0e, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x75, 0x62, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x75, 0x62, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x22,
+	0x00, 0x12, 0x3f, 0x0a, 0x0c, 0x67, 0x65, 0x74, 0x53, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x73, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73,
+	0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74,
+	0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x75, 0x62,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0e,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x00,
-	0x12, 0x38, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12,
-	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0d, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e,
-	0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x46,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x65, 0x65, 0x64, 0x12, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x66, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3a, 0x0a,
-	0x0a, 0x47, 0x65, 0x74, 0x44, 0x61, 0x6e, 0x6d, 0x61, 0x6b, 0x75, 0x12, 0x16, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x64, 0x61, 0x6e, 0x6d, 0x61, 0x6b, 0x75, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x64, 0x61, 0x6e, 0x6d,
-	0x61, 0x6b, 0x75, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x0a, 0x61, 0x64, 0x64,
-	0x44, 0x61, 0x6e, 0x6d, 0x61, 0x6b, 0x75, 0x12, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x64, 0x61, 0x6e, 0x6d, 0x61, 0x6b, 0x75, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74,
+	0x12, 0x4c, 0x0a, 0x13, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x75, 0x70, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x45,
+	0x0a, 0x14, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x75,
+	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x74, 0x68,
+	0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x6e, 0x65, 0x77, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x75, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x41, 0x0a,
+	0x11, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x75, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x75,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x28, 0x01,
+	0x12, 0x43, 0x0a, 0x10, 0x67, 0x65, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x75, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0e, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74,
 	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x6f, 0x72, 0x61, 0x68, 0x6f, 0x72, 0x61,
 	0x64, 0x65, 0x76, 0x2f, 0x68, 0x6f, 0x72, 0x61, 0x68, 0x6f, 0x72, 0x61, 0x2f, 0x76, 0x69, 0x64,
 	0x65, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
@@ -2847,99 +5994,215 @@ func file_videoservice_proto_rawDescGZIP() []byte {
 }
 
 var file_videoservice_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_videoservice_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_videoservice_proto_msgTypes = make([]protoimpl.MessageInfo, 71)
 var file_videoservice_proto_goTypes = []interface{}{
 	(OrderCategory)(0),             // 0: proto.orderCategory
 	(SortDirection)(0),             // 1: proto.sortDirection
 	(*DanmakuQueryReq)(nil),        // 2: proto.danmakuQueryReq
 	(*DanmakuList)(nil),            // 3: proto.danmakuList
 	(*Danmaku)(nil),                // 4: proto.danmaku
-	(*FavoriteReq)(nil),            // 5: proto.favoriteReq
-	(*FeedReq)(nil),                // 6: proto.feedReq
-	(*CategoryList)(nil),           // 7: proto.CategoryList
-	(*Category)(nil),               // 8: proto.Category
-	(*VideoDeletionReq)(nil),       // 9: proto.videoDeletionReq
-	(*Nothing)(nil),                // 10: proto.Nothing
-	(*RecReq)(nil),                 // 11: proto.recReq
-	(*RecResp)(nil),                // 12: proto.recResp
-	(*VideoRec)(nil),               // 13: proto.VideoRec
-	(*VideoComment)(nil),           // 14: proto.videoComment
-	(*CommentRequest)(nil),         // 15: proto.commentRequest
-	(*CommentUpvote)(nil),          // 16: proto.commentUpvote
-	(*CommentListResponse)(nil),    // 17: proto.CommentListResponse
-	(*Comment)(nil),                // 18: proto.Comment
-	(*VideoMetadata)(nil),          // 19: proto.videoMetadata
-	(*VideoList)(nil),              // 20: proto.VideoList
-	(*Video)(nil),                  // 21: proto.Video
-	(*VideoRating)(nil),            // 22: proto.videoRating
-	(*VideoViewing)(nil),           // 23: proto.videoViewing
-	(*VideoApproval)(nil),          // 24: proto.videoApproval
-	(*VideoQueryConfig)(nil),       // 25: proto.VideoQueryConfig
-	(*VideoExistenceResponse)(nil), // 26: proto.VideoExistenceResponse
-	(*ForeignVideoCheck)(nil),      // 27: proto.ForeignVideoCheck
-	(*VideoRequest)(nil),           // 28: proto.VideoRequest
-	(*InputVideoChunk)(nil),        // 29: proto.InputVideoChunk
-	(*ResponseVideoChunk)(nil),     // 30: proto.ResponseVideoChunk
-	(*FileContent)(nil),            // 31: proto.FileContent
-	(*RawMetadata)(nil),            // 32: proto.RawMetadata
-	(*InputFileMetadata)(nil),      // 33: proto.InputFileMetadata
-	(*ResponseFileMetadata)(nil),   // 34: proto.ResponseFileMetadata
-	(*UploadResponse)(nil),         // 35: proto.uploadResponse
-	(*CommentDeletionReq)(nil),     // 36: proto.commentDeletionReq
+	(*DanmakuDeleteReq)(nil),       // 5: proto.danmakuDeleteReq
+	(*DanmakuImportReq)(nil),       // 6: proto.danmakuImportReq
+	(*DanmakuImportResp)(nil),      // 7: proto.danmakuImportResp
+	(*SubtitleTrack)(nil),          // 8: proto.subtitleTrack
+	(*SubtitleTrackList)(nil),      // 9: proto.subtitleTrackList
+	(*SubtitleUpload)(nil),         // 10: proto.subtitleUpload
+	(*SubtitleDeleteReq)(nil),      // 11: proto.subtitleDeleteReq
+	(*UpstreamCheckClaim)(nil),     // 12: proto.upstreamCheckClaim
+	(*UpstreamCheck)(nil),          // 13: proto.upstreamCheck
+	(*UpstreamCheckList)(nil),      // 14: proto.upstreamCheckList
+	(*UpstreamStatusReport)(nil),   // 15: proto.upstreamStatusReport
+	(*VideoTagsReq)(nil),           // 16: proto.videoTagsReq
+	(*VideoTag)(nil),               // 17: proto.videoTag
+	(*VideoTags)(nil),              // 18: proto.videoTags
+	(*TagEditReq)(nil),             // 19: proto.tagEditReq
+	(*TagRevertReq)(nil),           // 20: proto.tagRevertReq
+	(*TagHistoryReq)(nil),          // 21: proto.tagHistoryReq
+	(*TagEdit)(nil),                // 22: proto.tagEdit
+	(*TagHistory)(nil),             // 23: proto.tagHistory
+	(*FavoriteReq)(nil),            // 24: proto.favoriteReq
+	(*FavoriteStatus)(nil),         // 25: proto.favoriteStatus
+	(*FavoritesQuery)(nil),         // 26: proto.favoritesQuery
+	(*Playlist)(nil),               // 27: proto.playlist
+	(*PlaylistEntry)(nil),          // 28: proto.playlistEntry
+	(*PlaylistReq)(nil),            // 29: proto.playlistReq
+	(*PlaylistListReq)(nil),        // 30: proto.playlistListReq
+	(*PlaylistList)(nil),           // 31: proto.playlistList
+	(*PlaylistEntryReq)(nil),       // 32: proto.playlistEntryReq
+	(*PlaylistPositionReq)(nil),    // 33: proto.playlistPositionReq
+	(*PlaylistNeighbours)(nil),     // 34: proto.playlistNeighbours
+	(*FeedReq)(nil),                // 35: proto.feedReq
+	(*CategoryList)(nil),           // 36: proto.CategoryList
+	(*Category)(nil),               // 37: proto.Category
+	(*VideoDeletionReq)(nil),       // 38: proto.videoDeletionReq
+	(*Nothing)(nil),                // 39: proto.Nothing
+	(*RecReq)(nil),                 // 40: proto.recReq
+	(*RecResp)(nil),                // 41: proto.recResp
+	(*VideoRec)(nil),               // 42: proto.VideoRec
+	(*VideoComment)(nil),           // 43: proto.videoComment
+	(*CommentRequest)(nil),         // 44: proto.commentRequest
+	(*CommentUpvote)(nil),          // 45: proto.commentUpvote
+	(*CommentListResponse)(nil),    // 46: proto.CommentListResponse
+	(*Comment)(nil),                // 47: proto.Comment
+	(*VideoMetadata)(nil),          // 48: proto.videoMetadata
+	(*VideoList)(nil),              // 49: proto.VideoList
+	(*Video)(nil),                  // 50: proto.Video
+	(*VideoRating)(nil),            // 51: proto.videoRating
+	(*VideoViewing)(nil),           // 52: proto.videoViewing
+	(*VideoApproval)(nil),          // 53: proto.videoApproval
+	(*VideoQueryConfig)(nil),       // 54: proto.VideoQueryConfig
+	(*FacetCount)(nil),             // 55: proto.facetCount
+	(*SearchFacets)(nil),           // 56: proto.searchFacets
+	(*ForeignVideoCategory)(nil),   // 57: proto.foreignVideoCategory
+	(*VideoExistenceResponse)(nil), // 58: proto.VideoExistenceResponse
+	(*ForeignVideoCheck)(nil),      // 59: proto.ForeignVideoCheck
+	(*VideoRequest)(nil),           // 60: proto.VideoRequest
+	(*InputVideoChunk)(nil),        // 61: proto.InputVideoChunk
+	(*ResponseVideoChunk)(nil),     // 62: proto.ResponseVideoChunk
+	(*FileContent)(nil),            // 63: proto.FileContent
+	(*RawMetadata)(nil),            // 64: proto.RawMetadata
+	(*InputFileMetadata)(nil),      // 65: proto.InputFileMetadata
+	(*ResponseFileMetadata)(nil),   // 66: proto.ResponseFileMetadata
+	(*UploadResponse)(nil),         // 67: proto.uploadResponse
+	(*CommentDeletionReq)(nil),     // 68: proto.commentDeletionReq
+	(*NewUploadSession)(nil),       // 69: proto.newUploadSession
+	(*UploadSession)(nil),          // 70: proto.uploadSession
+	(*UploadChunk)(nil),            // 71: proto.uploadChunk
+	(*UploadSessionReq)(nil),       // 72: proto.uploadSessionReq
 }
 var file_videoservice_proto_depIdxs = []int32{
 	4,  // 0: proto.danmakuList.comments:type_name -> proto.danmaku
-	8,  // 1: proto.CategoryList.categories:type_name -> proto.Category
-	21, // 2: proto.recResp.videos:type_name -> proto.Video
-	18, // 3: proto.CommentListResponse.comments:type_name -> proto.Comment
-	21, // 4: proto.VideoList.videos:type_name -> proto.Video
-	7,  // 5: proto.VideoList.categories:type_name -> proto.CategoryList
-	0,  // 6: proto.VideoQueryConfig.orderBy:type_name -> proto.orderCategory
-	1,  // 7: proto.VideoQueryConfig.direction:type_name -> proto.sortDirection
-	31, // 8: proto.InputVideoChunk.content:type_name -> proto.FileContent
-	33, // 9: proto.InputVideoChunk.meta:type_name -> proto.InputFileMetadata
-	32, // 10: proto.InputVideoChunk.rawmeta:type_name -> proto.RawMetadata
-	31, // 11: proto.ResponseVideoChunk.content:type_name -> proto.FileContent
-	34, // 12: proto.ResponseVideoChunk.meta:type_name -> proto.ResponseFileMetadata
-	29, // 13: proto.VideoService.uploadVideo:input_type -> proto.InputVideoChunk
-	28, // 14: proto.VideoService.downloadVideo:input_type -> proto.VideoRequest
-	27, // 15: proto.VideoService.foreignVideoExists:input_type -> proto.ForeignVideoCheck
-	25, // 16: proto.VideoService.getVideoList:input_type -> proto.VideoQueryConfig
-	28, // 17: proto.VideoService.getVideo:input_type -> proto.VideoRequest
-	22, // 18: proto.VideoService.rateVideo:input_type -> proto.videoRating
-	23, // 19: proto.VideoService.viewVideo:input_type -> proto.videoViewing
-	14, // 20: proto.VideoService.MakeComment:input_type -> proto.videoComment
-	16, // 21: proto.VideoService.MakeCommentUpvote:input_type -> proto.commentUpvote
-	15, // 22: proto.VideoService.GetCommentsForVideo:input_type -> proto.commentRequest
-	11, // 23: proto.VideoService.GetVideoRecommendations:input_type -> proto.recReq
-	24, // 24: proto.VideoService.ApproveVideo:input_type -> proto.videoApproval
-	9,  // 25: proto.VideoService.DeleteVideo:input_type -> proto.videoDeletionReq
-	36, // 26: proto.VideoService.DeleteComment:input_type -> proto.commentDeletionReq
-	6,  // 27: proto.VideoService.GetFollowFeed:input_type -> proto.feedReq
-	2,  // 28: proto.VideoService.GetDanmaku:input_type -> proto.danmakuQueryReq
-	4,  // 29: proto.VideoService.addDanmaku:input_type -> proto.danmaku
-	35, // 30: proto.VideoService.uploadVideo:output_type -> proto.uploadResponse
-	30, // 31: proto.VideoService.downloadVideo:output_type -> proto.ResponseVideoChunk
-	26, // 32: proto.VideoService.foreignVideoExists:output_type -> proto.VideoExistenceResponse
-	20, // 33: proto.VideoService.getVideoList:output_type -> proto.VideoList
-	19, // 34: proto.VideoService.getVideo:output_type -> proto.videoMetadata
-	10, // 35: proto.VideoService.rateVideo:output_type -> proto.Nothing
-	10, // 36: proto.VideoService.viewVideo:output_type -> proto.Nothing
-	10, // 37: proto.VideoService.MakeComment:output_type -> proto.Nothing
-	10, // 38: proto.VideoService.MakeCommentUpvote:output_type -> proto.Nothing
-	17, // 39: proto.VideoService.GetCommentsForVideo:output_type -> proto.CommentListResponse
-	12, // 40: proto.VideoService.GetVideoRecommendations:output_type -> proto.recResp
-	10, // 41: proto.VideoService.ApproveVideo:output_type -> proto.Nothing
-	10, // 42: proto.VideoService.DeleteVideo:output_type -> proto.Nothing
-	10, // 43: proto.VideoService.DeleteComment:output_type -> proto.Nothing
-	20, // 44: proto.VideoService.GetFollowFeed:output_type -> proto.VideoList
-	3,  // 45: proto.VideoService.GetDanmaku:output_type -> proto.danmakuList
-	10, // 46: proto.VideoService.addDanmaku:output_type -> proto.Nothing
-	30, // [30:47] is the sub-list for method output_type
-	13, // [13:30] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	4,  // 1: proto.danmakuImportReq.comments:type_name -> proto.danmaku
+	8,  // 2: proto.subtitleTrackList.tracks:type_name -> proto.subtitleTrack
+	13, // 3: proto.upstreamCheckList.checks:type_name -> proto.upstreamCheck
+	17, // 4: proto.videoTags.tags:type_name -> proto.videoTag
+	22, // 5: proto.tagHistory.edits:type_name -> proto.tagEdit
+	28, // 6: proto.playlist.entries:type_name -> proto.playlistEntry
+	50, // 7: proto.playlistEntry.video:type_name -> proto.Video
+	27, // 8: proto.playlistList.playlists:type_name -> proto.playlist
+	28, // 9: proto.playlistNeighbours.previous:type_name -> proto.playlistEntry
+	28, // 10: proto.playlistNeighbours.next:type_name -> proto.playlistEntry
+	37, // 11: proto.CategoryList.categories:type_name -> proto.Category
+	50, // 12: proto.recResp.videos:type_name -> proto.Video
+	47, // 13: proto.CommentListResponse.comments:type_name -> proto.Comment
+	8,  // 14: proto.videoMetadata.subtitles:type_name -> proto.subtitleTrack
+	50, // 15: proto.VideoList.videos:type_name -> proto.Video
+	36, // 16: proto.VideoList.categories:type_name -> proto.CategoryList
+	56, // 17: proto.VideoList.facets:type_name -> proto.searchFacets
+	0,  // 18: proto.VideoQueryConfig.orderBy:type_name -> proto.orderCategory
+	1,  // 19: proto.VideoQueryConfig.direction:type_name -> proto.sortDirection
+	55, // 20: proto.searchFacets.tags:type_name -> proto.facetCount
+	55, // 21: proto.searchFacets.durations:type_name -> proto.facetCount
+	55, // 22: proto.searchFacets.sites:type_name -> proto.facetCount
+	63, // 23: proto.InputVideoChunk.content:type_name -> proto.FileContent
+	65, // 24: proto.InputVideoChunk.meta:type_name -> proto.InputFileMetadata
+	64, // 25: proto.InputVideoChunk.rawmeta:type_name -> proto.RawMetadata
+	3,  // 26: proto.InputVideoChunk.danmaku:type_name -> proto.danmakuList
+	10, // 27: proto.InputVideoChunk.subtitle:type_name -> proto.subtitleUpload
+	63, // 28: proto.ResponseVideoChunk.content:type_name -> proto.FileContent
+	66, // 29: proto.ResponseVideoChunk.meta:type_name -> proto.ResponseFileMetadata
+	65, // 30: proto.newUploadSession.meta:type_name -> proto.InputFileMetadata
+	61, // 31: proto.VideoService.uploadVideo:input_type -> proto.InputVideoChunk
+	60, // 32: proto.VideoService.downloadVideo:input_type -> proto.VideoRequest
+	59, // 33: proto.VideoService.foreignVideoExists:input_type -> proto.ForeignVideoCheck
+	57, // 34: proto.VideoService.setForeignVideoCategory:input_type -> proto.foreignVideoCategory
+	54, // 35: proto.VideoService.getVideoList:input_type -> proto.VideoQueryConfig
+	60, // 36: proto.VideoService.getVideo:input_type -> proto.VideoRequest
+	51, // 37: proto.VideoService.rateVideo:input_type -> proto.videoRating
+	52, // 38: proto.VideoService.viewVideo:input_type -> proto.videoViewing
+	43, // 39: proto.VideoService.MakeComment:input_type -> proto.videoComment
+	45, // 40: proto.VideoService.MakeCommentUpvote:input_type -> proto.commentUpvote
+	44, // 41: proto.VideoService.GetCommentsForVideo:input_type -> proto.commentRequest
+	40, // 42: proto.VideoService.GetVideoRecommendations:input_type -> proto.recReq
+	53, // 43: proto.VideoService.ApproveVideo:input_type -> proto.videoApproval
+	38, // 44: proto.VideoService.DeleteVideo:input_type -> proto.videoDeletionReq
+	68, // 45: proto.VideoService.DeleteComment:input_type -> proto.commentDeletionReq
+	35, // 46: proto.VideoService.GetFollowFeed:input_type -> proto.feedReq
+	24, // 47: proto.VideoService.addFavorite:input_type -> proto.favoriteReq
+	24, // 48: proto.VideoService.removeFavorite:input_type -> proto.favoriteReq
+	24, // 49: proto.VideoService.getFavoriteStatus:input_type -> proto.favoriteReq
+	26, // 50: proto.VideoService.getFavorites:input_type -> proto.favoritesQuery
+	27, // 51: proto.VideoService.createPlaylist:input_type -> proto.playlist
+	27, // 52: proto.VideoService.updatePlaylist:input_type -> proto.playlist
+	29, // 53: proto.VideoService.deletePlaylist:input_type -> proto.playlistReq
+	29, // 54: proto.VideoService.getPlaylist:input_type -> proto.playlistReq
+	30, // 55: proto.VideoService.listPlaylists:input_type -> proto.playlistListReq
+	32, // 56: proto.VideoService.addPlaylistEntry:input_type -> proto.playlistEntryReq
+	32, // 57: proto.VideoService.updatePlaylistEntry:input_type -> proto.playlistEntryReq
+	32, // 58: proto.VideoService.removePlaylistEntry:input_type -> proto.playlistEntryReq
+	33, // 59: proto.VideoService.getPlaylistNeighbours:input_type -> proto.playlistPositionReq
+	16, // 60: proto.VideoService.getTags:input_type -> proto.videoTagsReq
+	19, // 61: proto.VideoService.addTag:input_type -> proto.tagEditReq
+	19, // 62: proto.VideoService.removeTag:input_type -> proto.tagEditReq
+	19, // 63: proto.VideoService.setTagLock:input_type -> proto.tagEditReq
+	20, // 64: proto.VideoService.revertTags:input_type -> proto.tagRevertReq
+	21, // 65: proto.VideoService.getTagHistory:input_type -> proto.tagHistoryReq
+	2,  // 66: proto.VideoService.GetDanmaku:input_type -> proto.danmakuQueryReq
+	4,  // 67: proto.VideoService.addDanmaku:input_type -> proto.danmaku
+	5,  // 68: proto.VideoService.deleteDanmaku:input_type -> proto.danmakuDeleteReq
+	6,  // 69: proto.VideoService.importDanmaku:input_type -> proto.danmakuImportReq
+	10, // 70: proto.VideoService.uploadSubtitle:input_type -> proto.subtitleUpload
+	60, // 71: proto.VideoService.getSubtitles:input_type -> proto.VideoRequest
+	11, // 72: proto.VideoService.deleteSubtitle:input_type -> proto.subtitleDeleteReq
+	12, // 73: proto.VideoService.claimUpstreamChecks:input_type -> proto.upstreamCheckClaim
+	15, // 74: proto.VideoService.reportUpstreamStatus:input_type -> proto.upstreamStatusReport
+	69, // 75: proto.VideoService.createUploadSession:input_type -> proto.newUploadSession
+	71, // 76: proto.VideoService.appendUploadChunk:input_type -> proto.uploadChunk
+	72, // 77: proto.VideoService.getUploadSession:input_type -> proto.uploadSessionReq
+	72, // 78: proto.VideoService.finalizeUpload:input_type -> proto.uploadSessionReq
+	67, // 79: proto.VideoService.uploadVideo:output_type -> proto.uploadResponse
+	62, // 80: proto.VideoService.downloadVideo:output_type -> proto.ResponseVideoChunk
+	58, // 81: proto.VideoService.foreignVideoExists:output_type -> proto.VideoExistenceResponse
+	39, // 82: proto.VideoService.setForeignVideoCategory:output_type -> proto.Nothing
+	49, // 83: proto.VideoService.getVideoList:output_type -> proto.VideoList
+	48, // 84: proto.VideoService.getVideo:output_type -> proto.videoMetadata
+	39, // 85: proto.VideoService.rateVideo:output_type -> proto.Nothing
+	39, // 86: proto.VideoService.viewVideo:output_type -> proto.Nothing
+	39, // 87: proto.VideoService.MakeComment:output_type -> proto.Nothing
+	39, // 88: proto.VideoService.MakeCommentUpvote:output_type -> proto.Nothing
+	46, // 89: proto.VideoService.GetCommentsForVideo:output_type -> proto.CommentListResponse
+	41, // 90: proto.VideoService.GetVideoRecommendations:output_type -> proto.recResp
+	39, // 91: proto.VideoService.ApproveVideo:output_type -> proto.Nothing
+	39, // 92: proto.VideoService.DeleteVideo:output_type -> proto.Nothing
+	39, // 93: proto.VideoService.DeleteComment:output_type -> proto.Nothing
+	49, // 94: proto.VideoService.GetFollowFeed:output_type -> proto.VideoList
+	25, // 95: proto.VideoService.addFavorite:output_type -> proto.favoriteStatus
+	25, // 96: proto.VideoService.removeFavorite:output_type -> proto.favoriteStatus
+	25, // 97: proto.VideoService.getFavoriteStatus:output_type -> proto.favoriteStatus
+	49, // 98: proto.VideoService.getFavorites:output_type -> proto.VideoList
+	27, // 99: proto.VideoService.createPlaylist:output_type -> proto.playlist
+	27, // 100: proto.VideoService.updatePlaylist:output_type -> proto.playlist
+	39, // 101: proto.VideoService.deletePlaylist:output_type -> proto.Nothing
+	27, // 102: proto.VideoService.getPlaylist:output_type -> proto.playlist
+	31, // 103: proto.VideoService.listPlaylists:output_type -> proto.playlistList
+	28, // 104: proto.VideoService.addPlaylistEntry:output_type -> proto.playlistEntry
+	28, // 105: proto.VideoService.updatePlaylistEntry:output_type -> proto.playlistEntry
+	39, // 106: proto.VideoService.removePlaylistEntry:output_type -> proto.Nothing
+	34, // 107: proto.VideoService.getPlaylistNeighbours:output_type -> proto.playlistNeighbours
+	18, // 108: proto.VideoService.getTags:output_type -> proto.videoTags
+	18, // 109: proto.VideoService.addTag:output_type -> proto.videoTags
+	18, // 110: proto.VideoService.removeTag:output_type -> proto.videoTags
+	18, // 111: proto.VideoService.setTagLock:output_type -> proto.videoTags
+	18, // 112: proto.VideoService.revertTags:output_type -> proto.videoTags
+	23, // 113: proto.VideoService.getTagHistory:output_type -> proto.tagHistory
+	3,  // 114: proto.VideoService.GetDanmaku:output_type -> proto.danmakuList
+	4,  // 115: proto.VideoService.addDanmaku:output_type -> proto.danmaku
+	3,  // 116: proto.VideoService.deleteDanmaku:output_type -> proto.danmakuList
+	7,  // 117: proto.VideoService.importDanmaku:output_type -> proto.danmakuImportResp
+	8,  // 118: proto.VideoService.uploadSubtitle:output_type -> proto.subtitleTrack
+	9,  // 119: proto.VideoService.getSubtitles:output_type -> proto.subtitleTrackList
+	39, // 120: proto.VideoService.deleteSubtitle:output_type -> proto.Nothing
+	14, // 121: proto.VideoService.claimUpstreamChecks:output_type -> proto.upstreamCheckList
+	39, // 122: proto.VideoService.reportUpstreamStatus:output_type -> proto.Nothing
+	70, // 123: proto.VideoService.createUploadSession:output_type -> proto.uploadSession
+	70, // 124: proto.VideoService.appendUploadChunk:output_type -> proto.uploadSession
+	70, // 125: proto.VideoService.getUploadSession:output_type -> proto.uploadSession
+	67, // 126: proto.VideoService.finalizeUpload:output_type -> proto.uploadResponse
+	79, // [79:127] is the sub-list for method output_type
+	31, // [31:79] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_videoservice_proto_init() }
@@ -2960,8 +6223,200 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DanmakuList); i {
+		file_videoservice_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DanmakuList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Danmaku); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DanmakuDeleteReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DanmakuImportReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DanmakuImportResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubtitleTrack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubtitleTrackList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubtitleUpload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubtitleDeleteReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpstreamCheckClaim); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpstreamCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpstreamCheckList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpstreamStatusReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VideoTagsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VideoTag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VideoTags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagEditReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2972,8 +6427,8 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Danmaku); i {
+		file_videoservice_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagRevertReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2984,7 +6439,43 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagHistoryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagEdit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FavoriteReq); i {
 			case 0:
 				return &v.state
@@ -2996,7 +6487,127 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FavoriteStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FavoritesQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Playlist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaylistEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaylistReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaylistListReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaylistList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaylistEntryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaylistPositionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaylistNeighbours); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FeedReq); i {
 			case 0:
 				return &v.state
@@ -3008,7 +6619,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CategoryList); i {
 			case 0:
 				return &v.state
@@ -3020,7 +6631,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Category); i {
 			case 0:
 				return &v.state
@@ -3032,7 +6643,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VideoDeletionReq); i {
 			case 0:
 				return &v.state
@@ -3044,7 +6655,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Nothing); i {
 			case 0:
 				return &v.state
@@ -3056,7 +6667,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RecReq); i {
 			case 0:
 				return &v.state
@@ -3068,7 +6679,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RecResp); i {
 			case 0:
 				return &v.state
@@ -3080,7 +6691,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VideoRec); i {
 			case 0:
 				return &v.state
@@ -3092,7 +6703,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VideoComment); i {
 			case 0:
 				return &v.state
@@ -3104,7 +6715,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CommentRequest); i {
 			case 0:
 				return &v.state
@@ -3116,7 +6727,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CommentUpvote); i {
 			case 0:
 				return &v.state
@@ -3128,7 +6739,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CommentListResponse); i {
 			case 0:
 				return &v.state
@@ -3140,7 +6751,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Comment); i {
 			case 0:
 				return &v.state
@@ -3152,7 +6763,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VideoMetadata); i {
 			case 0:
 				return &v.state
@@ -3164,7 +6775,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VideoList); i {
 			case 0:
 				return &v.state
@@ -3176,7 +6787,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Video); i {
 			case 0:
 				return &v.state
@@ -3188,7 +6799,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VideoRating); i {
 			case 0:
 				return &v.state
@@ -3200,7 +6811,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VideoViewing); i {
 			case 0:
 				return &v.state
@@ -3212,7 +6823,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VideoApproval); i {
 			case 0:
 				return &v.state
@@ -3224,7 +6835,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VideoQueryConfig); i {
 			case 0:
 				return &v.state
@@ -3236,7 +6847,43 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FacetCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchFacets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForeignVideoCategory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VideoExistenceResponse); i {
 			case 0:
 				return &v.state
@@ -3248,7 +6895,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ForeignVideoCheck); i {
 			case 0:
 				return &v.state
@@ -3260,7 +6907,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VideoRequest); i {
 			case 0:
 				return &v.state
@@ -3272,7 +6919,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InputVideoChunk); i {
 			case 0:
 				return &v.state
@@ -3284,7 +6931,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResponseVideoChunk); i {
 			case 0:
 				return &v.state
@@ -3296,7 +6943,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FileContent); i {
 			case 0:
 				return &v.state
@@ -3308,7 +6955,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RawMetadata); i {
 			case 0:
 				return &v.state
@@ -3320,7 +6967,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InputFileMetadata); i {
 			case 0:
 				return &v.state
@@ -3332,7 +6979,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResponseFileMetadata); i {
 			case 0:
 				return &v.state
@@ -3344,7 +6991,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UploadResponse); i {
 			case 0:
 				return &v.state
@@ -3356,7 +7003,7 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
-		file_videoservice_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_videoservice_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CommentDeletionReq); i {
 			case 0:
 				return &v.state
@@ -3368,13 +7015,63 @@ func file_videoservice_proto_init() {
 				return nil
 			}
 		}
+		file_videoservice_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewUploadSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_videoservice_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadSessionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_videoservice_proto_msgTypes[27].OneofWrappers = []interface{}{
+	file_videoservice_proto_msgTypes[59].OneofWrappers = []interface{}{
 		(*InputVideoChunk_Content)(nil),
 		(*InputVideoChunk_Meta)(nil),
 		(*InputVideoChunk_Rawmeta)(nil),
+		(*InputVideoChunk_Danmaku)(nil),
+		(*InputVideoChunk_Subtitle)(nil),
 	}
-	file_videoservice_proto_msgTypes[28].OneofWrappers = []interface{}{
+	file_videoservice_proto_msgTypes[60].OneofWrappers = []interface{}{
 		(*ResponseVideoChunk_Content)(nil),
 		(*ResponseVideoChunk_Meta)(nil),
 	}
@@ -3384,7 +7081,7 @@ func file_videoservice_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_videoservice_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   35,
+			NumMessages:   71,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -307,6 +307,10 @@ func (m *Danmaku) validate(all bool) error {
 
 	// no validation rules for Id
 
+	// no validation rules for Imported
+
+	// no validation rules for Source
+
 	if len(errors) > 0 {
 		return DanmakuMultiError(errors)
 	}
@@ -384,45 +388,46 @@ var _ interface {
 	ErrorName() string
 } = DanmakuValidationError{}
 
-// Validate checks the field values on FavoriteReq with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *FavoriteReq) Validate() error {
+// Validate checks the field values on DanmakuDeleteReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DanmakuDeleteReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FavoriteReq with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in FavoriteReqMultiError, or
-// nil if none found.
-func (m *FavoriteReq) ValidateAll() error {
+// ValidateAll checks the field values on DanmakuDeleteReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DanmakuDeleteReqMultiError, or nil if none found.
+func (m *DanmakuDeleteReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FavoriteReq) validate(all bool) error {
+func (m *DanmakuDeleteReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	// no validation rules for AuthorId
 
 	// no validation rules for VideoId
 
 	if len(errors) > 0 {
-		return FavoriteReqMultiError(errors)
+		return DanmakuDeleteReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// FavoriteReqMultiError is an error wrapping multiple validation errors
-// returned by FavoriteReq.ValidateAll() if the designated constraints aren't met.
-type FavoriteReqMultiError []error
+// DanmakuDeleteReqMultiError is an error wrapping multiple validation errors
+// returned by DanmakuDeleteReq.ValidateAll() if the designated constraints
+// aren't met.
+type DanmakuDeleteReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FavoriteReqMultiError) Error() string {
+func (m DanmakuDeleteReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -431,11 +436,11 @@ func (m FavoriteReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FavoriteReqMultiError) AllErrors() []error { return m }
+func (m DanmakuDeleteReqMultiError) AllErrors() []error { return m }
 
-// FavoriteReqValidationError is the validation error returned by
-// FavoriteReq.Validate if the designated constraints aren't met.
-type FavoriteReqValidationError struct {
+// DanmakuDeleteReqValidationError is the validation error returned by
+// DanmakuDeleteReq.Validate if the designated constraints aren't met.
+type DanmakuDeleteReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -443,22 +448,22 @@ type FavoriteReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e FavoriteReqValidationError) Field() string { return e.field }
+func (e DanmakuDeleteReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FavoriteReqValidationError) Reason() string { return e.reason }
+func (e DanmakuDeleteReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FavoriteReqValidationError) Cause() error { return e.cause }
+func (e DanmakuDeleteReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FavoriteReqValidationError) Key() bool { return e.key }
+func (e DanmakuDeleteReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FavoriteReqValidationError) ErrorName() string { return "FavoriteReqValidationError" }
+func (e DanmakuDeleteReqValidationError) ErrorName() string { return "DanmakuDeleteReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e FavoriteReqValidationError) Error() string {
+func (e DanmakuDeleteReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -470,14 +475,14 @@ func (e FavoriteReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFavoriteReq.%s: %s%s",
+		"invalid %sDanmakuDeleteReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FavoriteReqValidationError{}
+var _ error = DanmakuDeleteReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -485,44 +490,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FavoriteReqValidationError{}
+} = DanmakuDeleteReqValidationError{}
 
-// Validate checks the field values on FeedReq with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *FeedReq) Validate() error {
+// Validate checks the field values on DanmakuImportReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DanmakuImportReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FeedReq with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in FeedReqMultiError, or nil if none found.
-func (m *FeedReq) ValidateAll() error {
+// ValidateAll checks the field values on DanmakuImportReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DanmakuImportReqMultiError, or nil if none found.
+func (m *DanmakuImportReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FeedReq) validate(all bool) error {
+func (m *DanmakuImportReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ShowMature
+	// no validation rules for VideoId
+
+	// no validation rules for UserId
+
+	// no validation rules for Source
+
+	for idx, item := range m.GetComments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DanmakuImportReqValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DanmakuImportReqValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DanmakuImportReqValidationError{
+					field:  fmt.Sprintf("Comments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return FeedReqMultiError(errors)
+		return DanmakuImportReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// FeedReqMultiError is an error wrapping multiple validation errors returned
-// by FeedReq.ValidateAll() if the designated constraints aren't met.
-type FeedReqMultiError []error
+// DanmakuImportReqMultiError is an error wrapping multiple validation errors
+// returned by DanmakuImportReq.ValidateAll() if the designated constraints
+// aren't met.
+type DanmakuImportReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FeedReqMultiError) Error() string {
+func (m DanmakuImportReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -531,11 +576,11 @@ func (m FeedReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FeedReqMultiError) AllErrors() []error { return m }
+func (m DanmakuImportReqMultiError) AllErrors() []error { return m }
 
-// FeedReqValidationError is the validation error returned by FeedReq.Validate
-// if the designated constraints aren't met.
-type FeedReqValidationError struct {
+// DanmakuImportReqValidationError is the validation error returned by
+// DanmakuImportReq.Validate if the designated constraints aren't met.
+type DanmakuImportReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -543,22 +588,22 @@ type FeedReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e FeedReqValidationError) Field() string { return e.field }
+func (e DanmakuImportReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FeedReqValidationError) Reason() string { return e.reason }
+func (e DanmakuImportReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FeedReqValidationError) Cause() error { return e.cause }
+func (e DanmakuImportReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FeedReqValidationError) Key() bool { return e.key }
+func (e DanmakuImportReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FeedReqValidationError) ErrorName() string { return "FeedReqValidationError" }
+func (e DanmakuImportReqValidationError) ErrorName() string { return "DanmakuImportReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e FeedReqValidationError) Error() string {
+func (e DanmakuImportReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -570,14 +615,14 @@ func (e FeedReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFeedReq.%s: %s%s",
+		"invalid %sDanmakuImportReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FeedReqValidationError{}
+var _ error = DanmakuImportReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -585,77 +630,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FeedReqValidationError{}
+} = DanmakuImportReqValidationError{}
 
-// Validate checks the field values on CategoryList with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CategoryList) Validate() error {
+// Validate checks the field values on DanmakuImportResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DanmakuImportResp) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CategoryList with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CategoryListMultiError, or
-// nil if none found.
-func (m *CategoryList) ValidateAll() error {
+// ValidateAll checks the field values on DanmakuImportResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DanmakuImportRespMultiError, or nil if none found.
+func (m *DanmakuImportResp) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CategoryList) validate(all bool) error {
+func (m *DanmakuImportResp) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetCategories() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CategoryListValidationError{
-						field:  fmt.Sprintf("Categories[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CategoryListValidationError{
-						field:  fmt.Sprintf("Categories[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CategoryListValidationError{
-					field:  fmt.Sprintf("Categories[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Imported
 
 	if len(errors) > 0 {
-		return CategoryListMultiError(errors)
+		return DanmakuImportRespMultiError(errors)
 	}
 
 	return nil
 }
 
-// CategoryListMultiError is an error wrapping multiple validation errors
-// returned by CategoryList.ValidateAll() if the designated constraints aren't met.
-type CategoryListMultiError []error
+// DanmakuImportRespMultiError is an error wrapping multiple validation errors
+// returned by DanmakuImportResp.ValidateAll() if the designated constraints
+// aren't met.
+type DanmakuImportRespMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CategoryListMultiError) Error() string {
+func (m DanmakuImportRespMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -664,11 +678,11 @@ func (m CategoryListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CategoryListMultiError) AllErrors() []error { return m }
+func (m DanmakuImportRespMultiError) AllErrors() []error { return m }
 
-// CategoryListValidationError is the validation error returned by
-// CategoryList.Validate if the designated constraints aren't met.
-type CategoryListValidationError struct {
+// DanmakuImportRespValidationError is the validation error returned by
+// DanmakuImportResp.Validate if the designated constraints aren't met.
+type DanmakuImportRespValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -676,22 +690,24 @@ type CategoryListValidationError struct {
 }
 
 // Field function returns field value.
-func (e CategoryListValidationError) Field() string { return e.field }
+func (e DanmakuImportRespValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CategoryListValidationError) Reason() string { return e.reason }
+func (e DanmakuImportRespValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CategoryListValidationError) Cause() error { return e.cause }
+func (e DanmakuImportRespValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CategoryListValidationError) Key() bool { return e.key }
+func (e DanmakuImportRespValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CategoryListValidationError) ErrorName() string { return "CategoryListValidationError" }
+func (e DanmakuImportRespValidationError) ErrorName() string {
+	return "DanmakuImportRespValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CategoryListValidationError) Error() string {
+func (e DanmakuImportRespValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -703,14 +719,14 @@ func (e CategoryListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCategoryList.%s: %s%s",
+		"invalid %sDanmakuImportResp.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CategoryListValidationError{}
+var _ error = DanmakuImportRespValidationError{}
 
 var _ interface {
 	Field() string
@@ -718,47 +734,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CategoryListValidationError{}
+} = DanmakuImportRespValidationError{}
 
-// Validate checks the field values on Category with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on SubtitleTrack with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Category) Validate() error {
+func (m *SubtitleTrack) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Category with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CategoryMultiError, or nil
-// if none found.
-func (m *Category) ValidateAll() error {
+// ValidateAll checks the field values on SubtitleTrack with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SubtitleTrackMultiError, or
+// nil if none found.
+func (m *SubtitleTrack) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Category) validate(all bool) error {
+func (m *SubtitleTrack) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	// no validation rules for Id
 
-	// no validation rules for Cardinality
+	// no validation rules for VideoId
+
+	// no validation rules for Language
+
+	// no validation rules for Kind
+
+	// no validation rules for Label
+
+	// no validation rules for Location
+
+	// no validation rules for Imported
 
 	if len(errors) > 0 {
-		return CategoryMultiError(errors)
+		return SubtitleTrackMultiError(errors)
 	}
 
 	return nil
 }
 
-// CategoryMultiError is an error wrapping multiple validation errors returned
-// by Category.ValidateAll() if the designated constraints aren't met.
-type CategoryMultiError []error
+// SubtitleTrackMultiError is an error wrapping multiple validation errors
+// returned by SubtitleTrack.ValidateAll() if the designated constraints
+// aren't met.
+type SubtitleTrackMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CategoryMultiError) Error() string {
+func (m SubtitleTrackMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -767,11 +794,11 @@ func (m CategoryMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CategoryMultiError) AllErrors() []error { return m }
+func (m SubtitleTrackMultiError) AllErrors() []error { return m }
 
-// CategoryValidationError is the validation error returned by
-// Category.Validate if the designated constraints aren't met.
-type CategoryValidationError struct {
+// SubtitleTrackValidationError is the validation error returned by
+// SubtitleTrack.Validate if the designated constraints aren't met.
+type SubtitleTrackValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -779,22 +806,22 @@ type CategoryValidationError struct {
 }
 
 // Field function returns field value.
-func (e CategoryValidationError) Field() string { return e.field }
+func (e SubtitleTrackValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CategoryValidationError) Reason() string { return e.reason }
+func (e SubtitleTrackValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CategoryValidationError) Cause() error { return e.cause }
+func (e SubtitleTrackValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CategoryValidationError) Key() bool { return e.key }
+func (e SubtitleTrackValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CategoryValidationError) ErrorName() string { return "CategoryValidationError" }
+func (e SubtitleTrackValidationError) ErrorName() string { return "SubtitleTrackValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CategoryValidationError) Error() string {
+func (e SubtitleTrackValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -806,14 +833,14 @@ func (e CategoryValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCategory.%s: %s%s",
+		"invalid %sSubtitleTrack.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CategoryValidationError{}
+var _ error = SubtitleTrackValidationError{}
 
 var _ interface {
 	Field() string
@@ -821,46 +848,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CategoryValidationError{}
+} = SubtitleTrackValidationError{}
 
-// Validate checks the field values on VideoDeletionReq with the rules defined
+// Validate checks the field values on SubtitleTrackList with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *VideoDeletionReq) Validate() error {
+func (m *SubtitleTrackList) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VideoDeletionReq with the rules
+// ValidateAll checks the field values on SubtitleTrackList with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// VideoDeletionReqMultiError, or nil if none found.
-func (m *VideoDeletionReq) ValidateAll() error {
+// SubtitleTrackListMultiError, or nil if none found.
+func (m *SubtitleTrackList) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VideoDeletionReq) validate(all bool) error {
+func (m *SubtitleTrackList) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for VideoID
+	for idx, item := range m.GetTracks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SubtitleTrackListValidationError{
+						field:  fmt.Sprintf("Tracks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SubtitleTrackListValidationError{
+						field:  fmt.Sprintf("Tracks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SubtitleTrackListValidationError{
+					field:  fmt.Sprintf("Tracks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return VideoDeletionReqMultiError(errors)
+		return SubtitleTrackListMultiError(errors)
 	}
 
 	return nil
 }
 
-// VideoDeletionReqMultiError is an error wrapping multiple validation errors
-// returned by VideoDeletionReq.ValidateAll() if the designated constraints
+// SubtitleTrackListMultiError is an error wrapping multiple validation errors
+// returned by SubtitleTrackList.ValidateAll() if the designated constraints
 // aren't met.
-type VideoDeletionReqMultiError []error
+type SubtitleTrackListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VideoDeletionReqMultiError) Error() string {
+func (m SubtitleTrackListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -869,11 +928,11 @@ func (m VideoDeletionReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VideoDeletionReqMultiError) AllErrors() []error { return m }
+func (m SubtitleTrackListMultiError) AllErrors() []error { return m }
 
-// VideoDeletionReqValidationError is the validation error returned by
-// VideoDeletionReq.Validate if the designated constraints aren't met.
-type VideoDeletionReqValidationError struct {
+// SubtitleTrackListValidationError is the validation error returned by
+// SubtitleTrackList.Validate if the designated constraints aren't met.
+type SubtitleTrackListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -881,22 +940,24 @@ type VideoDeletionReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e VideoDeletionReqValidationError) Field() string { return e.field }
+func (e SubtitleTrackListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VideoDeletionReqValidationError) Reason() string { return e.reason }
+func (e SubtitleTrackListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VideoDeletionReqValidationError) Cause() error { return e.cause }
+func (e SubtitleTrackListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VideoDeletionReqValidationError) Key() bool { return e.key }
+func (e SubtitleTrackListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VideoDeletionReqValidationError) ErrorName() string { return "VideoDeletionReqValidationError" }
+func (e SubtitleTrackListValidationError) ErrorName() string {
+	return "SubtitleTrackListValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e VideoDeletionReqValidationError) Error() string {
+func (e SubtitleTrackListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -908,14 +969,14 @@ func (e VideoDeletionReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVideoDeletionReq.%s: %s%s",
+		"invalid %sSubtitleTrackList.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VideoDeletionReqValidationError{}
+var _ error = SubtitleTrackListValidationError{}
 
 var _ interface {
 	Field() string
@@ -923,42 +984,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VideoDeletionReqValidationError{}
+} = SubtitleTrackListValidationError{}
 
-// Validate checks the field values on Nothing with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on SubtitleUpload with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Nothing) Validate() error {
+func (m *SubtitleUpload) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Nothing with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in NothingMultiError, or nil if none found.
-func (m *Nothing) ValidateAll() error {
+// ValidateAll checks the field values on SubtitleUpload with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SubtitleUploadMultiError,
+// or nil if none found.
+func (m *SubtitleUpload) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Nothing) validate(all bool) error {
+func (m *SubtitleUpload) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for VideoId
+
+	// no validation rules for UserId
+
+	// no validation rules for Language
+
+	// no validation rules for Kind
+
+	// no validation rules for Label
+
+	// no validation rules for Data
+
 	if len(errors) > 0 {
-		return NothingMultiError(errors)
+		return SubtitleUploadMultiError(errors)
 	}
 
 	return nil
 }
 
-// NothingMultiError is an error wrapping multiple validation errors returned
-// by Nothing.ValidateAll() if the designated constraints aren't met.
-type NothingMultiError []error
+// SubtitleUploadMultiError is an error wrapping multiple validation errors
+// returned by SubtitleUpload.ValidateAll() if the designated constraints
+// aren't met.
+type SubtitleUploadMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m NothingMultiError) Error() string {
+func (m SubtitleUploadMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -967,11 +1042,11 @@ func (m NothingMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m NothingMultiError) AllErrors() []error { return m }
+func (m SubtitleUploadMultiError) AllErrors() []error { return m }
 
-// NothingValidationError is the validation error returned by Nothing.Validate
-// if the designated constraints aren't met.
-type NothingValidationError struct {
+// SubtitleUploadValidationError is the validation error returned by
+// SubtitleUpload.Validate if the designated constraints aren't met.
+type SubtitleUploadValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -979,22 +1054,22 @@ type NothingValidationError struct {
 }
 
 // Field function returns field value.
-func (e NothingValidationError) Field() string { return e.field }
+func (e SubtitleUploadValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NothingValidationError) Reason() string { return e.reason }
+func (e SubtitleUploadValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NothingValidationError) Cause() error { return e.cause }
+func (e SubtitleUploadValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NothingValidationError) Key() bool { return e.key }
+func (e SubtitleUploadValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NothingValidationError) ErrorName() string { return "NothingValidationError" }
+func (e SubtitleUploadValidationError) ErrorName() string { return "SubtitleUploadValidationError" }
 
 // Error satisfies the builtin error interface
-func (e NothingValidationError) Error() string {
+func (e SubtitleUploadValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1006,14 +1081,14 @@ func (e NothingValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNothing.%s: %s%s",
+		"invalid %sSubtitleUpload.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NothingValidationError{}
+var _ error = SubtitleUploadValidationError{}
 
 var _ interface {
 	Field() string
@@ -1021,48 +1096,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NothingValidationError{}
+} = SubtitleUploadValidationError{}
 
-// Validate checks the field values on RecReq with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *RecReq) Validate() error {
+// Validate checks the field values on SubtitleDeleteReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SubtitleDeleteReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecReq with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in RecReqMultiError, or nil if none found.
-func (m *RecReq) ValidateAll() error {
+// ValidateAll checks the field values on SubtitleDeleteReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubtitleDeleteReqMultiError, or nil if none found.
+func (m *SubtitleDeleteReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecReq) validate(all bool) error {
+func (m *SubtitleDeleteReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
-	// no validation rules for VideoId
+	// no validation rules for Id
 
-	// no validation rules for ShowMature
+	// no validation rules for VideoAuthorId
 
 	if len(errors) > 0 {
-		return RecReqMultiError(errors)
+		return SubtitleDeleteReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecReqMultiError is an error wrapping multiple validation errors returned by
-// RecReq.ValidateAll() if the designated constraints aren't met.
-type RecReqMultiError []error
+// SubtitleDeleteReqMultiError is an error wrapping multiple validation errors
+// returned by SubtitleDeleteReq.ValidateAll() if the designated constraints
+// aren't met.
+type SubtitleDeleteReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecReqMultiError) Error() string {
+func (m SubtitleDeleteReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1071,11 +1146,11 @@ func (m RecReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecReqMultiError) AllErrors() []error { return m }
+func (m SubtitleDeleteReqMultiError) AllErrors() []error { return m }
 
-// RecReqValidationError is the validation error returned by RecReq.Validate if
-// the designated constraints aren't met.
-type RecReqValidationError struct {
+// SubtitleDeleteReqValidationError is the validation error returned by
+// SubtitleDeleteReq.Validate if the designated constraints aren't met.
+type SubtitleDeleteReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1083,22 +1158,24 @@ type RecReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecReqValidationError) Field() string { return e.field }
+func (e SubtitleDeleteReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecReqValidationError) Reason() string { return e.reason }
+func (e SubtitleDeleteReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecReqValidationError) Cause() error { return e.cause }
+func (e SubtitleDeleteReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecReqValidationError) Key() bool { return e.key }
+func (e SubtitleDeleteReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecReqValidationError) ErrorName() string { return "RecReqValidationError" }
+func (e SubtitleDeleteReqValidationError) ErrorName() string {
+	return "SubtitleDeleteReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RecReqValidationError) Error() string {
+func (e SubtitleDeleteReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1110,14 +1187,14 @@ func (e RecReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecReq.%s: %s%s",
+		"invalid %sSubtitleDeleteReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecReqValidationError{}
+var _ error = SubtitleDeleteReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -1125,76 +1202,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecReqValidationError{}
+} = SubtitleDeleteReqValidationError{}
 
-// Validate checks the field values on RecResp with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *RecResp) Validate() error {
+// Validate checks the field values on UpstreamCheckClaim with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpstreamCheckClaim) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecResp with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in RecRespMultiError, or nil if none found.
-func (m *RecResp) ValidateAll() error {
+// ValidateAll checks the field values on UpstreamCheckClaim with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpstreamCheckClaimMultiError, or nil if none found.
+func (m *UpstreamCheckClaim) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecResp) validate(all bool) error {
+func (m *UpstreamCheckClaim) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetVideos() {
-		_, _ = idx, item
+	// no validation rules for Limit
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RecRespValidationError{
-						field:  fmt.Sprintf("Videos[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, RecRespValidationError{
-						field:  fmt.Sprintf("Videos[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return RecRespValidationError{
-					field:  fmt.Sprintf("Videos[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for LeaseSeconds
 
-	}
+	// no validation rules for IntervalSeconds
 
 	if len(errors) > 0 {
-		return RecRespMultiError(errors)
+		return UpstreamCheckClaimMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecRespMultiError is an error wrapping multiple validation errors returned
-// by RecResp.ValidateAll() if the designated constraints aren't met.
-type RecRespMultiError []error
+// UpstreamCheckClaimMultiError is an error wrapping multiple validation errors
+// returned by UpstreamCheckClaim.ValidateAll() if the designated constraints
+// aren't met.
+type UpstreamCheckClaimMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecRespMultiError) Error() string {
+func (m UpstreamCheckClaimMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1203,11 +1254,11 @@ func (m RecRespMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecRespMultiError) AllErrors() []error { return m }
+func (m UpstreamCheckClaimMultiError) AllErrors() []error { return m }
 
-// RecRespValidationError is the validation error returned by RecResp.Validate
-// if the designated constraints aren't met.
-type RecRespValidationError struct {
+// UpstreamCheckClaimValidationError is the validation error returned by
+// UpstreamCheckClaim.Validate if the designated constraints aren't met.
+type UpstreamCheckClaimValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1215,22 +1266,24 @@ type RecRespValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecRespValidationError) Field() string { return e.field }
+func (e UpstreamCheckClaimValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecRespValidationError) Reason() string { return e.reason }
+func (e UpstreamCheckClaimValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecRespValidationError) Cause() error { return e.cause }
+func (e UpstreamCheckClaimValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecRespValidationError) Key() bool { return e.key }
+func (e UpstreamCheckClaimValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecRespValidationError) ErrorName() string { return "RecRespValidationError" }
+func (e UpstreamCheckClaimValidationError) ErrorName() string {
+	return "UpstreamCheckClaimValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RecRespValidationError) Error() string {
+func (e UpstreamCheckClaimValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1242,14 +1295,14 @@ func (e RecRespValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecResp.%s: %s%s",
+		"invalid %sUpstreamCheckClaim.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecRespValidationError{}
+var _ error = UpstreamCheckClaimValidationError{}
 
 var _ interface {
 	Field() string
@@ -1257,49 +1310,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecRespValidationError{}
+} = UpstreamCheckClaimValidationError{}
 
-// Validate checks the field values on VideoRec with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on UpstreamCheck with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *VideoRec) Validate() error {
+func (m *UpstreamCheck) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VideoRec with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in VideoRecMultiError, or nil
-// if none found.
-func (m *VideoRec) ValidateAll() error {
+// ValidateAll checks the field values on UpstreamCheck with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UpstreamCheckMultiError, or
+// nil if none found.
+func (m *UpstreamCheck) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VideoRec) validate(all bool) error {
+func (m *UpstreamCheck) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ThumbnailLoc
-
-	// no validation rules for VideoTitle
+	// no validation rules for VideoId
 
-	// no validation rules for VideoID
+	// no validation rules for Url
 
 	if len(errors) > 0 {
-		return VideoRecMultiError(errors)
+		return UpstreamCheckMultiError(errors)
 	}
 
 	return nil
 }
 
-// VideoRecMultiError is an error wrapping multiple validation errors returned
-// by VideoRec.ValidateAll() if the designated constraints aren't met.
-type VideoRecMultiError []error
+// UpstreamCheckMultiError is an error wrapping multiple validation errors
+// returned by UpstreamCheck.ValidateAll() if the designated constraints
+// aren't met.
+type UpstreamCheckMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VideoRecMultiError) Error() string {
+func (m UpstreamCheckMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1308,11 +1360,11 @@ func (m VideoRecMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VideoRecMultiError) AllErrors() []error { return m }
+func (m UpstreamCheckMultiError) AllErrors() []error { return m }
 
-// VideoRecValidationError is the validation error returned by
-// VideoRec.Validate if the designated constraints aren't met.
-type VideoRecValidationError struct {
+// UpstreamCheckValidationError is the validation error returned by
+// UpstreamCheck.Validate if the designated constraints aren't met.
+type UpstreamCheckValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1320,22 +1372,22 @@ type VideoRecValidationError struct {
 }
 
 // Field function returns field value.
-func (e VideoRecValidationError) Field() string { return e.field }
+func (e UpstreamCheckValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VideoRecValidationError) Reason() string { return e.reason }
+func (e UpstreamCheckValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VideoRecValidationError) Cause() error { return e.cause }
+func (e UpstreamCheckValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VideoRecValidationError) Key() bool { return e.key }
+func (e UpstreamCheckValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VideoRecValidationError) ErrorName() string { return "VideoRecValidationError" }
+func (e UpstreamCheckValidationError) ErrorName() string { return "UpstreamCheckValidationError" }
 
 // Error satisfies the builtin error interface
-func (e VideoRecValidationError) Error() string {
+func (e UpstreamCheckValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1347,14 +1399,14 @@ func (e VideoRecValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVideoRec.%s: %s%s",
+		"invalid %sUpstreamCheck.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VideoRecValidationError{}
+var _ error = UpstreamCheckValidationError{}
 
 var _ interface {
 	Field() string
@@ -1362,51 +1414,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VideoRecValidationError{}
+} = UpstreamCheckValidationError{}
 
-// Validate checks the field values on VideoComment with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *VideoComment) Validate() error {
+// Validate checks the field values on UpstreamCheckList with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpstreamCheckList) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VideoComment with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in VideoCommentMultiError, or
-// nil if none found.
-func (m *VideoComment) ValidateAll() error {
+// ValidateAll checks the field values on UpstreamCheckList with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpstreamCheckListMultiError, or nil if none found.
+func (m *UpstreamCheckList) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VideoComment) validate(all bool) error {
+func (m *UpstreamCheckList) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
-	// no validation rules for VideoId
+	for idx, item := range m.GetChecks() {
+		_, _ = idx, item
 
-	// no validation rules for Comment
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpstreamCheckListValidationError{
+						field:  fmt.Sprintf("Checks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpstreamCheckListValidationError{
+						field:  fmt.Sprintf("Checks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpstreamCheckListValidationError{
+					field:  fmt.Sprintf("Checks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for ParentComment
+	}
 
 	if len(errors) > 0 {
-		return VideoCommentMultiError(errors)
+		return UpstreamCheckListMultiError(errors)
 	}
 
 	return nil
 }
 
-// VideoCommentMultiError is an error wrapping multiple validation errors
-// returned by VideoComment.ValidateAll() if the designated constraints aren't met.
-type VideoCommentMultiError []error
+// UpstreamCheckListMultiError is an error wrapping multiple validation errors
+// returned by UpstreamCheckList.ValidateAll() if the designated constraints
+// aren't met.
+type UpstreamCheckListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VideoCommentMultiError) Error() string {
+func (m UpstreamCheckListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1415,11 +1494,11 @@ func (m VideoCommentMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VideoCommentMultiError) AllErrors() []error { return m }
+func (m UpstreamCheckListMultiError) AllErrors() []error { return m }
 
-// VideoCommentValidationError is the validation error returned by
-// VideoComment.Validate if the designated constraints aren't met.
-type VideoCommentValidationError struct {
+// UpstreamCheckListValidationError is the validation error returned by
+// UpstreamCheckList.Validate if the designated constraints aren't met.
+type UpstreamCheckListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1427,22 +1506,24 @@ type VideoCommentValidationError struct {
 }
 
 // Field function returns field value.
-func (e VideoCommentValidationError) Field() string { return e.field }
+func (e UpstreamCheckListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VideoCommentValidationError) Reason() string { return e.reason }
+func (e UpstreamCheckListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VideoCommentValidationError) Cause() error { return e.cause }
+func (e UpstreamCheckListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VideoCommentValidationError) Key() bool { return e.key }
+func (e UpstreamCheckListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VideoCommentValidationError) ErrorName() string { return "VideoCommentValidationError" }
+func (e UpstreamCheckListValidationError) ErrorName() string {
+	return "UpstreamCheckListValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e VideoCommentValidationError) Error() string {
+func (e UpstreamCheckListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1454,14 +1535,14 @@ func (e VideoCommentValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVideoComment.%s: %s%s",
+		"invalid %sUpstreamCheckList.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VideoCommentValidationError{}
+var _ error = UpstreamCheckListValidationError{}
 
 var _ interface {
 	Field() string
@@ -1469,48 +1550,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VideoCommentValidationError{}
+} = UpstreamCheckListValidationError{}
 
-// Validate checks the field values on CommentRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CommentRequest) Validate() error {
+// Validate checks the field values on UpstreamStatusReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpstreamStatusReport) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CommentRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CommentRequestMultiError,
-// or nil if none found.
-func (m *CommentRequest) ValidateAll() error {
+// ValidateAll checks the field values on UpstreamStatusReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpstreamStatusReportMultiError, or nil if none found.
+func (m *UpstreamStatusReport) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CommentRequest) validate(all bool) error {
+func (m *UpstreamStatusReport) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for VideoID
+	// no validation rules for VideoId
 
-	// no validation rules for CurrUserID
+	// no validation rules for Status
+
+	// no validation rules for Message
+
+	// no validation rules for RecheckAfterSeconds
 
 	if len(errors) > 0 {
-		return CommentRequestMultiError(errors)
+		return UpstreamStatusReportMultiError(errors)
 	}
 
 	return nil
 }
 
-// CommentRequestMultiError is an error wrapping multiple validation errors
-// returned by CommentRequest.ValidateAll() if the designated constraints
-// aren't met.
-type CommentRequestMultiError []error
+// UpstreamStatusReportMultiError is an error wrapping multiple validation
+// errors returned by UpstreamStatusReport.ValidateAll() if the designated
+// constraints aren't met.
+type UpstreamStatusReportMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CommentRequestMultiError) Error() string {
+func (m UpstreamStatusReportMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1519,11 +1604,11 @@ func (m CommentRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CommentRequestMultiError) AllErrors() []error { return m }
+func (m UpstreamStatusReportMultiError) AllErrors() []error { return m }
 
-// CommentRequestValidationError is the validation error returned by
-// CommentRequest.Validate if the designated constraints aren't met.
-type CommentRequestValidationError struct {
+// UpstreamStatusReportValidationError is the validation error returned by
+// UpstreamStatusReport.Validate if the designated constraints aren't met.
+type UpstreamStatusReportValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1531,22 +1616,24 @@ type CommentRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CommentRequestValidationError) Field() string { return e.field }
+func (e UpstreamStatusReportValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CommentRequestValidationError) Reason() string { return e.reason }
+func (e UpstreamStatusReportValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CommentRequestValidationError) Cause() error { return e.cause }
+func (e UpstreamStatusReportValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CommentRequestValidationError) Key() bool { return e.key }
+func (e UpstreamStatusReportValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CommentRequestValidationError) ErrorName() string { return "CommentRequestValidationError" }
+func (e UpstreamStatusReportValidationError) ErrorName() string {
+	return "UpstreamStatusReportValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CommentRequestValidationError) Error() string {
+func (e UpstreamStatusReportValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1558,14 +1645,14 @@ func (e CommentRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCommentRequest.%s: %s%s",
+		"invalid %sUpstreamStatusReport.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CommentRequestValidationError{}
+var _ error = UpstreamStatusReportValidationError{}
 
 var _ interface {
 	Field() string
@@ -1573,50 +1660,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CommentRequestValidationError{}
+} = UpstreamStatusReportValidationError{}
 
-// Validate checks the field values on CommentUpvote with the rules defined in
+// Validate checks the field values on VideoTagsReq with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *CommentUpvote) Validate() error {
+func (m *VideoTagsReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CommentUpvote with the rules defined
+// ValidateAll checks the field values on VideoTagsReq with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CommentUpvoteMultiError, or
+// result is a list of violation errors wrapped in VideoTagsReqMultiError, or
 // nil if none found.
-func (m *CommentUpvote) ValidateAll() error {
+func (m *VideoTagsReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CommentUpvote) validate(all bool) error {
+func (m *VideoTagsReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
-
-	// no validation rules for CommentId
-
-	// no validation rules for Score
+	// no validation rules for VideoId
 
 	if len(errors) > 0 {
-		return CommentUpvoteMultiError(errors)
+		return VideoTagsReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// CommentUpvoteMultiError is an error wrapping multiple validation errors
-// returned by CommentUpvote.ValidateAll() if the designated constraints
-// aren't met.
-type CommentUpvoteMultiError []error
+// VideoTagsReqMultiError is an error wrapping multiple validation errors
+// returned by VideoTagsReq.ValidateAll() if the designated constraints aren't met.
+type VideoTagsReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CommentUpvoteMultiError) Error() string {
+func (m VideoTagsReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1625,11 +1707,11 @@ func (m CommentUpvoteMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CommentUpvoteMultiError) AllErrors() []error { return m }
+func (m VideoTagsReqMultiError) AllErrors() []error { return m }
 
-// CommentUpvoteValidationError is the validation error returned by
-// CommentUpvote.Validate if the designated constraints aren't met.
-type CommentUpvoteValidationError struct {
+// VideoTagsReqValidationError is the validation error returned by
+// VideoTagsReq.Validate if the designated constraints aren't met.
+type VideoTagsReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1637,22 +1719,22 @@ type CommentUpvoteValidationError struct {
 }
 
 // Field function returns field value.
-func (e CommentUpvoteValidationError) Field() string { return e.field }
+func (e VideoTagsReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CommentUpvoteValidationError) Reason() string { return e.reason }
+func (e VideoTagsReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CommentUpvoteValidationError) Cause() error { return e.cause }
+func (e VideoTagsReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CommentUpvoteValidationError) Key() bool { return e.key }
+func (e VideoTagsReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CommentUpvoteValidationError) ErrorName() string { return "CommentUpvoteValidationError" }
+func (e VideoTagsReqValidationError) ErrorName() string { return "VideoTagsReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CommentUpvoteValidationError) Error() string {
+func (e VideoTagsReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1664,14 +1746,14 @@ func (e CommentUpvoteValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCommentUpvote.%s: %s%s",
+		"invalid %sVideoTagsReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CommentUpvoteValidationError{}
+var _ error = VideoTagsReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -1679,78 +1761,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CommentUpvoteValidationError{}
+} = VideoTagsReqValidationError{}
 
-// Validate checks the field values on CommentListResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CommentListResponse) Validate() error {
+// Validate checks the field values on VideoTag with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VideoTag) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CommentListResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CommentListResponseMultiError, or nil if none found.
-func (m *CommentListResponse) ValidateAll() error {
+// ValidateAll checks the field values on VideoTag with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in VideoTagMultiError, or nil
+// if none found.
+func (m *VideoTag) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CommentListResponse) validate(all bool) error {
+func (m *VideoTag) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetComments() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CommentListResponseValidationError{
-						field:  fmt.Sprintf("Comments[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CommentListResponseValidationError{
-						field:  fmt.Sprintf("Comments[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CommentListResponseValidationError{
-					field:  fmt.Sprintf("Comments[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Tag
 
-	}
+	// no validation rules for Locked
 
 	if len(errors) > 0 {
-		return CommentListResponseMultiError(errors)
+		return VideoTagMultiError(errors)
 	}
 
 	return nil
 }
 
-// CommentListResponseMultiError is an error wrapping multiple validation
-// errors returned by CommentListResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CommentListResponseMultiError []error
+// VideoTagMultiError is an error wrapping multiple validation errors returned
+// by VideoTag.ValidateAll() if the designated constraints aren't met.
+type VideoTagMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CommentListResponseMultiError) Error() string {
+func (m VideoTagMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1759,11 +1810,11 @@ func (m CommentListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CommentListResponseMultiError) AllErrors() []error { return m }
+func (m VideoTagMultiError) AllErrors() []error { return m }
 
-// CommentListResponseValidationError is the validation error returned by
-// CommentListResponse.Validate if the designated constraints aren't met.
-type CommentListResponseValidationError struct {
+// VideoTagValidationError is the validation error returned by
+// VideoTag.Validate if the designated constraints aren't met.
+type VideoTagValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1771,24 +1822,22 @@ type CommentListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CommentListResponseValidationError) Field() string { return e.field }
+func (e VideoTagValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CommentListResponseValidationError) Reason() string { return e.reason }
+func (e VideoTagValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CommentListResponseValidationError) Cause() error { return e.cause }
+func (e VideoTagValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CommentListResponseValidationError) Key() bool { return e.key }
+func (e VideoTagValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CommentListResponseValidationError) ErrorName() string {
-	return "CommentListResponseValidationError"
-}
+func (e VideoTagValidationError) ErrorName() string { return "VideoTagValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CommentListResponseValidationError) Error() string {
+func (e VideoTagValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1800,14 +1849,14 @@ func (e CommentListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCommentListResponse.%s: %s%s",
+		"invalid %sVideoTag.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CommentListResponseValidationError{}
+var _ error = VideoTagValidationError{}
 
 var _ interface {
 	Field() string
@@ -1815,62 +1864,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CommentListResponseValidationError{}
+} = VideoTagValidationError{}
 
-// Validate checks the field values on Comment with the rules defined in the
+// Validate checks the field values on VideoTags with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Comment) Validate() error {
+func (m *VideoTags) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Comment with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in CommentMultiError, or nil if none found.
-func (m *Comment) ValidateAll() error {
+// ValidateAll checks the field values on VideoTags with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in VideoTagsMultiError, or nil
+// if none found.
+func (m *VideoTags) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Comment) validate(all bool) error {
+func (m *VideoTags) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CommentId
-
-	// no validation rules for CreationDate
-
-	// no validation rules for Content
-
-	// no validation rules for AuthorUsername
-
-	// no validation rules for AuthorProfileImageUrl
-
-	// no validation rules for VoteScore
-
-	// no validation rules for CurrentUserHasUpvoted
+	// no validation rules for VideoId
 
-	// no validation rules for CurrentUserHasDownvoted
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
 
-	// no validation rules for AuthorId
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VideoTagsValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VideoTagsValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VideoTagsValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for ParentId
+	}
 
 	if len(errors) > 0 {
-		return CommentMultiError(errors)
+		return VideoTagsMultiError(errors)
 	}
 
 	return nil
 }
 
-// CommentMultiError is an error wrapping multiple validation errors returned
-// by Comment.ValidateAll() if the designated constraints aren't met.
-type CommentMultiError []error
+// VideoTagsMultiError is an error wrapping multiple validation errors returned
+// by VideoTags.ValidateAll() if the designated constraints aren't met.
+type VideoTagsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CommentMultiError) Error() string {
+func (m VideoTagsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1879,11 +1945,11 @@ func (m CommentMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CommentMultiError) AllErrors() []error { return m }
+func (m VideoTagsMultiError) AllErrors() []error { return m }
 
-// CommentValidationError is the validation error returned by Comment.Validate
-// if the designated constraints aren't met.
-type CommentValidationError struct {
+// VideoTagsValidationError is the validation error returned by
+// VideoTags.Validate if the designated constraints aren't met.
+type VideoTagsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1891,22 +1957,22 @@ type CommentValidationError struct {
 }
 
 // Field function returns field value.
-func (e CommentValidationError) Field() string { return e.field }
+func (e VideoTagsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CommentValidationError) Reason() string { return e.reason }
+func (e VideoTagsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CommentValidationError) Cause() error { return e.cause }
+func (e VideoTagsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CommentValidationError) Key() bool { return e.key }
+func (e VideoTagsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CommentValidationError) ErrorName() string { return "CommentValidationError" }
+func (e VideoTagsValidationError) ErrorName() string { return "VideoTagsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CommentValidationError) Error() string {
+func (e VideoTagsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1918,14 +1984,14 @@ func (e CommentValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sComment.%s: %s%s",
+		"invalid %sVideoTags.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CommentValidationError{}
+var _ error = VideoTagsValidationError{}
 
 var _ interface {
 	Field() string
@@ -1933,70 +1999,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CommentValidationError{}
+} = VideoTagsValidationError{}
 
-// Validate checks the field values on VideoMetadata with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on TagEditReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *VideoMetadata) Validate() error {
+func (m *TagEditReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VideoMetadata with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in VideoMetadataMultiError, or
+// ValidateAll checks the field values on TagEditReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TagEditReqMultiError, or
 // nil if none found.
-func (m *VideoMetadata) ValidateAll() error {
+func (m *TagEditReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VideoMetadata) validate(all bool) error {
+func (m *TagEditReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for VideoLoc
-
-	// no validation rules for VideoTitle
-
-	// no validation rules for Rating
-
-	// no validation rules for AuthorName
-
-	// no validation rules for Views
-
-	// no validation rules for VideoID
-
-	// no validation rules for UploadDate
-
-	// no validation rules for Description
-
-	// no validation rules for AuthorID
+	// no validation rules for VideoId
 
-	// no validation rules for VideoDuration
+	// no validation rules for UserId
 
-	// no validation rules for Category
+	// no validation rules for Moderator
 
-	// no validation rules for Thumbnail
+	// no validation rules for Tag
 
-	// no validation rules for IsMature
+	// no validation rules for Locked
 
 	if len(errors) > 0 {
-		return VideoMetadataMultiError(errors)
+		return TagEditReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// VideoMetadataMultiError is an error wrapping multiple validation errors
-// returned by VideoMetadata.ValidateAll() if the designated constraints
-// aren't met.
-type VideoMetadataMultiError []error
+// TagEditReqMultiError is an error wrapping multiple validation errors
+// returned by TagEditReq.ValidateAll() if the designated constraints aren't met.
+type TagEditReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VideoMetadataMultiError) Error() string {
+func (m TagEditReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2005,11 +2054,11 @@ func (m VideoMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VideoMetadataMultiError) AllErrors() []error { return m }
+func (m TagEditReqMultiError) AllErrors() []error { return m }
 
-// VideoMetadataValidationError is the validation error returned by
-// VideoMetadata.Validate if the designated constraints aren't met.
-type VideoMetadataValidationError struct {
+// TagEditReqValidationError is the validation error returned by
+// TagEditReq.Validate if the designated constraints aren't met.
+type TagEditReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2017,22 +2066,22 @@ type VideoMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e VideoMetadataValidationError) Field() string { return e.field }
+func (e TagEditReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VideoMetadataValidationError) Reason() string { return e.reason }
+func (e TagEditReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VideoMetadataValidationError) Cause() error { return e.cause }
+func (e TagEditReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VideoMetadataValidationError) Key() bool { return e.key }
+func (e TagEditReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VideoMetadataValidationError) ErrorName() string { return "VideoMetadataValidationError" }
+func (e TagEditReqValidationError) ErrorName() string { return "TagEditReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e VideoMetadataValidationError) Error() string {
+func (e TagEditReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2044,14 +2093,14 @@ func (e VideoMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVideoMetadata.%s: %s%s",
+		"invalid %sTagEditReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VideoMetadataValidationError{}
+var _ error = TagEditReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -2059,108 +2108,51 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VideoMetadataValidationError{}
+} = TagEditReqValidationError{}
 
-// Validate checks the field values on VideoList with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on TagRevertReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *VideoList) Validate() error {
+func (m *TagRevertReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VideoList with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in VideoListMultiError, or nil
-// if none found.
-func (m *VideoList) ValidateAll() error {
+// ValidateAll checks the field values on TagRevertReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TagRevertReqMultiError, or
+// nil if none found.
+func (m *TagRevertReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VideoList) validate(all bool) error {
+func (m *TagRevertReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetVideos() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, VideoListValidationError{
-						field:  fmt.Sprintf("Videos[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, VideoListValidationError{
-						field:  fmt.Sprintf("Videos[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return VideoListValidationError{
-					field:  fmt.Sprintf("Videos[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for VideoId
 
-	}
+	// no validation rules for UserId
 
-	// no validation rules for NumberOfVideos
+	// no validation rules for Moderator
 
-	if all {
-		switch v := interface{}(m.GetCategories()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, VideoListValidationError{
-					field:  "Categories",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, VideoListValidationError{
-					field:  "Categories",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCategories()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return VideoListValidationError{
-				field:  "Categories",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for EditId
 
 	if len(errors) > 0 {
-		return VideoListMultiError(errors)
+		return TagRevertReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// VideoListMultiError is an error wrapping multiple validation errors returned
-// by VideoList.ValidateAll() if the designated constraints aren't met.
-type VideoListMultiError []error
+// TagRevertReqMultiError is an error wrapping multiple validation errors
+// returned by TagRevertReq.ValidateAll() if the designated constraints aren't met.
+type TagRevertReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VideoListMultiError) Error() string {
+func (m TagRevertReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2169,11 +2161,11 @@ func (m VideoListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VideoListMultiError) AllErrors() []error { return m }
+func (m TagRevertReqMultiError) AllErrors() []error { return m }
 
-// VideoListValidationError is the validation error returned by
-// VideoList.Validate if the designated constraints aren't met.
-type VideoListValidationError struct {
+// TagRevertReqValidationError is the validation error returned by
+// TagRevertReq.Validate if the designated constraints aren't met.
+type TagRevertReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2181,22 +2173,22 @@ type VideoListValidationError struct {
 }
 
 // Field function returns field value.
-func (e VideoListValidationError) Field() string { return e.field }
+func (e TagRevertReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VideoListValidationError) Reason() string { return e.reason }
+func (e TagRevertReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VideoListValidationError) Cause() error { return e.cause }
+func (e TagRevertReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VideoListValidationError) Key() bool { return e.key }
+func (e TagRevertReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VideoListValidationError) ErrorName() string { return "VideoListValidationError" }
+func (e TagRevertReqValidationError) ErrorName() string { return "TagRevertReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e VideoListValidationError) Error() string {
+func (e TagRevertReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2208,14 +2200,14 @@ func (e VideoListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVideoList.%s: %s%s",
+		"invalid %sTagRevertReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VideoListValidationError{}
+var _ error = TagRevertReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -2223,62 +2215,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VideoListValidationError{}
+} = TagRevertReqValidationError{}
 
-// Validate checks the field values on Video with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on TagHistoryReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Video) Validate() error {
+func (m *TagHistoryReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Video with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in VideoMultiError, or nil if none found.
-func (m *Video) ValidateAll() error {
+// ValidateAll checks the field values on TagHistoryReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TagHistoryReqMultiError, or
+// nil if none found.
+func (m *TagHistoryReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Video) validate(all bool) error {
+func (m *TagHistoryReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for VideoTitle
-
-	// no validation rules for Views
-
-	// no validation rules for Rating
-
-	// no validation rules for ThumbnailLoc
-
-	// no validation rules for VideoID
-
-	// no validation rules for AuthorName
-
-	// no validation rules for UploadDate
-
-	// no validation rules for AuthorID
-
-	// no validation rules for VideoDuration
+	// no validation rules for VideoId
 
-	// no validation rules for IsMature
+	// no validation rules for PageNumber
 
 	if len(errors) > 0 {
-		return VideoMultiError(errors)
+		return TagHistoryReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// VideoMultiError is an error wrapping multiple validation errors returned by
-// Video.ValidateAll() if the designated constraints aren't met.
-type VideoMultiError []error
+// TagHistoryReqMultiError is an error wrapping multiple validation errors
+// returned by TagHistoryReq.ValidateAll() if the designated constraints
+// aren't met.
+type TagHistoryReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VideoMultiError) Error() string {
+func (m TagHistoryReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2287,11 +2265,11 @@ func (m VideoMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VideoMultiError) AllErrors() []error { return m }
+func (m TagHistoryReqMultiError) AllErrors() []error { return m }
 
-// VideoValidationError is the validation error returned by Video.Validate if
-// the designated constraints aren't met.
-type VideoValidationError struct {
+// TagHistoryReqValidationError is the validation error returned by
+// TagHistoryReq.Validate if the designated constraints aren't met.
+type TagHistoryReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2299,22 +2277,22 @@ type VideoValidationError struct {
 }
 
 // Field function returns field value.
-func (e VideoValidationError) Field() string { return e.field }
+func (e TagHistoryReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VideoValidationError) Reason() string { return e.reason }
+func (e TagHistoryReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VideoValidationError) Cause() error { return e.cause }
+func (e TagHistoryReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VideoValidationError) Key() bool { return e.key }
+func (e TagHistoryReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VideoValidationError) ErrorName() string { return "VideoValidationError" }
+func (e TagHistoryReqValidationError) ErrorName() string { return "TagHistoryReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e VideoValidationError) Error() string {
+func (e TagHistoryReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2326,14 +2304,14 @@ func (e VideoValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVideo.%s: %s%s",
+		"invalid %sTagHistoryReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VideoValidationError{}
+var _ error = TagHistoryReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -2341,49 +2319,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VideoValidationError{}
+} = TagHistoryReqValidationError{}
 
-// Validate checks the field values on VideoRating with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on TagEdit with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *VideoRating) Validate() error {
+func (m *TagEdit) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VideoRating with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in VideoRatingMultiError, or
-// nil if none found.
-func (m *VideoRating) ValidateAll() error {
+// ValidateAll checks the field values on TagEdit with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TagEditMultiError, or nil if none found.
+func (m *TagEdit) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VideoRating) validate(all bool) error {
+func (m *TagEdit) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserID
+	// no validation rules for Id
 
-	// no validation rules for VideoID
+	// no validation rules for VideoId
 
-	// no validation rules for Rating
+	// no validation rules for UserId
+
+	// no validation rules for Username
+
+	// no validation rules for Action
+
+	// no validation rules for Tag
+
+	// no validation rules for RevertedEditId
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return VideoRatingMultiError(errors)
+		return TagEditMultiError(errors)
 	}
 
 	return nil
 }
 
-// VideoRatingMultiError is an error wrapping multiple validation errors
-// returned by VideoRating.ValidateAll() if the designated constraints aren't met.
-type VideoRatingMultiError []error
+// TagEditMultiError is an error wrapping multiple validation errors returned
+// by TagEdit.ValidateAll() if the designated constraints aren't met.
+type TagEditMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VideoRatingMultiError) Error() string {
+func (m TagEditMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2392,11 +2379,11 @@ func (m VideoRatingMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VideoRatingMultiError) AllErrors() []error { return m }
+func (m TagEditMultiError) AllErrors() []error { return m }
 
-// VideoRatingValidationError is the validation error returned by
-// VideoRating.Validate if the designated constraints aren't met.
-type VideoRatingValidationError struct {
+// TagEditValidationError is the validation error returned by TagEdit.Validate
+// if the designated constraints aren't met.
+type TagEditValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2404,22 +2391,22 @@ type VideoRatingValidationError struct {
 }
 
 // Field function returns field value.
-func (e VideoRatingValidationError) Field() string { return e.field }
+func (e TagEditValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VideoRatingValidationError) Reason() string { return e.reason }
+func (e TagEditValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VideoRatingValidationError) Cause() error { return e.cause }
+func (e TagEditValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VideoRatingValidationError) Key() bool { return e.key }
+func (e TagEditValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VideoRatingValidationError) ErrorName() string { return "VideoRatingValidationError" }
+func (e TagEditValidationError) ErrorName() string { return "TagEditValidationError" }
 
 // Error satisfies the builtin error interface
-func (e VideoRatingValidationError) Error() string {
+func (e TagEditValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2431,14 +2418,14 @@ func (e VideoRatingValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVideoRating.%s: %s%s",
+		"invalid %sTagEdit.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VideoRatingValidationError{}
+var _ error = TagEditValidationError{}
 
 var _ interface {
 	Field() string
@@ -2446,45 +2433,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VideoRatingValidationError{}
+} = TagEditValidationError{}
 
-// Validate checks the field values on VideoViewing with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on TagHistory with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *VideoViewing) Validate() error {
+func (m *TagHistory) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VideoViewing with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in VideoViewingMultiError, or
+// ValidateAll checks the field values on TagHistory with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TagHistoryMultiError, or
 // nil if none found.
-func (m *VideoViewing) ValidateAll() error {
+func (m *TagHistory) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VideoViewing) validate(all bool) error {
+func (m *TagHistory) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for VideoID
+	for idx, item := range m.GetEdits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TagHistoryValidationError{
+						field:  fmt.Sprintf("Edits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TagHistoryValidationError{
+						field:  fmt.Sprintf("Edits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TagHistoryValidationError{
+					field:  fmt.Sprintf("Edits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
 
 	if len(errors) > 0 {
-		return VideoViewingMultiError(errors)
+		return TagHistoryMultiError(errors)
 	}
 
 	return nil
 }
 
-// VideoViewingMultiError is an error wrapping multiple validation errors
-// returned by VideoViewing.ValidateAll() if the designated constraints aren't met.
-type VideoViewingMultiError []error
+// TagHistoryMultiError is an error wrapping multiple validation errors
+// returned by TagHistory.ValidateAll() if the designated constraints aren't met.
+type TagHistoryMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VideoViewingMultiError) Error() string {
+func (m TagHistoryMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2493,11 +2514,11 @@ func (m VideoViewingMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VideoViewingMultiError) AllErrors() []error { return m }
+func (m TagHistoryMultiError) AllErrors() []error { return m }
 
-// VideoViewingValidationError is the validation error returned by
-// VideoViewing.Validate if the designated constraints aren't met.
-type VideoViewingValidationError struct {
+// TagHistoryValidationError is the validation error returned by
+// TagHistory.Validate if the designated constraints aren't met.
+type TagHistoryValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2505,22 +2526,22 @@ type VideoViewingValidationError struct {
 }
 
 // Field function returns field value.
-func (e VideoViewingValidationError) Field() string { return e.field }
+func (e TagHistoryValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VideoViewingValidationError) Reason() string { return e.reason }
+func (e TagHistoryValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VideoViewingValidationError) Cause() error { return e.cause }
+func (e TagHistoryValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VideoViewingValidationError) Key() bool { return e.key }
+func (e TagHistoryValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VideoViewingValidationError) ErrorName() string { return "VideoViewingValidationError" }
+func (e TagHistoryValidationError) ErrorName() string { return "TagHistoryValidationError" }
 
 // Error satisfies the builtin error interface
-func (e VideoViewingValidationError) Error() string {
+func (e TagHistoryValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2532,14 +2553,14 @@ func (e VideoViewingValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVideoViewing.%s: %s%s",
+		"invalid %sTagHistory.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VideoViewingValidationError{}
+var _ error = TagHistoryValidationError{}
 
 var _ interface {
 	Field() string
@@ -2547,50 +2568,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VideoViewingValidationError{}
+} = TagHistoryValidationError{}
 
-// Validate checks the field values on VideoApproval with the rules defined in
+// Validate checks the field values on FavoriteReq with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *VideoApproval) Validate() error {
+func (m *FavoriteReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VideoApproval with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in VideoApprovalMultiError, or
+// ValidateAll checks the field values on FavoriteReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FavoriteReqMultiError, or
 // nil if none found.
-func (m *VideoApproval) ValidateAll() error {
+func (m *FavoriteReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VideoApproval) validate(all bool) error {
+func (m *FavoriteReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserID
-
-	// no validation rules for VideoID
+	// no validation rules for UserId
 
-	// no validation rules for Mature
+	// no validation rules for VideoId
 
 	if len(errors) > 0 {
-		return VideoApprovalMultiError(errors)
+		return FavoriteReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// VideoApprovalMultiError is an error wrapping multiple validation errors
-// returned by VideoApproval.ValidateAll() if the designated constraints
-// aren't met.
-type VideoApprovalMultiError []error
+// FavoriteReqMultiError is an error wrapping multiple validation errors
+// returned by FavoriteReq.ValidateAll() if the designated constraints aren't met.
+type FavoriteReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VideoApprovalMultiError) Error() string {
+func (m FavoriteReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2599,11 +2617,11 @@ func (m VideoApprovalMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VideoApprovalMultiError) AllErrors() []error { return m }
+func (m FavoriteReqMultiError) AllErrors() []error { return m }
 
-// VideoApprovalValidationError is the validation error returned by
-// VideoApproval.Validate if the designated constraints aren't met.
-type VideoApprovalValidationError struct {
+// FavoriteReqValidationError is the validation error returned by
+// FavoriteReq.Validate if the designated constraints aren't met.
+type FavoriteReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2611,22 +2629,22 @@ type VideoApprovalValidationError struct {
 }
 
 // Field function returns field value.
-func (e VideoApprovalValidationError) Field() string { return e.field }
+func (e FavoriteReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VideoApprovalValidationError) Reason() string { return e.reason }
+func (e FavoriteReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VideoApprovalValidationError) Cause() error { return e.cause }
+func (e FavoriteReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VideoApprovalValidationError) Key() bool { return e.key }
+func (e FavoriteReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VideoApprovalValidationError) ErrorName() string { return "VideoApprovalValidationError" }
+func (e FavoriteReqValidationError) ErrorName() string { return "FavoriteReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e VideoApprovalValidationError) Error() string {
+func (e FavoriteReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2638,14 +2656,14 @@ func (e VideoApprovalValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVideoApproval.%s: %s%s",
+		"invalid %sFavoriteReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VideoApprovalValidationError{}
+var _ error = FavoriteReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -2653,62 +2671,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VideoApprovalValidationError{}
+} = FavoriteReqValidationError{}
 
-// Validate checks the field values on VideoQueryConfig with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *VideoQueryConfig) Validate() error {
+// Validate checks the field values on FavoriteStatus with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FavoriteStatus) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VideoQueryConfig with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// VideoQueryConfigMultiError, or nil if none found.
-func (m *VideoQueryConfig) ValidateAll() error {
+// ValidateAll checks the field values on FavoriteStatus with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FavoriteStatusMultiError,
+// or nil if none found.
+func (m *FavoriteStatus) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VideoQueryConfig) validate(all bool) error {
+func (m *FavoriteStatus) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OrderBy
-
-	// no validation rules for Direction
-
-	// no validation rules for PageNumber
-
-	// no validation rules for SearchVal
-
-	// no validation rules for FromUserID
-
-	// no validation rules for ShowUnapproved
-
-	// no validation rules for UnapprovedOnly
-
-	// no validation rules for Category
+	// no validation rules for Favorite
 
-	// no validation rules for ShowMature
+	// no validation rules for Count
 
 	if len(errors) > 0 {
-		return VideoQueryConfigMultiError(errors)
+		return FavoriteStatusMultiError(errors)
 	}
 
 	return nil
 }
 
-// VideoQueryConfigMultiError is an error wrapping multiple validation errors
-// returned by VideoQueryConfig.ValidateAll() if the designated constraints
+// FavoriteStatusMultiError is an error wrapping multiple validation errors
+// returned by FavoriteStatus.ValidateAll() if the designated constraints
 // aren't met.
-type VideoQueryConfigMultiError []error
+type FavoriteStatusMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VideoQueryConfigMultiError) Error() string {
+func (m FavoriteStatusMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2717,11 +2721,11 @@ func (m VideoQueryConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VideoQueryConfigMultiError) AllErrors() []error { return m }
+func (m FavoriteStatusMultiError) AllErrors() []error { return m }
 
-// VideoQueryConfigValidationError is the validation error returned by
-// VideoQueryConfig.Validate if the designated constraints aren't met.
-type VideoQueryConfigValidationError struct {
+// FavoriteStatusValidationError is the validation error returned by
+// FavoriteStatus.Validate if the designated constraints aren't met.
+type FavoriteStatusValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2729,22 +2733,22 @@ type VideoQueryConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e VideoQueryConfigValidationError) Field() string { return e.field }
+func (e FavoriteStatusValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VideoQueryConfigValidationError) Reason() string { return e.reason }
+func (e FavoriteStatusValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VideoQueryConfigValidationError) Cause() error { return e.cause }
+func (e FavoriteStatusValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VideoQueryConfigValidationError) Key() bool { return e.key }
+func (e FavoriteStatusValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VideoQueryConfigValidationError) ErrorName() string { return "VideoQueryConfigValidationError" }
+func (e FavoriteStatusValidationError) ErrorName() string { return "FavoriteStatusValidationError" }
 
 // Error satisfies the builtin error interface
-func (e VideoQueryConfigValidationError) Error() string {
+func (e FavoriteStatusValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2756,14 +2760,14 @@ func (e VideoQueryConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVideoQueryConfig.%s: %s%s",
+		"invalid %sFavoriteStatus.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VideoQueryConfigValidationError{}
+var _ error = FavoriteStatusValidationError{}
 
 var _ interface {
 	Field() string
@@ -2771,46 +2775,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VideoQueryConfigValidationError{}
+} = FavoriteStatusValidationError{}
 
-// Validate checks the field values on VideoExistenceResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *VideoExistenceResponse) Validate() error {
+// Validate checks the field values on FavoritesQuery with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FavoritesQuery) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VideoExistenceResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// VideoExistenceResponseMultiError, or nil if none found.
-func (m *VideoExistenceResponse) ValidateAll() error {
+// ValidateAll checks the field values on FavoritesQuery with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FavoritesQueryMultiError,
+// or nil if none found.
+func (m *FavoritesQuery) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VideoExistenceResponse) validate(all bool) error {
+func (m *FavoritesQuery) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Exists
+	// no validation rules for UserId
+
+	// no validation rules for PageNumber
+
+	// no validation rules for ShowMature
 
 	if len(errors) > 0 {
-		return VideoExistenceResponseMultiError(errors)
+		return FavoritesQueryMultiError(errors)
 	}
 
 	return nil
 }
 
-// VideoExistenceResponseMultiError is an error wrapping multiple validation
-// errors returned by VideoExistenceResponse.ValidateAll() if the designated
-// constraints aren't met.
-type VideoExistenceResponseMultiError []error
+// FavoritesQueryMultiError is an error wrapping multiple validation errors
+// returned by FavoritesQuery.ValidateAll() if the designated constraints
+// aren't met.
+type FavoritesQueryMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VideoExistenceResponseMultiError) Error() string {
+func (m FavoritesQueryMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2819,11 +2827,11 @@ func (m VideoExistenceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VideoExistenceResponseMultiError) AllErrors() []error { return m }
+func (m FavoritesQueryMultiError) AllErrors() []error { return m }
 
-// VideoExistenceResponseValidationError is the validation error returned by
-// VideoExistenceResponse.Validate if the designated constraints aren't met.
-type VideoExistenceResponseValidationError struct {
+// FavoritesQueryValidationError is the validation error returned by
+// FavoritesQuery.Validate if the designated constraints aren't met.
+type FavoritesQueryValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2831,24 +2839,22 @@ type VideoExistenceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e VideoExistenceResponseValidationError) Field() string { return e.field }
+func (e FavoritesQueryValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VideoExistenceResponseValidationError) Reason() string { return e.reason }
+func (e FavoritesQueryValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VideoExistenceResponseValidationError) Cause() error { return e.cause }
+func (e FavoritesQueryValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VideoExistenceResponseValidationError) Key() bool { return e.key }
+func (e FavoritesQueryValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VideoExistenceResponseValidationError) ErrorName() string {
-	return "VideoExistenceResponseValidationError"
-}
+func (e FavoritesQueryValidationError) ErrorName() string { return "FavoritesQueryValidationError" }
 
 // Error satisfies the builtin error interface
-func (e VideoExistenceResponseValidationError) Error() string {
+func (e FavoritesQueryValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2860,14 +2866,14 @@ func (e VideoExistenceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVideoExistenceResponse.%s: %s%s",
+		"invalid %sFavoritesQuery.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VideoExistenceResponseValidationError{}
+var _ error = FavoritesQueryValidationError{}
 
 var _ interface {
 	Field() string
@@ -2875,48 +2881,93 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VideoExistenceResponseValidationError{}
+} = FavoritesQueryValidationError{}
 
-// Validate checks the field values on ForeignVideoCheck with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ForeignVideoCheck) Validate() error {
+// Validate checks the field values on Playlist with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Playlist) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ForeignVideoCheck with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ForeignVideoCheckMultiError, or nil if none found.
-func (m *ForeignVideoCheck) ValidateAll() error {
+// ValidateAll checks the field values on Playlist with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PlaylistMultiError, or nil
+// if none found.
+func (m *Playlist) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ForeignVideoCheck) validate(all bool) error {
+func (m *Playlist) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ForeignVideoID
+	// no validation rules for Id
 
-	// no validation rules for ForeignWebsite
+	// no validation rules for UserId
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	// no validation rules for Visibility
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	// no validation rules for EntryCount
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlaylistValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlaylistValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlaylistValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return ForeignVideoCheckMultiError(errors)
+		return PlaylistMultiError(errors)
 	}
 
 	return nil
 }
 
-// ForeignVideoCheckMultiError is an error wrapping multiple validation errors
-// returned by ForeignVideoCheck.ValidateAll() if the designated constraints
-// aren't met.
-type ForeignVideoCheckMultiError []error
+// PlaylistMultiError is an error wrapping multiple validation errors returned
+// by Playlist.ValidateAll() if the designated constraints aren't met.
+type PlaylistMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ForeignVideoCheckMultiError) Error() string {
+func (m PlaylistMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2925,11 +2976,11 @@ func (m ForeignVideoCheckMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ForeignVideoCheckMultiError) AllErrors() []error { return m }
+func (m PlaylistMultiError) AllErrors() []error { return m }
 
-// ForeignVideoCheckValidationError is the validation error returned by
-// ForeignVideoCheck.Validate if the designated constraints aren't met.
-type ForeignVideoCheckValidationError struct {
+// PlaylistValidationError is the validation error returned by
+// Playlist.Validate if the designated constraints aren't met.
+type PlaylistValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2937,24 +2988,22 @@ type ForeignVideoCheckValidationError struct {
 }
 
 // Field function returns field value.
-func (e ForeignVideoCheckValidationError) Field() string { return e.field }
+func (e PlaylistValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ForeignVideoCheckValidationError) Reason() string { return e.reason }
+func (e PlaylistValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ForeignVideoCheckValidationError) Cause() error { return e.cause }
+func (e PlaylistValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ForeignVideoCheckValidationError) Key() bool { return e.key }
+func (e PlaylistValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ForeignVideoCheckValidationError) ErrorName() string {
-	return "ForeignVideoCheckValidationError"
-}
+func (e PlaylistValidationError) ErrorName() string { return "PlaylistValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ForeignVideoCheckValidationError) Error() string {
+func (e PlaylistValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2966,14 +3015,14 @@ func (e ForeignVideoCheckValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sForeignVideoCheck.%s: %s%s",
+		"invalid %sPlaylist.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ForeignVideoCheckValidationError{}
+var _ error = PlaylistValidationError{}
 
 var _ interface {
 	Field() string
@@ -2981,45 +3030,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ForeignVideoCheckValidationError{}
+} = PlaylistValidationError{}
 
-// Validate checks the field values on VideoRequest with the rules defined in
+// Validate checks the field values on PlaylistEntry with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *VideoRequest) Validate() error {
+func (m *PlaylistEntry) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VideoRequest with the rules defined
+// ValidateAll checks the field values on PlaylistEntry with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in VideoRequestMultiError, or
+// result is a list of violation errors wrapped in PlaylistEntryMultiError, or
 // nil if none found.
-func (m *VideoRequest) ValidateAll() error {
+func (m *PlaylistEntry) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VideoRequest) validate(all bool) error {
+func (m *PlaylistEntry) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for VideoID
+	// no validation rules for PlaylistId
+
+	if all {
+		switch v := interface{}(m.GetVideo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PlaylistEntryValidationError{
+					field:  "Video",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PlaylistEntryValidationError{
+					field:  "Video",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVideo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PlaylistEntryValidationError{
+				field:  "Video",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Position
+
+	// no validation rules for Note
+
+	// no validation rules for AddedAt
 
 	if len(errors) > 0 {
-		return VideoRequestMultiError(errors)
+		return PlaylistEntryMultiError(errors)
 	}
 
 	return nil
 }
 
-// VideoRequestMultiError is an error wrapping multiple validation errors
-// returned by VideoRequest.ValidateAll() if the designated constraints aren't met.
-type VideoRequestMultiError []error
+// PlaylistEntryMultiError is an error wrapping multiple validation errors
+// returned by PlaylistEntry.ValidateAll() if the designated constraints
+// aren't met.
+type PlaylistEntryMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VideoRequestMultiError) Error() string {
+func (m PlaylistEntryMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3028,11 +3113,11 @@ func (m VideoRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VideoRequestMultiError) AllErrors() []error { return m }
+func (m PlaylistEntryMultiError) AllErrors() []error { return m }
 
-// VideoRequestValidationError is the validation error returned by
-// VideoRequest.Validate if the designated constraints aren't met.
-type VideoRequestValidationError struct {
+// PlaylistEntryValidationError is the validation error returned by
+// PlaylistEntry.Validate if the designated constraints aren't met.
+type PlaylistEntryValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3040,22 +3125,22 @@ type VideoRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e VideoRequestValidationError) Field() string { return e.field }
+func (e PlaylistEntryValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VideoRequestValidationError) Reason() string { return e.reason }
+func (e PlaylistEntryValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VideoRequestValidationError) Cause() error { return e.cause }
+func (e PlaylistEntryValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VideoRequestValidationError) Key() bool { return e.key }
+func (e PlaylistEntryValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VideoRequestValidationError) ErrorName() string { return "VideoRequestValidationError" }
+func (e PlaylistEntryValidationError) ErrorName() string { return "PlaylistEntryValidationError" }
 
 // Error satisfies the builtin error interface
-func (e VideoRequestValidationError) Error() string {
+func (e PlaylistEntryValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3067,14 +3152,14 @@ func (e VideoRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVideoRequest.%s: %s%s",
+		"invalid %sPlaylistEntry.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VideoRequestValidationError{}
+var _ error = PlaylistEntryValidationError{}
 
 var _ interface {
 	Field() string
@@ -3082,141 +3167,4529 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VideoRequestValidationError{}
+} = PlaylistEntryValidationError{}
 
-// Validate checks the field values on InputVideoChunk with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *InputVideoChunk) Validate() error {
+// Validate checks the field values on PlaylistReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PlaylistReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InputVideoChunk with the rules
+// ValidateAll checks the field values on PlaylistReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PlaylistReqMultiError, or
+// nil if none found.
+func (m *PlaylistReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlaylistReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return PlaylistReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlaylistReqMultiError is an error wrapping multiple validation errors
+// returned by PlaylistReq.ValidateAll() if the designated constraints aren't met.
+type PlaylistReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlaylistReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlaylistReqMultiError) AllErrors() []error { return m }
+
+// PlaylistReqValidationError is the validation error returned by
+// PlaylistReq.Validate if the designated constraints aren't met.
+type PlaylistReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlaylistReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlaylistReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlaylistReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlaylistReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlaylistReqValidationError) ErrorName() string { return "PlaylistReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PlaylistReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlaylistReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlaylistReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlaylistReqValidationError{}
+
+// Validate checks the field values on PlaylistListReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PlaylistListReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlaylistListReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// InputVideoChunkMultiError, or nil if none found.
-func (m *InputVideoChunk) ValidateAll() error {
+// PlaylistListReqMultiError, or nil if none found.
+func (m *PlaylistListReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InputVideoChunk) validate(all bool) error {
+func (m *PlaylistListReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch m.Payload.(type) {
+	// no validation rules for OwnerId
 
-	case *InputVideoChunk_Content:
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return PlaylistListReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlaylistListReqMultiError is an error wrapping multiple validation errors
+// returned by PlaylistListReq.ValidateAll() if the designated constraints
+// aren't met.
+type PlaylistListReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlaylistListReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlaylistListReqMultiError) AllErrors() []error { return m }
+
+// PlaylistListReqValidationError is the validation error returned by
+// PlaylistListReq.Validate if the designated constraints aren't met.
+type PlaylistListReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlaylistListReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlaylistListReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlaylistListReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlaylistListReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlaylistListReqValidationError) ErrorName() string { return "PlaylistListReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PlaylistListReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlaylistListReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlaylistListReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlaylistListReqValidationError{}
+
+// Validate checks the field values on PlaylistList with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PlaylistList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlaylistList with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PlaylistListMultiError, or
+// nil if none found.
+func (m *PlaylistList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlaylistList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPlaylists() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetContent()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, InputVideoChunkValidationError{
-						field:  "Content",
+					errors = append(errors, PlaylistListValidationError{
+						field:  fmt.Sprintf("Playlists[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, InputVideoChunkValidationError{
-						field:  "Content",
+					errors = append(errors, PlaylistListValidationError{
+						field:  fmt.Sprintf("Playlists[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return InputVideoChunkValidationError{
-					field:  "Content",
+				return PlaylistListValidationError{
+					field:  fmt.Sprintf("Playlists[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *InputVideoChunk_Meta:
+	}
+
+	if len(errors) > 0 {
+		return PlaylistListMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlaylistListMultiError is an error wrapping multiple validation errors
+// returned by PlaylistList.ValidateAll() if the designated constraints aren't met.
+type PlaylistListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlaylistListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlaylistListMultiError) AllErrors() []error { return m }
+
+// PlaylistListValidationError is the validation error returned by
+// PlaylistList.Validate if the designated constraints aren't met.
+type PlaylistListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlaylistListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlaylistListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlaylistListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlaylistListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlaylistListValidationError) ErrorName() string { return "PlaylistListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PlaylistListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlaylistList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlaylistListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlaylistListValidationError{}
+
+// Validate checks the field values on PlaylistEntryReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PlaylistEntryReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlaylistEntryReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlaylistEntryReqMultiError, or nil if none found.
+func (m *PlaylistEntryReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlaylistEntryReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PlaylistId
+
+	// no validation rules for UserId
+
+	// no validation rules for VideoId
+
+	// no validation rules for Note
+
+	// no validation rules for Position
+
+	if len(errors) > 0 {
+		return PlaylistEntryReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlaylistEntryReqMultiError is an error wrapping multiple validation errors
+// returned by PlaylistEntryReq.ValidateAll() if the designated constraints
+// aren't met.
+type PlaylistEntryReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlaylistEntryReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlaylistEntryReqMultiError) AllErrors() []error { return m }
+
+// PlaylistEntryReqValidationError is the validation error returned by
+// PlaylistEntryReq.Validate if the designated constraints aren't met.
+type PlaylistEntryReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlaylistEntryReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlaylistEntryReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlaylistEntryReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlaylistEntryReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlaylistEntryReqValidationError) ErrorName() string { return "PlaylistEntryReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PlaylistEntryReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlaylistEntryReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlaylistEntryReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlaylistEntryReqValidationError{}
+
+// Validate checks the field values on PlaylistPositionReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PlaylistPositionReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlaylistPositionReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlaylistPositionReqMultiError, or nil if none found.
+func (m *PlaylistPositionReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlaylistPositionReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PlaylistId
+
+	// no validation rules for UserId
+
+	// no validation rules for VideoId
+
+	// no validation rules for Loop
+
+	if len(errors) > 0 {
+		return PlaylistPositionReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlaylistPositionReqMultiError is an error wrapping multiple validation
+// errors returned by PlaylistPositionReq.ValidateAll() if the designated
+// constraints aren't met.
+type PlaylistPositionReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlaylistPositionReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlaylistPositionReqMultiError) AllErrors() []error { return m }
+
+// PlaylistPositionReqValidationError is the validation error returned by
+// PlaylistPositionReq.Validate if the designated constraints aren't met.
+type PlaylistPositionReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlaylistPositionReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlaylistPositionReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlaylistPositionReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlaylistPositionReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlaylistPositionReqValidationError) ErrorName() string {
+	return "PlaylistPositionReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlaylistPositionReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlaylistPositionReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlaylistPositionReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlaylistPositionReqValidationError{}
+
+// Validate checks the field values on PlaylistNeighbours with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PlaylistNeighbours) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlaylistNeighbours with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlaylistNeighboursMultiError, or nil if none found.
+func (m *PlaylistNeighbours) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlaylistNeighbours) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPrevious()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PlaylistNeighboursValidationError{
+					field:  "Previous",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PlaylistNeighboursValidationError{
+					field:  "Previous",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrevious()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PlaylistNeighboursValidationError{
+				field:  "Previous",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNext()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PlaylistNeighboursValidationError{
+					field:  "Next",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PlaylistNeighboursValidationError{
+					field:  "Next",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNext()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PlaylistNeighboursValidationError{
+				field:  "Next",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PlaylistNeighboursMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlaylistNeighboursMultiError is an error wrapping multiple validation errors
+// returned by PlaylistNeighbours.ValidateAll() if the designated constraints
+// aren't met.
+type PlaylistNeighboursMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlaylistNeighboursMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlaylistNeighboursMultiError) AllErrors() []error { return m }
+
+// PlaylistNeighboursValidationError is the validation error returned by
+// PlaylistNeighbours.Validate if the designated constraints aren't met.
+type PlaylistNeighboursValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlaylistNeighboursValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlaylistNeighboursValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlaylistNeighboursValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlaylistNeighboursValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlaylistNeighboursValidationError) ErrorName() string {
+	return "PlaylistNeighboursValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlaylistNeighboursValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlaylistNeighbours.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlaylistNeighboursValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlaylistNeighboursValidationError{}
+
+// Validate checks the field values on FeedReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FeedReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FeedReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in FeedReqMultiError, or nil if none found.
+func (m *FeedReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FeedReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ShowMature
+
+	// no validation rules for Cursor
+
+	if len(errors) > 0 {
+		return FeedReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// FeedReqMultiError is an error wrapping multiple validation errors returned
+// by FeedReq.ValidateAll() if the designated constraints aren't met.
+type FeedReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FeedReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FeedReqMultiError) AllErrors() []error { return m }
+
+// FeedReqValidationError is the validation error returned by FeedReq.Validate
+// if the designated constraints aren't met.
+type FeedReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FeedReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FeedReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FeedReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FeedReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FeedReqValidationError) ErrorName() string { return "FeedReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FeedReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFeedReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FeedReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FeedReqValidationError{}
+
+// Validate checks the field values on CategoryList with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CategoryList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CategoryList with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CategoryListMultiError, or
+// nil if none found.
+func (m *CategoryList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CategoryList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCategories() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CategoryListValidationError{
+						field:  fmt.Sprintf("Categories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CategoryListValidationError{
+						field:  fmt.Sprintf("Categories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CategoryListValidationError{
+					field:  fmt.Sprintf("Categories[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CategoryListMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryListMultiError is an error wrapping multiple validation errors
+// returned by CategoryList.ValidateAll() if the designated constraints aren't met.
+type CategoryListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryListMultiError) AllErrors() []error { return m }
+
+// CategoryListValidationError is the validation error returned by
+// CategoryList.Validate if the designated constraints aren't met.
+type CategoryListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryListValidationError) ErrorName() string { return "CategoryListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CategoryListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategoryList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryListValidationError{}
+
+// Validate checks the field values on Category with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Category) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Category with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CategoryMultiError, or nil
+// if none found.
+func (m *Category) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Category) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Cardinality
+
+	if len(errors) > 0 {
+		return CategoryMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryMultiError is an error wrapping multiple validation errors returned
+// by Category.ValidateAll() if the designated constraints aren't met.
+type CategoryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryMultiError) AllErrors() []error { return m }
+
+// CategoryValidationError is the validation error returned by
+// Category.Validate if the designated constraints aren't met.
+type CategoryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryValidationError) ErrorName() string { return "CategoryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CategoryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategory.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryValidationError{}
+
+// Validate checks the field values on VideoDeletionReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *VideoDeletionReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VideoDeletionReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VideoDeletionReqMultiError, or nil if none found.
+func (m *VideoDeletionReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VideoDeletionReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for VideoID
+
+	if len(errors) > 0 {
+		return VideoDeletionReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// VideoDeletionReqMultiError is an error wrapping multiple validation errors
+// returned by VideoDeletionReq.ValidateAll() if the designated constraints
+// aren't met.
+type VideoDeletionReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VideoDeletionReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VideoDeletionReqMultiError) AllErrors() []error { return m }
+
+// VideoDeletionReqValidationError is the validation error returned by
+// VideoDeletionReq.Validate if the designated constraints aren't met.
+type VideoDeletionReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VideoDeletionReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VideoDeletionReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VideoDeletionReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VideoDeletionReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VideoDeletionReqValidationError) ErrorName() string { return "VideoDeletionReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VideoDeletionReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVideoDeletionReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VideoDeletionReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VideoDeletionReqValidationError{}
+
+// Validate checks the field values on Nothing with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Nothing) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Nothing with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in NothingMultiError, or nil if none found.
+func (m *Nothing) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Nothing) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return NothingMultiError(errors)
+	}
+
+	return nil
+}
+
+// NothingMultiError is an error wrapping multiple validation errors returned
+// by Nothing.ValidateAll() if the designated constraints aren't met.
+type NothingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NothingMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NothingMultiError) AllErrors() []error { return m }
+
+// NothingValidationError is the validation error returned by Nothing.Validate
+// if the designated constraints aren't met.
+type NothingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NothingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NothingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NothingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NothingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NothingValidationError) ErrorName() string { return "NothingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NothingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNothing.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NothingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NothingValidationError{}
+
+// Validate checks the field values on RecReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RecReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RecReqMultiError, or nil if none found.
+func (m *RecReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for VideoId
+
+	// no validation rules for ShowMature
+
+	if len(errors) > 0 {
+		return RecReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecReqMultiError is an error wrapping multiple validation errors returned by
+// RecReq.ValidateAll() if the designated constraints aren't met.
+type RecReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecReqMultiError) AllErrors() []error { return m }
+
+// RecReqValidationError is the validation error returned by RecReq.Validate if
+// the designated constraints aren't met.
+type RecReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecReqValidationError) ErrorName() string { return "RecReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RecReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecReqValidationError{}
+
+// Validate checks the field values on RecResp with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RecResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecResp with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RecRespMultiError, or nil if none found.
+func (m *RecResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVideos() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RecRespValidationError{
+						field:  fmt.Sprintf("Videos[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RecRespValidationError{
+						field:  fmt.Sprintf("Videos[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RecRespValidationError{
+					field:  fmt.Sprintf("Videos[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RecRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecRespMultiError is an error wrapping multiple validation errors returned
+// by RecResp.ValidateAll() if the designated constraints aren't met.
+type RecRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecRespMultiError) AllErrors() []error { return m }
+
+// RecRespValidationError is the validation error returned by RecResp.Validate
+// if the designated constraints aren't met.
+type RecRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecRespValidationError) ErrorName() string { return "RecRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RecRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecRespValidationError{}
+
+// Validate checks the field values on VideoRec with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VideoRec) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VideoRec with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in VideoRecMultiError, or nil
+// if none found.
+func (m *VideoRec) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VideoRec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ThumbnailLoc
+
+	// no validation rules for VideoTitle
+
+	// no validation rules for VideoID
+
+	if len(errors) > 0 {
+		return VideoRecMultiError(errors)
+	}
+
+	return nil
+}
+
+// VideoRecMultiError is an error wrapping multiple validation errors returned
+// by VideoRec.ValidateAll() if the designated constraints aren't met.
+type VideoRecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VideoRecMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VideoRecMultiError) AllErrors() []error { return m }
+
+// VideoRecValidationError is the validation error returned by
+// VideoRec.Validate if the designated constraints aren't met.
+type VideoRecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VideoRecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VideoRecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VideoRecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VideoRecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VideoRecValidationError) ErrorName() string { return "VideoRecValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VideoRecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVideoRec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VideoRecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VideoRecValidationError{}
+
+// Validate checks the field values on VideoComment with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VideoComment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VideoComment with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in VideoCommentMultiError, or
+// nil if none found.
+func (m *VideoComment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VideoComment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for VideoId
+
+	// no validation rules for Comment
+
+	// no validation rules for ParentComment
+
+	if len(errors) > 0 {
+		return VideoCommentMultiError(errors)
+	}
+
+	return nil
+}
+
+// VideoCommentMultiError is an error wrapping multiple validation errors
+// returned by VideoComment.ValidateAll() if the designated constraints aren't met.
+type VideoCommentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VideoCommentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VideoCommentMultiError) AllErrors() []error { return m }
+
+// VideoCommentValidationError is the validation error returned by
+// VideoComment.Validate if the designated constraints aren't met.
+type VideoCommentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VideoCommentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VideoCommentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VideoCommentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VideoCommentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VideoCommentValidationError) ErrorName() string { return "VideoCommentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VideoCommentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVideoComment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VideoCommentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VideoCommentValidationError{}
+
+// Validate checks the field values on CommentRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CommentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommentRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CommentRequestMultiError,
+// or nil if none found.
+func (m *CommentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for VideoID
+
+	// no validation rules for CurrUserID
+
+	if len(errors) > 0 {
+		return CommentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommentRequestMultiError is an error wrapping multiple validation errors
+// returned by CommentRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CommentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommentRequestMultiError) AllErrors() []error { return m }
+
+// CommentRequestValidationError is the validation error returned by
+// CommentRequest.Validate if the designated constraints aren't met.
+type CommentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommentRequestValidationError) ErrorName() string { return "CommentRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CommentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommentRequestValidationError{}
+
+// Validate checks the field values on CommentUpvote with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CommentUpvote) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommentUpvote with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CommentUpvoteMultiError, or
+// nil if none found.
+func (m *CommentUpvote) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommentUpvote) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for CommentId
+
+	// no validation rules for Score
+
+	if len(errors) > 0 {
+		return CommentUpvoteMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommentUpvoteMultiError is an error wrapping multiple validation errors
+// returned by CommentUpvote.ValidateAll() if the designated constraints
+// aren't met.
+type CommentUpvoteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommentUpvoteMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommentUpvoteMultiError) AllErrors() []error { return m }
+
+// CommentUpvoteValidationError is the validation error returned by
+// CommentUpvote.Validate if the designated constraints aren't met.
+type CommentUpvoteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommentUpvoteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommentUpvoteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommentUpvoteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommentUpvoteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommentUpvoteValidationError) ErrorName() string { return "CommentUpvoteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CommentUpvoteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommentUpvote.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommentUpvoteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommentUpvoteValidationError{}
+
+// Validate checks the field values on CommentListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommentListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommentListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommentListResponseMultiError, or nil if none found.
+func (m *CommentListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommentListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetComments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CommentListResponseValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CommentListResponseValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CommentListResponseValidationError{
+					field:  fmt.Sprintf("Comments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CommentListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommentListResponseMultiError is an error wrapping multiple validation
+// errors returned by CommentListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CommentListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommentListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommentListResponseMultiError) AllErrors() []error { return m }
+
+// CommentListResponseValidationError is the validation error returned by
+// CommentListResponse.Validate if the designated constraints aren't met.
+type CommentListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommentListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommentListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommentListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommentListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommentListResponseValidationError) ErrorName() string {
+	return "CommentListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommentListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommentListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommentListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommentListResponseValidationError{}
+
+// Validate checks the field values on Comment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Comment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Comment with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CommentMultiError, or nil if none found.
+func (m *Comment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Comment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CommentId
+
+	// no validation rules for CreationDate
+
+	// no validation rules for Content
+
+	// no validation rules for AuthorUsername
+
+	// no validation rules for AuthorProfileImageUrl
+
+	// no validation rules for VoteScore
+
+	// no validation rules for CurrentUserHasUpvoted
+
+	// no validation rules for CurrentUserHasDownvoted
+
+	// no validation rules for AuthorId
+
+	// no validation rules for ParentId
+
+	// no validation rules for Archived
+
+	// no validation rules for LikeCount
+
+	if len(errors) > 0 {
+		return CommentMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommentMultiError is an error wrapping multiple validation errors returned
+// by Comment.ValidateAll() if the designated constraints aren't met.
+type CommentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommentMultiError) AllErrors() []error { return m }
+
+// CommentValidationError is the validation error returned by Comment.Validate
+// if the designated constraints aren't met.
+type CommentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommentValidationError) ErrorName() string { return "CommentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CommentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommentValidationError{}
+
+// Validate checks the field values on VideoMetadata with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VideoMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VideoMetadata with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in VideoMetadataMultiError, or
+// nil if none found.
+func (m *VideoMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VideoMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for VideoLoc
+
+	// no validation rules for VideoTitle
+
+	// no validation rules for Rating
+
+	// no validation rules for AuthorName
+
+	// no validation rules for Views
+
+	// no validation rules for VideoID
+
+	// no validation rules for UploadDate
+
+	// no validation rules for Description
+
+	// no validation rules for AuthorID
+
+	// no validation rules for VideoDuration
+
+	// no validation rules for Category
+
+	// no validation rules for Thumbnail
+
+	// no validation rules for IsMature
+
+	// no validation rules for HLSLoc
+
+	for idx, item := range m.GetSubtitles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VideoMetadataValidationError{
+						field:  fmt.Sprintf("Subtitles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VideoMetadataValidationError{
+						field:  fmt.Sprintf("Subtitles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VideoMetadataValidationError{
+					field:  fmt.Sprintf("Subtitles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for UpstreamStatus
+
+	// no validation rules for UpstreamStatusSince
+
+	// no validation rules for UpstreamCheckedAt
+
+	if len(errors) > 0 {
+		return VideoMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// VideoMetadataMultiError is an error wrapping multiple validation errors
+// returned by VideoMetadata.ValidateAll() if the designated constraints
+// aren't met.
+type VideoMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VideoMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VideoMetadataMultiError) AllErrors() []error { return m }
+
+// VideoMetadataValidationError is the validation error returned by
+// VideoMetadata.Validate if the designated constraints aren't met.
+type VideoMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VideoMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VideoMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VideoMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VideoMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VideoMetadataValidationError) ErrorName() string { return "VideoMetadataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VideoMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVideoMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VideoMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VideoMetadataValidationError{}
+
+// Validate checks the field values on VideoList with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VideoList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VideoList with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in VideoListMultiError, or nil
+// if none found.
+func (m *VideoList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VideoList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVideos() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VideoListValidationError{
+						field:  fmt.Sprintf("Videos[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VideoListValidationError{
+						field:  fmt.Sprintf("Videos[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VideoListValidationError{
+					field:  fmt.Sprintf("Videos[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NumberOfVideos
+
+	if all {
+		switch v := interface{}(m.GetCategories()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VideoListValidationError{
+					field:  "Categories",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VideoListValidationError{
+					field:  "Categories",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCategories()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VideoListValidationError{
+				field:  "Categories",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFacets()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VideoListValidationError{
+					field:  "Facets",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VideoListValidationError{
+					field:  "Facets",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFacets()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VideoListValidationError{
+				field:  "Facets",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for NextCursor
+
+	// no validation rules for PrevCursor
+
+	if len(errors) > 0 {
+		return VideoListMultiError(errors)
+	}
+
+	return nil
+}
+
+// VideoListMultiError is an error wrapping multiple validation errors returned
+// by VideoList.ValidateAll() if the designated constraints aren't met.
+type VideoListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VideoListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VideoListMultiError) AllErrors() []error { return m }
+
+// VideoListValidationError is the validation error returned by
+// VideoList.Validate if the designated constraints aren't met.
+type VideoListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VideoListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VideoListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VideoListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VideoListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VideoListValidationError) ErrorName() string { return "VideoListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VideoListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVideoList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VideoListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VideoListValidationError{}
+
+// Validate checks the field values on Video with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Video) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Video with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in VideoMultiError, or nil if none found.
+func (m *Video) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Video) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for VideoTitle
+
+	// no validation rules for Views
+
+	// no validation rules for Rating
+
+	// no validation rules for ThumbnailLoc
+
+	// no validation rules for VideoID
+
+	// no validation rules for AuthorName
+
+	// no validation rules for UploadDate
+
+	// no validation rules for AuthorID
+
+	// no validation rules for VideoDuration
+
+	// no validation rules for IsMature
+
+	if len(errors) > 0 {
+		return VideoMultiError(errors)
+	}
+
+	return nil
+}
+
+// VideoMultiError is an error wrapping multiple validation errors returned by
+// Video.ValidateAll() if the designated constraints aren't met.
+type VideoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VideoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VideoMultiError) AllErrors() []error { return m }
+
+// VideoValidationError is the validation error returned by Video.Validate if
+// the designated constraints aren't met.
+type VideoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VideoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VideoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VideoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VideoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VideoValidationError) ErrorName() string { return "VideoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VideoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVideo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VideoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VideoValidationError{}
+
+// Validate checks the field values on VideoRating with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VideoRating) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VideoRating with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in VideoRatingMultiError, or
+// nil if none found.
+func (m *VideoRating) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VideoRating) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserID
+
+	// no validation rules for VideoID
+
+	// no validation rules for Rating
+
+	if len(errors) > 0 {
+		return VideoRatingMultiError(errors)
+	}
+
+	return nil
+}
+
+// VideoRatingMultiError is an error wrapping multiple validation errors
+// returned by VideoRating.ValidateAll() if the designated constraints aren't met.
+type VideoRatingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VideoRatingMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VideoRatingMultiError) AllErrors() []error { return m }
+
+// VideoRatingValidationError is the validation error returned by
+// VideoRating.Validate if the designated constraints aren't met.
+type VideoRatingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VideoRatingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VideoRatingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VideoRatingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VideoRatingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VideoRatingValidationError) ErrorName() string { return "VideoRatingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VideoRatingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVideoRating.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VideoRatingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VideoRatingValidationError{}
+
+// Validate checks the field values on VideoViewing with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VideoViewing) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VideoViewing with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in VideoViewingMultiError, or
+// nil if none found.
+func (m *VideoViewing) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VideoViewing) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for VideoID
+
+	if len(errors) > 0 {
+		return VideoViewingMultiError(errors)
+	}
+
+	return nil
+}
+
+// VideoViewingMultiError is an error wrapping multiple validation errors
+// returned by VideoViewing.ValidateAll() if the designated constraints aren't met.
+type VideoViewingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VideoViewingMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VideoViewingMultiError) AllErrors() []error { return m }
+
+// VideoViewingValidationError is the validation error returned by
+// VideoViewing.Validate if the designated constraints aren't met.
+type VideoViewingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VideoViewingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VideoViewingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VideoViewingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VideoViewingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VideoViewingValidationError) ErrorName() string { return "VideoViewingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VideoViewingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVideoViewing.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VideoViewingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VideoViewingValidationError{}
+
+// Validate checks the field values on VideoApproval with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VideoApproval) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VideoApproval with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in VideoApprovalMultiError, or
+// nil if none found.
+func (m *VideoApproval) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VideoApproval) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserID
+
+	// no validation rules for VideoID
+
+	// no validation rules for Mature
+
+	if len(errors) > 0 {
+		return VideoApprovalMultiError(errors)
+	}
+
+	return nil
+}
+
+// VideoApprovalMultiError is an error wrapping multiple validation errors
+// returned by VideoApproval.ValidateAll() if the designated constraints
+// aren't met.
+type VideoApprovalMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VideoApprovalMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VideoApprovalMultiError) AllErrors() []error { return m }
+
+// VideoApprovalValidationError is the validation error returned by
+// VideoApproval.Validate if the designated constraints aren't met.
+type VideoApprovalValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VideoApprovalValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VideoApprovalValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VideoApprovalValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VideoApprovalValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VideoApprovalValidationError) ErrorName() string { return "VideoApprovalValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VideoApprovalValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVideoApproval.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VideoApprovalValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VideoApprovalValidationError{}
+
+// Validate checks the field values on VideoQueryConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *VideoQueryConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VideoQueryConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VideoQueryConfigMultiError, or nil if none found.
+func (m *VideoQueryConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VideoQueryConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderBy
+
+	// no validation rules for Direction
+
+	// no validation rules for PageNumber
+
+	// no validation rules for SearchVal
+
+	// no validation rules for FromUserID
+
+	// no validation rules for ShowUnapproved
+
+	// no validation rules for UnapprovedOnly
+
+	// no validation rules for Category
+
+	// no validation rules for ShowMature
+
+	// no validation rules for LostUpstreamOnly
+
+	// no validation rules for UploadedSince
+
+	// no validation rules for UploadedBefore
+
+	// no validation rules for OriginalUploadedSince
+
+	// no validation rules for OriginalUploadedBefore
+
+	// no validation rules for MinRating
+
+	// no validation rules for MinViews
+
+	// no validation rules for HasDanmaku
+
+	// no validation rules for Cursor
+
+	if len(errors) > 0 {
+		return VideoQueryConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// VideoQueryConfigMultiError is an error wrapping multiple validation errors
+// returned by VideoQueryConfig.ValidateAll() if the designated constraints
+// aren't met.
+type VideoQueryConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VideoQueryConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VideoQueryConfigMultiError) AllErrors() []error { return m }
+
+// VideoQueryConfigValidationError is the validation error returned by
+// VideoQueryConfig.Validate if the designated constraints aren't met.
+type VideoQueryConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VideoQueryConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VideoQueryConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VideoQueryConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VideoQueryConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VideoQueryConfigValidationError) ErrorName() string { return "VideoQueryConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VideoQueryConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVideoQueryConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VideoQueryConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VideoQueryConfigValidationError{}
+
+// Validate checks the field values on FacetCount with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FacetCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FacetCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FacetCountMultiError, or
+// nil if none found.
+func (m *FacetCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FacetCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Value
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return FacetCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// FacetCountMultiError is an error wrapping multiple validation errors
+// returned by FacetCount.ValidateAll() if the designated constraints aren't met.
+type FacetCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FacetCountMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FacetCountMultiError) AllErrors() []error { return m }
+
+// FacetCountValidationError is the validation error returned by
+// FacetCount.Validate if the designated constraints aren't met.
+type FacetCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FacetCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FacetCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FacetCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FacetCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FacetCountValidationError) ErrorName() string { return "FacetCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FacetCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFacetCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FacetCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FacetCountValidationError{}
+
+// Validate checks the field values on SearchFacets with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchFacets) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchFacets with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchFacetsMultiError, or
+// nil if none found.
+func (m *SearchFacets) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchFacets) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchFacetsValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchFacetsValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchFacetsValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDurations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchFacetsValidationError{
+						field:  fmt.Sprintf("Durations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchFacetsValidationError{
+						field:  fmt.Sprintf("Durations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchFacetsValidationError{
+					field:  fmt.Sprintf("Durations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSites() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchFacetsValidationError{
+						field:  fmt.Sprintf("Sites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchFacetsValidationError{
+						field:  fmt.Sprintf("Sites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchFacetsValidationError{
+					field:  fmt.Sprintf("Sites[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for WithDanmaku
+
+	if len(errors) > 0 {
+		return SearchFacetsMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchFacetsMultiError is an error wrapping multiple validation errors
+// returned by SearchFacets.ValidateAll() if the designated constraints aren't met.
+type SearchFacetsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchFacetsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchFacetsMultiError) AllErrors() []error { return m }
+
+// SearchFacetsValidationError is the validation error returned by
+// SearchFacets.Validate if the designated constraints aren't met.
+type SearchFacetsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchFacetsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchFacetsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchFacetsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchFacetsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchFacetsValidationError) ErrorName() string { return "SearchFacetsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchFacetsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchFacets.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchFacetsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchFacetsValidationError{}
+
+// Validate checks the field values on ForeignVideoCategory with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ForeignVideoCategory) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForeignVideoCategory with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ForeignVideoCategoryMultiError, or nil if none found.
+func (m *ForeignVideoCategory) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForeignVideoCategory) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ForeignVideoID
+
+	// no validation rules for ForeignWebsite
+
+	// no validation rules for Category
+
+	if len(errors) > 0 {
+		return ForeignVideoCategoryMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForeignVideoCategoryMultiError is an error wrapping multiple validation
+// errors returned by ForeignVideoCategory.ValidateAll() if the designated
+// constraints aren't met.
+type ForeignVideoCategoryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForeignVideoCategoryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForeignVideoCategoryMultiError) AllErrors() []error { return m }
+
+// ForeignVideoCategoryValidationError is the validation error returned by
+// ForeignVideoCategory.Validate if the designated constraints aren't met.
+type ForeignVideoCategoryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForeignVideoCategoryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForeignVideoCategoryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForeignVideoCategoryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForeignVideoCategoryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForeignVideoCategoryValidationError) ErrorName() string {
+	return "ForeignVideoCategoryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForeignVideoCategoryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForeignVideoCategory.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForeignVideoCategoryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForeignVideoCategoryValidationError{}
+
+// Validate checks the field values on VideoExistenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VideoExistenceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VideoExistenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VideoExistenceResponseMultiError, or nil if none found.
+func (m *VideoExistenceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VideoExistenceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Exists
+
+	if len(errors) > 0 {
+		return VideoExistenceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VideoExistenceResponseMultiError is an error wrapping multiple validation
+// errors returned by VideoExistenceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type VideoExistenceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VideoExistenceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VideoExistenceResponseMultiError) AllErrors() []error { return m }
+
+// VideoExistenceResponseValidationError is the validation error returned by
+// VideoExistenceResponse.Validate if the designated constraints aren't met.
+type VideoExistenceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VideoExistenceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VideoExistenceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VideoExistenceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VideoExistenceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VideoExistenceResponseValidationError) ErrorName() string {
+	return "VideoExistenceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VideoExistenceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVideoExistenceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VideoExistenceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VideoExistenceResponseValidationError{}
+
+// Validate checks the field values on ForeignVideoCheck with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ForeignVideoCheck) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForeignVideoCheck with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ForeignVideoCheckMultiError, or nil if none found.
+func (m *ForeignVideoCheck) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForeignVideoCheck) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ForeignVideoID
+
+	// no validation rules for ForeignWebsite
+
+	if len(errors) > 0 {
+		return ForeignVideoCheckMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForeignVideoCheckMultiError is an error wrapping multiple validation errors
+// returned by ForeignVideoCheck.ValidateAll() if the designated constraints
+// aren't met.
+type ForeignVideoCheckMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForeignVideoCheckMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForeignVideoCheckMultiError) AllErrors() []error { return m }
+
+// ForeignVideoCheckValidationError is the validation error returned by
+// ForeignVideoCheck.Validate if the designated constraints aren't met.
+type ForeignVideoCheckValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForeignVideoCheckValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForeignVideoCheckValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForeignVideoCheckValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForeignVideoCheckValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForeignVideoCheckValidationError) ErrorName() string {
+	return "ForeignVideoCheckValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForeignVideoCheckValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForeignVideoCheck.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForeignVideoCheckValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForeignVideoCheckValidationError{}
+
+// Validate checks the field values on VideoRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VideoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VideoRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in VideoRequestMultiError, or
+// nil if none found.
+func (m *VideoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VideoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for VideoID
+
+	// no validation rules for Offset
+
+	// no validation rules for Length
+
+	if len(errors) > 0 {
+		return VideoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VideoRequestMultiError is an error wrapping multiple validation errors
+// returned by VideoRequest.ValidateAll() if the designated constraints aren't met.
+type VideoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VideoRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VideoRequestMultiError) AllErrors() []error { return m }
+
+// VideoRequestValidationError is the validation error returned by
+// VideoRequest.Validate if the designated constraints aren't met.
+type VideoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VideoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VideoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VideoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VideoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VideoRequestValidationError) ErrorName() string { return "VideoRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VideoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVideoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VideoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VideoRequestValidationError{}
+
+// Validate checks the field values on InputVideoChunk with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *InputVideoChunk) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InputVideoChunk with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InputVideoChunkMultiError, or nil if none found.
+func (m *InputVideoChunk) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InputVideoChunk) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch m.Payload.(type) {
+
+	case *InputVideoChunk_Content:
+
+		if all {
+			switch v := interface{}(m.GetContent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InputVideoChunkValidationError{
+						field:  "Content",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InputVideoChunkValidationError{
+						field:  "Content",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InputVideoChunkValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *InputVideoChunk_Meta:
+
+		if all {
+			switch v := interface{}(m.GetMeta()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InputVideoChunkValidationError{
+						field:  "Meta",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InputVideoChunkValidationError{
+						field:  "Meta",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InputVideoChunkValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *InputVideoChunk_Rawmeta:
+
+		if all {
+			switch v := interface{}(m.GetRawmeta()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InputVideoChunkValidationError{
+						field:  "Rawmeta",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InputVideoChunkValidationError{
+						field:  "Rawmeta",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRawmeta()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InputVideoChunkValidationError{
+					field:  "Rawmeta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *InputVideoChunk_Danmaku:
+
+		if all {
+			switch v := interface{}(m.GetDanmaku()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InputVideoChunkValidationError{
+						field:  "Danmaku",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InputVideoChunkValidationError{
+						field:  "Danmaku",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDanmaku()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InputVideoChunkValidationError{
+					field:  "Danmaku",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *InputVideoChunk_Subtitle:
+
+		if all {
+			switch v := interface{}(m.GetSubtitle()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InputVideoChunkValidationError{
+						field:  "Subtitle",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InputVideoChunkValidationError{
+						field:  "Subtitle",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSubtitle()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InputVideoChunkValidationError{
+					field:  "Subtitle",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InputVideoChunkMultiError(errors)
+	}
+
+	return nil
+}
+
+// InputVideoChunkMultiError is an error wrapping multiple validation errors
+// returned by InputVideoChunk.ValidateAll() if the designated constraints
+// aren't met.
+type InputVideoChunkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InputVideoChunkMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InputVideoChunkMultiError) AllErrors() []error { return m }
+
+// InputVideoChunkValidationError is the validation error returned by
+// InputVideoChunk.Validate if the designated constraints aren't met.
+type InputVideoChunkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InputVideoChunkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InputVideoChunkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InputVideoChunkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InputVideoChunkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InputVideoChunkValidationError) ErrorName() string { return "InputVideoChunkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InputVideoChunkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInputVideoChunk.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InputVideoChunkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InputVideoChunkValidationError{}
+
+// Validate checks the field values on ResponseVideoChunk with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResponseVideoChunk) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResponseVideoChunk with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResponseVideoChunkMultiError, or nil if none found.
+func (m *ResponseVideoChunk) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResponseVideoChunk) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch m.Payload.(type) {
+
+	case *ResponseVideoChunk_Content:
+
+		if all {
+			switch v := interface{}(m.GetContent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ResponseVideoChunkValidationError{
+						field:  "Content",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ResponseVideoChunkValidationError{
+						field:  "Content",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ResponseVideoChunkValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ResponseVideoChunk_Meta:
+
+		if all {
+			switch v := interface{}(m.GetMeta()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ResponseVideoChunkValidationError{
+						field:  "Meta",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ResponseVideoChunkValidationError{
+						field:  "Meta",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ResponseVideoChunkValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ResponseVideoChunkMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResponseVideoChunkMultiError is an error wrapping multiple validation errors
+// returned by ResponseVideoChunk.ValidateAll() if the designated constraints
+// aren't met.
+type ResponseVideoChunkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResponseVideoChunkMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResponseVideoChunkMultiError) AllErrors() []error { return m }
+
+// ResponseVideoChunkValidationError is the validation error returned by
+// ResponseVideoChunk.Validate if the designated constraints aren't met.
+type ResponseVideoChunkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResponseVideoChunkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResponseVideoChunkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResponseVideoChunkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResponseVideoChunkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResponseVideoChunkValidationError) ErrorName() string {
+	return "ResponseVideoChunkValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResponseVideoChunkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResponseVideoChunk.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResponseVideoChunkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResponseVideoChunkValidationError{}
+
+// Validate checks the field values on FileContent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FileContent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FileContent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FileContentMultiError, or
+// nil if none found.
+func (m *FileContent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FileContent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return FileContentMultiError(errors)
+	}
+
+	return nil
+}
+
+// FileContentMultiError is an error wrapping multiple validation errors
+// returned by FileContent.ValidateAll() if the designated constraints aren't met.
+type FileContentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FileContentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FileContentMultiError) AllErrors() []error { return m }
+
+// FileContentValidationError is the validation error returned by
+// FileContent.Validate if the designated constraints aren't met.
+type FileContentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FileContentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FileContentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FileContentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FileContentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FileContentValidationError) ErrorName() string { return "FileContentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FileContentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFileContent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FileContentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FileContentValidationError{}
+
+// Validate checks the field values on RawMetadata with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RawMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RawMetadata with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RawMetadataMultiError, or
+// nil if none found.
+func (m *RawMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RawMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return RawMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// RawMetadataMultiError is an error wrapping multiple validation errors
+// returned by RawMetadata.ValidateAll() if the designated constraints aren't met.
+type RawMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RawMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RawMetadataMultiError) AllErrors() []error { return m }
+
+// RawMetadataValidationError is the validation error returned by
+// RawMetadata.Validate if the designated constraints aren't met.
+type RawMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RawMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RawMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RawMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RawMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RawMetadataValidationError) ErrorName() string { return "RawMetadataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RawMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRawMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RawMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RawMetadataValidationError{}
+
+// Validate checks the field values on InputFileMetadata with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *InputFileMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InputFileMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InputFileMetadataMultiError, or nil if none found.
+func (m *InputFileMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InputFileMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	// no validation rules for AuthorUID
+
+	// no validation rules for OriginalVideoLink
+
+	// no validation rules for AuthorUsername
+
+	// no validation rules for OriginalSite
 
-		if all {
-			switch v := interface{}(m.GetMeta()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, InputVideoChunkValidationError{
-						field:  "Meta",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, InputVideoChunkValidationError{
-						field:  "Meta",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return InputVideoChunkValidationError{
-					field:  "Meta",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for OriginalID
 
-	case *InputVideoChunk_Rawmeta:
+	// no validation rules for DomesticAuthorID
 
-		if all {
-			switch v := interface{}(m.GetRawmeta()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, InputVideoChunkValidationError{
-						field:  "Rawmeta",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, InputVideoChunkValidationError{
-						field:  "Rawmeta",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetRawmeta()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return InputVideoChunkValidationError{
-					field:  "Rawmeta",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Thumbnail
 
-	}
+	// no validation rules for Category
+
+	// no validation rules for OriginalUploadDate
 
 	if len(errors) > 0 {
-		return InputVideoChunkMultiError(errors)
+		return InputFileMetadataMultiError(errors)
 	}
 
 	return nil
 }
 
-// InputVideoChunkMultiError is an error wrapping multiple validation errors
-// returned by InputVideoChunk.ValidateAll() if the designated constraints
+// InputFileMetadataMultiError is an error wrapping multiple validation errors
+// returned by InputFileMetadata.ValidateAll() if the designated constraints
 // aren't met.
-type InputVideoChunkMultiError []error
+type InputFileMetadataMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InputVideoChunkMultiError) Error() string {
+func (m InputFileMetadataMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3225,11 +7698,11 @@ func (m InputVideoChunkMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InputVideoChunkMultiError) AllErrors() []error { return m }
+func (m InputFileMetadataMultiError) AllErrors() []error { return m }
 
-// InputVideoChunkValidationError is the validation error returned by
-// InputVideoChunk.Validate if the designated constraints aren't met.
-type InputVideoChunkValidationError struct {
+// InputFileMetadataValidationError is the validation error returned by
+// InputFileMetadata.Validate if the designated constraints aren't met.
+type InputFileMetadataValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3237,22 +7710,24 @@ type InputVideoChunkValidationError struct {
 }
 
 // Field function returns field value.
-func (e InputVideoChunkValidationError) Field() string { return e.field }
+func (e InputFileMetadataValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InputVideoChunkValidationError) Reason() string { return e.reason }
+func (e InputFileMetadataValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InputVideoChunkValidationError) Cause() error { return e.cause }
+func (e InputFileMetadataValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InputVideoChunkValidationError) Key() bool { return e.key }
+func (e InputFileMetadataValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InputVideoChunkValidationError) ErrorName() string { return "InputVideoChunkValidationError" }
+func (e InputFileMetadataValidationError) ErrorName() string {
+	return "InputFileMetadataValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e InputVideoChunkValidationError) Error() string {
+func (e InputFileMetadataValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3264,14 +7739,14 @@ func (e InputVideoChunkValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInputVideoChunk.%s: %s%s",
+		"invalid %sInputFileMetadata.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InputVideoChunkValidationError{}
+var _ error = InputFileMetadataValidationError{}
 
 var _ interface {
 	Field() string
@@ -3279,110 +7754,60 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InputVideoChunkValidationError{}
+} = InputFileMetadataValidationError{}
 
-// Validate checks the field values on ResponseVideoChunk with the rules
+// Validate checks the field values on ResponseFileMetadata with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ResponseVideoChunk) Validate() error {
+func (m *ResponseFileMetadata) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ResponseVideoChunk with the rules
+// ValidateAll checks the field values on ResponseFileMetadata with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ResponseVideoChunkMultiError, or nil if none found.
-func (m *ResponseVideoChunk) ValidateAll() error {
+// ResponseFileMetadataMultiError, or nil if none found.
+func (m *ResponseFileMetadata) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ResponseVideoChunk) validate(all bool) error {
+func (m *ResponseFileMetadata) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch m.Payload.(type) {
+	// no validation rules for Title
 
-	case *ResponseVideoChunk_Content:
+	// no validation rules for Description
 
-		if all {
-			switch v := interface{}(m.GetContent()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ResponseVideoChunkValidationError{
-						field:  "Content",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ResponseVideoChunkValidationError{
-						field:  "Content",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ResponseVideoChunkValidationError{
-					field:  "Content",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for AuthorUID
 
-	case *ResponseVideoChunk_Meta:
+	// no validation rules for OriginalVideoLink
 
-		if all {
-			switch v := interface{}(m.GetMeta()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ResponseVideoChunkValidationError{
-						field:  "Meta",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ResponseVideoChunkValidationError{
-						field:  "Meta",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ResponseVideoChunkValidationError{
-					field:  "Meta",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Size
 
-	}
+	// no validation rules for Sha256
+
+	// no validation rules for Offset
+
+	// no validation rules for Length
 
 	if len(errors) > 0 {
-		return ResponseVideoChunkMultiError(errors)
+		return ResponseFileMetadataMultiError(errors)
 	}
 
 	return nil
 }
 
-// ResponseVideoChunkMultiError is an error wrapping multiple validation errors
-// returned by ResponseVideoChunk.ValidateAll() if the designated constraints
-// aren't met.
-type ResponseVideoChunkMultiError []error
+// ResponseFileMetadataMultiError is an error wrapping multiple validation
+// errors returned by ResponseFileMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type ResponseFileMetadataMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ResponseVideoChunkMultiError) Error() string {
+func (m ResponseFileMetadataMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3391,11 +7816,11 @@ func (m ResponseVideoChunkMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ResponseVideoChunkMultiError) AllErrors() []error { return m }
+func (m ResponseFileMetadataMultiError) AllErrors() []error { return m }
 
-// ResponseVideoChunkValidationError is the validation error returned by
-// ResponseVideoChunk.Validate if the designated constraints aren't met.
-type ResponseVideoChunkValidationError struct {
+// ResponseFileMetadataValidationError is the validation error returned by
+// ResponseFileMetadata.Validate if the designated constraints aren't met.
+type ResponseFileMetadataValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3403,24 +7828,24 @@ type ResponseVideoChunkValidationError struct {
 }
 
 // Field function returns field value.
-func (e ResponseVideoChunkValidationError) Field() string { return e.field }
+func (e ResponseFileMetadataValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ResponseVideoChunkValidationError) Reason() string { return e.reason }
+func (e ResponseFileMetadataValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ResponseVideoChunkValidationError) Cause() error { return e.cause }
+func (e ResponseFileMetadataValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ResponseVideoChunkValidationError) Key() bool { return e.key }
+func (e ResponseFileMetadataValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ResponseVideoChunkValidationError) ErrorName() string {
-	return "ResponseVideoChunkValidationError"
+func (e ResponseFileMetadataValidationError) ErrorName() string {
+	return "ResponseFileMetadataValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ResponseVideoChunkValidationError) Error() string {
+func (e ResponseFileMetadataValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3432,14 +7857,14 @@ func (e ResponseVideoChunkValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sResponseVideoChunk.%s: %s%s",
+		"invalid %sResponseFileMetadata.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ResponseVideoChunkValidationError{}
+var _ error = ResponseFileMetadataValidationError{}
 
 var _ interface {
 	Field() string
@@ -3447,45 +7872,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ResponseVideoChunkValidationError{}
+} = ResponseFileMetadataValidationError{}
 
-// Validate checks the field values on FileContent with the rules defined in
+// Validate checks the field values on UploadResponse with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *FileContent) Validate() error {
+func (m *UploadResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FileContent with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in FileContentMultiError, or
-// nil if none found.
-func (m *FileContent) ValidateAll() error {
+// ValidateAll checks the field values on UploadResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UploadResponseMultiError,
+// or nil if none found.
+func (m *UploadResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FileContent) validate(all bool) error {
+func (m *UploadResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Data
+	// no validation rules for VideoID
 
 	if len(errors) > 0 {
-		return FileContentMultiError(errors)
+		return UploadResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// FileContentMultiError is an error wrapping multiple validation errors
-// returned by FileContent.ValidateAll() if the designated constraints aren't met.
-type FileContentMultiError []error
+// UploadResponseMultiError is an error wrapping multiple validation errors
+// returned by UploadResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UploadResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FileContentMultiError) Error() string {
+func (m UploadResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3494,11 +7920,11 @@ func (m FileContentMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FileContentMultiError) AllErrors() []error { return m }
+func (m UploadResponseMultiError) AllErrors() []error { return m }
 
-// FileContentValidationError is the validation error returned by
-// FileContent.Validate if the designated constraints aren't met.
-type FileContentValidationError struct {
+// UploadResponseValidationError is the validation error returned by
+// UploadResponse.Validate if the designated constraints aren't met.
+type UploadResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3506,22 +7932,22 @@ type FileContentValidationError struct {
 }
 
 // Field function returns field value.
-func (e FileContentValidationError) Field() string { return e.field }
+func (e UploadResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FileContentValidationError) Reason() string { return e.reason }
+func (e UploadResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FileContentValidationError) Cause() error { return e.cause }
+func (e UploadResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FileContentValidationError) Key() bool { return e.key }
+func (e UploadResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FileContentValidationError) ErrorName() string { return "FileContentValidationError" }
+func (e UploadResponseValidationError) ErrorName() string { return "UploadResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e FileContentValidationError) Error() string {
+func (e UploadResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3533,14 +7959,14 @@ func (e FileContentValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFileContent.%s: %s%s",
+		"invalid %sUploadResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FileContentValidationError{}
+var _ error = UploadResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3548,45 +7974,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FileContentValidationError{}
+} = UploadResponseValidationError{}
 
-// Validate checks the field values on RawMetadata with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *RawMetadata) Validate() error {
+// Validate checks the field values on CommentDeletionReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommentDeletionReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RawMetadata with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in RawMetadataMultiError, or
-// nil if none found.
-func (m *RawMetadata) ValidateAll() error {
+// ValidateAll checks the field values on CommentDeletionReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommentDeletionReqMultiError, or nil if none found.
+func (m *CommentDeletionReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RawMetadata) validate(all bool) error {
+func (m *CommentDeletionReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Data
+	// no validation rules for CommentID
+
+	// no validation rules for UserID
 
 	if len(errors) > 0 {
-		return RawMetadataMultiError(errors)
+		return CommentDeletionReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// RawMetadataMultiError is an error wrapping multiple validation errors
-// returned by RawMetadata.ValidateAll() if the designated constraints aren't met.
-type RawMetadataMultiError []error
+// CommentDeletionReqMultiError is an error wrapping multiple validation errors
+// returned by CommentDeletionReq.ValidateAll() if the designated constraints
+// aren't met.
+type CommentDeletionReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RawMetadataMultiError) Error() string {
+func (m CommentDeletionReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3595,11 +8024,11 @@ func (m RawMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RawMetadataMultiError) AllErrors() []error { return m }
+func (m CommentDeletionReqMultiError) AllErrors() []error { return m }
 
-// RawMetadataValidationError is the validation error returned by
-// RawMetadata.Validate if the designated constraints aren't met.
-type RawMetadataValidationError struct {
+// CommentDeletionReqValidationError is the validation error returned by
+// CommentDeletionReq.Validate if the designated constraints aren't met.
+type CommentDeletionReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3607,22 +8036,24 @@ type RawMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e RawMetadataValidationError) Field() string { return e.field }
+func (e CommentDeletionReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RawMetadataValidationError) Reason() string { return e.reason }
+func (e CommentDeletionReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RawMetadataValidationError) Cause() error { return e.cause }
+func (e CommentDeletionReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RawMetadataValidationError) Key() bool { return e.key }
+func (e CommentDeletionReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RawMetadataValidationError) ErrorName() string { return "RawMetadataValidationError" }
+func (e CommentDeletionReqValidationError) ErrorName() string {
+	return "CommentDeletionReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RawMetadataValidationError) Error() string {
+func (e CommentDeletionReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3634,14 +8065,14 @@ func (e RawMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRawMetadata.%s: %s%s",
+		"invalid %sCommentDeletionReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RawMetadataValidationError{}
+var _ error = CommentDeletionReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -3649,64 +8080,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RawMetadataValidationError{}
+} = CommentDeletionReqValidationError{}
 
-// Validate checks the field values on InputFileMetadata with the rules defined
+// Validate checks the field values on NewUploadSession with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *InputFileMetadata) Validate() error {
+func (m *NewUploadSession) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InputFileMetadata with the rules
+// ValidateAll checks the field values on NewUploadSession with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// InputFileMetadataMultiError, or nil if none found.
-func (m *InputFileMetadata) ValidateAll() error {
+// NewUploadSessionMultiError, or nil if none found.
+func (m *NewUploadSession) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InputFileMetadata) validate(all bool) error {
+func (m *NewUploadSession) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Title
-
-	// no validation rules for Description
-
-	// no validation rules for AuthorUID
-
-	// no validation rules for OriginalVideoLink
-
-	// no validation rules for AuthorUsername
-
-	// no validation rules for OriginalSite
-
-	// no validation rules for OriginalID
+	if all {
+		switch v := interface{}(m.GetMeta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NewUploadSessionValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NewUploadSessionValidationError{
+					field:  "Meta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMeta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NewUploadSessionValidationError{
+				field:  "Meta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for DomesticAuthorID
+	// no validation rules for Size
 
-	// no validation rules for Thumbnail
+	// no validation rules for Sha256
 
-	// no validation rules for Category
+	// no validation rules for UserID
 
 	if len(errors) > 0 {
-		return InputFileMetadataMultiError(errors)
+		return NewUploadSessionMultiError(errors)
 	}
 
 	return nil
 }
 
-// InputFileMetadataMultiError is an error wrapping multiple validation errors
-// returned by InputFileMetadata.ValidateAll() if the designated constraints
+// NewUploadSessionMultiError is an error wrapping multiple validation errors
+// returned by NewUploadSession.ValidateAll() if the designated constraints
 // aren't met.
-type InputFileMetadataMultiError []error
+type NewUploadSessionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InputFileMetadataMultiError) Error() string {
+func (m NewUploadSessionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3715,11 +8161,11 @@ func (m InputFileMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InputFileMetadataMultiError) AllErrors() []error { return m }
+func (m NewUploadSessionMultiError) AllErrors() []error { return m }
 
-// InputFileMetadataValidationError is the validation error returned by
-// InputFileMetadata.Validate if the designated constraints aren't met.
-type InputFileMetadataValidationError struct {
+// NewUploadSessionValidationError is the validation error returned by
+// NewUploadSession.Validate if the designated constraints aren't met.
+type NewUploadSessionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3727,24 +8173,22 @@ type InputFileMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e InputFileMetadataValidationError) Field() string { return e.field }
+func (e NewUploadSessionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InputFileMetadataValidationError) Reason() string { return e.reason }
+func (e NewUploadSessionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InputFileMetadataValidationError) Cause() error { return e.cause }
+func (e NewUploadSessionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InputFileMetadataValidationError) Key() bool { return e.key }
+func (e NewUploadSessionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InputFileMetadataValidationError) ErrorName() string {
-	return "InputFileMetadataValidationError"
-}
+func (e NewUploadSessionValidationError) ErrorName() string { return "NewUploadSessionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e InputFileMetadataValidationError) Error() string {
+func (e NewUploadSessionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3756,14 +8200,14 @@ func (e InputFileMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInputFileMetadata.%s: %s%s",
+		"invalid %sNewUploadSession.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InputFileMetadataValidationError{}
+var _ error = NewUploadSessionValidationError{}
 
 var _ interface {
 	Field() string
@@ -3771,52 +8215,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InputFileMetadataValidationError{}
+} = NewUploadSessionValidationError{}
 
-// Validate checks the field values on ResponseFileMetadata with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ResponseFileMetadata) Validate() error {
+// Validate checks the field values on UploadSession with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UploadSession) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ResponseFileMetadata with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ResponseFileMetadataMultiError, or nil if none found.
-func (m *ResponseFileMetadata) ValidateAll() error {
+// ValidateAll checks the field values on UploadSession with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UploadSessionMultiError, or
+// nil if none found.
+func (m *UploadSession) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ResponseFileMetadata) validate(all bool) error {
+func (m *UploadSession) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Title
+	// no validation rules for SessionID
 
-	// no validation rules for Description
+	// no validation rules for Offset
 
-	// no validation rules for AuthorUID
+	// no validation rules for Size
 
-	// no validation rules for OriginalVideoLink
+	// no validation rules for ExpiresAt
 
 	if len(errors) > 0 {
-		return ResponseFileMetadataMultiError(errors)
+		return UploadSessionMultiError(errors)
 	}
 
 	return nil
 }
 
-// ResponseFileMetadataMultiError is an error wrapping multiple validation
-// errors returned by ResponseFileMetadata.ValidateAll() if the designated
-// constraints aren't met.
-type ResponseFileMetadataMultiError []error
+// UploadSessionMultiError is an error wrapping multiple validation errors
+// returned by UploadSession.ValidateAll() if the designated constraints
+// aren't met.
+type UploadSessionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ResponseFileMetadataMultiError) Error() string {
+func (m UploadSessionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3825,11 +8269,11 @@ func (m ResponseFileMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ResponseFileMetadataMultiError) AllErrors() []error { return m }
+func (m UploadSessionMultiError) AllErrors() []error { return m }
 
-// ResponseFileMetadataValidationError is the validation error returned by
-// ResponseFileMetadata.Validate if the designated constraints aren't met.
-type ResponseFileMetadataValidationError struct {
+// UploadSessionValidationError is the validation error returned by
+// UploadSession.Validate if the designated constraints aren't met.
+type UploadSessionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3837,24 +8281,22 @@ type ResponseFileMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e ResponseFileMetadataValidationError) Field() string { return e.field }
+func (e UploadSessionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ResponseFileMetadataValidationError) Reason() string { return e.reason }
+func (e UploadSessionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ResponseFileMetadataValidationError) Cause() error { return e.cause }
+func (e UploadSessionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ResponseFileMetadataValidationError) Key() bool { return e.key }
+func (e UploadSessionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ResponseFileMetadataValidationError) ErrorName() string {
-	return "ResponseFileMetadataValidationError"
-}
+func (e UploadSessionValidationError) ErrorName() string { return "UploadSessionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ResponseFileMetadataValidationError) Error() string {
+func (e UploadSessionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3866,14 +8308,14 @@ func (e ResponseFileMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sResponseFileMetadata.%s: %s%s",
+		"invalid %sUploadSession.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ResponseFileMetadataValidationError{}
+var _ error = UploadSessionValidationError{}
 
 var _ interface {
 	Field() string
@@ -3881,46 +8323,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ResponseFileMetadataValidationError{}
+} = UploadSessionValidationError{}
 
-// Validate checks the field values on UploadResponse with the rules defined in
+// Validate checks the field values on UploadChunk with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *UploadResponse) Validate() error {
+func (m *UploadChunk) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UploadResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in UploadResponseMultiError,
-// or nil if none found.
-func (m *UploadResponse) ValidateAll() error {
+// ValidateAll checks the field values on UploadChunk with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UploadChunkMultiError, or
+// nil if none found.
+func (m *UploadChunk) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UploadResponse) validate(all bool) error {
+func (m *UploadChunk) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for VideoID
+	// no validation rules for SessionID
+
+	// no validation rules for UserID
+
+	// no validation rules for Offset
+
+	// no validation rules for Sha256
+
+	// no validation rules for Data
 
 	if len(errors) > 0 {
-		return UploadResponseMultiError(errors)
+		return UploadChunkMultiError(errors)
 	}
 
 	return nil
 }
 
-// UploadResponseMultiError is an error wrapping multiple validation errors
-// returned by UploadResponse.ValidateAll() if the designated constraints
-// aren't met.
-type UploadResponseMultiError []error
+// UploadChunkMultiError is an error wrapping multiple validation errors
+// returned by UploadChunk.ValidateAll() if the designated constraints aren't met.
+type UploadChunkMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UploadResponseMultiError) Error() string {
+func (m UploadChunkMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3929,11 +8378,11 @@ func (m UploadResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UploadResponseMultiError) AllErrors() []error { return m }
+func (m UploadChunkMultiError) AllErrors() []error { return m }
 
-// UploadResponseValidationError is the validation error returned by
-// UploadResponse.Validate if the designated constraints aren't met.
-type UploadResponseValidationError struct {
+// UploadChunkValidationError is the validation error returned by
+// UploadChunk.Validate if the designated constraints aren't met.
+type UploadChunkValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3941,22 +8390,22 @@ type UploadResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UploadResponseValidationError) Field() string { return e.field }
+func (e UploadChunkValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UploadResponseValidationError) Reason() string { return e.reason }
+func (e UploadChunkValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UploadResponseValidationError) Cause() error { return e.cause }
+func (e UploadChunkValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UploadResponseValidationError) Key() bool { return e.key }
+func (e UploadChunkValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UploadResponseValidationError) ErrorName() string { return "UploadResponseValidationError" }
+func (e UploadChunkValidationError) ErrorName() string { return "UploadChunkValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UploadResponseValidationError) Error() string {
+func (e UploadChunkValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3968,14 +8417,14 @@ func (e UploadResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUploadResponse.%s: %s%s",
+		"invalid %sUploadChunk.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UploadResponseValidationError{}
+var _ error = UploadChunkValidationError{}
 
 var _ interface {
 	Field() string
@@ -3983,48 +8432,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UploadResponseValidationError{}
+} = UploadChunkValidationError{}
 
-// Validate checks the field values on CommentDeletionReq with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CommentDeletionReq) Validate() error {
+// Validate checks the field values on UploadSessionReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UploadSessionReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CommentDeletionReq with the rules
+// ValidateAll checks the field values on UploadSessionReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CommentDeletionReqMultiError, or nil if none found.
-func (m *CommentDeletionReq) ValidateAll() error {
+// UploadSessionReqMultiError, or nil if none found.
+func (m *UploadSessionReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CommentDeletionReq) validate(all bool) error {
+func (m *UploadSessionReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CommentID
+	// no validation rules for SessionID
 
 	// no validation rules for UserID
 
 	if len(errors) > 0 {
-		return CommentDeletionReqMultiError(errors)
+		return UploadSessionReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// CommentDeletionReqMultiError is an error wrapping multiple validation errors
-// returned by CommentDeletionReq.ValidateAll() if the designated constraints
+// UploadSessionReqMultiError is an error wrapping multiple validation errors
+// returned by UploadSessionReq.ValidateAll() if the designated constraints
 // aren't met.
-type CommentDeletionReqMultiError []error
+type UploadSessionReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CommentDeletionReqMultiError) Error() string {
+func (m UploadSessionReqMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4033,11 +8482,11 @@ func (m CommentDeletionReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CommentDeletionReqMultiError) AllErrors() []error { return m }
+func (m UploadSessionReqMultiError) AllErrors() []error { return m }
 
-// CommentDeletionReqValidationError is the validation error returned by
-// CommentDeletionReq.Validate if the designated constraints aren't met.
-type CommentDeletionReqValidationError struct {
+// UploadSessionReqValidationError is the validation error returned by
+// UploadSessionReq.Validate if the designated constraints aren't met.
+type UploadSessionReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4045,24 +8494,22 @@ type CommentDeletionReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e CommentDeletionReqValidationError) Field() string { return e.field }
+func (e UploadSessionReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CommentDeletionReqValidationError) Reason() string { return e.reason }
+func (e UploadSessionReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CommentDeletionReqValidationError) Cause() error { return e.cause }
+func (e UploadSessionReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CommentDeletionReqValidationError) Key() bool { return e.key }
+func (e UploadSessionReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CommentDeletionReqValidationError) ErrorName() string {
-	return "CommentDeletionReqValidationError"
-}
+func (e UploadSessionReqValidationError) ErrorName() string { return "UploadSessionReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CommentDeletionReqValidationError) Error() string {
+func (e UploadSessionReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4074,14 +8521,14 @@ func (e CommentDeletionReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCommentDeletionReq.%s: %s%s",
+		"invalid %sUploadSessionReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CommentDeletionReqValidationError{}
+var _ error = UploadSessionReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -4089,4 +8536,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CommentDeletionReqValidationError{}
+} = UploadSessionReqValidationError{}
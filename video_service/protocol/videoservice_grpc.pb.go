@@ -21,6 +21,8 @@ type VideoServiceClient interface {
 	UploadVideo(ctx context.Context, opts ...grpc.CallOption) (VideoService_UploadVideoClient, error)
 	DownloadVideo(ctx context.Context, in *VideoRequest, opts ...grpc.CallOption) (VideoService_DownloadVideoClient, error)
 	ForeignVideoExists(ctx context.Context, in *ForeignVideoCheck, opts ...grpc.CallOption) (*VideoExistenceResponse, error)
+	// Changes the category of an archived video, e.g. when the scheduler's classification rules change
+	SetForeignVideoCategory(ctx context.Context, in *ForeignVideoCategory, opts ...grpc.CallOption) (*Nothing, error)
 	GetVideoList(ctx context.Context, in *VideoQueryConfig, opts ...grpc.CallOption) (*VideoList, error)
 	GetVideo(ctx context.Context, in *VideoRequest, opts ...grpc.CallOption) (*VideoMetadata, error)
 	RateVideo(ctx context.Context, in *VideoRating, opts ...grpc.CallOption) (*Nothing, error)
@@ -33,8 +35,56 @@ type VideoServiceClient interface {
 	DeleteVideo(ctx context.Context, in *VideoDeletionReq, opts ...grpc.CallOption) (*Nothing, error)
 	DeleteComment(ctx context.Context, in *CommentDeletionReq, opts ...grpc.CallOption) (*Nothing, error)
 	GetFollowFeed(ctx context.Context, in *FeedReq, opts ...grpc.CallOption) (*VideoList, error)
+	// Favorites are only listed for the user who added them
+	AddFavorite(ctx context.Context, in *FavoriteReq, opts ...grpc.CallOption) (*FavoriteStatus, error)
+	RemoveFavorite(ctx context.Context, in *FavoriteReq, opts ...grpc.CallOption) (*FavoriteStatus, error)
+	GetFavoriteStatus(ctx context.Context, in *FavoriteReq, opts ...grpc.CallOption) (*FavoriteStatus, error)
+	GetFavorites(ctx context.Context, in *FavoritesQuery, opts ...grpc.CallOption) (*VideoList, error)
+	// Playlists (mylists) are named, ordered lists of videos. Only their owners can change them. Playlists which the
+	// requesting user can't see are reported as not found.
+	CreatePlaylist(ctx context.Context, in *Playlist, opts ...grpc.CallOption) (*Playlist, error)
+	UpdatePlaylist(ctx context.Context, in *Playlist, opts ...grpc.CallOption) (*Playlist, error)
+	DeletePlaylist(ctx context.Context, in *PlaylistReq, opts ...grpc.CallOption) (*Nothing, error)
+	// Returns the playlist with its entries, in order
+	GetPlaylist(ctx context.Context, in *PlaylistReq, opts ...grpc.CallOption) (*Playlist, error)
+	// Lists a user's playlists, without their entries. Other users only see public ones.
+	ListPlaylists(ctx context.Context, in *PlaylistListReq, opts ...grpc.CallOption) (*PlaylistList, error)
+	AddPlaylistEntry(ctx context.Context, in *PlaylistEntryReq, opts ...grpc.CallOption) (*PlaylistEntry, error)
+	// Replaces the entry's note, and moves it if a position is given
+	UpdatePlaylistEntry(ctx context.Context, in *PlaylistEntryReq, opts ...grpc.CallOption) (*PlaylistEntry, error)
+	RemovePlaylistEntry(ctx context.Context, in *PlaylistEntryReq, opts ...grpc.CallOption) (*Nothing, error)
+	// Returns the entries either side of a video, so that players can go on to the next one
+	GetPlaylistNeighbours(ctx context.Context, in *PlaylistPositionReq, opts ...grpc.CallOption) (*PlaylistNeighbours, error)
+	// Tag editing: logged in users can add and remove tags, but locked tags can only be removed by the video's uploader
+	// or a moderator, who are also the ones who can lock and unlock them. Every change goes in the video's tag history,
+	// and moderators can put the tags back to how they were before any change.
+	GetTags(ctx context.Context, in *VideoTagsReq, opts ...grpc.CallOption) (*VideoTags, error)
+	AddTag(ctx context.Context, in *TagEditReq, opts ...grpc.CallOption) (*VideoTags, error)
+	RemoveTag(ctx context.Context, in *TagEditReq, opts ...grpc.CallOption) (*VideoTags, error)
+	SetTagLock(ctx context.Context, in *TagEditReq, opts ...grpc.CallOption) (*VideoTags, error)
+	RevertTags(ctx context.Context, in *TagRevertReq, opts ...grpc.CallOption) (*VideoTags, error)
+	GetTagHistory(ctx context.Context, in *TagHistoryReq, opts ...grpc.CallOption) (*TagHistory, error)
 	GetDanmaku(ctx context.Context, in *DanmakuQueryReq, opts ...grpc.CallOption) (*DanmakuList, error)
-	AddDanmaku(ctx context.Context, in *Danmaku, opts ...grpc.CallOption) (*Nothing, error)
+	// Returns the saved danmaku. Posting is rate limited per user, and messages with banned words are rejected.
+	AddDanmaku(ctx context.Context, in *Danmaku, opts ...grpc.CallOption) (*Danmaku, error)
+	DeleteDanmaku(ctx context.Context, in *DanmakuDeleteReq, opts ...grpc.CallOption) (*DanmakuList, error)
+	// Adds danmaku in bulk, e.g. from a file made with another player. Limited per import and per user per day.
+	ImportDanmaku(ctx context.Context, in *DanmakuImportReq, opts ...grpc.CallOption) (*DanmakuImportResp, error)
+	// Adds a subtitle track (SRT, ASS or WebVTT, stored as WebVTT), replacing the video's track with the same language
+	// and kind
+	UploadSubtitle(ctx context.Context, in *SubtitleUpload, opts ...grpc.CallOption) (*SubtitleTrack, error)
+	GetSubtitles(ctx context.Context, in *VideoRequest, opts ...grpc.CallOption) (*SubtitleTrackList, error)
+	DeleteSubtitle(ctx context.Context, in *SubtitleDeleteReq, opts ...grpc.CallOption) (*Nothing, error)
+	// Upstream monitoring: the scheduler claims archived videos which are due to have their original checked (leasing
+	// them so that other replicas don't check them too), then reports whether they're still there
+	ClaimUpstreamChecks(ctx context.Context, in *UpstreamCheckClaim, opts ...grpc.CallOption) (*UpstreamCheckList, error)
+	ReportUpstreamStatus(ctx context.Context, in *UpstreamStatusReport, opts ...grpc.CallOption) (*Nothing, error)
+	// Resumable uploads: create a session, append chunks to it (resuming from the session's offset after a failure),
+	// then finalize it to create the video
+	CreateUploadSession(ctx context.Context, in *NewUploadSession, opts ...grpc.CallOption) (*UploadSession, error)
+	AppendUploadChunk(ctx context.Context, opts ...grpc.CallOption) (VideoService_AppendUploadChunkClient, error)
+	GetUploadSession(ctx context.Context, in *UploadSessionReq, opts ...grpc.CallOption) (*UploadSession, error)
+	FinalizeUpload(ctx context.Context, in *UploadSessionReq, opts ...grpc.CallOption) (*UploadResponse, error)
 }
 
 type videoServiceClient struct {
@@ -120,6 +170,15 @@ func (c *videoServiceClient) ForeignVideoExists(ctx context.Context, in *Foreign
 	return out, nil
 }
 
+func (c *videoServiceClient) SetForeignVideoCategory(ctx context.Context, in *ForeignVideoCategory, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/setForeignVideoCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *videoServiceClient) GetVideoList(ctx context.Context, in *VideoQueryConfig, opts ...grpc.CallOption) (*VideoList, error) {
 	out := new(VideoList)
 	err := c.cc.Invoke(ctx, "/proto.VideoService/getVideoList", in, out, opts...)
@@ -228,6 +287,177 @@ func (c *videoServiceClient) GetFollowFeed(ctx context.Context, in *FeedReq, opt
 	return out, nil
 }
 
+func (c *videoServiceClient) AddFavorite(ctx context.Context, in *FavoriteReq, opts ...grpc.CallOption) (*FavoriteStatus, error) {
+	out := new(FavoriteStatus)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/addFavorite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) RemoveFavorite(ctx context.Context, in *FavoriteReq, opts ...grpc.CallOption) (*FavoriteStatus, error) {
+	out := new(FavoriteStatus)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/removeFavorite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetFavoriteStatus(ctx context.Context, in *FavoriteReq, opts ...grpc.CallOption) (*FavoriteStatus, error) {
+	out := new(FavoriteStatus)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/getFavoriteStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetFavorites(ctx context.Context, in *FavoritesQuery, opts ...grpc.CallOption) (*VideoList, error) {
+	out := new(VideoList)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/getFavorites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) CreatePlaylist(ctx context.Context, in *Playlist, opts ...grpc.CallOption) (*Playlist, error) {
+	out := new(Playlist)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/createPlaylist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) UpdatePlaylist(ctx context.Context, in *Playlist, opts ...grpc.CallOption) (*Playlist, error) {
+	out := new(Playlist)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/updatePlaylist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) DeletePlaylist(ctx context.Context, in *PlaylistReq, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/deletePlaylist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetPlaylist(ctx context.Context, in *PlaylistReq, opts ...grpc.CallOption) (*Playlist, error) {
+	out := new(Playlist)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/getPlaylist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ListPlaylists(ctx context.Context, in *PlaylistListReq, opts ...grpc.CallOption) (*PlaylistList, error) {
+	out := new(PlaylistList)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/listPlaylists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) AddPlaylistEntry(ctx context.Context, in *PlaylistEntryReq, opts ...grpc.CallOption) (*PlaylistEntry, error) {
+	out := new(PlaylistEntry)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/addPlaylistEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) UpdatePlaylistEntry(ctx context.Context, in *PlaylistEntryReq, opts ...grpc.CallOption) (*PlaylistEntry, error) {
+	out := new(PlaylistEntry)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/updatePlaylistEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) RemovePlaylistEntry(ctx context.Context, in *PlaylistEntryReq, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/removePlaylistEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetPlaylistNeighbours(ctx context.Context, in *PlaylistPositionReq, opts ...grpc.CallOption) (*PlaylistNeighbours, error) {
+	out := new(PlaylistNeighbours)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/getPlaylistNeighbours", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetTags(ctx context.Context, in *VideoTagsReq, opts ...grpc.CallOption) (*VideoTags, error) {
+	out := new(VideoTags)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/getTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) AddTag(ctx context.Context, in *TagEditReq, opts ...grpc.CallOption) (*VideoTags, error) {
+	out := new(VideoTags)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/addTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) RemoveTag(ctx context.Context, in *TagEditReq, opts ...grpc.CallOption) (*VideoTags, error) {
+	out := new(VideoTags)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/removeTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) SetTagLock(ctx context.Context, in *TagEditReq, opts ...grpc.CallOption) (*VideoTags, error) {
+	out := new(VideoTags)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/setTagLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) RevertTags(ctx context.Context, in *TagRevertReq, opts ...grpc.CallOption) (*VideoTags, error) {
+	out := new(VideoTags)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/revertTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetTagHistory(ctx context.Context, in *TagHistoryReq, opts ...grpc.CallOption) (*TagHistory, error) {
+	out := new(TagHistory)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/getTagHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *videoServiceClient) GetDanmaku(ctx context.Context, in *DanmakuQueryReq, opts ...grpc.CallOption) (*DanmakuList, error) {
 	out := new(DanmakuList)
 	err := c.cc.Invoke(ctx, "/proto.VideoService/GetDanmaku", in, out, opts...)
@@ -237,8 +467,8 @@ func (c *videoServiceClient) GetDanmaku(ctx context.Context, in *DanmakuQueryReq
 	return out, nil
 }
 
-func (c *videoServiceClient) AddDanmaku(ctx context.Context, in *Danmaku, opts ...grpc.CallOption) (*Nothing, error) {
-	out := new(Nothing)
+func (c *videoServiceClient) AddDanmaku(ctx context.Context, in *Danmaku, opts ...grpc.CallOption) (*Danmaku, error) {
+	out := new(Danmaku)
 	err := c.cc.Invoke(ctx, "/proto.VideoService/addDanmaku", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -246,6 +476,130 @@ func (c *videoServiceClient) AddDanmaku(ctx context.Context, in *Danmaku, opts .
 	return out, nil
 }
 
+func (c *videoServiceClient) DeleteDanmaku(ctx context.Context, in *DanmakuDeleteReq, opts ...grpc.CallOption) (*DanmakuList, error) {
+	out := new(DanmakuList)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/deleteDanmaku", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ImportDanmaku(ctx context.Context, in *DanmakuImportReq, opts ...grpc.CallOption) (*DanmakuImportResp, error) {
+	out := new(DanmakuImportResp)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/importDanmaku", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) UploadSubtitle(ctx context.Context, in *SubtitleUpload, opts ...grpc.CallOption) (*SubtitleTrack, error) {
+	out := new(SubtitleTrack)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/uploadSubtitle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) GetSubtitles(ctx context.Context, in *VideoRequest, opts ...grpc.CallOption) (*SubtitleTrackList, error) {
+	out := new(SubtitleTrackList)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/getSubtitles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) DeleteSubtitle(ctx context.Context, in *SubtitleDeleteReq, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/deleteSubtitle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ClaimUpstreamChecks(ctx context.Context, in *UpstreamCheckClaim, opts ...grpc.CallOption) (*UpstreamCheckList, error) {
+	out := new(UpstreamCheckList)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/claimUpstreamChecks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ReportUpstreamStatus(ctx context.Context, in *UpstreamStatusReport, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/reportUpstreamStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) CreateUploadSession(ctx context.Context, in *NewUploadSession, opts ...grpc.CallOption) (*UploadSession, error) {
+	out := new(UploadSession)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/createUploadSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) AppendUploadChunk(ctx context.Context, opts ...grpc.CallOption) (VideoService_AppendUploadChunkClient, error) {
+	stream, err := c.cc.NewStream(ctx, &VideoService_ServiceDesc.Streams[2], "/proto.VideoService/appendUploadChunk", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &videoServiceAppendUploadChunkClient{stream}
+	return x, nil
+}
+
+type VideoService_AppendUploadChunkClient interface {
+	Send(*UploadChunk) error
+	CloseAndRecv() (*UploadSession, error)
+	grpc.ClientStream
+}
+
+type videoServiceAppendUploadChunkClient struct {
+	grpc.ClientStream
+}
+
+func (x *videoServiceAppendUploadChunkClient) Send(m *UploadChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *videoServiceAppendUploadChunkClient) CloseAndRecv() (*UploadSession, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadSession)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *videoServiceClient) GetUploadSession(ctx context.Context, in *UploadSessionReq, opts ...grpc.CallOption) (*UploadSession, error) {
+	out := new(UploadSession)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/getUploadSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) FinalizeUpload(ctx context.Context, in *UploadSessionReq, opts ...grpc.CallOption) (*UploadResponse, error) {
+	out := new(UploadResponse)
+	err := c.cc.Invoke(ctx, "/proto.VideoService/finalizeUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VideoServiceServer is the server API for VideoService service.
 // All implementations must embed UnimplementedVideoServiceServer
 // for forward compatibility
@@ -253,6 +607,8 @@ type VideoServiceServer interface {
 	UploadVideo(VideoService_UploadVideoServer) error
 	DownloadVideo(*VideoRequest, VideoService_DownloadVideoServer) error
 	ForeignVideoExists(context.Context, *ForeignVideoCheck) (*VideoExistenceResponse, error)
+	// Changes the category of an archived video, e.g. when the scheduler's classification rules change
+	SetForeignVideoCategory(context.Context, *ForeignVideoCategory) (*Nothing, error)
 	GetVideoList(context.Context, *VideoQueryConfig) (*VideoList, error)
 	GetVideo(context.Context, *VideoRequest) (*VideoMetadata, error)
 	RateVideo(context.Context, *VideoRating) (*Nothing, error)
@@ -265,8 +621,56 @@ type VideoServiceServer interface {
 	DeleteVideo(context.Context, *VideoDeletionReq) (*Nothing, error)
 	DeleteComment(context.Context, *CommentDeletionReq) (*Nothing, error)
 	GetFollowFeed(context.Context, *FeedReq) (*VideoList, error)
+	// Favorites are only listed for the user who added them
+	AddFavorite(context.Context, *FavoriteReq) (*FavoriteStatus, error)
+	RemoveFavorite(context.Context, *FavoriteReq) (*FavoriteStatus, error)
+	GetFavoriteStatus(context.Context, *FavoriteReq) (*FavoriteStatus, error)
+	GetFavorites(context.Context, *FavoritesQuery) (*VideoList, error)
+	// Playlists (mylists) are named, ordered lists of videos. Only their owners can change them. Playlists which the
+	// requesting user can't see are reported as not found.
+	CreatePlaylist(context.Context, *Playlist) (*Playlist, error)
+	UpdatePlaylist(context.Context, *Playlist) (*Playlist, error)
+	DeletePlaylist(context.Context, *PlaylistReq) (*Nothing, error)
+	// Returns the playlist with its entries, in order
+	GetPlaylist(context.Context, *PlaylistReq) (*Playlist, error)
+	// Lists a user's playlists, without their entries. Other users only see public ones.
+	ListPlaylists(context.Context, *PlaylistListReq) (*PlaylistList, error)
+	AddPlaylistEntry(context.Context, *PlaylistEntryReq) (*PlaylistEntry, error)
+	// Replaces the entry's note, and moves it if a position is given
+	UpdatePlaylistEntry(context.Context, *PlaylistEntryReq) (*PlaylistEntry, error)
+	RemovePlaylistEntry(context.Context, *PlaylistEntryReq) (*Nothing, error)
+	// Returns the entries either side of a video, so that players can go on to the next one
+	GetPlaylistNeighbours(context.Context, *PlaylistPositionReq) (*PlaylistNeighbours, error)
+	// Tag editing: logged in users can add and remove tags, but locked tags can only be removed by the video's uploader
+	// or a moderator, who are also the ones who can lock and unlock them. Every change goes in the video's tag history,
+	// and moderators can put the tags back to how they were before any change.
+	GetTags(context.Context, *VideoTagsReq) (*VideoTags, error)
+	AddTag(context.Context, *TagEditReq) (*VideoTags, error)
+	RemoveTag(context.Context, *TagEditReq) (*VideoTags, error)
+	SetTagLock(context.Context, *TagEditReq) (*VideoTags, error)
+	RevertTags(context.Context, *TagRevertReq) (*VideoTags, error)
+	GetTagHistory(context.Context, *TagHistoryReq) (*TagHistory, error)
 	GetDanmaku(context.Context, *DanmakuQueryReq) (*DanmakuList, error)
-	AddDanmaku(context.Context, *Danmaku) (*Nothing, error)
+	// Returns the saved danmaku. Posting is rate limited per user, and messages with banned words are rejected.
+	AddDanmaku(context.Context, *Danmaku) (*Danmaku, error)
+	DeleteDanmaku(context.Context, *DanmakuDeleteReq) (*DanmakuList, error)
+	// Adds danmaku in bulk, e.g. from a file made with another player. Limited per import and per user per day.
+	ImportDanmaku(context.Context, *DanmakuImportReq) (*DanmakuImportResp, error)
+	// Adds a subtitle track (SRT, ASS or WebVTT, stored as WebVTT), replacing the video's track with the same language
+	// and kind
+	UploadSubtitle(context.Context, *SubtitleUpload) (*SubtitleTrack, error)
+	GetSubtitles(context.Context, *VideoRequest) (*SubtitleTrackList, error)
+	DeleteSubtitle(context.Context, *SubtitleDeleteReq) (*Nothing, error)
+	// Upstream monitoring: the scheduler claims archived videos which are due to have their original checked (leasing
+	// them so that other replicas don't check them too), then reports whether they're still there
+	ClaimUpstreamChecks(context.Context, *UpstreamCheckClaim) (*UpstreamCheckList, error)
+	ReportUpstreamStatus(context.Context, *UpstreamStatusReport) (*Nothing, error)
+	// Resumable uploads: create a session, append chunks to it (resuming from the session's offset after a failure),
+	// then finalize it to create the video
+	CreateUploadSession(context.Context, *NewUploadSession) (*UploadSession, error)
+	AppendUploadChunk(VideoService_AppendUploadChunkServer) error
+	GetUploadSession(context.Context, *UploadSessionReq) (*UploadSession, error)
+	FinalizeUpload(context.Context, *UploadSessionReq) (*UploadResponse, error)
 	mustEmbedUnimplementedVideoServiceServer()
 }
 
@@ -283,6 +687,9 @@ func (UnimplementedVideoServiceServer) DownloadVideo(*VideoRequest, VideoService
 func (UnimplementedVideoServiceServer) ForeignVideoExists(context.Context, *ForeignVideoCheck) (*VideoExistenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForeignVideoExists not implemented")
 }
+func (UnimplementedVideoServiceServer) SetForeignVideoCategory(context.Context, *ForeignVideoCategory) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetForeignVideoCategory not implemented")
+}
 func (UnimplementedVideoServiceServer) GetVideoList(context.Context, *VideoQueryConfig) (*VideoList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVideoList not implemented")
 }
@@ -319,12 +726,102 @@ func (UnimplementedVideoServiceServer) DeleteComment(context.Context, *CommentDe
 func (UnimplementedVideoServiceServer) GetFollowFeed(context.Context, *FeedReq) (*VideoList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFollowFeed not implemented")
 }
+func (UnimplementedVideoServiceServer) AddFavorite(context.Context, *FavoriteReq) (*FavoriteStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFavorite not implemented")
+}
+func (UnimplementedVideoServiceServer) RemoveFavorite(context.Context, *FavoriteReq) (*FavoriteStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFavorite not implemented")
+}
+func (UnimplementedVideoServiceServer) GetFavoriteStatus(context.Context, *FavoriteReq) (*FavoriteStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFavoriteStatus not implemented")
+}
+func (UnimplementedVideoServiceServer) GetFavorites(context.Context, *FavoritesQuery) (*VideoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFavorites not implemented")
+}
+func (UnimplementedVideoServiceServer) CreatePlaylist(context.Context, *Playlist) (*Playlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlaylist not implemented")
+}
+func (UnimplementedVideoServiceServer) UpdatePlaylist(context.Context, *Playlist) (*Playlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePlaylist not implemented")
+}
+func (UnimplementedVideoServiceServer) DeletePlaylist(context.Context, *PlaylistReq) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlaylist not implemented")
+}
+func (UnimplementedVideoServiceServer) GetPlaylist(context.Context, *PlaylistReq) (*Playlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlaylist not implemented")
+}
+func (UnimplementedVideoServiceServer) ListPlaylists(context.Context, *PlaylistListReq) (*PlaylistList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlaylists not implemented")
+}
+func (UnimplementedVideoServiceServer) AddPlaylistEntry(context.Context, *PlaylistEntryReq) (*PlaylistEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPlaylistEntry not implemented")
+}
+func (UnimplementedVideoServiceServer) UpdatePlaylistEntry(context.Context, *PlaylistEntryReq) (*PlaylistEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePlaylistEntry not implemented")
+}
+func (UnimplementedVideoServiceServer) RemovePlaylistEntry(context.Context, *PlaylistEntryReq) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePlaylistEntry not implemented")
+}
+func (UnimplementedVideoServiceServer) GetPlaylistNeighbours(context.Context, *PlaylistPositionReq) (*PlaylistNeighbours, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlaylistNeighbours not implemented")
+}
+func (UnimplementedVideoServiceServer) GetTags(context.Context, *VideoTagsReq) (*VideoTags, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTags not implemented")
+}
+func (UnimplementedVideoServiceServer) AddTag(context.Context, *TagEditReq) (*VideoTags, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTag not implemented")
+}
+func (UnimplementedVideoServiceServer) RemoveTag(context.Context, *TagEditReq) (*VideoTags, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTag not implemented")
+}
+func (UnimplementedVideoServiceServer) SetTagLock(context.Context, *TagEditReq) (*VideoTags, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTagLock not implemented")
+}
+func (UnimplementedVideoServiceServer) RevertTags(context.Context, *TagRevertReq) (*VideoTags, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertTags not implemented")
+}
+func (UnimplementedVideoServiceServer) GetTagHistory(context.Context, *TagHistoryReq) (*TagHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTagHistory not implemented")
+}
 func (UnimplementedVideoServiceServer) GetDanmaku(context.Context, *DanmakuQueryReq) (*DanmakuList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDanmaku not implemented")
 }
-func (UnimplementedVideoServiceServer) AddDanmaku(context.Context, *Danmaku) (*Nothing, error) {
+func (UnimplementedVideoServiceServer) AddDanmaku(context.Context, *Danmaku) (*Danmaku, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddDanmaku not implemented")
 }
+func (UnimplementedVideoServiceServer) DeleteDanmaku(context.Context, *DanmakuDeleteReq) (*DanmakuList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDanmaku not implemented")
+}
+func (UnimplementedVideoServiceServer) ImportDanmaku(context.Context, *DanmakuImportReq) (*DanmakuImportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportDanmaku not implemented")
+}
+func (UnimplementedVideoServiceServer) UploadSubtitle(context.Context, *SubtitleUpload) (*SubtitleTrack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadSubtitle not implemented")
+}
+func (UnimplementedVideoServiceServer) GetSubtitles(context.Context, *VideoRequest) (*SubtitleTrackList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubtitles not implemented")
+}
+func (UnimplementedVideoServiceServer) DeleteSubtitle(context.Context, *SubtitleDeleteReq) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubtitle not implemented")
+}
+func (UnimplementedVideoServiceServer) ClaimUpstreamChecks(context.Context, *UpstreamCheckClaim) (*UpstreamCheckList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimUpstreamChecks not implemented")
+}
+func (UnimplementedVideoServiceServer) ReportUpstreamStatus(context.Context, *UpstreamStatusReport) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportUpstreamStatus not implemented")
+}
+func (UnimplementedVideoServiceServer) CreateUploadSession(context.Context, *NewUploadSession) (*UploadSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUploadSession not implemented")
+}
+func (UnimplementedVideoServiceServer) AppendUploadChunk(VideoService_AppendUploadChunkServer) error {
+	return status.Errorf(codes.Unimplemented, "method AppendUploadChunk not implemented")
+}
+func (UnimplementedVideoServiceServer) GetUploadSession(context.Context, *UploadSessionReq) (*UploadSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUploadSession not implemented")
+}
+func (UnimplementedVideoServiceServer) FinalizeUpload(context.Context, *UploadSessionReq) (*UploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeUpload not implemented")
+}
 func (UnimplementedVideoServiceServer) mustEmbedUnimplementedVideoServiceServer() {}
 
 // UnsafeVideoServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -403,6 +900,24 @@ func _VideoService_ForeignVideoExists_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VideoService_SetForeignVideoCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForeignVideoCategory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).SetForeignVideoCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/setForeignVideoCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).SetForeignVideoCategory(ctx, req.(*ForeignVideoCategory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VideoService_GetVideoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VideoQueryConfig)
 	if err := dec(in); err != nil {
@@ -619,6 +1134,348 @@ func _VideoService_GetFollowFeed_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VideoService_AddFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).AddFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/addFavorite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).AddFavorite(ctx, req.(*FavoriteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_RemoveFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).RemoveFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/removeFavorite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).RemoveFavorite(ctx, req.(*FavoriteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetFavoriteStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetFavoriteStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/getFavoriteStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetFavoriteStatus(ctx, req.(*FavoriteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoritesQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/getFavorites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetFavorites(ctx, req.(*FavoritesQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_CreatePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Playlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).CreatePlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/createPlaylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).CreatePlaylist(ctx, req.(*Playlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_UpdatePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Playlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).UpdatePlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/updatePlaylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).UpdatePlaylist(ctx, req.(*Playlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_DeletePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaylistReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).DeletePlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/deletePlaylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).DeletePlaylist(ctx, req.(*PlaylistReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetPlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaylistReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetPlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/getPlaylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetPlaylist(ctx, req.(*PlaylistReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ListPlaylists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaylistListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListPlaylists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/listPlaylists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListPlaylists(ctx, req.(*PlaylistListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_AddPlaylistEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaylistEntryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).AddPlaylistEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/addPlaylistEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).AddPlaylistEntry(ctx, req.(*PlaylistEntryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_UpdatePlaylistEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaylistEntryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).UpdatePlaylistEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/updatePlaylistEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).UpdatePlaylistEntry(ctx, req.(*PlaylistEntryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_RemovePlaylistEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaylistEntryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).RemovePlaylistEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/removePlaylistEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).RemovePlaylistEntry(ctx, req.(*PlaylistEntryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetPlaylistNeighbours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaylistPositionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetPlaylistNeighbours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/getPlaylistNeighbours",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetPlaylistNeighbours(ctx, req.(*PlaylistPositionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VideoTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/getTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetTags(ctx, req.(*VideoTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_AddTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagEditReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).AddTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/addTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).AddTag(ctx, req.(*TagEditReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_RemoveTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagEditReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).RemoveTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/removeTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).RemoveTag(ctx, req.(*TagEditReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_SetTagLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagEditReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).SetTagLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/setTagLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).SetTagLock(ctx, req.(*TagEditReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_RevertTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagRevertReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).RevertTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/revertTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).RevertTags(ctx, req.(*TagRevertReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetTagHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetTagHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/getTagHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetTagHistory(ctx, req.(*TagHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VideoService_GetDanmaku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DanmakuQueryReq)
 	if err := dec(in); err != nil {
@@ -655,6 +1512,212 @@ func _VideoService_AddDanmaku_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VideoService_DeleteDanmaku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DanmakuDeleteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).DeleteDanmaku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/deleteDanmaku",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).DeleteDanmaku(ctx, req.(*DanmakuDeleteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ImportDanmaku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DanmakuImportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ImportDanmaku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/importDanmaku",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ImportDanmaku(ctx, req.(*DanmakuImportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_UploadSubtitle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubtitleUpload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).UploadSubtitle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/uploadSubtitle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).UploadSubtitle(ctx, req.(*SubtitleUpload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_GetSubtitles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetSubtitles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/getSubtitles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetSubtitles(ctx, req.(*VideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_DeleteSubtitle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubtitleDeleteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).DeleteSubtitle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/deleteSubtitle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).DeleteSubtitle(ctx, req.(*SubtitleDeleteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ClaimUpstreamChecks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpstreamCheckClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ClaimUpstreamChecks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/claimUpstreamChecks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ClaimUpstreamChecks(ctx, req.(*UpstreamCheckClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ReportUpstreamStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpstreamStatusReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ReportUpstreamStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/reportUpstreamStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ReportUpstreamStatus(ctx, req.(*UpstreamStatusReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_CreateUploadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewUploadSession)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).CreateUploadSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/createUploadSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).CreateUploadSession(ctx, req.(*NewUploadSession))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_AppendUploadChunk_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(VideoServiceServer).AppendUploadChunk(&videoServiceAppendUploadChunkServer{stream})
+}
+
+type VideoService_AppendUploadChunkServer interface {
+	SendAndClose(*UploadSession) error
+	Recv() (*UploadChunk, error)
+	grpc.ServerStream
+}
+
+type videoServiceAppendUploadChunkServer struct {
+	grpc.ServerStream
+}
+
+func (x *videoServiceAppendUploadChunkServer) SendAndClose(m *UploadSession) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *videoServiceAppendUploadChunkServer) Recv() (*UploadChunk, error) {
+	m := new(UploadChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _VideoService_GetUploadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).GetUploadSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/getUploadSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).GetUploadSession(ctx, req.(*UploadSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_FinalizeUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).FinalizeUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VideoService/finalizeUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).FinalizeUpload(ctx, req.(*UploadSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VideoService_ServiceDesc is the grpc.ServiceDesc for VideoService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -666,6 +1729,10 @@ var VideoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "foreignVideoExists",
 			Handler:    _VideoService_ForeignVideoExists_Handler,
 		},
+		{
+			MethodName: "setForeignVideoCategory",
+			Handler:    _VideoService_SetForeignVideoCategory_Handler,
+		},
 		{
 			MethodName: "getVideoList",
 			Handler:    _VideoService_GetVideoList_Handler,
@@ -714,6 +1781,82 @@ var VideoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFollowFeed",
 			Handler:    _VideoService_GetFollowFeed_Handler,
 		},
+		{
+			MethodName: "addFavorite",
+			Handler:    _VideoService_AddFavorite_Handler,
+		},
+		{
+			MethodName: "removeFavorite",
+			Handler:    _VideoService_RemoveFavorite_Handler,
+		},
+		{
+			MethodName: "getFavoriteStatus",
+			Handler:    _VideoService_GetFavoriteStatus_Handler,
+		},
+		{
+			MethodName: "getFavorites",
+			Handler:    _VideoService_GetFavorites_Handler,
+		},
+		{
+			MethodName: "createPlaylist",
+			Handler:    _VideoService_CreatePlaylist_Handler,
+		},
+		{
+			MethodName: "updatePlaylist",
+			Handler:    _VideoService_UpdatePlaylist_Handler,
+		},
+		{
+			MethodName: "deletePlaylist",
+			Handler:    _VideoService_DeletePlaylist_Handler,
+		},
+		{
+			MethodName: "getPlaylist",
+			Handler:    _VideoService_GetPlaylist_Handler,
+		},
+		{
+			MethodName: "listPlaylists",
+			Handler:    _VideoService_ListPlaylists_Handler,
+		},
+		{
+			MethodName: "addPlaylistEntry",
+			Handler:    _VideoService_AddPlaylistEntry_Handler,
+		},
+		{
+			MethodName: "updatePlaylistEntry",
+			Handler:    _VideoService_UpdatePlaylistEntry_Handler,
+		},
+		{
+			MethodName: "removePlaylistEntry",
+			Handler:    _VideoService_RemovePlaylistEntry_Handler,
+		},
+		{
+			MethodName: "getPlaylistNeighbours",
+			Handler:    _VideoService_GetPlaylistNeighbours_Handler,
+		},
+		{
+			MethodName: "getTags",
+			Handler:    _VideoService_GetTags_Handler,
+		},
+		{
+			MethodName: "addTag",
+			Handler:    _VideoService_AddTag_Handler,
+		},
+		{
+			MethodName: "removeTag",
+			Handler:    _VideoService_RemoveTag_Handler,
+		},
+		{
+			MethodName: "setTagLock",
+			Handler:    _VideoService_SetTagLock_Handler,
+		},
+		{
+			MethodName: "revertTags",
+			Handler:    _VideoService_RevertTags_Handler,
+		},
+		{
+			MethodName: "getTagHistory",
+			Handler:    _VideoService_GetTagHistory_Handler,
+		},
 		{
 			MethodName: "GetDanmaku",
 			Handler:    _VideoService_GetDanmaku_Handler,
@@ -722,6 +1865,46 @@ var VideoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "addDanmaku",
 			Handler:    _VideoService_AddDanmaku_Handler,
 		},
+		{
+			MethodName: "deleteDanmaku",
+			Handler:    _VideoService_DeleteDanmaku_Handler,
+		},
+		{
+			MethodName: "importDanmaku",
+			Handler:    _VideoService_ImportDanmaku_Handler,
+		},
+		{
+			MethodName: "uploadSubtitle",
+			Handler:    _VideoService_UploadSubtitle_Handler,
+		},
+		{
+			MethodName: "getSubtitles",
+			Handler:    _VideoService_GetSubtitles_Handler,
+		},
+		{
+			MethodName: "deleteSubtitle",
+			Handler:    _VideoService_DeleteSubtitle_Handler,
+		},
+		{
+			MethodName: "claimUpstreamChecks",
+			Handler:    _VideoService_ClaimUpstreamChecks_Handler,
+		},
+		{
+			MethodName: "reportUpstreamStatus",
+			Handler:    _VideoService_ReportUpstreamStatus_Handler,
+		},
+		{
+			MethodName: "createUploadSession",
+			Handler:    _VideoService_CreateUploadSession_Handler,
+		},
+		{
+			MethodName: "getUploadSession",
+			Handler:    _VideoService_GetUploadSession_Handler,
+		},
+		{
+			MethodName: "finalizeUpload",
+			Handler:    _VideoService_FinalizeUpload_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -734,6 +1917,11 @@ var VideoService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _VideoService_DownloadVideo_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "appendUploadChunk",
+			Handler:       _VideoService_AppendUploadChunk_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "videoservice.proto",
 }
@@ -12,9 +12,17 @@ cd /tmp
 # ${1}_audio.m4a#audio:id=${1}_128k:role=main \
 # -out ${1}.mpd
 
+# Usage: manifest.sh NAME RENDITION...
+# Packages the audio and every rendition once, and writes both a DASH manifest (NAME.mpd) and an HLS master playlist
+# (NAME.m3u8) which reference the same media files.
+# Videos packaged before the HLS master existed have an HLS master playlist at NAME.mpd instead.
+
+STREAMS=("in=${1}_audio.m4a,stream=audio,output=${1}_audio.m4a,playlist_name=${1}_audio.m3u8,hls_group_id=audio,hls_name=audio")
+for RENDITION in "${@:2}"; do
+  STREAMS+=("in=${1}_${RENDITION}.mp4,stream=video,output=${1}_${RENDITION}.mp4,playlist_name=${1}_video_${RENDITION}.m3u8")
+done
+
 packager \
-  in=${1}_audio.m4a,stream=audio,output=${1}_audio.m4a,playlist_name=${1}_audio.m3u8 \
-  in=${1}_360p.mp4,stream=video,output=${1}_360p.mp4,playlist_name=${1}_video_360p.m3u8 \
-  in=${1}_720p.mp4,stream=video,output=${1}_720p.mp4,playlist_name=${1}_video_720p.m3u8 \
-  --hls_master_playlist_output ${1}.mpd
-  # so i know it's not an mpd but i have a hard dependency on this file extension LOL
+  "${STREAMS[@]}" \
+  --mpd_output ${1}.mpd \
+  --hls_master_playlist_output ${1}.m3u8
@@ -14,33 +14,26 @@ set -e -x -o pipefail -u
 # -g is keyframe interval length
 # so I believe this would determine the distance between iframes?
 
-# This script transcodes the input video to multiple different qualities
-# In the future, there should be smarter, more customizable behavior here.
-# E.g. if the input video is 360p, there's no need to output a version at 1080p.
-
-# H264_DASH_PARAMS="-r 24 -x264opts keyint=48:min-keyint=48:no-scenecut -movflags faststart -preset medium -profile:v main -threads 24"
-# COMMON_VIDEO_ARGS="-keyint_min 240 -g 240 -threads 8"
-
-# TODO: two-pass encoding/various other parameter tweaks
-# See: https://trac.ffmpeg.org/wiki/Encode/VP9
-
-# I'm a little unsure about the CRF values, even though this is what google recommends
-# TODO: improve understanding of CRF in the context of targeted avg video bitrate
-# CRF is maximum quantization, so I think it'd set an upper bound on compression within a set of frames?
-# If CRF was lower, I think it'd be the case that the bitrate would tend to be higher
-# But how does the targeted bitrate come into play here? :thinking:
-# https://developers.google.com/media/vp9/bitrate-modes#compression helpful
-#
-
-# TODO: improve understanding of two-pass encoding
-
-H264_DASH_PARAMS="-r 24 -x264opts keyint=48:min-keyint=48:no-scenecut -movflags +faststart -preset slow -crf 23  -profile:v main -threads 8"
-
-# 360p video
-ffmpeg -i ${1} -c:v libx264 -vf "scale=480:360" ${H264_DASH_PARAMS} -an ${1}_360p.mp4
-
-# 720p video
-ffmpeg -i ${1} -c:v libx264 -vf "scale=1280:720" ${H264_DASH_PARAMS} -an  ${1}_720p.mp4
-
-# TODO: --strict -2
-ffmpeg -i ${1} -ac 2 -c:a aac -ar 48000 -b:a 128k -vn -strict -2 ${1}_audio.m4a
+# This script transcodes the input video to a single rendition of the bitrate ladder, and is called once per rendition.
+# Usage: transcode.sh INPUT NAME HEIGHT BITRATE CODEC
+# The ladder itself is configured in the video service (see dashutils.ParseLadder), which also skips renditions
+# which are taller than the input. The scale filter never upscales regardless.
+
+INPUT=${1}
+NAME=${2}
+HEIGHT=${3}
+BITRATE=${4}
+CODEC=${5}
+
+# Keyframes every 2 seconds, with no scenecut keyframes, so that segments line up across renditions
+DASH_PARAMS="-r 24 -g 48 -keyint_min 48 -sc_threshold 0 -movflags +faststart -preset slow -threads 8"
+# Capped VBR, so the bandwidth advertised in the manifests is roughly accurate
+RATE_PARAMS="-b:v ${BITRATE} -maxrate ${BITRATE} -bufsize ${BITRATE}"
+
+CODEC_PARAMS=""
+if [ "${CODEC}" == "libx264" ]; then
+  CODEC_PARAMS="-profile:v main -pix_fmt yuv420p"
+fi
+
+# -2 keeps the aspect ratio with an even width
+ffmpeg -y -i ${INPUT} -c:v ${CODEC} -vf "scale=-2:'min(${HEIGHT},ih)'" ${DASH_PARAMS} ${RATE_PARAMS} ${CODEC_PARAMS} -an ${INPUT}_${NAME}.mp4
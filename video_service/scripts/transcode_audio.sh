@@ -0,0 +1,8 @@
+#!/bin/bash
+set -e -x -o pipefail -u
+
+# Transcodes the audio track, which is shared by every rendition
+# Usage: transcode_audio.sh INPUT
+
+# TODO: --strict -2
+ffmpeg -y -i ${1} -ac 2 -c:a aac -ar 48000 -b:a 128k -vn -strict -2 ${1}_audio.m4a
@@ -2,9 +2,7 @@ package storage
 
 import (
 	"context"
-	"errors"
 	"io"
-	"os"
 
 	"github.com/kurin/blazer/b2"
 )
@@ -28,6 +26,7 @@ I modified some of the source code used in Blazer's README when writing this fil
 
 Summary of changes:
 - April 3, 2021: made some small changes to to the source examples to get the code to comply with my storage interface
+- Ported to the streaming ObjectStorage interface
 
 */
 
@@ -52,41 +51,91 @@ func NewB2(backblazeID, backblazeAPIKey, bucketname string) (*B2Storage, error)
 
 }
 
-func (s *B2Storage) Fetch(id string) (*os.File, error) {
-	r := s.Bucket.Object(id).NewReader(context.Background())
-	defer r.Close()
+func b2Err(err error) error {
+	if b2.IsNotExist(err) {
+		return ErrNotFound
+	}
+	return err
+}
 
-	f, err := os.Create(uploadDir + id)
-	if err != nil {
-		return nil, err
+func b2Info(key string, attrs *b2.Attrs) ObjectInfo {
+	modTime := attrs.LastModified
+	if modTime.IsZero() {
+		modTime = attrs.UploadTimestamp
 	}
 
-	r.ConcurrentDownloads = 1
-	if _, err := io.Copy(f, r); err != nil {
-		f.Close()
-		return nil, err
+	return ObjectInfo{
+		Key:         key,
+		Size:        attrs.Size,
+		ContentType: attrs.ContentType,
+		ModTime:     modTime,
 	}
+}
 
-	return f, nil
+func (s *B2Storage) Open(ctx context.Context, key string) (io.ReadCloser, ObjectInfo, error) {
+	return s.OpenRange(ctx, key, 0, 0)
 }
 
-func (s *B2Storage) Upload(path, desiredFilename string) error {
-	f, err := os.Open(path)
+func (s *B2Storage) OpenRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, ObjectInfo, error) {
+	obj := s.Bucket.Object(key)
+
+	// The reader doesn't report the object's attributes, and fails lazily, so check that it exists first
+	attrs, err := obj.Attrs(ctx)
 	if err != nil {
-		return err
+		return nil, ObjectInfo{}, b2Err(err)
+	}
+
+	if length <= 0 {
+		length = attrs.Size - offset
 	}
-	defer f.Close()
 
-	obj := s.Bucket.Object(desiredFilename)
-	w := obj.NewWriter(context.Background())
-	if _, err := io.Copy(w, f); err != nil {
+	r := obj.NewRangeReader(ctx, offset, length)
+	r.ConcurrentDownloads = 1
+	return r, b2Info(key, attrs), nil
+}
+
+func (s *B2Storage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	r, contentType = withContentType(r, contentType)
+
+	w := s.Bucket.Object(key).NewWriter(ctx, b2.WithAttrsOption(&b2.Attrs{ContentType: contentType}))
+	if _, err := io.Copy(w, r); err != nil {
 		w.Close()
 		return err
 	}
 	return w.Close()
 }
 
-// TODO: Not implemented
-func (s *B2Storage) Delete(filename string) error {
-	return errors.New("Not implemented") // there's no easy delete operation
+func (s *B2Storage) Stat(ctx context.Context, key string) (ObjectInfo, error) {
+	attrs, err := s.Bucket.Object(key).Attrs(ctx)
+	if err != nil {
+		return ObjectInfo{}, b2Err(err)
+	}
+
+	return b2Info(key, attrs), nil
+}
+
+func (s *B2Storage) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	var ret []ObjectInfo
+
+	iter := s.Bucket.List(ctx, b2.ListPrefix(prefix))
+	for iter.Next() {
+		obj := iter.Object()
+		attrs, err := obj.Attrs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		ret = append(ret, b2Info(obj.Name(), attrs))
+	}
+
+	return ret, iter.Err()
+}
+
+// Delete removes the latest version of the object, which is all we ever write
+func (s *B2Storage) Delete(ctx context.Context, key string) error {
+	err := s.Bucket.Object(key).Delete(ctx)
+	if b2.IsNotExist(err) {
+		return nil
+	}
+	return err
 }
@@ -0,0 +1,31 @@
+package storage
+
+import "fmt"
+
+// Config describes a backend. Which fields are used depends on the backend.
+type Config struct {
+	// Backend is one of b2, s3 or fs
+	Backend  string
+	Bucket   string
+	Endpoint string
+	APIID    string
+	APIKey   string
+	// Root is the directory used by the fs backend
+	Root string
+}
+
+func New(c Config) (ObjectStorage, error) {
+	switch c.Backend {
+	case "b2":
+		return NewB2(c.APIID, c.APIKey, c.Bucket)
+	case "s3":
+		if c.Endpoint == "" {
+			return NewS3(c.Bucket)
+		}
+		return NewS3Endpoint(c.Bucket, c.Endpoint, c.APIID, c.APIKey)
+	case "fs":
+		return NewFilesystem(c.Root)
+	default:
+		return nil, fmt.Errorf("Unknown storage backend %s", c.Backend)
+	}
+}
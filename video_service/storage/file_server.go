@@ -0,0 +1,159 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Files in FilesystemStorage are served with signed URLs of the form /files/{expiry}/{signature}/{key}.
+// Manifests and playlists refer to the rest of the video's files by relative paths, so the signature covers a group of keys
+// (everything starting with the video's UUID) rather than a single key. Otherwise the player couldn't fetch the segments.
+// The original video and its raw metadata are never served this way, since only some users may download them.
+
+const FilesPrefix = "/files/"
+
+var (
+	ErrURLExpired          = errors.New("url has expired")
+	ErrURLInvalidSignature = errors.New("url signature is invalid")
+)
+
+type URLSigner struct {
+	Secret []byte
+	TTL    time.Duration
+}
+
+func NewURLSigner(secret string, ttl time.Duration) (*URLSigner, error) {
+	if len(secret) < 32 {
+		return nil, errors.New("the file signing key must be at least 32 characters")
+	}
+
+	return &URLSigner{Secret: []byte(secret), TTL: ttl}, nil
+}
+
+// keyGroup returns the part of the key which the signature covers, e.g. the UUID of
+// 8a6e4b42-....mpd, 8a6e4b42-..._720p.mp4 and 8a6e4b42-....thumb.
+// It returns false for the original (the bare UUID) and its raw metadata (UUID.json), which can't be signed.
+func keyGroup(key string) (string, bool) {
+	i := strings.IndexAny(key, "_.")
+	if i == -1 || filepath.Ext(key) == ".json" {
+		return "", false
+	}
+	return key[:i], true
+}
+
+func (u *URLSigner) signature(expires int64, group string) string {
+	mac := hmac.New(sha256.New, u.Secret)
+	fmt.Fprintf(mac, "%d/%s", expires, group)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Sign returns a signed path for the key, without a leading slash (like the locations stored for videos)
+func (u *URLSigner) Sign(key string) string {
+	return u.SignAt(key, time.Now())
+}
+
+func (u *URLSigner) SignAt(key string, now time.Time) string {
+	// Rounded, so that URLs for the same video are stable for a while and can be cached
+	expires := now.Add(u.TTL).Truncate(time.Minute).Add(time.Minute).Unix()
+
+	sig := "unsignable"
+	if group, ok := keyGroup(key); ok {
+		sig = u.signature(expires, group)
+	} else {
+		log.Errorf("Refusing to sign private key %s", key)
+	}
+
+	return fmt.Sprintf("%s%d/%s/%s", strings.TrimPrefix(FilesPrefix, "/"), expires, sig, key)
+}
+
+// Verify parses a signed path, and returns the key if the signature is valid and hasn't expired
+func (u *URLSigner) Verify(path string, now time.Time) (string, error) {
+	spl := strings.SplitN(strings.TrimPrefix(path, FilesPrefix), "/", 3)
+	if len(spl) != 3 {
+		return "", ErrURLInvalidSignature
+	}
+
+	expires, err := strconv.ParseInt(spl[0], 10, 64)
+	if err != nil {
+		return "", ErrURLInvalidSignature
+	}
+
+	group, ok := keyGroup(spl[2])
+	if !ok || !hmac.Equal([]byte(spl[1]), []byte(u.signature(expires, group))) {
+		return "", ErrURLInvalidSignature
+	}
+
+	if now.Unix() > expires {
+		return "", ErrURLExpired
+	}
+
+	return spl[2], nil
+}
+
+var contentTypes = map[string]string{
+	".mpd":   "application/dash+xml",
+	".m3u8":  "application/vnd.apple.mpegurl",
+	".mp4":   "video/mp4",
+	".m4a":   "audio/mp4",
+	".thumb": "image/jpeg",
+	".vtt":   "text/vtt; charset=utf-8",
+}
+
+// FileServer serves files from FilesystemStorage to anyone holding a valid signed URL. Range requests are supported.
+type FileServer struct {
+	Storage *FilesystemStorage
+	Signer  *URLSigner
+}
+
+func (f FileServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	key, err := f.Signer.Verify(r.URL.Path, time.Now())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	path, err := f.Storage.path(key)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Errorf("Could not open %s. Err: %s", path, err)
+		}
+		http.NotFound(w, r)
+		return
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil || stat.IsDir() {
+		http.NotFound(w, r)
+		return
+	}
+
+	if contentType, ok := contentTypes[filepath.Ext(key)]; ok {
+		w.Header().Set("Content-Type", contentType)
+	}
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+
+	http.ServeContent(w, r, key, stat.ModTime(), file)
+}
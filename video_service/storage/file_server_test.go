@@ -0,0 +1,86 @@
+package storage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSigningKey = "0123456789abcdef0123456789abcdef"
+
+func TestURLSigner(t *testing.T) {
+	signer, err := NewURLSigner(testSigningKey, time.Hour)
+	assert.NoError(t, err)
+
+	now := time.Now()
+	signed := signer.SignAt("8a6e4b42-1d2c.mpd", now)
+	assert.True(t, strings.HasPrefix(signed, "files/"))
+
+	key, err := signer.Verify("/"+signed, now)
+	assert.NoError(t, err)
+	assert.Equal(t, "8a6e4b42-1d2c.mpd", key)
+
+	// Files referenced by the manifest share its signature
+	segment := strings.Replace("/"+signed, ".mpd", "_720p.mp4", 1)
+	key, err = signer.Verify(segment, now)
+	assert.NoError(t, err)
+	assert.Equal(t, "8a6e4b42-1d2c_720p.mp4", key)
+
+	// But the original and its raw metadata don't
+	for _, private := range []string{"8a6e4b42-1d2c", "8a6e4b42-1d2c.json"} {
+		_, err = signer.Verify(strings.Replace("/"+signed, "8a6e4b42-1d2c.mpd", private, 1), now)
+		assert.ErrorIs(t, err, ErrURLInvalidSignature, private)
+
+		_, err = signer.Verify("/"+signer.SignAt(private, now), now)
+		assert.ErrorIs(t, err, ErrURLInvalidSignature, private)
+	}
+
+	other := strings.Replace("/"+signed, "8a6e4b42-1d2c", "8a6e4b42-ffff", 1)
+	_, err = signer.Verify(other, now)
+	assert.ErrorIs(t, err, ErrURLInvalidSignature)
+
+	_, err = signer.Verify("/"+signed, now.Add(2*time.Hour))
+	assert.ErrorIs(t, err, ErrURLExpired)
+
+	_, err = NewURLSigner("short", time.Hour)
+	assert.Error(t, err)
+}
+
+func TestFileServer(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "video")
+	assert.NoError(t, os.WriteFile(src, []byte("0123456789"), 0644))
+
+	fs, err := NewFilesystem(t.TempDir())
+	assert.NoError(t, err)
+	assert.NoError(t, PutFile(context.Background(), fs, src, "8a6e4b42.mpd"))
+	assert.Error(t, PutFile(context.Background(), fs, src, "../escape"))
+
+	signer, err := NewURLSigner(testSigningKey, time.Hour)
+	assert.NoError(t, err)
+	server := FileServer{Storage: fs, Signer: signer}
+
+	req := httptest.NewRequest(http.MethodGet, "/"+signer.Sign("8a6e4b42.mpd"), nil)
+	req.Header.Set("Range", "bytes=2-4")
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusPartialContent, rec.Code)
+	assert.Equal(t, "234", rec.Body.String())
+	assert.Equal(t, "application/dash+xml", rec.Header().Get("Content-Type"))
+
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/files/0/bogus/8a6e4b42.mpd", nil))
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	assert.NoError(t, fs.Delete(context.Background(), "8a6e4b42.mpd"))
+	assert.NoError(t, fs.Delete(context.Background(), "8a6e4b42.mpd"))
+	rec = httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/"+signer.Sign("8a6e4b42.mpd"), nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
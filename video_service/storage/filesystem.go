@@ -0,0 +1,171 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FilesystemStorage stores files in a local directory, so that the whole pipeline can run without an object store.
+// Files are served to viewers by FileServer.
+type FilesystemStorage struct {
+	Root string
+}
+
+func NewFilesystem(root string) (*FilesystemStorage, error) {
+	err := os.MkdirAll(root, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FilesystemStorage{Root: root}, nil
+}
+
+// path returns the location of a file in the tree. Keys are flat filenames, so anything which could escape the root is rejected.
+func (s *FilesystemStorage) path(key string) (string, error) {
+	if key == "" || key != filepath.Base(key) || key == "." || key == ".." || strings.HasPrefix(key, ".") {
+		return "", fmt.Errorf("invalid key %q", key)
+	}
+
+	return filepath.Join(s.Root, key), nil
+}
+
+func (s *FilesystemStorage) info(key string, stat os.FileInfo) ObjectInfo {
+	return ObjectInfo{
+		Key:         key,
+		Size:        stat.Size(),
+		ContentType: contentTypes[filepath.Ext(key)],
+		ModTime:     stat.ModTime(),
+	}
+}
+
+func (s *FilesystemStorage) Open(ctx context.Context, key string) (io.ReadCloser, ObjectInfo, error) {
+	return s.OpenRange(ctx, key, 0, 0)
+}
+
+func (s *FilesystemStorage) OpenRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, ObjectInfo, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, ObjectInfo{}, err
+	}
+
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, ObjectInfo{}, ErrNotFound
+	} else if err != nil {
+		return nil, ObjectInfo{}, err
+	}
+
+	stat, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, ObjectInfo{}, err
+	}
+
+	if length <= 0 {
+		length = stat.Size() - offset
+	}
+
+	return readCloser{Reader: io.NewSectionReader(f, offset, length), Closer: f}, s.info(key, stat), nil
+}
+
+// Put writes to a temporary file and renames it into place, so readers never see a partially written file.
+// The content type isn't stored, it's inferred from the key's extension instead.
+func (s *FilesystemStorage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	dst, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	// Hidden, so that it can't be requested through the file server
+	tmp, err := os.CreateTemp(s.Root, ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // no-op after the rename
+
+	n, err := io.Copy(tmp, r)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if n != size {
+		tmp.Close()
+		return fmt.Errorf("expected %d bytes for %s but got %d", size, key, n)
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), dst)
+}
+
+func (s *FilesystemStorage) Stat(ctx context.Context, key string) (ObjectInfo, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+
+	stat, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return ObjectInfo{}, ErrNotFound
+	} else if err != nil {
+		return ObjectInfo{}, err
+	}
+
+	return s.info(key, stat), nil
+}
+
+func (s *FilesystemStorage) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	entries, err := os.ReadDir(s.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []ObjectInfo
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || strings.HasPrefix(name, ".") || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+
+		stat, err := entry.Info()
+		if os.IsNotExist(err) {
+			// Deleted since the directory was read
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		ret = append(ret, s.info(name, stat))
+	}
+
+	return ret, nil
+}
+
+func (s *FilesystemStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,69 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilesystemStorage(t *testing.T) {
+	ctx := context.Background()
+	fs, err := NewFilesystem(t.TempDir())
+	assert.NoError(t, err)
+
+	assert.NoError(t, fs.Put(ctx, "8a6e4b42", strings.NewReader("0123456789"), 10, ""))
+	assert.NoError(t, fs.Put(ctx, "8a6e4b42.thumb", strings.NewReader("thumb"), 5, ""))
+	assert.NoError(t, fs.Put(ctx, "ffffffff", strings.NewReader("other"), 5, ""))
+	assert.Error(t, fs.Put(ctx, "short", strings.NewReader("abc"), 10, ""))
+
+	r, info, err := fs.OpenRange(ctx, "8a6e4b42", 3, 4)
+	assert.NoError(t, err)
+	data, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	assert.NoError(t, r.Close())
+	assert.Equal(t, "3456", string(data))
+	assert.Equal(t, int64(10), info.Size)
+
+	r, _, err = fs.OpenRange(ctx, "8a6e4b42", 7, 0)
+	assert.NoError(t, err)
+	data, _ = io.ReadAll(r)
+	r.Close()
+	assert.Equal(t, "789", string(data))
+
+	info, err = fs.Stat(ctx, "8a6e4b42.thumb")
+	assert.NoError(t, err)
+	assert.Equal(t, "image/jpeg", info.ContentType)
+
+	objs, err := fs.List(ctx, "8a6e4b42")
+	assert.NoError(t, err)
+	var keys []string
+	for _, obj := range objs {
+		keys = append(keys, obj.Key)
+	}
+	assert.ElementsMatch(t, []string{"8a6e4b42", "8a6e4b42.thumb"}, keys)
+
+	_, _, err = fs.Open(ctx, "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = fs.Stat(ctx, "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestWithContentType(t *testing.T) {
+	png := []byte("\x89PNG\x0D\x0A\x1A\x0A rest of the image")
+
+	// Seekers are rewound, everything else is buffered, and nothing is lost either way
+	for _, r := range []io.Reader{bytes.NewReader(png), io.MultiReader(bytes.NewReader(png))} {
+		r, contentType := withContentType(r, "")
+		assert.Equal(t, "image/png", contentType)
+		data, err := io.ReadAll(r)
+		assert.NoError(t, err)
+		assert.Equal(t, png, data)
+	}
+
+	_, contentType := withContentType(bytes.NewReader(png), "video/mp4")
+	assert.Equal(t, "video/mp4", contentType)
+}
@@ -0,0 +1,91 @@
+package storage
+
+import (
+	"context"
+	"io"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Replicated writes through to a secondary backend, and reads from the primary.
+// The primary is the source of truth: failures to write to the secondary are logged rather than returned, and can be
+// repaired by running migrate_storage against it.
+type Replicated struct {
+	Primary   ObjectStorage
+	Secondary ObjectStorage
+}
+
+func NewReplicated(primary, secondary ObjectStorage) *Replicated {
+	return &Replicated{Primary: primary, Secondary: secondary}
+}
+
+func (s *Replicated) Open(ctx context.Context, key string) (io.ReadCloser, ObjectInfo, error) {
+	return s.Primary.Open(ctx, key)
+}
+
+func (s *Replicated) OpenRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, ObjectInfo, error) {
+	return s.Primary.OpenRange(ctx, key, offset, length)
+}
+
+func (s *Replicated) Stat(ctx context.Context, key string) (ObjectInfo, error) {
+	return s.Primary.Stat(ctx, key)
+}
+
+func (s *Replicated) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	return s.Primary.List(ctx, prefix)
+}
+
+// droppingWriter stops writing after the first error instead of failing, so that a broken secondary can't fail the primary
+type droppingWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (d *droppingWriter) Write(p []byte) (int, error) {
+	if d.err == nil {
+		_, d.err = d.w.Write(p)
+	}
+	return len(p), nil
+}
+
+// Put streams the data to both backends at once, so it's only read once
+func (s *Replicated) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	r, contentType = withContentType(r, contentType)
+
+	pr, pw := io.Pipe()
+	secondaryErr := make(chan error, 1)
+	go func() {
+		err := s.Secondary.Put(ctx, key, pr, size, contentType)
+		// Unblock the primary if the secondary gave up early
+		pr.CloseWithError(err)
+		secondaryErr <- err
+	}()
+
+	dw := &droppingWriter{w: pw}
+	err := s.Primary.Put(ctx, key, io.TeeReader(r, dw), size, contentType)
+	if err != nil {
+		pw.CloseWithError(err)
+	} else {
+		pw.Close()
+	}
+
+	if replErr := <-secondaryErr; replErr != nil && err == nil {
+		log.Errorf("Could not replicate %s to the secondary storage. Err: %s", key, replErr)
+	}
+
+	return err
+}
+
+func (s *Replicated) Delete(ctx context.Context, key string) error {
+	err := s.Primary.Delete(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	replErr := s.Secondary.Delete(ctx, key)
+	if replErr != nil {
+		log.Errorf("Could not delete %s from the secondary storage. Err: %s", key, replErr)
+	}
+
+	return nil
+}
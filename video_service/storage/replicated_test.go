@@ -0,0 +1,40 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplicated(t *testing.T) {
+	ctx := context.Background()
+	primary, err := NewFilesystem(t.TempDir())
+	assert.NoError(t, err)
+	secondary, err := NewFilesystem(t.TempDir())
+	assert.NoError(t, err)
+
+	s := NewReplicated(primary, secondary)
+	assert.NoError(t, s.Put(ctx, "aaaa", strings.NewReader("0123456789"), 10, ""))
+
+	for _, backend := range []ObjectStorage{primary, secondary} {
+		r, _, err := backend.Open(ctx, "aaaa")
+		assert.NoError(t, err)
+		data, _ := io.ReadAll(r)
+		r.Close()
+		assert.Equal(t, "0123456789", string(data))
+	}
+
+	// A failing secondary doesn't fail the write
+	broken := NewReplicated(primary, &FilesystemStorage{Root: filepath.Join(t.TempDir(), "missing")})
+	assert.NoError(t, broken.Put(ctx, "bbbb", strings.NewReader("0123456789"), 10, ""))
+	_, err = primary.Stat(ctx, "bbbb")
+	assert.NoError(t, err)
+
+	assert.NoError(t, s.Delete(ctx, "aaaa"))
+	_, err = secondary.Stat(ctx, "aaaa")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -2,17 +2,20 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
-	"os"
+	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
-	log "github.com/sirupsen/logrus"
 )
 
 const uploadDir = "/tmp/"
@@ -59,113 +62,130 @@ func NewS3Endpoint(bucketName string, endpoint string, apiID string, apiKey stri
 	return &S3Storage{S3Client: *s3Client, BucketName: bucketName}, nil
 }
 
-// FIXME: can probably rewrite a significant portion of this. Too long and complicated!
-func (s *S3Storage) Fetch(id string) (*os.File, error) {
+func isS3NotFound(err error) bool {
+	var noSuchKey *types.NoSuchKey
+	var respErr *awshttp.ResponseError
+	return errors.As(err, &noSuchKey) || (errors.As(err, &respErr) && respErr.HTTPStatusCode() == http.StatusNotFound)
+}
+
+func (s *S3Storage) Open(ctx context.Context, key string) (io.ReadCloser, ObjectInfo, error) {
+	return s.OpenRange(ctx, key, 0, 0)
+}
+
+func (s *S3Storage) OpenRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, ObjectInfo, error) {
 	getReq := &s3.GetObjectInput{
 		Bucket: &s.BucketName,
-		Key:    &id,
+		Key:    &key,
 	}
 
-	res, err := s.S3Client.GetObject(context.Background(), getReq)
-	if err != nil {
-		return nil, err
+	if offset > 0 || length > 0 {
+		rng := fmt.Sprintf("bytes=%d-", offset)
+		if length > 0 {
+			rng += strconv.FormatInt(offset+length-1, 10)
+		}
+		getReq.Range = &rng
 	}
-	defer res.Body.Close()
 
-	f, err := os.OpenFile(uploadDir+id, os.O_APPEND|os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0644)
-	if err != nil {
-		return nil, err
+	res, err := s.S3Client.GetObject(ctx, getReq)
+	if isS3NotFound(err) {
+		return nil, ObjectInfo{}, ErrNotFound
+	} else if err != nil {
+		return nil, ObjectInfo{}, err
 	}
 
-loop:
-	for true {
-		buf := make([]byte, 1024*1024*1)
-		n, err := res.Body.Read(buf)
-
-		switch {
-		case n == 0 && err == io.EOF:
-			break loop
-		case err != io.EOF && err != nil:
-			err = fmt.Errorf("could not read from S3 for transcoding. Err: %s", err)
-			log.Error(err)
-			f.Close()
-			return nil, err
-		}
-
-		// Truncate
-		buf = buf[:n]
+	info := ObjectInfo{
+		Key:         key,
+		Size:        res.ContentLength,
+		ContentType: aws.ToString(res.ContentType),
+		ModTime:     aws.ToTime(res.LastModified),
+	}
 
-		_, err = f.Write(buf)
-		if err != nil {
-			f.Close()
-			return nil, err
+	// e.g. bytes 0-99/1234
+	if res.ContentRange != nil {
+		if i := strings.LastIndex(*res.ContentRange, "/"); i != -1 {
+			size, err := strconv.ParseInt((*res.ContentRange)[i+1:], 10, 64)
+			if err == nil {
+				info.Size = size
+			}
 		}
 	}
 
-	return f, nil
+	return res.Body, info, nil
 }
 
-func (s *S3Storage) Upload(path, desiredFilename string) error {
-	data, err := os.Open(path)
-	if err != nil {
-		return err
+func (s *S3Storage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	r, contentType = withContentType(r, contentType)
+
+	putObjInp := s3.PutObjectInput{
+		ACL:           "public-read",
+		Body:          r,
+		Bucket:        &s.BucketName,
+		ContentLength: size,
+		ContentType:   &contentType,
+		Key:           &key,
+		StorageClass:  types.StorageClass(s.StorageClass),
 	}
 
-	// Damn it! TODO
-	mimeTypeData, err := io.ReadAll(data)
-	if err != nil {
-		return err
+	var opts []func(*s3.Options)
+	// The payload can't be hashed for the signature without reading it twice
+	if _, ok := r.(io.ReadSeeker); !ok {
+		opts = append(opts, s3.WithAPIOptions(v4.SwapComputePayloadSHA256ForUnsignedPayloadMiddleware))
 	}
 
-	mimeType := http.DetectContentType(mimeTypeData)
+	_, err := s.S3Client.PutObject(ctx, &putObjInp, opts...)
+	return err
+}
 
-	_, err = data.Seek(0, 0)
-	if err != nil {
-		return err
+func (s *S3Storage) Stat(ctx context.Context, key string) (ObjectInfo, error) {
+	res, err := s.S3Client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: &s.BucketName,
+		Key:    &key,
+	})
+	if isS3NotFound(err) {
+		return ObjectInfo{}, ErrNotFound
+	} else if err != nil {
+		return ObjectInfo{}, err
 	}
 
-	putObjInp := s3.PutObjectInput{
-		ACL:                       "public-read",
-		Body:                      data,
-		Bucket:                    &s.BucketName,
-		CacheControl:              nil,
-		ContentDisposition:        nil,
-		ContentEncoding:           nil,
-		ContentLanguage:           nil,
-		ContentMD5:                nil,
-		ContentType:               &mimeType, // TODO
-		Expires:                   nil,
-		GrantFullControl:          nil,
-		GrantRead:                 nil,
-		GrantReadACP:              nil,
-		GrantWriteACP:             nil,
-		Key:                       &desiredFilename,
-		Metadata:                  nil,
-		ObjectLockLegalHoldStatus: "",
-		ObjectLockMode:            "",
-		ObjectLockRetainUntilDate: nil,
-		RequestPayer:              "",
-		SSECustomerAlgorithm:      nil,
-		SSECustomerKey:            nil,
-		SSECustomerKeyMD5:         nil,
-		SSEKMSEncryptionContext:   nil,
-		SSEKMSKeyId:               nil,
-		ServerSideEncryption:      "",
-		StorageClass:              types.StorageClass(s.StorageClass),
-		Tagging:                   nil,
-		WebsiteRedirectLocation:   nil,
+	return ObjectInfo{
+		Key:         key,
+		Size:        res.ContentLength,
+		ContentType: aws.ToString(res.ContentType),
+		ModTime:     aws.ToTime(res.LastModified),
+	}, nil
+}
+
+func (s *S3Storage) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	var ret []ObjectInfo
+
+	pages := s3.NewListObjectsV2Paginator(&s.S3Client, &s3.ListObjectsV2Input{
+		Bucket: &s.BucketName,
+		Prefix: &prefix,
+	})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, obj := range page.Contents {
+			ret = append(ret, ObjectInfo{
+				Key:     aws.ToString(obj.Key),
+				Size:    obj.Size,
+				ModTime: aws.ToTime(obj.LastModified),
+			})
+		}
 	}
 
-	_, err = s.S3Client.PutObject(context.TODO(), &putObjInp)
-	return err // TODO
+	return ret, nil
 }
 
-func (s *S3Storage) Delete(filename string) error {
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
 	deleteObjInp := s3.DeleteObjectInput{
 		Bucket: &s.BucketName,
-		Key:    &filename,
+		Key:    &key,
 	}
 
-	_, err := s.S3Client.DeleteObject(context.TODO(), &deleteObjInp)
+	_, err := s.S3Client.DeleteObject(ctx, &deleteObjInp)
 	return err
 }
@@ -1,9 +1,104 @@
 package storage
 
-import "os"
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+)
 
-type Storage interface {
-	Fetch(path string) (*os.File, error)
-	Upload(path, desiredFilename string) error
-	Delete(filename string) error
+// ErrNotFound is returned by ObjectStorage if the key doesn't exist
+var ErrNotFound = errors.New("object not found")
+
+type ObjectInfo struct {
+	Key         string
+	Size        int64
+	ContentType string
+	ModTime     time.Time
+}
+
+// ObjectStorage streams objects to and from a backend, so that nothing has to be spooled to local disk unless the
+// caller really needs a file (e.g. ffmpeg).
+type ObjectStorage interface {
+	// Open returns the whole object. The caller must close it.
+	Open(ctx context.Context, key string) (io.ReadCloser, ObjectInfo, error)
+	// OpenRange returns length bytes starting at offset, or the rest of the object if length is 0.
+	// ObjectInfo.Size is the size of the whole object.
+	OpenRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, ObjectInfo, error)
+	// Put stores size bytes from r. If contentType is empty, it's detected from the data.
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Stat(ctx context.Context, key string) (ObjectInfo, error)
+	// List returns the objects whose keys start with prefix
+	List(ctx context.Context, prefix string) ([]ObjectInfo, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// FetchFile copies the object into the upload directory, for the things which can only work with files.
+// The file is named after the key, and it's up to the caller to close and remove it.
+func FetchFile(ctx context.Context, s ObjectStorage, key string) (*os.File, error) {
+	r, _, err := s.Open(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	f, err := os.OpenFile(uploadDir+key, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, fmt.Errorf("could not fetch %s. Err: %s", key, err)
+	}
+
+	return f, nil
+}
+
+// PutFile stores the file at path under key
+func PutFile(ctx context.Context, s ObjectStorage, path, key string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	stat, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	return s.Put(ctx, key, f, stat.Size(), "")
+}
+
+// withContentType detects the content type from the start of r if it isn't known, without consuming any of it
+func withContentType(r io.Reader, contentType string) (io.Reader, string) {
+	if contentType != "" {
+		return r, contentType
+	}
+
+	// Peeking at a seeker would lose the ability to seek, which some backends need
+	if rs, ok := r.(io.ReadSeeker); ok {
+		if pos, err := rs.Seek(0, io.SeekCurrent); err == nil {
+			buf := make([]byte, 512)
+			n, _ := io.ReadFull(rs, buf)
+			if _, err := rs.Seek(pos, io.SeekStart); err == nil {
+				return rs, http.DetectContentType(buf[:n])
+			}
+		}
+	}
+
+	br := bufio.NewReaderSize(r, 512)
+	buf, _ := br.Peek(512)
+	return br, http.DetectContentType(buf)
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
 }